@@ -36,11 +36,13 @@ func init() {
 	accountCreateTokenCmd.Flags().BoolVar(&flagAccount.Scratch, "scratch", false, "Create a scratch token account")
 	accountCreateDataCmd.Flags().BoolVar(&flagAccount.Scratch, "scratch", false, "Create a scratch data account")
 	accountCreateDataCmd.Flags().BoolVar(&flagAccount.Lite, "lite", false, "Create a lite data account")
+	accountGetCmd.Flags().Uint64Var(&flagAccount.AtBlock, "at-block", 0, "Get the state of the account as of the given block (requires an archive node)")
 }
 
 var flagAccount = struct {
 	Lite    bool
 	Scratch bool
+	AtBlock uint64
 }{}
 
 var accountCmd = &cobra.Command{
@@ -150,7 +152,7 @@ var accountListCmd = &cobra.Command{
 
 var accountRestoreCmd = &cobra.Command{
 	Use:   "restore",
-	Short: "Restore old lite token accounts",
+	Short: "Restore old lite token accounts and rediscover lite token accounts derived from the mnemonic",
 	Args:  cobra.NoArgs,
 	Run: func(cmd *cobra.Command, _ []string) {
 		out, err := RestoreAccounts()
@@ -159,7 +161,7 @@ var accountRestoreCmd = &cobra.Command{
 }
 
 func GetAccount(url string) (string, error) {
-	res, err := GetUrl(url)
+	res, err := GetUrlAt(url, flagAccount.AtBlock)
 	if err != nil {
 		return "", err
 	}
@@ -194,7 +196,7 @@ func QrAccount(s string) (string, error) {
 	return string(r), err
 }
 
-//CreateAccount account create url labelOrPubKeyHex height index tokenUrl keyBookUrl
+// CreateAccount account create url labelOrPubKeyHex height index tokenUrl keyBookUrl
 func CreateAccount(cmd *cobra.Command, origin string, args []string) (string, error) {
 	u, err := url2.Parse(origin)
 	if err != nil {
@@ -292,7 +294,20 @@ func ListAccounts() (string, error) {
 	return out, nil
 }
 
-func RestoreAccounts() (out string, err error) {
+func RestoreAccounts() (string, error) {
+	out, err := restoreLegacyAccounts()
+	if err != nil {
+		return out, err
+	}
+
+	derived, err := restoreDerivedAccounts()
+	out += derived
+	return out, err
+}
+
+// restoreLegacyAccounts converts lite accounts stored by old versions of the
+// wallet.
+func restoreLegacyAccounts() (out string, err error) {
 	anon, err := Db.GetBucket(BucketAnon)
 	if err == nil {
 		for _, v := range anon.KeyValueList {
@@ -336,7 +351,7 @@ func RestoreAccounts() (out string, err error) {
 	labelz, err := Db.GetBucket(BucketLabel)
 	if err != nil {
 		//nothing to do...
-		return
+		return out, nil
 	}
 	for _, v := range labelz.KeyValueList {
 		label, isLite := LabelForLiteTokenAccount(string(v.Key))
@@ -359,6 +374,19 @@ func RestoreAccounts() (out string, err error) {
 				if err != nil {
 					return "", err
 				}
+
+				//and move the derivation path, if there is one
+				hdPath, err := Db.Get(BucketDerivation, []byte(bogusLiteLabel))
+				if err == nil {
+					err = Db.Put(BucketDerivation, []byte(label), hdPath)
+					if err != nil {
+						return "", err
+					}
+					err = Db.Delete(BucketDerivation, []byte(bogusLiteLabel))
+					if err != nil {
+						return "", err
+					}
+				}
 			} else {
 				//ok so it does exist, now need to know if public key is the same, it is
 				//an error if they don't match so warn user
@@ -379,3 +407,125 @@ func RestoreAccounts() (out string, err error) {
 	}
 	return out, nil
 }
+
+// restoreDerivedAccounts regenerates the keys derived from the mnemonic seed
+// and restores those whose lite ACME account exists on the network. Keys
+// derived by old versions of the wallet, which did not use derivation paths,
+// are restored as well. For each key type and derivation scheme, scanning
+// stops after hdGapLimit consecutive indexes without an account.
+func restoreDerivedAccounts() (out string, err error) {
+	seed, err := lookupSeed()
+	if err != nil {
+		//no seed, nothing to derive
+		return "", nil
+	}
+
+	for _, keyType := range []protocol.SignatureType{protocol.SignatureTypeED25519, protocol.SignatureTypeSECP256K1} {
+		restored, next, err := scanDerivedAccounts(func(i uint32) ([]byte, derivationPath, error) {
+			hdPath := walletDerivationPath(i)
+			privKey, err := derivePrivateKey(seed, keyType, hdPath)
+			return privKey, hdPath, err
+		})
+		out += restored
+		if err != nil {
+			return out, err
+		}
+
+		//make sure new keys are not derived from indexes that are in use
+		if next > getKeyCount(keyType) {
+			err = setKeyCount(keyType, next)
+			if err != nil {
+				return out, err
+			}
+		}
+
+		//legacy keys do not have a derivation path, and new keys are never
+		//derived the legacy way, so they do not affect the key count
+		restored, _, err = scanDerivedAccounts(func(i uint32) ([]byte, derivationPath, error) {
+			privKey, err := deriveLegacyPrivateKey(seed, keyType, i)
+			return privKey, nil, err
+		})
+		out += restored
+		if err != nil {
+			return out, err
+		}
+	}
+
+	return out, nil
+}
+
+// scanDerivedAccounts restores the keys returned by derive, starting from
+// index 0, whose lite ACME account exists on the network. Scanning stops after
+// hdGapLimit consecutive indexes without an account. scanDerivedAccounts
+// returns the index after the last one with an account.
+func scanDerivedAccounts(derive func(uint32) ([]byte, derivationPath, error)) (out string, next uint32, err error) {
+	for i, gap := uint32(0), 0; gap < hdGapLimit; i++ {
+		privKey, hdPath, err := derive(i)
+		if err != nil {
+			return out, next, err
+		}
+
+		pubKey := privKey[32:]
+		u, err := protocol.LiteTokenAddress(pubKey, protocol.AcmeUrl().String())
+		if err != nil {
+			return out, next, err
+		}
+
+		found, err := liteAccountExists(u)
+		if err != nil {
+			return out, next, err
+		}
+		if !found {
+			gap++
+			continue
+		}
+		gap = 0
+		next = i + 1
+
+		//skip keys that are already in the wallet
+		_, err = LookupByPubKey(pubKey)
+		if err == nil {
+			continue
+		}
+
+		label, _ := LabelForLiteTokenAccount(u.String())
+		if hdPath == nil {
+			out += fmt.Sprintf("Restoring %s : %x (legacy index %d)\n", label, pubKey, i)
+		} else {
+			out += fmt.Sprintf("Restoring %s : %x (%v)\n", label, pubKey, hdPath)
+		}
+
+		err = Db.Put(BucketKeys, pubKey, privKey)
+		if err != nil {
+			return out, next, err
+		}
+		err = Db.Put(BucketLabel, []byte(label), pubKey)
+		if err != nil {
+			return out, next, err
+		}
+		if hdPath != nil {
+			err = Db.Put(BucketDerivation, []byte(label), []byte(hdPath.String()))
+			if err != nil {
+				return out, next, err
+			}
+		}
+	}
+
+	return out, next, nil
+}
+
+// liteAccountExists queries the network for the lite account.
+func liteAccountExists(u *url2.URL) (bool, error) {
+	params := api.UrlQuery{Url: u}
+	res := new(api.ChainQueryResponse)
+	err := Client.RequestAPIv2(context.Background(), "query", &params, res)
+	if err == nil {
+		return true, nil
+	}
+
+	var rpcErr jsonrpc2.Error
+	if errors.As(err, &rpcErr) && rpcErr.Code == api.ErrCodeNotFound {
+		return false, nil
+	}
+	return false, err
+}
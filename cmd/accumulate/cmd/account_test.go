@@ -1,11 +1,14 @@
 package cmd
 
 import (
+	"crypto/ed25519"
 	"encoding/json"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tyler-smith/go-bip32"
+	"gitlab.com/accumulatenetwork/accumulate/cmd/accumulate/db"
 	"gitlab.com/accumulatenetwork/accumulate/internal/api/v2"
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
 )
@@ -15,6 +18,8 @@ func init() {
 	testMatrix.addTest(testCase3_1)
 	testMatrix.addTest(testCase3_2)
 	testMatrix.addTest(testCase3_3)
+	testMatrix.addTest(testCase6_1)
+	testMatrix.addTest(testCase6_2)
 }
 
 //testCase1_1 Generate 100 lite account addresses in cli
@@ -73,6 +78,84 @@ func testCase3_3(t *testing.T, tc *testCmd) {
 
 }
 
+//testCase6_1
+//Restore the lite accounts derived from the mnemonic into an empty wallet,
+//should restore every account that was fauceted
+func testCase6_1(t *testing.T, tc *testCmd) {
+	t.Helper()
+
+	wallet, database := Wallet, Db
+	defer func() { Wallet, Db = wallet, database }()
+	Wallet = db.NewEncryptedDB(initDB(t.TempDir(), true))
+	Db = Wallet
+
+	_, err := tc.execute(t, "key import mnemonic yellow yellow yellow yellow yellow yellow yellow yellow yellow yellow yellow yellow")
+	require.NoError(t, err)
+
+	r, err := tc.execute(t, "account restore")
+	require.NoError(t, err)
+	t.Log(r)
+
+	for i, account := range liteAccounts {
+		label, _ := LabelForLiteTokenAccount(account)
+		_, err := LookupByLabel(label)
+		require.NoErrorf(t, err, "%s was not restored", account)
+
+		hdPath, err := Db.Get(BucketDerivation, []byte(label))
+		require.NoError(t, err)
+		require.Equal(t, walletDerivationPath(uint32(i)).String(), string(hdPath))
+	}
+
+	//new accounts are not derived from indexes that are in use
+	require.Equal(t, uint32(len(liteAccounts)), getKeyCount(protocol.SignatureTypeED25519))
+
+	//restoring again does nothing
+	r, err = tc.execute(t, "account restore")
+	require.NoError(t, err)
+	require.NotContains(t, r, "Restoring")
+}
+
+//testCase6_2
+//Restore a lite account whose key was derived by an old version of the wallet,
+//should restore the account without a derivation path
+func testCase6_2(t *testing.T, tc *testCmd) {
+	t.Helper()
+
+	wallet, database := Wallet, Db
+	defer func() { Wallet, Db = wallet, database }()
+	Wallet = db.NewEncryptedDB(initDB(t.TempDir(), true))
+	Db = Wallet
+
+	_, err := tc.execute(t, "key import mnemonic yellow yellow yellow yellow yellow yellow yellow yellow yellow yellow yellow yellow")
+	require.NoError(t, err)
+
+	//old versions of the wallet used the BIP32 child key as the ED25519 seed
+	seed, err := lookupSeed()
+	require.NoError(t, err)
+	masterKey, err := bip32.NewMasterKey(seed)
+	require.NoError(t, err)
+	childKey, err := masterKey.NewChildKey(3)
+	require.NoError(t, err)
+	privKey := ed25519.NewKeyFromSeed(childKey.Key)
+	account, err := protocol.LiteTokenAddress(privKey[32:], protocol.AcmeUrl().String())
+	require.NoError(t, err)
+
+	_, err = tc.executeTx(t, "faucet %s", account)
+	require.NoError(t, err)
+
+	r, err := tc.execute(t, "account restore")
+	require.NoError(t, err)
+	t.Log(r)
+
+	label, _ := LabelForLiteTokenAccount(account.String())
+	restored, err := LookupByLabel(label)
+	require.NoErrorf(t, err, "%s was not restored", account)
+	require.Equal(t, []byte(privKey), restored)
+
+	_, err = Db.Get(BucketDerivation, []byte(label))
+	require.ErrorIs(t, err, db.ErrNotFound)
+}
+
 //testGetBalance helper function to get the balance of a token account
 func testGetBalance(t *testing.T, tc *testCmd, accountUrl string) (string, error) {
 	//now query the account to make sure each account has 10 acme.
@@ -181,6 +181,7 @@ func NewADIFromADISigner(origin *url2.URL, args []string) (string, error) {
 	idc := protocol.CreateIdentity{}
 	idc.Url = u
 	idc.PublicKey = pubKey
+	idc.KeyType = keySpecType(pubKey)
 	idc.KeyBookName = book
 	idc.KeyPageName = page
 
@@ -28,9 +28,18 @@ var creditsCmd = &cobra.Command{
 	},
 }
 
+func init() {
+	creditsCmd.Flags().BoolVar(&flagCredits.Acme, "acme", false, "Spend the given amount of ACME instead of buying the given amount of credits")
+}
+
+var flagCredits = struct {
+	Acme bool
+}{}
+
 func PrintCredits() {
 	fmt.Println("  accumulate credits [origin lite token account] [lite token account or key page url] [amount] 		Send credits using a lite token account or adi key page to another lite token account or adi key page")
 	fmt.Println("  accumulate credits [origin url] [origin key name] [key index (optional)] [key height (optional)] [key page or lite account url] [amount] 		Send credits to another lite token account or adi key page")
+	fmt.Println("  accumulate credits --acme ... [amount] 		Spend the given amount of ACME on credits instead of buying the given amount of credits")
 }
 
 func AddCredits(origin string, args []string) (string, error) {
@@ -54,14 +63,21 @@ func AddCredits(origin string, args []string) (string, error) {
 		return "", err
 	}
 
-	amt, err := strconv.ParseFloat(args[1], 64)
-	if err != nil {
-		return "", fmt.Errorf("amount must be an integer %v", err)
-	}
-
 	credits := protocol.AddCredits{}
 	credits.Recipient = u2
-	credits.Amount = uint64(amt * protocol.CreditPrecision)
+	if flagCredits.Acme {
+		amt, err := amountToBigInt(protocol.AcmeUrl().String(), args[1])
+		if err != nil {
+			return "", err
+		}
+		credits.AcmeAmount = *amt
+	} else {
+		amt, err := strconv.ParseFloat(args[1], 64)
+		if err != nil {
+			return "", fmt.Errorf("amount must be an integer %v", err)
+		}
+		credits.Amount = uint64(amt * protocol.CreditPrecision)
+	}
 
 	res, err := dispatchTxRequest("add-credits", &credits, nil, u, si, privKey)
 	if err != nil {
@@ -67,9 +67,11 @@ var getCmd = &cobra.Command{
 }
 
 var GetDirect bool
+var GetAtBlock uint64
 
 func init() {
 	getCmd.Flags().BoolVar(&GetDirect, "direct", false, "Use debug-query-direct instead of query")
+	getCmd.Flags().Uint64Var(&GetAtBlock, "at-block", 0, "Get the state of the account as of the given block (requires an archive node)")
 }
 
 func PrintGet() {
@@ -102,8 +104,9 @@ func Get(urlStr string) (string, error) {
 		return "", err
 	}
 
-	params := api2.UrlQuery{}
+	params := api2.GeneralQuery{}
 	params.Url = u
+	params.Height = GetAtBlock
 
 	method := "query"
 	if GetDirect {
@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tyler-smith/go-bip32"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+)
+
+// Wallet keys are derived from the mnemonic seed along
+// m/44'/281'/0'/0'/<index>', where 281 is the SLIP-0044 coin type registered
+// for Accumulate. ED25519 keys follow SLIP-0010, which only supports hardened
+// derivation, so every level of the path is hardened.
+const (
+	hdPurpose  = 44
+	hdCoinType = 281
+)
+
+// hdGapLimit is the number of consecutive unused derivation indexes account
+// restore will scan before it gives up.
+const hdGapLimit = 20
+
+// derivationPath is a list of BIP32 child indexes.
+type derivationPath []uint32
+
+// walletDerivationPath returns the derivation path of the key with the given
+// index.
+func walletDerivationPath(index uint32) derivationPath {
+	return derivationPath{
+		hdPurpose + bip32.FirstHardenedChild,
+		hdCoinType + bip32.FirstHardenedChild,
+		bip32.FirstHardenedChild,
+		bip32.FirstHardenedChild,
+		index + bip32.FirstHardenedChild,
+	}
+}
+
+// parseDerivationPath parses a path of the form m/44'/281'/0'/0'/0'.
+func parseDerivationPath(s string) (derivationPath, error) {
+	parts := strings.Split(s, "/")
+	if parts[0] != "m" {
+		return nil, fmt.Errorf("invalid derivation path %q: must start with m", s)
+	}
+
+	path := make(derivationPath, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		var offset uint32
+		if strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h") || strings.HasSuffix(part, "H") {
+			part = part[:len(part)-1]
+			offset = bip32.FirstHardenedChild
+		}
+
+		i, err := strconv.ParseUint(part, 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("invalid derivation path %q: %v", s, err)
+		}
+		path = append(path, uint32(i)+offset)
+	}
+	return path, nil
+}
+
+func (p derivationPath) String() string {
+	var sb strings.Builder
+	sb.WriteString("m")
+	for _, i := range p {
+		if i >= bip32.FirstHardenedChild {
+			fmt.Fprintf(&sb, "/%d'", i-bip32.FirstHardenedChild)
+		} else {
+			fmt.Fprintf(&sb, "/%d", i)
+		}
+	}
+	return sb.String()
+}
+
+// deriveEd25519 derives an ED25519 private key from the seed as specified by
+// SLIP-0010.
+func deriveEd25519(seed []byte, path derivationPath) (ed25519.PrivateKey, error) {
+	mac := hmac.New(sha512.New, []byte("ed25519 seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	key, chainCode := sum[:32], sum[32:]
+
+	for _, i := range path {
+		if i < bip32.FirstHardenedChild {
+			return nil, fmt.Errorf("invalid derivation path %v: ed25519 only supports hardened derivation", path)
+		}
+
+		data := make([]byte, 1+32+4)
+		copy(data[1:], key)
+		binary.BigEndian.PutUint32(data[33:], i)
+
+		mac = hmac.New(sha512.New, chainCode)
+		mac.Write(data)
+		sum = mac.Sum(nil)
+		key, chainCode = sum[:32], sum[32:]
+	}
+
+	return ed25519.NewKeyFromSeed(key), nil
+}
+
+// deriveSecp256k1 derives a SECP256K1 private key from the seed as specified
+// by BIP32.
+func deriveSecp256k1(seed []byte, path derivationPath) ([]byte, error) {
+	key, err := bip32.NewMasterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range path {
+		key, err = key.NewChildKey(i)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return secp256k1WalletKey(key.Key), nil
+}
+
+// derivePrivateKey derives a private key of the given type from the seed, in
+// the form it is stored in the wallet.
+func derivePrivateKey(seed []byte, keyType protocol.SignatureType, path derivationPath) ([]byte, error) {
+	switch keyType {
+	case protocol.SignatureTypeED25519:
+		return deriveEd25519(seed, path)
+	case protocol.SignatureTypeSECP256K1:
+		return deriveSecp256k1(seed, path)
+	default:
+		return nil, fmt.Errorf("unsupported key type %v", keyType)
+	}
+}
+
+// deriveLegacyPrivateKey derives a private key the way versions of the wallet
+// that predate derivation paths did: the key with the given index is the
+// non-hardened BIP32 child m/<index> of the master key, which is used as the
+// seed of an ED25519 key or as the SECP256K1 key.
+func deriveLegacyPrivateKey(seed []byte, keyType protocol.SignatureType, index uint32) ([]byte, error) {
+	key, err := bip32.NewMasterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err = key.NewChildKey(index)
+	if err != nil {
+		return nil, err
+	}
+
+	switch keyType {
+	case protocol.SignatureTypeED25519:
+		return ed25519.NewKeyFromSeed(key.Key), nil
+	case protocol.SignatureTypeSECP256K1:
+		return secp256k1WalletKey(key.Key), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %v", keyType)
+	}
+}
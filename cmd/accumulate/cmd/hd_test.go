@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+)
+
+func TestDeriveEd25519(t *testing.T) {
+	// SLIP-0010 test vector 1 for ed25519
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	cases := map[string]string{
+		"m":                         "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7",
+		"m/0'":                      "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3",
+		"m/0'/1'":                   "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2",
+		"m/0'/1'/2'":                "92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9",
+		"m/0'/1'/2'/2'":             "30d1dc7e5fc04c31219ab25a27ae00b50f6fd66622f6e9c913253d6511d1e662",
+		"m/0'/1'/2'/2'/1000000000'": "8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793",
+	}
+
+	for s, expected := range cases {
+		path, err := parseDerivationPath(s)
+		require.NoError(t, err)
+		require.Equal(t, s, path.String())
+
+		key, err := deriveEd25519(seed, path)
+		require.NoError(t, err)
+		require.Equal(t, expected, hex.EncodeToString(key.Seed()), s)
+	}
+
+	path, err := parseDerivationPath("m/0'/1")
+	require.NoError(t, err)
+	_, err = deriveEd25519(seed, path)
+	require.Error(t, err, "ed25519 does not support non-hardened derivation")
+}
+
+func TestDerivedKeys(t *testing.T) {
+	Db = initDB(t.TempDir(), true)
+	mnemonic := strings.Fields("yellow yellow yellow yellow yellow yellow yellow yellow yellow yellow yellow yellow")
+	_, err := ImportMnemonic(mnemonic)
+	require.NoError(t, err)
+
+	_, err = GenerateKey("one")
+	require.NoError(t, err)
+	_, err = GenerateKey("two")
+	require.NoError(t, err)
+
+	// The derivation path is recorded with the label
+	hdPath, err := Db.Get(BucketDerivation, []byte("two"))
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/281'/0'/0'/1'", string(hdPath))
+
+	// The key can be regenerated from the path
+	path, err := parseDerivationPath(string(hdPath))
+	require.NoError(t, err)
+	seed, err := lookupSeed()
+	require.NoError(t, err)
+	derived, err := derivePrivateKey(seed, protocol.SignatureTypeED25519, path)
+	require.NoError(t, err)
+	privKey, err := LookupByLabel("two")
+	require.NoError(t, err)
+	require.Equal(t, privKey, derived)
+
+	// A wallet restored from the same mnemonic generates the same keys
+	Db = initDB(t.TempDir(), true)
+	_, err = ImportMnemonic(mnemonic)
+	require.NoError(t, err)
+	_, err = GenerateKey("one")
+	require.NoError(t, err)
+	_, err = GenerateKey("two")
+	require.NoError(t, err)
+	restored, err := LookupByLabel("two")
+	require.NoError(t, err)
+	require.Equal(t, privKey, restored)
+}
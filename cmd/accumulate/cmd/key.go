@@ -11,10 +11,10 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/btcsuite/btcd/btcec"
 	"github.com/spf13/cobra"
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	"github.com/tendermint/tendermint/privval"
-	"github.com/tyler-smith/go-bip32"
 	"github.com/tyler-smith/go-bip39"
 	"gitlab.com/accumulatenetwork/accumulate/internal/url"
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
@@ -92,12 +92,21 @@ var keyCmd = &cobra.Command{
 	},
 }
 
+var flagKey = struct {
+	Type string
+}{}
+
+func init() {
+	keyCmd.Flags().StringVar(&flagKey.Type, "type", "ed25519", "Type of key to generate or import: ed25519 or secp256k1")
+}
+
 type KeyResponse struct {
 	Label      types.String `json:"name,omitempty"`
 	PrivateKey types.Bytes  `json:"privateKey,omitempty"`
 	PublicKey  types.Bytes  `json:"publicKey,omitempty"`
 	Seed       types.Bytes  `json:"seed,omitempty"`
 	Mnemonic   types.String `json:"mnemonic,omitempty"`
+	Derivation types.String `json:"derivationPath,omitempty"`
 }
 
 func PrintKeyPublic() {
@@ -113,12 +122,14 @@ func PrintKeyExport() {
 
 func PrintKeyGenerate() {
 	fmt.Println("  accumulate key generate [key name]     Generate a new key and give it a name in the wallet")
+	fmt.Println("  accumulate key generate --type secp256k1 [key name]     Generate a new SECP256K1 key and give it a name in the wallet")
 }
 
 func PrintKeyImport() {
 	fmt.Println("  accumulate key import mnemonic [mnemonic phrase...]     Import the mneumonic phrase used to generate keys in the wallet")
 	fmt.Println("  accumulate key import private [private key hex] [key name]      Import a key and give it a name in the wallet")
 	fmt.Println("  accumulate key import lite [private key hex]       Import a key as a lite address")
+	fmt.Println("  accumulate key import --type secp256k1 private [private key hex] [key name]      Import a SECP256K1 key")
 }
 
 func PrintKey() {
@@ -159,7 +170,7 @@ func parseKey(s string) (pubKey, privKey []byte, err error) {
 
 	privKey, err = LookupByLabel(s)
 	if err == nil {
-		// Assume ED25519
+		// Wallet keys are the private key followed by the public key
 		return privKey[32:], privKey, nil
 	}
 
@@ -184,21 +195,65 @@ func parseKey(s string) (pubKey, privKey []byte, err error) {
 }
 
 func pubKeyFromString(s string) ([]byte, error) {
-	var pubKey types.Bytes32
-	if len(s) != 64 {
+	// ED25519 keys are 32 bytes, compressed SECP256K1 keys are 33 bytes
+	if len(s) != 64 && len(s) != 2*btcec.PubKeyBytesLenCompressed {
 		return nil, fmt.Errorf("invalid public key or wallet key name")
 	}
-	i, err := hex.Decode(pubKey[:], []byte(s))
 
+	pubKey, err := hex.DecodeString(s)
 	if err != nil {
 		return nil, err
 	}
 
-	if i != 32 {
-		return nil, fmt.Errorf("invalid public key")
+	return pubKey, nil
+}
+
+// parseKeyType parses the --type flag.
+func parseKeyType(s string) (protocol.SignatureType, error) {
+	switch strings.ToLower(s) {
+	case "", "ed25519":
+		return protocol.SignatureTypeED25519, nil
+	case "secp256k1":
+		return protocol.SignatureTypeSECP256K1, nil
+	default:
+		return 0, fmt.Errorf("unsupported key type %q", s)
+	}
+}
+
+// walletKeyType returns the type of a private key from the wallet. ED25519
+// keys are 64 bytes, SECP256K1 keys are 65 bytes. In both cases the private
+// key is followed by the public key.
+func walletKeyType(privKey []byte) protocol.SignatureType {
+	if len(privKey) == 32+btcec.PubKeyBytesLenCompressed {
+		return protocol.SignatureTypeSECP256K1
 	}
+	return protocol.SignatureTypeED25519
+}
 
-	return pubKey[:], nil
+// keySpecType returns the key type to record on a key page for the public key.
+// ED25519 keys are recorded without a type.
+func keySpecType(pubKey []byte) protocol.SignatureType {
+	switch len(pubKey) {
+	case btcec.PubKeyBytesLenCompressed, btcec.PubKeyBytesLenUncompressed:
+		return protocol.SignatureTypeSECP256K1
+	default:
+		return protocol.SignatureTypeUnknown
+	}
+}
+
+// newSignature returns an empty signature of the type that matches the wallet
+// key.
+func newSignature(privKey []byte) protocol.Signature {
+	if walletKeyType(privKey) == protocol.SignatureTypeSECP256K1 {
+		return new(protocol.SECP256K1Signature)
+	}
+	return new(protocol.LegacyED25519Signature)
+}
+
+// secp256k1WalletKey returns the wallet form of a SECP256K1 private key.
+func secp256k1WalletKey(key []byte) []byte {
+	_, pub := btcec.PrivKeyFromBytes(btcec.S256(), key)
+	return append(append([]byte{}, key...), pub.SerializeCompressed()...)
 }
 
 func LookupByLabel(label string) ([]byte, error) {
@@ -238,8 +293,12 @@ func GenerateKey(label string) (string, error) {
 		return "", fmt.Errorf("key name cannot be a number")
 	}
 
-	privKey, err := GeneratePrivateKey()
+	keyType, err := parseKeyType(flagKey.Type)
+	if err != nil {
+		return "", err
+	}
 
+	privKey, hdPath, err := GeneratePrivateKeyOfType(keyType)
 	if err != nil {
 		return "", err
 	}
@@ -271,10 +330,18 @@ func GenerateKey(label string) (string, error) {
 		return "", err
 	}
 
+	if hdPath != "" {
+		err = Db.Put(BucketDerivation, []byte(label), []byte(hdPath))
+		if err != nil {
+			return "", err
+		}
+	}
+
 	if WantJsonOutput {
 		a := KeyResponse{}
 		a.Label = types.String(label)
 		a.PublicKey = pubKey
+		a.Derivation = types.String(hdPath)
 		dump, err := json.Marshal(&a)
 		if err != nil {
 			return "", err
@@ -321,16 +388,26 @@ func FindLabelFromPubKey(pubKey []byte) (lab string, err error) {
 // ImportKey will import the private key and assign it to the label
 func ImportKey(pkhex string, label string) (out string, err error) {
 
-	var pk ed25519.PrivateKey
+	var pk []byte
+
+	keyType, err := parseKeyType(flagKey.Type)
+	if err != nil {
+		return "", err
+	}
 
 	token, err := hex.DecodeString(pkhex)
 	if err != nil {
 		return "", err
 	}
 
-	if len(token) == 32 {
+	switch {
+	case keyType == protocol.SignatureTypeSECP256K1 && len(token) == 32:
+		pk = secp256k1WalletKey(token)
+	case keyType == protocol.SignatureTypeSECP256K1:
+		return "", fmt.Errorf("invalid SECP256K1 private key: want 32 bytes, got %d", len(token))
+	case len(token) == 32:
 		pk = ed25519.NewKeyFromSeed(token)
-	} else {
+	default:
 		pk = token
 	}
 
@@ -408,58 +485,97 @@ func ExportKey(label string) (string, error) {
 		}
 	}
 
+	hdPath, _ := Db.Get(BucketDerivation, []byte(label))
+
 	if WantJsonOutput {
 		a := KeyResponse{}
 		a.Label = types.String(label)
 		a.PrivateKey = pk[:32]
 		a.PublicKey = pk[32:]
+		a.Derivation = types.String(hdPath)
 		dump, err := json.Marshal(&a)
 		if err != nil {
 			return "", err
 		}
 		return fmt.Sprintf("%s\n", string(dump)), nil
-	} else {
-		return fmt.Sprintf("name\t\t\t:\t%s\n\tprivate key\t:\t%x\n\tpublic key\t:\t%x\n", label, pk[:32], pk[32:]), nil
 	}
+
+	out := fmt.Sprintf("name\t\t\t:\t%s\n\tprivate key\t:\t%x\n\tpublic key\t:\t%x\n", label, pk[:32], pk[32:])
+	if len(hdPath) > 0 {
+		out += fmt.Sprintf("\tderivation path\t:\t%s\n", hdPath)
+	}
+	return out, nil
 }
 
 func GeneratePrivateKey() (privKey []byte, err error) {
+	privKey, _, err = GeneratePrivateKeyOfType(protocol.SignatureTypeED25519)
+	return privKey, err
+}
+
+// GeneratePrivateKeyOfType generates an ED25519 or SECP256K1 private key, in
+// the form it is stored in the wallet. If the wallet has a mnemonic seed, the
+// key is derived from the seed at the next unused index and its derivation
+// path is returned. Otherwise a random key is generated and the path is empty.
+func GeneratePrivateKeyOfType(keyType protocol.SignatureType) (privKey []byte, path string, err error) {
 	seed, err := lookupSeed()
 
 	if err != nil {
 		//if private key seed doesn't exist, just create a key
-		_, privKey, err = ed25519.GenerateKey(nil)
-		if err != nil {
-			return nil, err
+		if keyType == protocol.SignatureTypeSECP256K1 {
+			key, err := btcec.NewPrivateKey(btcec.S256())
+			if err != nil {
+				return nil, "", err
+			}
+			return secp256k1WalletKey(key.Serialize()), "", nil
 		}
-	} else {
-		//if we do have a seed, then create a new key
-		masterKey, _ := bip32.NewMasterKey(seed)
 
-		ct, err := getKeyCountAndIncrement()
+		_, privKey, err = ed25519.GenerateKey(nil)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
+		return privKey, "", nil
+	}
 
-		newKey, err := masterKey.NewChildKey(ct)
-		if err != nil {
-			return nil, err
-		}
-		privKey = ed25519.NewKeyFromSeed(newKey.Key)
+	//if we do have a seed, then derive the next key
+	ct, err := getKeyCountAndIncrement(keyType)
+	if err != nil {
+		return nil, "", err
 	}
-	return
+
+	hdPath := walletDerivationPath(ct)
+	privKey, err = derivePrivateKey(seed, keyType, hdPath)
+	if err != nil {
+		return nil, "", err
+	}
+	return privKey, hdPath.String(), nil
 }
 
-func getKeyCountAndIncrement() (count uint32, err error) {
+// keyCountName returns the name of the derivation index counter for the key
+// type. Each key type has its own sequence of indexes.
+func keyCountName(keyType protocol.SignatureType) []byte {
+	if keyType == protocol.SignatureTypeED25519 {
+		return []byte("count")
+	}
+	return []byte("count-" + keyType.String())
+}
 
-	ct, err := Db.Get(BucketMnemonic, []byte("count"))
-	if ct != nil {
-		count = binary.LittleEndian.Uint32(ct)
+func getKeyCount(keyType protocol.SignatureType) uint32 {
+	ct, _ := Db.Get(BucketMnemonic, keyCountName(keyType))
+	if len(ct) < 4 {
+		return 0
 	}
+	return binary.LittleEndian.Uint32(ct)
+}
+
+func setKeyCount(keyType protocol.SignatureType, count uint32) error {
+	ct := make([]byte, 8)
+	binary.LittleEndian.PutUint32(ct, count)
+	return Db.Put(BucketMnemonic, keyCountName(keyType), ct)
+}
 
-	ct = make([]byte, 8)
-	binary.LittleEndian.PutUint32(ct, count+1)
-	err = Db.Put(BucketMnemonic, []byte("count"), ct)
+func getKeyCountAndIncrement(keyType protocol.SignatureType) (count uint32, err error) {
+	count = getKeyCount(keyType)
+	err = setKeyCount(keyType, count+1)
 	if err != nil {
 		return 0, err
 	}
@@ -1,8 +1,13 @@
 package cmd
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
 )
 
 func keysTest(t *testing.T, tc *testCmd) {
@@ -39,3 +44,38 @@ func keysTest(t *testing.T, tc *testCmd) {
 		t.Fatalf("expected \"%s\" got \"%s\"", "hi-via-args", string(out))
 	}
 }
+
+func TestSECP256K1Keys(t *testing.T) {
+	Db = initDB(t.TempDir(), true)
+	flagKey.Type = "secp256k1"
+	defer func() { flagKey.Type = "ed25519" }()
+
+	// Generate a key
+	_, err := GenerateKey("secp")
+	require.NoError(t, err)
+	privKey, err := resolvePrivateKey("secp")
+	require.NoError(t, err)
+	require.Equal(t, protocol.SignatureTypeSECP256K1, walletKeyType(privKey))
+	require.Equal(t, protocol.SignatureTypeSECP256K1, keySpecType(privKey[32:]))
+
+	// Import a key
+	seed := sha256.Sum256([]byte("imported"))
+	_, err = ImportKey(hex.EncodeToString(seed[:]), "imported")
+	require.NoError(t, err)
+	imported, err := resolvePrivateKey("imported")
+	require.NoError(t, err)
+	require.Equal(t, seed[:], imported[:32])
+
+	// The public key resolves as a hex string
+	pubKey, err := resolvePublicKey(hex.EncodeToString(imported[32:]))
+	require.NoError(t, err)
+	require.Equal(t, imported[32:], pubKey)
+
+	// Sign with it
+	hash := sha256.Sum256([]byte("message"))
+	sig := newSignature(imported)
+	require.NoError(t, sig.Sign(1, imported, hash[:]))
+	require.Equal(t, protocol.SignatureTypeSECP256K1, sig.Type())
+	require.Equal(t, pubKey, sig.GetPublicKey())
+	require.True(t, sig.Verify(hash[:]))
+}
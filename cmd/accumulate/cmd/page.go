@@ -1,8 +1,10 @@
 package cmd
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/AccumulateNetwork/jsonrpc2/v15"
 	"github.com/spf13/cobra"
@@ -16,7 +18,18 @@ var pageCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		var out string
 		var err error
-		if len(args) == 2 {
+		if len(args) > 2 && (args[0] == "cancel" || args[0] == "freeze" || args[0] == "unfreeze" || args[0] == "set-min-delay") {
+			switch args[0] {
+			case "cancel":
+				out, err = KeyPageUpdate(args[1], protocol.KeyPageOperationCancel, args[2:])
+			case "freeze":
+				out, err = KeyPageUpdate(args[1], protocol.KeyPageOperationFreeze, args[2:])
+			case "unfreeze":
+				out, err = KeyPageUpdate(args[1], protocol.KeyPageOperationUnfreeze, args[2:])
+			case "set-min-delay":
+				out, err = KeyPageUpdate(args[1], protocol.KeyPageOperationSetMinDelay, args[2:])
+			}
+		} else if len(args) == 2 {
 			if args[0] == "get" {
 				out, err = GetAndPrintKeyPage(args[1])
 			} else {
@@ -68,12 +81,29 @@ func PrintKeyUpdate() {
 	fmt.Println("\t\t example usage: accumulate page key remove acc://RedWagon/RedPage1 redKey1 redKey2")
 }
 
+func PrintKeyPageFreeze() {
+	fmt.Println("  accumulate page freeze [key page url] [signing key name] [key index (optional)] [key height (optional)] Block all transactions signed by a key page; any one key of the page can freeze it")
+	fmt.Println("  accumulate page unfreeze [key page url] [signing key name] [key index (optional)] [key height (optional)] Lift a freeze")
+	fmt.Println("  accumulate page cancel [key page url] [signing key name] [key index (optional)] [key height (optional)] [txid] Cancel a time-locked operation; any one key of the page can cancel it")
+	fmt.Println("  accumulate page set-min-delay [key page url] [signing key name] [key index (optional)] [key height (optional)] [blocks] Require every operation that can be time-locked to be delayed by at least the given number of blocks")
+	fmt.Println("\t\t Key updates, unfreezing, and setting the minimum delay can be time-locked with --delay [blocks]")
+}
+
 func PrintPage() {
 	PrintKeyPageCreate()
 	PrintKeyPageGet()
 	PrintKeyUpdate()
+	PrintKeyPageFreeze()
+}
+
+func init() {
+	pageCmd.Flags().Uint64Var(&flagPage.Delay, "delay", 0, "Delay the operation by the given number of blocks, during which it can be cancelled")
 }
 
+var flagPage = struct {
+	Delay uint64
+}{}
+
 func GetAndPrintKeyPage(url string) (string, error) {
 	res, _, err := GetKeyPage(url)
 	if err != nil {
@@ -140,6 +170,7 @@ func CreateKeyPage(page string, args []string) (string, error) {
 		} else {
 			ksp.PublicKey = pk[32:]
 		}
+		ksp.KeyType = keySpecType(ksp.PublicKey)
 
 		ckp.Keys[i] = &ksp
 	}
@@ -209,10 +240,29 @@ func KeyPageUpdate(origin string, op protocol.KeyPageOperation, args []string) (
 		if err != nil {
 			return "", err
 		}
+	case protocol.KeyPageOperationCancel:
+		if len(args) < 1 {
+			return "", fmt.Errorf("invalid number of arguments")
+		}
+		txid, err := hex.DecodeString(args[0])
+		if err != nil || len(txid) != 32 {
+			return "", fmt.Errorf("invalid transaction ID %q", args[0])
+		}
+		copy(ukp.PendingTxid[:], txid)
+	case protocol.KeyPageOperationSetMinDelay:
+		if len(args) < 1 {
+			return "", fmt.Errorf("invalid number of arguments")
+		}
+		ukp.MinDelay, err = strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid minimum delay %q", args[0])
+		}
 	}
 
+	ukp.Delay = flagPage.Delay
 	ukp.Key = oldKey[:]
 	ukp.NewKey = newKey[:]
+	ukp.NewKeyType = keySpecType(newKey)
 
 	res, err := dispatchTxRequest("update-key-page", &ukp, nil, u, si, privKey)
 	if err != nil {
@@ -18,6 +18,7 @@ var (
 	ClientTimeout  time.Duration
 	ClientDebug    bool
 	Db             db.DB
+	Wallet         *db.EncryptedDB
 	WantJsonOutput = false
 	TxPretend      = false
 	TxProve        = false
@@ -34,7 +35,9 @@ var currentUser = func() *user.User {
 var DidError error
 
 func InitRootCmd(database db.DB) *cobra.Command {
-	Db = database
+	Wallet = db.NewEncryptedDB(database)
+	Wallet.Prompt = unlockPrompt
+	Db = Wallet
 	cmd := &cobra.Command{
 		Use:   "accumulate",
 		Short: "CLI for Accumulate Network",
@@ -54,6 +57,7 @@ func InitRootCmd(database db.DB) *cobra.Command {
 	flags.BoolVarP(&WantJsonOutput, "json", "j", false, "print outputs as json")
 	flags.BoolVarP(&TxPretend, "pretend", "n", false, "Enables check-only mode for transactions")
 	flags.BoolVar(&TxProve, "prove", false, "Request a receipt proving the transaction is in a block")
+	flags.StringVar(&TxUnsignedFile, "unsigned", "", "Write the unsigned transaction to a file instead of signing and submitting it")
 	flags.BoolVar(&TxNoWait, "no-wait", false, "Don't wait for the transaction to complete")
 	flags.DurationVarP(&TxWait, "wait", "w", 0, "Wait for the transaction to complete")
 
@@ -70,6 +74,7 @@ func InitRootCmd(database db.DB) *cobra.Command {
 	cmd.AddCommand(versionCmd)
 	cmd.AddCommand(tokenCmd)
 	cmd.AddCommand(managerCmd)
+	cmd.AddCommand(walletCmd)
 
 	//for the testnet integration
 	cmd.AddCommand(faucetCmd)
@@ -94,7 +99,11 @@ func InitRootCmd(database db.DB) *cobra.Command {
 		Client.Timeout = ClientTimeout
 		Client.DebugRequest = ClientDebug
 
-		return nil
+		// Wallet commands prompt for the passphrase
+		if cmd == walletCmd {
+			return nil
+		}
+		return unlockWithSession()
 	}
 
 	cmd.PersistentPostRun = func(*cobra.Command, []string) {
@@ -119,11 +128,12 @@ func init() {
 }
 
 var (
-	BucketAnon     = []byte("anon")
-	BucketAdi      = []byte("adi")
-	BucketKeys     = []byte("keys")
-	BucketLabel    = []byte("label")
-	BucketMnemonic = []byte("mnemonic")
+	BucketAnon       = []byte("anon")
+	BucketAdi        = []byte("adi")
+	BucketKeys       = []byte("keys")
+	BucketLabel      = []byte("label")
+	BucketMnemonic   = []byte("mnemonic")
+	BucketDerivation = []byte("derivation")
 )
 
 func initDB(defaultWorkDir string, memDb bool) db.DB {
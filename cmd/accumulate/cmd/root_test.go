@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto"
 	acctesting "gitlab.com/accumulatenetwork/accumulate/internal/testing"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
 )
 
 type testCase func(t *testing.T, tc *testCmd)
@@ -91,8 +92,11 @@ func NewTestBVNN(t *testing.T) (string, crypto.PrivKey) {
 	t.Helper()
 	acctesting.SkipPlatformCI(t, "darwin", "requires setting up localhost aliases")
 
-	// Start
-	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	// Start. The CLI tests fund the same accounts repeatedly, so disable the
+	// faucet cooldown.
+	globals := protocol.DefaultNetworkGlobals()
+	globals.Faucet.Cooldown = 0
+	subnets, daemons := acctesting.CreateTestNetWithGlobals(t, 1, 1, 0, globals)
 	acctesting.RunTestNet(t, subnets, daemons)
 
 	time.Sleep(time.Second)
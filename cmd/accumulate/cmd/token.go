@@ -69,8 +69,14 @@ func init() {
 		tokenCmdCreate,
 		tokenCmdIssue,
 		tokenCmdBurn)
+
+	tokenCmdCreate.Flags().StringVar(&flagToken.SupplyLimit, "supply-limit", "", "Limit the amount of tokens that can be issued")
 }
 
+var flagToken = struct {
+	SupplyLimit string
+}{}
+
 func PrintTokenGet() {
 	fmt.Println("  accumulate token get [url] Get token by URL")
 }
@@ -139,6 +145,15 @@ func CreateToken(origin string, args []string) (string, error) {
 	params.Precision = uint64(prcsn)
 	params.Properties = properties
 
+	if flagToken.SupplyLimit != "" {
+		limit, err := amountWithPrecision(params.Precision, flagToken.SupplyLimit)
+		if err != nil {
+			return "", err
+		}
+		params.InitialSupply = *limit
+		params.HasSupplyLimit = true
+	}
+
 	res, err := dispatchTxRequest("create-token", &params, nil, originUrl, si, privKey)
 	if err != nil {
 		return "", err
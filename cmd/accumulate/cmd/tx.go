@@ -34,10 +34,11 @@ var txCmd = &cobra.Command{
 					PrintTXGet()
 				}
 			case "pending":
-				if len(args) == 1 {
-					out, err = GetPendingTx(args[1], []string{})
-				} else {
+				if len(args) > 1 {
 					out, err = GetPendingTx(args[1], args[2:])
+				} else {
+					fmt.Println("Usage:")
+					PrintTXPendingGet()
 				}
 			case "history":
 				if len(args) > 3 {
@@ -61,12 +62,21 @@ var txCmd = &cobra.Command{
 					PrintTXExecute()
 				}
 			case "sign":
-				if len(args) > 2 {
+				if len(args) > 2 && isTxFile(args[1]) {
+					out, err = SignTxFile(args[1], args[2:])
+				} else if len(args) > 2 {
 					out, err = SignTX(args[1], args[2:])
 				} else {
 					fmt.Println("Usage:")
 					PrintTxSign()
 				}
+			case "submit":
+				if len(args) > 1 {
+					out, err = SubmitTxFile(args[1])
+				} else {
+					fmt.Println("Usage:")
+					PrintTxOffline()
+				}
 			default:
 				fmt.Println("Usage:")
 				PrintTX()
@@ -95,6 +105,7 @@ func PrintTXGet() {
 }
 
 func PrintTXPendingGet() {
+	fmt.Println("  accumulate tx pending [url]			List pending transactions waiting on a key page, or originated by an account, and the keys that have signed them")
 	fmt.Println("  accumulate tx pending [txid]			Get token transaction by txid")
 	fmt.Println("  accumulate tx pending [height]			Get token transaction by block height")
 	fmt.Println("  accumulate tx pending [starting transaction number]	[ending transaction number]		Get token transaction by beginning and ending height")
@@ -121,6 +132,7 @@ func PrintTX() {
 	PrintTXCreate()
 	PrintTXExecute()
 	PrintTxSign()
+	PrintTxOffline()
 	PrintTXHistoryGet()
 	PrintTXPendingGet()
 }
@@ -139,11 +151,10 @@ func GetPendingTx(origin string, args []string) (string, error) {
 	var perr error
 	switch len(args) {
 	case 0:
-		//query with no parameters
-		u.Fragment = "pending"
+		//query the signature status of the pending transactions
 		params.Url = u
 		res := api2.MultiResponse{}
-		err = queryAs("query", &params, &res)
+		err = queryAs("query-pending", &params, &res)
 		if err != nil {
 			return "", err
 		}
@@ -424,13 +435,12 @@ func ExecuteTX(sender string, args []string) (string, error) {
 }
 
 func SignTX(sender string, args []string) (string, error) {
-	//sender string, receiver string, amount string
 	u, err := url.Parse(sender)
 	if err != nil {
 		return "", err
 	}
 
-	args, si, pk, err := prepareSigner(u, args)
+	args, _, pk, err := prepareSigner(u, args)
 	if err != nil {
 		return "", fmt.Errorf("unable to prepare signer, %v", err)
 	}
@@ -444,9 +454,24 @@ func SignTX(sender string, args []string) (string, error) {
 		return "", fmt.Errorf("unable to parse transaction hash: %v", err)
 	}
 
-	res, err := dispatchTxRequest("execute", nil, txHash, u, si, pk)
+	nonce := nonceFromTimeNow()
+	ed := newSignature(pk)
+	err = ed.Sign(nonce, pk, txHash)
 	if err != nil {
 		return "", err
 	}
-	return ActionResponseFrom(res).Print()
+
+	params := new(api2.SignPendingRequest)
+	params.CheckOnly = TxPretend
+	params.Txid = txHash
+	params.Signer.Nonce = nonce
+	params.Signer.PublicKey = ed.GetPublicKey()
+	params.Signer.SignatureType = ed.Type()
+	params.Signature = ed.GetSignature()
+
+	var res api2.TxResponse
+	if err := Client.RequestAPIv2(context.Background(), "sign-pending", params, &res); err != nil {
+		return PrintJsonRpcError(err)
+	}
+	return ActionResponseFrom(&res).Print()
 }
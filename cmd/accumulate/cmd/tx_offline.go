@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	api2 "gitlab.com/accumulatenetwork/accumulate/internal/api/v2"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+	"gitlab.com/accumulatenetwork/accumulate/types/api/transactions"
+)
+
+// TxUnsignedFile is set by --unsigned. If it is set, transactions are written
+// to the file instead of being signed and submitted.
+var TxUnsignedFile string
+
+func PrintTxOffline() {
+	fmt.Println("  accumulate [command] --unsigned [file] ...		Write the transaction to a file instead of signing and submitting it; the signing key may be a public key")
+	fmt.Println("  accumulate tx sign [file] [signing key name]	Sign a transaction file, for example on an offline machine")
+	fmt.Println("  accumulate tx submit [file]			Submit a signed transaction file")
+}
+
+// isTxFile returns true if s names a transaction file rather than a URL.
+func isTxFile(s string) bool {
+	fi, err := os.Stat(s)
+	return err == nil && !fi.IsDir()
+}
+
+func readTxFile(filename string) (*transactions.Envelope, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	env := new(transactions.Envelope)
+	err = json.Unmarshal(data, env)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction file %s: %v", filename, err)
+	}
+	if env.Transaction == nil || env.Transaction.Body == nil {
+		return nil, fmt.Errorf("invalid transaction file %s: missing transaction", filename)
+	}
+	return env, nil
+}
+
+func writeTxFile(filename string, env *transactions.Envelope) error {
+	data, err := json.MarshalIndent(env, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, data, 0600)
+}
+
+// exportUnsignedTx writes an unsigned transaction, with its header, to
+// TxUnsignedFile.
+func exportUnsignedTx(payload protocol.TransactionPayload, txHash []byte, hdr *transactions.Header, nonce uint64) (*api2.TxResponse, error) {
+	env := new(transactions.Envelope)
+	env.TxHash = txHash
+	env.Transaction = new(transactions.Transaction)
+	env.Transaction.Body = payload
+	env.Transaction.TransactionHeader = *hdr
+	env.Transaction.Nonce = nonce
+
+	err := writeTxFile(TxUnsignedFile, env)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(api2.TxResponse)
+	res.TransactionHash = env.GetTxHash()
+	return res, nil
+}
+
+// SignTxFile adds a signature to a transaction file. It does not need a
+// network connection.
+func SignTxFile(filename string, args []string) (string, error) {
+	if len(args) != 1 {
+		PrintTxOffline()
+		return "", nil
+	}
+
+	env, err := readTxFile(filename)
+	if err != nil {
+		return "", err
+	}
+
+	privKey, err := resolvePrivateKey(args[0])
+	if err != nil {
+		return "", err
+	}
+
+	ed := newSignature(privKey)
+	err = ed.Sign(env.Transaction.Nonce, privKey, env.GetTxHash())
+	if err != nil {
+		return "", err
+	}
+	env.Signatures = append(env.Signatures, ed)
+
+	err = writeTxFile(filename, env)
+	if err != nil {
+		return "", err
+	}
+
+	res := new(api2.TxResponse)
+	res.TransactionHash = env.GetTxHash()
+	return ActionResponseFrom(res).Print()
+}
+
+// SubmitTxFile submits a signed transaction file with the execute method.
+func SubmitTxFile(filename string) (string, error) {
+	env, err := readTxFile(filename)
+	if err != nil {
+		return "", err
+	}
+	if len(env.Signatures) == 0 {
+		return "", fmt.Errorf("transaction file %s has not been signed", filename)
+	}
+
+	data, err := env.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+
+	params := new(api2.TxRequest)
+	params.CheckOnly = TxPretend
+	params.IsEnvelope = true
+	params.Origin = env.Transaction.Origin
+	params.Payload = hex.EncodeToString(data)
+
+	var res api2.TxResponse
+	if err := Client.RequestAPIv2(context.Background(), "execute", params, &res); err != nil {
+		return PrintJsonRpcError(err)
+	}
+
+	if !TxNoWait && TxWait > 0 {
+		_, err := waitForTxn(res.TransactionHash, TxWait)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return ActionResponseFrom(&res).Print()
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+	"gitlab.com/accumulatenetwork/accumulate/types/api/transactions"
+)
+
+func TestOfflineSigning(t *testing.T) {
+	Db = initDB(t.TempDir(), true)
+	_, err := GenerateKey("offline")
+	require.NoError(t, err)
+
+	TxUnsignedFile = filepath.Join(t.TempDir(), "tx.json")
+	defer func() { TxUnsignedFile = "" }()
+
+	// Export an unsigned transaction
+	origin, err := url.Parse("foo/tokens")
+	require.NoError(t, err)
+	body := new(protocol.SendTokens)
+	body.AddRecipient(origin, big.NewInt(protocol.AcmePrecision))
+	hdr := new(transactions.Header)
+	hdr.Origin = origin
+	hdr.KeyPageHeight = 2
+	res, err := exportUnsignedTx(body, nil, hdr, 10)
+	require.NoError(t, err)
+
+	env, err := readTxFile(TxUnsignedFile)
+	require.NoError(t, err)
+	require.Empty(t, env.Signatures)
+	require.Equal(t, uint64(2), env.Transaction.KeyPageHeight)
+	require.Equal(t, uint64(10), env.Transaction.Nonce)
+	require.Equal(t, res.TransactionHash, env.GetTxHash())
+
+	// Sign it
+	_, err = SignTxFile(TxUnsignedFile, []string{"offline"})
+	require.NoError(t, err)
+
+	env, err = readTxFile(TxUnsignedFile)
+	require.NoError(t, err)
+	require.Len(t, env.Signatures, 1)
+	require.True(t, env.Verify())
+	require.Equal(t, res.TransactionHash, env.GetTxHash())
+}
@@ -19,6 +19,7 @@ import (
 	url2 "gitlab.com/accumulatenetwork/accumulate/internal/url"
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
 	"gitlab.com/accumulatenetwork/accumulate/types"
+	"gitlab.com/accumulatenetwork/accumulate/types/api/query"
 	"gitlab.com/accumulatenetwork/accumulate/types/api/transactions"
 	"gitlab.com/accumulatenetwork/accumulate/types/state"
 )
@@ -68,14 +69,25 @@ func prepareSigner(origin *url2.URL, args []string) ([]string, *transactions.Hea
 
 	if IsLiteAccount(origin.String()) {
 		privKey, err = LookupByLabel(origin.String())
-		if err != nil {
+		if err != nil && TxUnsignedFile == "" {
 			return nil, nil, nil, fmt.Errorf("unable to find private key for lite token account %s %v", origin.String(), err)
 		}
 		return args, &hdr, privKey, nil
 	}
 
+	// The private key is not needed to export an unsigned transaction, so
+	// the key may be a public key
+	var pubKey []byte
 	privKey, err = resolvePrivateKey(args[0])
-	if err != nil {
+	switch {
+	case err == nil:
+		pubKey = privKey[32:]
+	case TxUnsignedFile != "":
+		pubKey, err = resolvePublicKey(args[0])
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	default:
 		return nil, nil, nil, err
 	}
 	ct++
@@ -87,7 +99,7 @@ func prepareSigner(origin *url2.URL, args []string) ([]string, *transactions.Hea
 		}
 	}
 
-	keyInfo, err := getKey(origin.String(), privKey[32:])
+	keyInfo, err := getKey(origin.String(), pubKey)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("failed to get key for %q : %v", origin, err)
 	}
@@ -134,12 +146,12 @@ func signGenTx(payload protocol.TransactionPayload, txHash []byte, origin *url2.
 	hdr.Nonce = nonce
 	env.Transaction.TransactionHeader = *hdr
 
-	ed := new(protocol.LegacyED25519Signature)
-	err := ed.Sign(nonce, privKey, env.GetTxHash())
+	sig := newSignature(privKey)
+	err := sig.Sign(nonce, privKey, env.GetTxHash())
 	if err != nil {
 		return nil, err
 	}
-	return ed, nil
+	return sig, nil
 }
 
 func prepareGenTxV2(payload protocol.TransactionPayload, jsonPayload, txHash []byte, origin *url2.URL, si *transactions.Header, privKey []byte, nonce uint64) (*api2.TxRequest, error) {
@@ -167,6 +179,7 @@ func prepareGenTxV2(payload protocol.TransactionPayload, jsonPayload, txHash []b
 	//to pass verification, the validator will hash the key and check the
 	//sig spec group to make sure this key belongs to the identity.
 	params.Signer.PublicKey = ed.GetPublicKey()
+	params.Signer.SignatureType = ed.Type()
 
 	return params, err
 }
@@ -204,11 +217,21 @@ type QueryResponse struct {
 }
 
 func GetUrl(url string) (*QueryResponse, error) {
+	return GetUrlAt(url, 0)
+}
+
+// GetUrlAt queries the state of an account as of the given block. If the
+// block is zero, GetUrlAt queries the current state.
+func GetUrlAt(url string, block uint64) (*QueryResponse, error) {
 	var res QueryResponse
 
 	u, err := url2.Parse(url)
-	params := api2.UrlQuery{}
+	if err != nil {
+		return nil, err
+	}
+	params := api2.GeneralQuery{}
 	params.Url = u
+	params.Height = block
 
 	err = queryAs("query", &params, &res)
 	if err != nil {
@@ -253,6 +276,10 @@ func dispatchTxRequest(action string, payload protocol.TransactionPayload, txHas
 	}
 
 	nonce := nonceFromTimeNow()
+	if TxUnsignedFile != "" {
+		return exportUnsignedTx(payload, txHash, si, nonce)
+	}
+
 	params, err := prepareGenTxV2(payload, data, txHash, origin, si, privKey, nonce)
 	if err != nil {
 		return nil, err
@@ -469,11 +496,16 @@ func amountToBigInt(tokenUrl string, amount string) (*big.Int, error) {
 		return nil, err
 	}
 
+	return amountWithPrecision(t.Precision, amount)
+}
+
+// amountWithPrecision converts a decimal amount to token units.
+func amountWithPrecision(precision uint64, amount string) (*big.Int, error) {
 	amt, _ := big.NewFloat(0).SetPrec(128).SetString(amount)
 	if amt == nil {
 		return nil, fmt.Errorf("invalid amount %s", amount)
 	}
-	oneToken := big.NewFloat(math.Pow(10.0, float64(t.Precision)))
+	oneToken := big.NewFloat(math.Pow(10.0, float64(precision)))
 	amt.Mul(amt, oneToken)
 	iAmt, _ := amt.Int(big.NewInt(0))
 	return iAmt, nil
@@ -629,6 +661,29 @@ func PrintMultiResponse(res *api2.MultiResponse) (string, error) {
 		for i, item := range res.Items {
 			out += fmt.Sprintf("\t%d\t%s", i, item)
 		}
+	case "pendingSignatures":
+		out += fmt.Sprintf("\n\tPending Transactions -> Total: %d\n", res.Total)
+		for i := range res.Items {
+			status := new(query.ResponseSignatureStatus)
+			err := Remarshal(res.Items[i], status)
+			if err != nil {
+				return "", err
+			}
+
+			out += fmt.Sprintf("\t%d\t%x\n", i, status.TxId)
+			out += fmt.Sprintf("\t\tOrigin:\t\t%v\n", status.Origin)
+			out += fmt.Sprintf("\t\tKey Page:\t%v\n", status.KeyPage)
+			out += fmt.Sprintf("\t\tSignatures:\t%d of %d\n", len(status.Signed), status.Threshold)
+			if status.Invalidated {
+				out += "\t\tInvalidated:\ttrue\n"
+			}
+			for _, key := range status.Signed {
+				out += fmt.Sprintf("\t\tSigned:\t\t%x\n", key)
+			}
+			for _, key := range status.Unsigned {
+				out += fmt.Sprintf("\t\tUnsigned:\t%x\n", key)
+			}
+		}
 	case "txHistory":
 		out += fmt.Sprintf("\n\tTrasaction History Start: %d\t Count: %d\t Total: %d\n", res.Start, res.Count, res.Total)
 		for i := range res.Items {
@@ -733,6 +788,18 @@ func outputForHumans(res *QueryResponse) (string, error) {
 			}
 			out += fmt.Sprintf("\t%d\t%d\t%x\t%s", i, k.Nonce, k.PublicKey, keyName)
 		}
+		if ss.Frozen {
+			out += "\n\n\tThe key page is frozen\n"
+		}
+		if ss.MinDelay > 0 {
+			out += fmt.Sprintf("\n\n\tTime-locked operations must be delayed by at least %d blocks\n", ss.MinDelay)
+		}
+		if len(ss.PendingOperations) > 0 {
+			out += "\n\n\tPending Operation\tEffective At\tTransaction\n"
+			for _, op := range ss.PendingOperations {
+				out += fmt.Sprintf("\t%v\t\t\t%d\t\t%x\n", op.Operation.Operation, op.EffectiveAt, op.Txid)
+			}
+		}
 		return out, nil
 	case "token", protocol.AccountTypeTokenIssuer.String():
 		ti := protocol.TokenIssuer{}
@@ -740,16 +807,23 @@ func outputForHumans(res *QueryResponse) (string, error) {
 		if err != nil {
 			return "", err
 		}
-		hasSupplyLimit := "no"
+		supplyLimit := "none"
+		remaining := "unlimited"
+		circulating := "unknown"
+		if ti.TracksCirculatingSupply() {
+			circulating = amountToString(ti.Precision, &ti.Issued)
+		}
 		if ti.HasSupplyLimit {
-			hasSupplyLimit = "yes"
+			supplyLimit = amountToString(ti.Precision, &ti.Supply)
+			remaining = amountToString(ti.Precision, ti.RemainingSupply())
 		}
 
 		out := fmt.Sprintf("\n\tToken URL\t:\t%s", ti.Url)
 		out += fmt.Sprintf("\n\tSymbol\t\t:\t%s", ti.Symbol)
 		out += fmt.Sprintf("\n\tPrecision\t:\t%d", ti.Precision)
-		out += fmt.Sprintf("\n\tSupply\t\t:\t%s", amountToString(ti.Precision, &ti.Supply))
-		out += fmt.Sprintf("\n\tSupply Limit\t:\t%s", hasSupplyLimit)
+		out += fmt.Sprintf("\n\tCirculating\t:\t%s", circulating)
+		out += fmt.Sprintf("\n\tSupply Limit\t:\t%s", supplyLimit)
+		out += fmt.Sprintf("\n\tRemaining\t:\t%s", remaining)
 		out += fmt.Sprintf("\n\tProperties URL\t:\t%s", ti.Properties)
 		out += "\n"
 		return out, nil
@@ -0,0 +1,247 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// WalletSessionEnv is the environment variable that holds the session token
+// printed by `wallet unlock`.
+const WalletSessionEnv = "ACC_WALLET_SESSION"
+
+// walletBuckets are the buckets whose values are encrypted.
+var walletBuckets = [][]byte{BucketAnon, BucketAdi, BucketKeys, BucketLabel, BucketMnemonic, BucketDerivation}
+
+var walletCmd = &cobra.Command{
+	Use:   "wallet",
+	Short: "Encrypt and unlock the wallet",
+	Run: func(cmd *cobra.Command, args []string) {
+		var out string
+		var err error
+		if len(args) > 0 {
+			switch args[0] {
+			case "encrypt":
+				out, err = EncryptWallet()
+			case "unlock":
+				out, err = UnlockWallet()
+			case "lock":
+				out, err = LockWallet()
+			case "change-passphrase":
+				out, err = ChangeWalletPassphrase()
+			default:
+				fmt.Println("Usage:")
+				PrintWallet()
+			}
+		} else {
+			fmt.Println("Usage:")
+			PrintWallet()
+		}
+		printOutput(cmd, out, err)
+	},
+}
+
+func PrintWallet() {
+	fmt.Println("  accumulate wallet encrypt			Encrypt the wallet with a passphrase, migrating an existing plaintext wallet")
+	fmt.Println("  accumulate wallet unlock [--expires 1h]		Unlock the wallet and print a session token to export as " + WalletSessionEnv)
+	fmt.Println("  accumulate wallet lock			End every session, so session tokens no longer unlock the wallet")
+	fmt.Println("  accumulate wallet change-passphrase		Change the passphrase of an encrypted wallet")
+}
+
+// readPassphrase prompts for a passphrase on the terminal.
+func readPassphrase(prompt string) ([]byte, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return nil, fmt.Errorf("wallet is encrypted: set %s or run from a terminal", WalletSessionEnv)
+	}
+
+	fmt.Fprint(os.Stderr, prompt)
+	defer fmt.Fprintln(os.Stderr)
+	return term.ReadPassword(fd)
+}
+
+// readNewPassphrase prompts for a new passphrase twice.
+func readNewPassphrase() ([]byte, error) {
+	pass, err := readPassphrase("New passphrase: ")
+	if err != nil {
+		return nil, err
+	}
+	if len(pass) == 0 {
+		return nil, fmt.Errorf("passphrase is empty")
+	}
+
+	again, err := readPassphrase("Repeat passphrase: ")
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(pass, again) {
+		return nil, fmt.Errorf("passphrases do not match")
+	}
+	return pass, nil
+}
+
+// unlockPrompt is called when an encrypted wallet is accessed while it is
+// locked.
+func unlockPrompt() ([]byte, error) {
+	return readPassphrase("Wallet passphrase: ")
+}
+
+func init() {
+	walletCmd.Flags().DurationVar(&flagWallet.Expires, "expires", time.Hour, "How long the session token printed by wallet unlock is valid")
+}
+
+var flagWallet = struct {
+	Expires time.Duration
+}{}
+
+// unlockWithSession unlocks an encrypted wallet with the session token from
+// the environment, if there is one.
+func unlockWithSession() error {
+	session := os.Getenv(WalletSessionEnv)
+	if session == "" {
+		return nil
+	}
+
+	encrypted, err := Wallet.IsEncrypted()
+	if err != nil || !encrypted {
+		return err
+	}
+
+	token, err := hex.DecodeString(session)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %v", WalletSessionEnv, err)
+	}
+
+	err = Wallet.UnlockSession(token)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %v", WalletSessionEnv, err)
+	}
+	return nil
+}
+
+// EncryptWallet encrypts a plaintext wallet.
+func EncryptWallet() (string, error) {
+	encrypted, err := Wallet.IsEncrypted()
+	if err != nil {
+		return "", err
+	}
+	if encrypted {
+		return "", fmt.Errorf("wallet is already encrypted")
+	}
+
+	pass, err := readNewPassphrase()
+	if err != nil {
+		return "", err
+	}
+
+	err = Wallet.Encrypt(pass, walletBuckets...)
+	if err != nil {
+		return "", err
+	}
+
+	return walletResponse("Wallet encrypted")
+}
+
+// UnlockWallet checks the passphrase and returns a session token that unlocks
+// the wallet for later commands until it expires.
+func UnlockWallet() (string, error) {
+	encrypted, err := Wallet.IsEncrypted()
+	if err != nil {
+		return "", err
+	}
+	if !encrypted {
+		return "", fmt.Errorf("wallet is not encrypted")
+	}
+
+	pass, err := readPassphrase("Wallet passphrase: ")
+	if err != nil {
+		return "", err
+	}
+
+	err = Wallet.Unlock(pass)
+	if err != nil {
+		return "", err
+	}
+
+	if flagWallet.Expires <= 0 {
+		return "", fmt.Errorf("--expires must be positive")
+	}
+
+	token, err := Wallet.NewSession(time.Now().Add(flagWallet.Expires))
+	if err != nil {
+		return "", err
+	}
+
+	session := hex.EncodeToString(token)
+	if WantJsonOutput {
+		data, err := json.Marshal(map[string]string{"session": session})
+		return string(data), err
+	}
+	return fmt.Sprintf("export %s=%s", WalletSessionEnv, session), nil
+}
+
+// LockWallet ends every session of an encrypted wallet.
+func LockWallet() (string, error) {
+	encrypted, err := Wallet.IsEncrypted()
+	if err != nil {
+		return "", err
+	}
+	if !encrypted {
+		return "", fmt.Errorf("wallet is not encrypted")
+	}
+
+	err = Wallet.EndSessions()
+	if err != nil {
+		return "", err
+	}
+
+	return walletResponse("Wallet locked, existing session tokens are no longer valid")
+}
+
+// ChangeWalletPassphrase re-encrypts the wallet with a new passphrase.
+func ChangeWalletPassphrase() (string, error) {
+	encrypted, err := Wallet.IsEncrypted()
+	if err != nil {
+		return "", err
+	}
+	if !encrypted {
+		return "", fmt.Errorf("wallet is not encrypted, use wallet encrypt")
+	}
+
+	old, err := readPassphrase("Current passphrase: ")
+	if err != nil {
+		return "", err
+	}
+
+	// Check the current passphrase before asking for the new one
+	err = Wallet.Unlock(old)
+	if err != nil {
+		return "", err
+	}
+
+	pass, err := readNewPassphrase()
+	if err != nil {
+		return "", err
+	}
+
+	err = Wallet.ChangePassphrase(old, pass, walletBuckets...)
+	if err != nil {
+		return "", err
+	}
+
+	return walletResponse("Wallet passphrase changed, existing session tokens are no longer valid")
+}
+
+func walletResponse(msg string) (string, error) {
+	if WantJsonOutput {
+		data, err := json.Marshal(map[string]string{"message": msg})
+		return string(data), err
+	}
+	return msg, nil
+}
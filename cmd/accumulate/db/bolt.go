@@ -33,11 +33,11 @@ func (b *BoltDB) Get(bucket []byte, key []byte) (value []byte, err error) {
 	err = b.db.View(func(tx *bolt.Tx) error {
 		b := tx.Bucket(bucket)
 		if b == nil {
-			return fmt.Errorf("bucket not defined")
+			return ErrNoBucket
 		}
 		value = b.Get(key)
 		if value == nil {
-			return fmt.Errorf("key not found")
+			return ErrNotFound
 		}
 		return err
 	})
@@ -60,6 +60,27 @@ func (b *BoltDB) Put(bucket []byte, key []byte, value []byte) error {
 	})
 }
 
+//PutBatch will write all of the values in a single transaction
+func (b *BoltDB) PutBatch(puts []BatchPut) error {
+	if b.db == nil {
+		return fmt.Errorf("database not open")
+	}
+
+	return b.db.Update(func(tx *bolt.Tx) error {
+		for _, p := range puts {
+			buck, err := tx.CreateBucketIfNotExists(p.Bucket)
+			if err != nil {
+				return fmt.Errorf("DB: %s", err)
+			}
+			err = buck.Put(p.Key, p.Value)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 //GetBucket will return the contents of a bucket
 func (b *BoltDB) GetBucket(bucket []byte) (buck *Bucket, err error) {
 	if b.db == nil {
@@ -69,7 +90,7 @@ func (b *BoltDB) GetBucket(bucket []byte) (buck *Bucket, err error) {
 	err = b.db.View(func(tx *bolt.Tx) error {
 		b := tx.Bucket(bucket)
 		if b == nil {
-			return fmt.Errorf("bucket not defined")
+			return ErrNoBucket
 		}
 		c := b.Cursor()
 		buck = new(Bucket)
@@ -91,7 +112,7 @@ func (b *BoltDB) Delete(bucket []byte, key []byte) error {
 	return b.db.Update(func(tx *bolt.Tx) error {
 		buck := tx.Bucket(bucket)
 		if buck == nil {
-			return fmt.Errorf("bucket not defined")
+			return ErrNoBucket
 		}
 		return buck.Delete(key)
 	})
@@ -1,6 +1,17 @@
 package db
 
-import "crypto/sha256"
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+)
+
+// ErrNotFound is returned by Get when the key is not found
+var ErrNotFound = errors.New("key not found")
+
+// ErrNoBucket is returned when the bucket does not exist. A key cannot be found
+// in a bucket that does not exist, so ErrNoBucket is also ErrNotFound.
+var ErrNoBucket = fmt.Errorf("bucket not defined: %w", ErrNotFound)
 
 //KeyValue holds the key/value data for the entry
 type KeyValue struct {
@@ -31,7 +42,14 @@ func (b *Bucket) Put(k []byte, v []byte) {
 	value := make([]byte, len(v))
 	copy(value, v)
 
-	b.ref[sha256.Sum256(key)] = len(b.KeyValueList)
+	// Overwrite the existing entry, if there is one
+	kh := sha256.Sum256(key)
+	if i, ok := b.ref[kh]; ok {
+		b.KeyValueList[i].Value = value
+		return
+	}
+
+	b.ref[kh] = len(b.KeyValueList)
 
 	b.KeyValueList = append(b.KeyValueList, KeyValue{key, value})
 }
@@ -62,16 +80,29 @@ func (b *Bucket) Delete(key []byte) (err error) {
 		}
 		b.KeyValueList = newList
 		delete(b.ref, kh)
+
+		// Entries after the deleted one have moved
+		for i, kv := range b.KeyValueList[v:] {
+			b.ref[sha256.Sum256(kv.Key)] = v + i
+		}
 	}
 	return nil
 }
 
+//BatchPut is a single write of a batch passed to PutBatch
+type BatchPut struct {
+	Bucket []byte
+	Key    []byte
+	Value  []byte
+}
+
 //DB defines the interface functions to access the database
 type DB interface {
 	Close() error                                            // Returns an error if the close fails
 	InitDB(filepath string) error                            // Sets up the database, returns error if it fails
-	Get(bucket []byte, key []byte) (value []byte, err error) // Get key from database, returns ErrNotFound if the key is not found
+	Get(bucket []byte, key []byte) (value []byte, err error) // Get key from database, returns ErrNotFound if the key or bucket is not found
 	Put(bucket []byte, key []byte, value []byte) error       // Put the value in the database, throws an error if fails
+	PutBatch(puts []BatchPut) error                          // PutBatch writes all of the values or none of them
 	GetBucket(bucket []byte) (*Bucket, error)                // GetBucket retrieves all the data contained within a bucket
 	Delete(bucket []byte, key []byte) error                  // Delete will remove a key/value pair from the bucket
 	DeleteBucket(bucket []byte) error                        // DeleteBucket will delete all key/value pairs from a bucket
@@ -2,11 +2,18 @@ package db
 
 import (
 	"encoding/binary"
+	"errors"
 	"testing"
 )
 
 func databaseTests(t *testing.T, db DB) {
 
+	//missing keys and buckets are not found
+	_, err := db.Get([]byte("missing"), []byte("key"))
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+
 	//write some data
 	for i := 0; i < 1000; i++ {
 		var bucket [4]byte
@@ -21,6 +28,11 @@ func databaseTests(t *testing.T, db DB) {
 		}
 	}
 
+	_, err = db.Get([]byte{0, 0, 0, 0}, []byte("missing"))
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+
 	//read back the data
 	for i := uint32(0); i < 1000; i++ {
 		var bucket [4]byte
@@ -63,4 +75,21 @@ func databaseTests(t *testing.T, db DB) {
 			}
 		}
 	}
+	//write a batch
+	puts := []BatchPut{
+		{Bucket: []byte("batch"), Key: []byte("a"), Value: []byte("1")},
+		{Bucket: []byte("batch"), Key: []byte("b"), Value: []byte("2")},
+	}
+	if err := db.PutBatch(puts); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range puts {
+		value, err := db.Get(p.Bucket, p.Key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(value) != string(p.Value) {
+			t.Fatal("value written by batch doesn't match expected")
+		}
+	}
 }
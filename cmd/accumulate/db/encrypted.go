@@ -0,0 +1,517 @@
+package db
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// ErrWalletLocked is returned when an encrypted wallet is accessed before it
+// has been unlocked.
+var ErrWalletLocked = errors.New("wallet is locked")
+
+// ErrWrongPassphrase is returned when the passphrase does not match the
+// wallet.
+var ErrWrongPassphrase = errors.New("wrong passphrase")
+
+// ErrInvalidSession is returned when a session token is unknown or has
+// expired.
+var ErrInvalidSession = errors.New("invalid or expired session")
+
+// BucketEncryption holds the encryption parameters of an encrypted wallet. Its
+// values are not encrypted.
+var BucketEncryption = []byte("encryption")
+
+var keyEncryptionParams = []byte("params")
+
+// BucketSessions holds the sessions created by NewSession. Each session holds
+// the wallet key encrypted with the session token, so its values are not
+// encrypted with the wallet key.
+var BucketSessions = []byte("sessions")
+
+// encryptionCheck is encrypted with the wallet key and stored with the
+// parameters, so a wrong passphrase can be detected.
+var encryptionCheck = []byte("accumulate wallet")
+
+// Scrypt parameters, as recommended for interactive logins
+const (
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+	saltLen      = 32
+	tokenLen     = 32
+)
+
+type encryptionParams struct {
+	Salt  []byte `json:"salt"`
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	Check []byte `json:"check"`
+}
+
+type session struct {
+	Expires time.Time `json:"expires"`
+	Key     []byte    `json:"key"`
+}
+
+// EncryptedDB wraps a DB and encrypts every value with a key derived from a
+// passphrase. Keys are not encrypted. If the wallet has not been encrypted,
+// EncryptedDB passes everything through to the underlying DB, so plaintext
+// wallets keep working until they are migrated with Encrypt.
+type EncryptedDB struct {
+	DB
+
+	// Prompt is called to get the passphrase when a value is accessed and the
+	// wallet is locked. If Prompt is nil, ErrWalletLocked is returned instead.
+	Prompt func() ([]byte, error)
+
+	params *encryptionParams
+	key    []byte
+	aead   cipher.AEAD
+}
+
+// NewEncryptedDB wraps the DB.
+func NewEncryptedDB(db DB) *EncryptedDB {
+	return &EncryptedDB{DB: db}
+}
+
+func (e *EncryptedDB) loadParams() (*encryptionParams, error) {
+	if e.params != nil {
+		return e.params, nil
+	}
+
+	// A wallet without encryption parameters is not encrypted
+	data, err := e.DB.Get(BucketEncryption, keyEncryptionParams)
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrNotFound):
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("failed to load wallet encryption parameters: %w", err)
+	}
+
+	params := new(encryptionParams)
+	err = json.Unmarshal(data, params)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wallet encryption parameters: %v", err)
+	}
+
+	e.params = params
+	return params, nil
+}
+
+// IsEncrypted returns true if the wallet has been encrypted.
+func (e *EncryptedDB) IsEncrypted() (bool, error) {
+	params, err := e.loadParams()
+	return params != nil, err
+}
+
+// IsLocked returns true if the wallet is encrypted and has not been unlocked.
+func (e *EncryptedDB) IsLocked() (bool, error) {
+	encrypted, err := e.IsEncrypted()
+	return encrypted && e.aead == nil, err
+}
+
+// Unlock derives the wallet key from the passphrase and unlocks the wallet.
+func (e *EncryptedDB) Unlock(passphrase []byte) error {
+	params, err := e.loadParams()
+	if err != nil {
+		return err
+	}
+	if params == nil {
+		return fmt.Errorf("wallet is not encrypted")
+	}
+
+	key, err := scrypt.Key(passphrase, params.Salt, params.N, params.R, params.P, scryptKeyLen)
+	if err != nil {
+		return err
+	}
+
+	return e.unlockWithKey(key)
+}
+
+// unlockWithKey unlocks the wallet with the derived key.
+func (e *EncryptedDB) unlockWithKey(key []byte) error {
+	params, err := e.loadParams()
+	if err != nil {
+		return err
+	}
+	if params == nil {
+		return fmt.Errorf("wallet is not encrypted")
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+
+	check, err := open(aead, params.Check)
+	if err != nil || !bytes.Equal(check, encryptionCheck) {
+		return ErrWrongPassphrase
+	}
+
+	e.key, e.aead = key, aead
+	return nil
+}
+
+// NewSession returns a random token that can be passed to UnlockSession to
+// unlock the wallet until the session expires. The wallet key is stored
+// encrypted with the token, so the key is never exposed and the token is
+// useless without the wallet.
+func (e *EncryptedDB) NewSession(expires time.Time) ([]byte, error) {
+	locked, err := e.IsLocked()
+	switch {
+	case err != nil:
+		return nil, err
+	case locked:
+		return nil, ErrWalletLocked
+	case e.key == nil:
+		return nil, fmt.Errorf("wallet is not encrypted")
+	}
+
+	token := make([]byte, tokenLen)
+	_, err = rand.Read(token)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(token)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &session{Expires: expires.UTC()}
+	s.Key, err = seal(aead, e.key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	id := sha256.Sum256(token)
+	err = e.DB.Put(BucketSessions, id[:], data)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// UnlockSession unlocks the wallet with a token returned by NewSession. An
+// expired session is deleted.
+func (e *EncryptedDB) UnlockSession(token []byte) error {
+	id := sha256.Sum256(token)
+	data, err := e.DB.Get(BucketSessions, id[:])
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrNotFound):
+		return ErrInvalidSession
+	default:
+		return err
+	}
+
+	s := new(session)
+	err = json.Unmarshal(data, s)
+	if err != nil {
+		return fmt.Errorf("invalid wallet session: %v", err)
+	}
+
+	if !time.Now().Before(s.Expires) {
+		_ = e.DB.Delete(BucketSessions, id[:])
+		return ErrInvalidSession
+	}
+
+	aead, err := newAEAD(token)
+	if err != nil {
+		return ErrInvalidSession
+	}
+
+	key, err := open(aead, s.Key)
+	if err != nil {
+		return ErrInvalidSession
+	}
+
+	return e.unlockWithKey(key)
+}
+
+// EndSessions deletes every session, so their tokens no longer unlock the
+// wallet.
+func (e *EncryptedDB) EndSessions() error {
+	_, err := e.DB.GetBucket(BucketSessions)
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrNoBucket):
+		return nil
+	default:
+		return err
+	}
+	return e.DB.DeleteBucket(BucketSessions)
+}
+
+// Encrypt encrypts a plaintext wallet with the passphrase. Every value of the
+// given buckets is encrypted in place.
+func (e *EncryptedDB) Encrypt(passphrase []byte, buckets ...[]byte) error {
+	encrypted, err := e.IsEncrypted()
+	if err != nil {
+		return err
+	}
+	if encrypted {
+		return fmt.Errorf("wallet is already encrypted")
+	}
+
+	values, err := e.readAll(nil, buckets)
+	if err != nil {
+		return err
+	}
+
+	return e.rekey(passphrase, values)
+}
+
+// ChangePassphrase re-encrypts every value of the given buckets with a key
+// derived from the new passphrase. Existing sessions are ended.
+func (e *EncryptedDB) ChangePassphrase(oldPassphrase, newPassphrase []byte, buckets ...[]byte) error {
+	err := e.Unlock(oldPassphrase)
+	if err != nil {
+		return err
+	}
+
+	values, err := e.readAll(e.aead, buckets)
+	if err != nil {
+		return err
+	}
+
+	err = e.rekey(newPassphrase, values)
+	if err != nil {
+		return err
+	}
+
+	return e.EndSessions()
+}
+
+type bucketValues struct {
+	bucket []byte
+	values []KeyValue
+}
+
+// readAll reads and, if aead is not nil, decrypts every value of the buckets.
+func (e *EncryptedDB) readAll(aead cipher.AEAD, buckets [][]byte) ([]bucketValues, error) {
+	var all []bucketValues
+	for _, bucket := range buckets {
+		b, err := e.DB.GetBucket(bucket)
+		if err != nil {
+			// Bucket does not exist
+			continue
+		}
+
+		bv := bucketValues{bucket: bucket}
+		for _, kv := range b.KeyValueList {
+			if aead != nil {
+				kv.Value, err = open(aead, kv.Value)
+				if err != nil {
+					return nil, fmt.Errorf("failed to decrypt %s/%x: %v", bucket, kv.Key, err)
+				}
+			}
+			bv.values = append(bv.values, kv)
+		}
+		all = append(all, bv)
+	}
+	return all, nil
+}
+
+// rekey derives a new key from the passphrase, encrypts the values with it,
+// and writes the values and the new parameters in a single batch.
+func (e *EncryptedDB) rekey(passphrase []byte, values []bucketValues) error {
+	params := &encryptionParams{N: scryptN, R: scryptR, P: scryptP}
+	params.Salt = make([]byte, saltLen)
+	_, err := rand.Read(params.Salt)
+	if err != nil {
+		return err
+	}
+
+	key, err := scrypt.Key(passphrase, params.Salt, params.N, params.R, params.P, scryptKeyLen)
+	if err != nil {
+		return err
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+
+	params.Check, err = seal(aead, encryptionCheck)
+	if err != nil {
+		return err
+	}
+
+	var puts []BatchPut
+	for _, bv := range values {
+		for _, kv := range bv.values {
+			value, err := seal(aead, kv.Value)
+			if err != nil {
+				return err
+			}
+			puts = append(puts, BatchPut{Bucket: bv.bucket, Key: kv.Key, Value: value})
+		}
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	puts = append(puts, BatchPut{Bucket: BucketEncryption, Key: keyEncryptionParams, Value: data})
+
+	// Write everything at once, so an interrupted rekey cannot leave values
+	// encrypted with a key that does not match the parameters
+	err = e.DB.PutBatch(puts)
+	if err != nil {
+		return err
+	}
+
+	e.params, e.key, e.aead = params, key, aead
+	return nil
+}
+
+// cipher returns the AEAD to use for values, or nil if the wallet is not
+// encrypted. If the wallet is locked, it is unlocked with Prompt.
+func (e *EncryptedDB) cipher(bucket []byte) (cipher.AEAD, error) {
+	if bytes.Equal(bucket, BucketEncryption) || bytes.Equal(bucket, BucketSessions) {
+		return nil, nil
+	}
+
+	locked, err := e.IsLocked()
+	if err != nil || !locked {
+		return e.aead, err
+	}
+
+	if e.Prompt == nil {
+		return nil, ErrWalletLocked
+	}
+
+	passphrase, err := e.Prompt()
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.Unlock(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return e.aead, nil
+}
+
+// Get will get an entry in the database given a bucket and key
+func (e *EncryptedDB) Get(bucket []byte, key []byte) ([]byte, error) {
+	aead, err := e.cipher(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := e.DB.Get(bucket, key)
+	if err != nil || aead == nil {
+		return value, err
+	}
+
+	return open(aead, value)
+}
+
+// Put will write data to a given bucket using the key
+func (e *EncryptedDB) Put(bucket []byte, key []byte, value []byte) error {
+	aead, err := e.cipher(bucket)
+	if err != nil {
+		return err
+	}
+
+	if aead != nil {
+		value, err = seal(aead, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.DB.Put(bucket, key, value)
+}
+
+// PutBatch will write all of the values or none of them
+func (e *EncryptedDB) PutBatch(puts []BatchPut) error {
+	sealed := make([]BatchPut, len(puts))
+	for i, p := range puts {
+		aead, err := e.cipher(p.Bucket)
+		if err != nil {
+			return err
+		}
+
+		sealed[i] = p
+		if aead == nil {
+			continue
+		}
+
+		sealed[i].Value, err = seal(aead, p.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.DB.PutBatch(sealed)
+}
+
+// GetBucket will return the contents of a bucket
+func (e *EncryptedDB) GetBucket(bucket []byte) (*Bucket, error) {
+	aead, err := e.cipher(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	buck, err := e.DB.GetBucket(bucket)
+	if err != nil || aead == nil {
+		return buck, err
+	}
+
+	plain := NewBucket()
+	for _, kv := range buck.KeyValueList {
+		value, err := open(aead, kv.Value)
+		if err != nil {
+			return nil, err
+		}
+		plain.Put(kv.Key, value)
+	}
+	return plain, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts the value and prepends the nonce.
+func seal(aead cipher.AEAD, value []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(value)+aead.Overhead())
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, value, nil), nil
+}
+
+// open decrypts a value encrypted by seal.
+func open(aead cipher.AEAD, data []byte) ([]byte, error) {
+	if len(data) < aead.NonceSize() {
+		return nil, fmt.Errorf("encrypted value is too short")
+	}
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
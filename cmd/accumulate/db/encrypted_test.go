@@ -0,0 +1,137 @@
+package db
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptedDatabase(t *testing.T) {
+	raw := new(MemoryDB)
+	require.NoError(t, raw.InitDB(""))
+	defer raw.Close()
+
+	bucket, key, value := []byte("keys"), []byte("foo"), []byte("secret")
+
+	// An unencrypted wallet is passed through
+	db := NewEncryptedDB(raw)
+	require.NoError(t, db.Put(bucket, key, value))
+	v, err := raw.Get(bucket, key)
+	require.NoError(t, err)
+	require.Equal(t, value, v)
+
+	// Encrypt it
+	require.NoError(t, db.Encrypt([]byte("pass1"), bucket))
+	v, err = raw.Get(bucket, key)
+	require.NoError(t, err)
+	require.NotEqual(t, value, v)
+	v, err = db.Get(bucket, key)
+	require.NoError(t, err)
+	require.Equal(t, value, v)
+
+	// A new instance is locked
+	db = NewEncryptedDB(raw)
+	_, err = db.Get(bucket, key)
+	require.ErrorIs(t, err, ErrWalletLocked)
+	require.ErrorIs(t, db.Unlock([]byte("wrong")), ErrWrongPassphrase)
+	require.NoError(t, db.Unlock([]byte("pass1")))
+	v, err = db.Get(bucket, key)
+	require.NoError(t, err)
+	require.Equal(t, value, v)
+
+	// The session token unlocks the wallet until it expires
+	session, err := db.NewSession(time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	expired, err := db.NewSession(time.Now().Add(-time.Second))
+	require.NoError(t, err)
+	db = NewEncryptedDB(raw)
+	require.ErrorIs(t, db.UnlockSession(expired), ErrInvalidSession)
+	require.ErrorIs(t, db.UnlockSession([]byte("not a session")), ErrInvalidSession)
+	require.NoError(t, db.UnlockSession(session))
+
+	// The prompt is used to unlock the wallet when needed
+	db = NewEncryptedDB(raw)
+	db.Prompt = func() ([]byte, error) { return []byte("pass1"), nil }
+	v, err = db.Get(bucket, key)
+	require.NoError(t, err)
+	require.Equal(t, value, v)
+
+	// Change the passphrase
+	require.NoError(t, db.ChangePassphrase([]byte("pass1"), []byte("pass2"), bucket))
+	db = NewEncryptedDB(raw)
+	require.ErrorIs(t, db.Unlock([]byte("pass1")), ErrWrongPassphrase)
+	require.NoError(t, db.Unlock([]byte("pass2")))
+	v, err = db.Get(bucket, key)
+	require.NoError(t, err)
+	require.Equal(t, value, v)
+
+	// Changing the passphrase ends the sessions
+	require.ErrorIs(t, NewEncryptedDB(raw).UnlockSession(session), ErrInvalidSession)
+
+	// The encrypted wallet behaves like any other database
+	databaseTests(t, db)
+}
+
+// failingDB fails every batch write.
+type failingDB struct {
+	DB
+}
+
+func (failingDB) PutBatch([]BatchPut) error {
+	return errors.New("interrupted")
+}
+
+func TestEncryptedRekeyIsAtomic(t *testing.T) {
+	raw := new(MemoryDB)
+	require.NoError(t, raw.InitDB(""))
+	defer raw.Close()
+
+	bucket := []byte("keys")
+	db := NewEncryptedDB(raw)
+	for _, k := range []string{"foo", "bar", "baz"} {
+		require.NoError(t, db.Put(bucket, []byte(k), []byte("secret "+k)))
+	}
+	require.NoError(t, db.Encrypt([]byte("pass1"), bucket))
+
+	// A failed passphrase change leaves every value readable with the old
+	// passphrase
+	db = NewEncryptedDB(failingDB{raw})
+	require.Error(t, db.ChangePassphrase([]byte("pass1"), []byte("pass2"), bucket))
+
+	db = NewEncryptedDB(raw)
+	require.NoError(t, db.Unlock([]byte("pass1")))
+	for _, k := range []string{"foo", "bar", "baz"} {
+		v, err := db.Get(bucket, []byte(k))
+		require.NoError(t, err)
+		require.Equal(t, "secret "+k, string(v))
+	}
+}
+
+// brokenDB fails every read.
+type brokenDB struct {
+	DB
+}
+
+func (brokenDB) Get([]byte, []byte) ([]byte, error) {
+	return nil, errors.New("disk on fire")
+}
+
+func TestEncryptedReadError(t *testing.T) {
+	raw := new(MemoryDB)
+	require.NoError(t, raw.InitDB(""))
+	defer raw.Close()
+
+	// A wallet without encryption parameters is not encrypted
+	encrypted, err := NewEncryptedDB(raw).IsEncrypted()
+	require.NoError(t, err)
+	require.False(t, encrypted)
+
+	// Any other error must not be mistaken for a plaintext wallet
+	_, err = NewEncryptedDB(brokenDB{raw}).IsEncrypted()
+	require.Error(t, err)
+	_, err = NewEncryptedDB(brokenDB{raw}).Get([]byte("keys"), []byte("foo"))
+	require.Error(t, err)
+	require.Error(t, NewEncryptedDB(brokenDB{raw}).Encrypt([]byte("pass")))
+}
@@ -34,10 +34,10 @@ func (b *MemoryDB) Get(bucket []byte, key []byte) (value []byte, err error) {
 	if v, ok := b.buckets[sha256.Sum256(bucket)]; ok {
 		value = v.Get(key)
 		if value == nil {
-			err = fmt.Errorf("key not found")
+			err = ErrNotFound
 		}
 	} else {
-		err = fmt.Errorf("bucket not defined")
+		err = ErrNoBucket
 	}
 	return value, err
 }
@@ -60,6 +60,22 @@ func (b *MemoryDB) Put(bucket []byte, key []byte, value []byte) error {
 	return nil
 }
 
+//PutBatch will write all of the values. Writes to memory cannot fail, so the
+//batch is atomic.
+func (b *MemoryDB) PutBatch(puts []BatchPut) error {
+	if b.buckets == nil {
+		return fmt.Errorf("memory database not initialized")
+	}
+
+	for _, p := range puts {
+		err := b.Put(p.Bucket, p.Key, p.Value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 //GetBucket will return the contents of a bucket
 func (b *MemoryDB) GetBucket(bucket []byte) (buck *Bucket, err error) {
 	if b.buckets == nil {
@@ -68,7 +84,7 @@ func (b *MemoryDB) GetBucket(bucket []byte) (buck *Bucket, err error) {
 
 	var ok bool
 	if buck, ok = b.buckets[sha256.Sum256(bucket)]; !ok {
-		err = fmt.Errorf("bucket not defined")
+		err = ErrNoBucket
 	}
 	return buck, err
 }
@@ -82,7 +98,7 @@ func (b *MemoryDB) Delete(bucket []byte, key []byte) (err error) {
 	if buck, ok := b.buckets[sha256.Sum256(bucket)]; ok {
 		buck.Delete(key)
 	} else {
-		err = fmt.Errorf("bucket not defined")
+		err = ErrNoBucket
 	}
 	return err
 }
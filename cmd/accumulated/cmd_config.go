@@ -1,11 +1,14 @@
 package main
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 
 	"github.com/spf13/cobra"
 	"gitlab.com/accumulatenetwork/accumulate/config"
+	"gitlab.com/accumulatenetwork/accumulate/internal/database"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
 )
 
 var cmdConfig = &cobra.Command{
@@ -19,9 +22,19 @@ var cmdConfigSentry = &cobra.Command{
 	Args: cobra.ExactArgs(1),
 }
 
+var cmdConfigStorage = &cobra.Command{
+	Use:   "set-storage [badger|bolt]",
+	Short: "Copy each node's database to a new storage backend and configure the node to use it",
+	Long: `Copy each node's database to a new storage backend and configure the node to use it.
+The nodes must be stopped. The old database is left in place and can be deleted
+once the node has been started successfully with the new backend.`,
+	Run:  setStorage,
+	Args: cobra.ExactArgs(1),
+}
+
 func init() {
 	cmdMain.AddCommand(cmdConfig)
-	cmdConfig.AddCommand(cmdConfigSentry)
+	cmdConfig.AddCommand(cmdConfigSentry, cmdConfigStorage)
 }
 
 func setSentryDsn(cmd *cobra.Command, args []string) {
@@ -41,3 +54,69 @@ func setSentryDsn(cmd *cobra.Command, args []string) {
 		checkf(err, "saving config for %q", e.Name())
 	}
 }
+
+func setStorage(cmd *cobra.Command, args []string) {
+	typ := config.StorageType(args[0])
+	switch typ {
+	case config.BadgerStorage, config.BoltStorage:
+	default:
+		fatalf("cannot migrate to %q storage", typ)
+	}
+
+	entries, err := os.ReadDir(flagMain.WorkDir)
+	checkf(err, "reading %q", flagMain.WorkDir)
+
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+
+		cfg, err := config.Load(filepath.Join(flagMain.WorkDir, e.Name()))
+		checkf(err, "loading config for %q", e.Name())
+
+		from := cfg.Accumulate.Storage
+		if from.Type == "" {
+			from.Type = config.BadgerStorage
+		}
+		if from.Type == typ {
+			fmt.Printf("%s already uses %s storage\n", e.Name(), typ)
+			continue
+		}
+		if from.Type == config.MemoryStorage {
+			fatalf("%s uses memory storage, which cannot be migrated", e.Name())
+		}
+
+		to := from
+		to.Type = typ
+		migrateStorage(e.Name(), from, to, cfg.RootDir)
+
+		cfg.Accumulate.Storage.Type = typ
+		err = config.Store(cfg)
+		checkf(err, "saving config for %q", e.Name())
+		fmt.Printf("%s now uses %s storage\n", e.Name(), typ)
+	}
+}
+
+func migrateStorage(name string, from, to config.Storage, rootDir string) {
+	fromPath, toPath := from.Path(rootDir), to.Path(rootDir)
+	_, err := os.Stat(toPath)
+	if err == nil {
+		fatalf("%s: %q already exists", name, toPath)
+	}
+
+	src, err := database.OpenStore(from.Type, fromPath, nil)
+	checkf(err, "opening %q", fromPath)
+	defer src.Close()
+
+	dst, err := database.OpenStore(to.Type, toPath, nil)
+	checkf(err, "opening %q", toPath)
+	defer dst.Close()
+
+	err = storage.Copy(dst, src, 10000)
+	if err != nil {
+		// Do not leave a partial copy behind
+		_ = dst.Close()
+		_ = os.RemoveAll(toPath)
+		fatalf("copying %q to %q: %v", fromPath, toPath, err)
+	}
+}
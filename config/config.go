@@ -23,6 +23,14 @@ const (
 	Directory      NetworkType = "directory"
 )
 
+type StorageType string
+
+const (
+	BadgerStorage StorageType = "badger"
+	BoltStorage   StorageType = "bolt"
+	MemoryStorage StorageType = "memory"
+)
+
 type NodeType string
 
 const (
@@ -41,6 +49,11 @@ func Default(net NetworkType, node NodeType, netId string) *Config {
 	c.Accumulate.Website.Enabled = true
 	c.Accumulate.API.TxMaxWaitTime = 10 * time.Second
 	c.Accumulate.API.EnableDebugMethods = true
+	c.Accumulate.Snapshots.Enable = true
+	c.Accumulate.Snapshots.Directory = "snapshots"
+	c.Accumulate.Snapshots.Frequency = 10000
+	c.Accumulate.Snapshots.Retain = 3
+	c.Accumulate.Storage.Type = BadgerStorage
 	switch node {
 	case Validator:
 		c.Config = *tm.DefaultValidatorConfig()
@@ -61,9 +74,11 @@ type Config struct {
 type Accumulate struct {
 	SentryDSN string `toml:"sentry-dsn" mapstructure:"sentry-dsn"`
 
-	Network Network `toml:"network" mapstructure:"network"`
-	API     API     `toml:"api" mapstructure:"api"`
-	Website Website `toml:"website" mapstructure:"website"`
+	Network   Network   `toml:"network" mapstructure:"network"`
+	API       API       `toml:"api" mapstructure:"api"`
+	Website   Website   `toml:"website" mapstructure:"website"`
+	Snapshots Snapshots `toml:"snapshots" mapstructure:"snapshots"`
+	Storage   Storage   `toml:"storage" mapstructure:"storage"`
 }
 
 type Network struct {
@@ -97,6 +112,42 @@ type Website struct {
 	ListenAddress string `toml:"website-listen-address" mapstructure:"website-listen-address"`
 }
 
+// Snapshots configures state sync snapshots, which are taken every Frequency
+// blocks and served to nodes that are syncing. The directory is relative to
+// the node's root directory.
+type Snapshots struct {
+	Enable    bool   `toml:"enable" mapstructure:"enable"`
+	Directory string `toml:"directory" mapstructure:"directory"`
+	Frequency int64  `toml:"frequency" mapstructure:"frequency"`
+	Retain    int    `toml:"retain" mapstructure:"retain"`
+}
+
+// Storage configures the node's database. If Archive is set, the database
+// keeps a copy of the state of every account as of every block in which it
+// changed, which allows accounts to be queried at a past height.
+//
+// Type selects the database backend. Badger, the default, stores the database
+// in the valacc.db directory of the node. Bolt stores it in the single file
+// valacc.bolt, and does not have a value log that must be garbage collected or
+// truncated after a crash. Memory does not persist anything and is only
+// suitable for testing. Use `accumulated config set-storage` to migrate a
+// node's data to a different backend.
+type Storage struct {
+	Type    StorageType `toml:"type" mapstructure:"type"`
+	Archive bool        `toml:"archive" mapstructure:"archive"`
+}
+
+// Path returns the path of the database of the given type, relative to the
+// node's root directory. An empty type is Badger.
+func (s *Storage) Path(rootDir string) string {
+	switch s.Type {
+	case BoltStorage:
+		return filepath.Join(rootDir, "valacc.bolt")
+	default:
+		return filepath.Join(rootDir, "valacc.db")
+	}
+}
+
 func OffsetPort(addr string, offset int) (*url.URL, error) {
 	u, err := url.Parse(addr)
 	if err != nil {
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"gitlab.com/accumulatenetwork/accumulate/internal/database"
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
 	apiQuery "gitlab.com/accumulatenetwork/accumulate/types/api/query"
 	"gitlab.com/accumulatenetwork/accumulate/types/api/transactions"
@@ -59,6 +60,11 @@ type Chain interface {
 	BeginBlock(BeginBlockRequest) (BeginBlockResponse, error)
 	CheckTx(*transactions.Envelope) (protocol.TransactionResult, *protocol.Error)
 	DeliverTx(*transactions.Envelope) (protocol.TransactionResult, *protocol.Error)
-	EndBlock(EndBlockRequest) EndBlockResponse
+	EndBlock(EndBlockRequest) (EndBlockResponse, error)
 	Commit() ([]byte, error)
+
+	// SnapshotIndexes returns the index values that transactions depend on
+	// but that are not part of any account's state. Snapshots must include
+	// them.
+	SnapshotIndexes(*database.Batch) ([]*database.Value, error)
 }
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"runtime/debug"
+	"sync"
 	"time"
 
 	"github.com/getsentry/sentry-go"
@@ -37,17 +38,23 @@ type Accumulator struct {
 
 	txct     int64
 	timer    time.Time
+	height   int64
 	didPanic bool
 
+	snapshotMu sync.Mutex
+	snapshots  map[uint64]*abci.Snapshot
+	restore    *snapshotRestore
+
 	onFatal func(error)
 }
 
 type AccumulatorOptions struct {
-	Chain   Chain
-	DB      *database.Database
-	Logger  log.Logger
-	Network config.Network
-	Address crypto.Address // This is the address of this node, and is used to determine if the node is the leader
+	Chain     Chain
+	DB        *database.Database
+	Logger    log.Logger
+	Network   config.Network
+	Snapshots config.Snapshots
+	Address   crypto.Address // This is the address of this node, and is used to determine if the node is the leader
 }
 
 // NewAccumulator returns a new Accumulator.
@@ -255,6 +262,7 @@ func (app *Accumulator) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBegi
 	app.timer = time.Now()
 
 	app.txct = 0
+	app.height = req.Header.Height
 
 	/*
 		app.ValUpdates = make([]types.ValidatorUpdate, 0)
@@ -398,7 +406,12 @@ func (app *Accumulator) DeliverTx(req abci.RequestDeliverTx) (rdt abci.ResponseD
 func (app *Accumulator) EndBlock(req abci.RequestEndBlock) (resp abci.ResponseEndBlock) {
 	defer app.recover(nil, true)
 
-	r := app.Chain.EndBlock(EndBlockRequest{})
+	r, err := app.Chain.EndBlock(EndBlockRequest{})
+	if err != nil {
+		app.fatal(err, true)
+		return
+	}
+
 	resp.ValidatorUpdates = make([]abci.ValidatorUpdate, len(r.NewValidators))
 	for i, key := range r.NewValidators {
 		resp.ValidatorUpdates[i] = abci.ValidatorUpdate{
@@ -439,36 +452,15 @@ func (app *Accumulator) Commit() (resp abci.ResponseCommit) {
 	duration := time.Since(app.timer)
 	app.logger.Debug("Committed", "transactions", app.txct, "duration", duration.String(), "tps", float64(app.txct)/duration.Seconds())
 
-	return resp
-}
-
-// ListSnapshots implements github.com/tendermint/tendermint/abci/types.Application.
-func (app *Accumulator) ListSnapshots(
-	req abci.RequestListSnapshots) abci.ResponseListSnapshots {
-
-	req.ProtoMessage()
-	return abci.ResponseListSnapshots{}
-}
-
-// OfferSnapshot implements github.com/tendermint/tendermint/abci/types.Application.
-func (app *Accumulator) OfferSnapshot(
-	req abci.RequestOfferSnapshot) abci.ResponseOfferSnapshot {
-	return abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ABORT}
-}
-
-// LoadSnapshotChunk implements github.com/tendermint/tendermint/abci/types.Application.
-func (app *Accumulator) LoadSnapshotChunk(
-	req abci.RequestLoadSnapshotChunk) abci.ResponseLoadSnapshotChunk {
-
-	//req.Height
-	//resp := abcitypes.ResponseLoadSnapshotChunk{}
-	//need to get a block of data between markers.
-	//resp.Chunk = app.mm.GetState(req.Height)
-	return abci.ResponseLoadSnapshotChunk{}
-}
+	if app.shouldSnapshot(app.height) {
+		t := time.Now()
+		err := app.takeSnapshot(app.height)
+		if err != nil {
+			app.logger.Error("Failed to take snapshot", "height", app.height, "error", err)
+		} else {
+			app.logger.Info("Took snapshot", "height", app.height, "duration", time.Since(t))
+		}
+	}
 
-// ApplySnapshotChunk implements github.com/tendermint/tendermint/abci/types.Application.
-func (app *Accumulator) ApplySnapshotChunk(
-	req abci.RequestApplySnapshotChunk) abci.ResponseApplySnapshotChunk {
-	return abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ABORT}
+	return resp
 }
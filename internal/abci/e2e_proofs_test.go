@@ -1,13 +1,26 @@
 package abci_test
 
 import (
+	"context"
 	"crypto/sha256"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/tendermint/tendermint/version"
+	api2 "gitlab.com/accumulatenetwork/accumulate/internal/api/v2"
+	"gitlab.com/accumulatenetwork/accumulate/internal/client/light"
+	"gitlab.com/accumulatenetwork/accumulate/internal/indexing"
 	acctesting "gitlab.com/accumulatenetwork/accumulate/internal/testing"
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
+	"gitlab.com/accumulatenetwork/accumulate/types/api/query"
 	"gitlab.com/accumulatenetwork/accumulate/types/api/transactions"
+	"gitlab.com/accumulatenetwork/accumulate/types/proof"
 )
 
 func TestProofADI(t *testing.T) {
@@ -58,5 +71,246 @@ func TestProofADI(t *testing.T) {
 	n.GetADI("RoadRunner")
 	n.GetTokenAccount("RoadRunner/Baz")
 
+	// Proofs of account state are only available in archive mode
+	_, err := n.api.QueryProof(n.ParseUrl("RoadRunner/Baz"), nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "archive mode")
+
 	// TODO Verify proofs
 }
+
+func TestQueryProof(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, openArchive, true)
+	n := nodes[subnets[1]][0]
+
+	alice, bob := generateKey(), generateKey()
+	batch := n.db.Begin()
+	require.NoError(t, acctesting.CreateLiteTokenAccountWithCredits(batch, alice, acctesting.TestTokenAmount, 1e9))
+	require.NoError(t, batch.Commit())
+	aliceUrl := acctesting.AcmeLiteAddressTmPriv(alice).String()
+	bobUrl := acctesting.AcmeLiteAddressTmPriv(bob).String()
+
+	txid := n.Batch(func(send func(*transactions.Envelope)) {
+		exch := new(protocol.SendTokens)
+		exch.AddRecipient(n.ParseUrl(bobUrl), big.NewInt(1000))
+		send(newTxn(aliceUrl).
+			WithBody(exch).
+			SignLegacyED25519(alice))
+	})[0]
+
+	// Wait for the directory to anchor the block
+	queryProof := func(t *testing.T, u *url.URL, txid []byte) *query.ResponseProof {
+		var res *api2.ChainQueryResponse
+		var err error
+		for i := 0; i < 50; i++ {
+			res, err = n.api.QueryProof(u, txid)
+			if err == nil {
+				break
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+		require.NoError(t, err)
+		return res.Data.(*query.ResponseProof)
+	}
+
+	t.Run("Transaction", func(t *testing.T) {
+		p := queryProof(t, nil, txid[:])
+		require.Equal(t, txid[:], p.Start)
+		require.Equal(t, protocol.MainChain, p.Chain)
+		require.Len(t, p.Segments, 3)
+
+		receipt, err := proof.Verify(p, txid[:])
+		require.NoError(t, err)
+		require.True(t, receipt.Validate())
+	})
+
+	t.Run("Transaction on account", func(t *testing.T) {
+		p := queryProof(t, n.ParseUrl(aliceUrl), txid[:])
+		require.True(t, n.ParseUrl(aliceUrl).Equal(p.Account))
+		_, err := proof.Verify(p, txid[:])
+		require.NoError(t, err)
+	})
+
+	t.Run("Account state", func(t *testing.T) {
+		p := queryProof(t, n.ParseUrl(aliceUrl), nil)
+		require.Equal(t, "bpt", p.Chain)
+
+		batch := n.db.Begin()
+		defer batch.Discard()
+		hash, err := batch.Account(n.ParseUrl(aliceUrl)).StateHash()
+		require.NoError(t, err)
+
+		_, err = proof.Verify(p, hash[:])
+		require.NoError(t, err)
+	})
+
+	t.Run("Tampered", func(t *testing.T) {
+		p := queryProof(t, nil, txid[:])
+		p.Segments[1].Entries[0].Right = !p.Segments[1].Entries[0].Right
+		_, err := proof.Verify(p, txid[:])
+		require.ErrorIs(t, err, proof.ErrInvalidProof)
+	})
+}
+
+// querierNode adapts a Querier to the interface used by the light client.
+type querierNode struct {
+	api api2.Querier
+}
+
+func (q querierNode) QueryAnchor(_ context.Context, req *api2.AnchorQuery) (*api2.ChainQueryResponse, error) {
+	return q.api.QueryAnchor(req.Anchor)
+}
+
+func (q querierNode) QueryProof(_ context.Context, req *api2.ProofQuery) (*api2.ChainQueryResponse, error) {
+	return q.api.QueryProof(req.Url, req.Txid)
+}
+
+// lyingNode is a directory node that forges the proofs of anchors, so that
+// they end at a BPT root other than the one of the directory.
+type lyingNode struct {
+	querierNode
+}
+
+func (q lyingNode) QueryAnchor(ctx context.Context, req *api2.AnchorQuery) (*api2.ChainQueryResponse, error) {
+	res, err := q.querierNode.QueryAnchor(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	p := res.Data.(*query.ResponseAnchorProof)
+	p.StateReceipt.Entries = append(p.StateReceipt.Entries, protocol.ReceiptEntry{Hash: make([]byte, 32), Right: true})
+	return res, nil
+}
+
+// signedHeaders signs headers of the directory whose app hash is the BPT root
+// of the directory as of the previous block.
+type signedHeaders struct {
+	dn         *FakeNode
+	chainID    string
+	signer     tmtypes.PrivValidator
+	validators *tmtypes.ValidatorSet
+}
+
+func newSignedHeaders(t *testing.T, dn *FakeNode, chainID string, signer tmtypes.PrivValidator) *signedHeaders {
+	key, err := signer.GetPubKey(context.Background())
+	require.NoError(t, err)
+	h := new(signedHeaders)
+	h.dn = dn
+	h.chainID = chainID
+	h.signer = signer
+	h.validators = tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(key, 1)})
+	return h
+}
+
+func (h *signedHeaders) LightBlock(ctx context.Context, height int64) (*tmtypes.LightBlock, error) {
+	batch := h.dn.db.Begin()
+	defer batch.Discard()
+
+	ledgerUrl := h.dn.network.NodeUrl(protocol.Ledger)
+	root, err := indexing.BlockRoot(batch, ledgerUrl).Get(uint64(height - 1))
+	if err != nil {
+		return nil, err
+	}
+	rootChain, err := batch.Account(ledgerUrl).ReadChain(protocol.MinorRootChain)
+	if err != nil {
+		return nil, err
+	}
+	appHash, err := rootChain.Entry(int64(root.BptEntry))
+	if err != nil {
+		return nil, err
+	}
+
+	header := new(tmtypes.Header)
+	header.Version.Block = version.BlockProtocol
+	header.ChainID = h.chainID
+	header.Height = height
+	header.Time = time.Now()
+	header.AppHash = appHash
+	header.ValidatorsHash = h.validators.Hash()
+	header.NextValidatorsHash = h.validators.Hash()
+	header.ProposerAddress = h.validators.Proposer.Address
+
+	blockID := tmtypes.BlockID{Hash: header.Hash(), PartSetHeader: tmtypes.PartSetHeader{Total: 1, Hash: tmhash.Sum(nil)}}
+	vote := new(tmtypes.Vote)
+	vote.Type = tmproto.PrecommitType
+	vote.Height = height
+	vote.BlockID = blockID
+	vote.Timestamp = header.Time
+	vote.ValidatorAddress = h.validators.Validators[0].Address
+	v := vote.ToProto()
+	err = h.signer.SignVote(ctx, h.chainID, v)
+	if err != nil {
+		return nil, err
+	}
+	vote.Signature = v.Signature
+
+	votes := tmtypes.NewVoteSet(h.chainID, height, 0, tmproto.PrecommitType, h.validators)
+	_, err = votes.AddVote(vote)
+	if err != nil {
+		return nil, err
+	}
+
+	block := new(tmtypes.LightBlock)
+	block.SignedHeader = &tmtypes.SignedHeader{Header: header, Commit: votes.MakeCommit()}
+	block.ValidatorSet = h.validators
+	return block, nil
+}
+
+func TestLightClient(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, openArchive, true)
+	dn := nodes[subnets[0]][0]
+	n := nodes[subnets[1]][0]
+
+	alice, bob := generateKey(), generateKey()
+	batch := n.db.Begin()
+	require.NoError(t, acctesting.CreateLiteTokenAccountWithCredits(batch, alice, acctesting.TestTokenAmount, 1e9))
+	require.NoError(t, batch.Commit())
+	aliceUrl := n.ParseUrl(acctesting.AcmeLiteAddressTmPriv(alice).String())
+	bobUrl := n.ParseUrl(acctesting.AcmeLiteAddressTmPriv(bob).String())
+
+	txid := n.Batch(func(send func(*transactions.Envelope)) {
+		exch := new(protocol.SendTokens)
+		exch.AddRecipient(bobUrl, big.NewInt(1000))
+		send(newTxn(aliceUrl.String()).
+			WithBody(exch).
+			SignLegacyED25519(alice))
+	})[0]
+
+	const chainID = "Directory"
+	ctx := context.Background()
+	node := querierNode{n.api}
+	headers := newSignedHeaders(t, dn, chainID, tmtypes.NewMockPV())
+	client := light.New(node, light.NewDirectoryAnchors(node, headers, chainID, headers.validators))
+
+	// Wait for the directory to anchor the block
+	var txn *protocol.Transaction
+	var err error
+	for i := 0; i < 50; i++ {
+		txn, _, err = client.QueryTransaction(ctx, txid[:])
+		if err == nil {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	require.NoError(t, err)
+	require.True(t, aliceUrl.Equal(txn.Origin))
+	require.IsType(t, (*protocol.SendTokens)(nil), txn.Body)
+
+	account, _, err := client.QueryAccount(ctx, aliceUrl)
+	require.NoError(t, err)
+	require.IsType(t, (*protocol.LiteTokenAccount)(nil), account)
+	require.Equal(t, n.GetLiteTokenAccount(aliceUrl.String()).Balance, account.(*protocol.LiteTokenAccount).Balance)
+
+	// A directory node that lies about the anchor is caught by the app hash
+	liar := light.New(node, light.NewDirectoryAnchors(lyingNode{node}, headers, chainID, headers.validators))
+	_, _, err = liar.QueryTransaction(ctx, txid[:])
+	require.ErrorIs(t, err, light.ErrUntrusted)
+
+	// Headers that are not signed by the trusted validators are rejected
+	forged := newSignedHeaders(t, dn, chainID, tmtypes.NewMockPV())
+	untrusted := light.New(node, light.NewDirectoryAnchors(node, forged, chainID, headers.validators))
+	_, _, err = untrusted.QueryTransaction(ctx, txid[:])
+	require.ErrorIs(t, err, light.ErrUntrusted)
+}
@@ -0,0 +1,307 @@
+package abci_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+	tmed25519 "github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"gitlab.com/accumulatenetwork/accumulate/config"
+	"gitlab.com/accumulatenetwork/accumulate/internal/abci"
+	"gitlab.com/accumulatenetwork/accumulate/internal/database"
+	"gitlab.com/accumulatenetwork/accumulate/internal/indexing"
+	mock_abci "gitlab.com/accumulatenetwork/accumulate/internal/mock/abci"
+	acctesting "gitlab.com/accumulatenetwork/accumulate/internal/testing"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+)
+
+func TestStateSyncSnapshot(t *testing.T) {
+	n, _ := setupSnapshotNode(t)
+
+	// Simulate a transaction that has not been acknowledged
+	ledgerUrl := n.network.NodeUrl(protocol.Ledger)
+	stuck := new(indexing.SyntheticOutboxEntry)
+	stuck.Transaction = sha256.Sum256([]byte("stuck"))
+	stuck.Type = protocol.TransactionTypeSyntheticDepositTokens
+	stuck.Destination = n.ParseUrl("RoadRunner")
+	stuck.Attempts = 3
+	batch := n.db.Begin()
+	require.NoError(t, indexing.SyntheticOutbox(batch, ledgerUrl).Put(stuck))
+
+	// Simulate accounts waiting to be migrated, one of which has been
+	// migrated
+	queue := indexing.MigrationQueue(batch, ledgerUrl)
+	require.NoError(t, queue.Push(n.ParseUrl("foo"), n.ParseUrl("bar"), n.ParseUrl("baz")))
+	require.NoError(t, queue.Pop(1))
+	require.NoError(t, batch.Commit())
+
+	src, snapshot, rootHash, ledger := takeSnapshot(t, n)
+
+	// Restore it into an empty database
+	db, err := database.Open("", true, nil)
+	require.NoError(t, err)
+	dst := abci.NewAccumulator(abci.AccumulatorOptions{
+		Chain:   mock_abci.NewMockChain(gomock.NewController(t)),
+		DB:      db,
+		Logger:  n.logger,
+		Network: *n.network,
+	})
+
+	offer := dst.OfferSnapshot(abcitypes.RequestOfferSnapshot{Snapshot: snapshot, AppHash: rootHash})
+	require.Equal(t, abcitypes.ResponseOfferSnapshot_ACCEPT, offer.Result)
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk := src.LoadSnapshotChunk(abcitypes.RequestLoadSnapshotChunk{Height: snapshot.Height, Format: snapshot.Format, Chunk: i}).Chunk
+		require.NotEmpty(t, chunk)
+
+		// A corrupted chunk must be refetched
+		bad := append([]byte{}, chunk...)
+		bad[0]++
+		resp := dst.ApplySnapshotChunk(abcitypes.RequestApplySnapshotChunk{Index: i, Chunk: bad, Sender: "bad"})
+		require.Equal(t, abcitypes.ResponseApplySnapshotChunk_RETRY, resp.Result)
+		require.Equal(t, []string{"bad"}, resp.RejectSenders)
+
+		resp = dst.ApplySnapshotChunk(abcitypes.RequestApplySnapshotChunk{Index: i, Chunk: chunk, Sender: "good"})
+		require.Equal(t, abcitypes.ResponseApplySnapshotChunk_ACCEPT, resp.Result)
+	}
+
+	info := dst.Info(abcitypes.RequestInfo{})
+	require.Equal(t, ledger.Index, info.LastBlockHeight)
+	require.Equal(t, rootHash, info.LastBlockAppHash)
+
+	// Verify the restored state
+	batch = db.Begin()
+	defer batch.Discard()
+	adi := new(protocol.ADI)
+	require.NoError(t, batch.Account(n.ParseUrl("RoadRunner")).GetStateAs(adi))
+	require.Equal(t, "acc://RoadRunner", adi.Url.String())
+
+	chain1, err := batch.Account(n.ParseUrl("RoadRunner")).ReadChain(protocol.MainChain)
+	require.NoError(t, err)
+	require.NotZero(t, chain1.Height())
+
+	// The faucet's accounting is not part of the faucet's state but it must be
+	// restored
+	srcBatch := n.db.Begin()
+	defer srcBatch.Discard()
+	want, err := srcBatch.Account(protocol.FaucetUrl).Index("Faucet", "Ledger").Get()
+	require.NoError(t, err)
+	got, err := batch.Account(protocol.FaucetUrl).Index("Faucet", "Ledger").Get()
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	// So must the synthetic outbox
+	entry, err := indexing.SyntheticOutbox(batch, ledgerUrl).Get(stuck.Transaction)
+	require.NoError(t, err)
+	require.True(t, stuck.Equal(entry))
+
+	// And so must the migration queue
+	queue = indexing.MigrationQueue(batch, ledgerUrl)
+	count, err := queue.Len()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+	accounts, err := queue.Peek(count)
+	require.NoError(t, err)
+	require.Equal(t, "acc://bar", accounts[0].String())
+	require.Equal(t, "acc://baz", accounts[1].String())
+
+	// And so must the key hash index
+	page := new(protocol.KeyPage)
+	require.NoError(t, batch.Account(n.ParseUrl("RoadRunner/page")).GetStateAs(page))
+	require.NotEmpty(t, page.Keys)
+	for _, key := range page.Keys {
+		pages, err := indexing.KeyHash(batch, ledgerUrl, sha256.Sum256(key.PublicKey)).Get()
+		require.NoError(t, err)
+		require.Len(t, pages, 1)
+		require.Equal(t, "acc://RoadRunner/page", pages[0].String())
+	}
+}
+
+func TestStateSyncSnapshotAlteredState(t *testing.T) {
+	n, liteAccount := setupSnapshotNode(t)
+
+	// Alter the balance of the lite account without updating its BPT entry.
+	// The snapshot includes the altered state along with the real hashes, and
+	// its chunks hash correctly.
+	liteUrl := acctesting.AcmeLiteAddressTmPriv(liteAccount)
+	batch := n.db.Begin()
+	account := new(protocol.LiteTokenAccount)
+	require.NoError(t, batch.Account(liteUrl).GetStateAs(account))
+	account.Balance.SetUint64(1e18)
+	require.NoError(t, batch.Account(liteUrl).PutState(account))
+	require.NoError(t, batch.Commit())
+
+	src, snapshot, rootHash, _ := takeSnapshot(t, n)
+
+	db, err := database.Open("", true, nil)
+	require.NoError(t, err)
+	dst := abci.NewAccumulator(abci.AccumulatorOptions{
+		Chain:   mock_abci.NewMockChain(gomock.NewController(t)),
+		DB:      db,
+		Logger:  n.logger,
+		Network: *n.network,
+	})
+
+	offer := dst.OfferSnapshot(abcitypes.RequestOfferSnapshot{Snapshot: snapshot, AppHash: rootHash})
+	require.Equal(t, abcitypes.ResponseOfferSnapshot_ACCEPT, offer.Result)
+
+	var resp abcitypes.ResponseApplySnapshotChunk
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk := src.LoadSnapshotChunk(abcitypes.RequestLoadSnapshotChunk{Height: snapshot.Height, Format: snapshot.Format, Chunk: i}).Chunk
+		resp = dst.ApplySnapshotChunk(abcitypes.RequestApplySnapshotChunk{Index: i, Chunk: chunk, Sender: "evil"})
+	}
+
+	// The restore must fail and leave the database empty
+	require.Equal(t, abcitypes.ResponseApplySnapshotChunk_REJECT_SNAPSHOT, resp.Result)
+	batch = db.Begin()
+	defer batch.Discard()
+	_, err = batch.Account(liteUrl).GetState()
+	require.Error(t, err)
+}
+
+func TestStateSyncSnapshotOracle(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	dn := nodes[subnets[0]][0]
+
+	// Submit a price to the oracle
+	wd := new(protocol.WriteData)
+	var err error
+	wd.Entry.Data, err = json.Marshal(&protocol.AcmeOracle{Price: 1.00 * protocol.AcmeOraclePrecision})
+	require.NoError(t, err)
+	dn.Batch(func(send func(*Tx)) {
+		send(newTxn(protocol.PriceOracle().String()).
+			WithBody(wd).
+			SignLegacyED25519(dn.key.Bytes()))
+	})
+	dn.client.Shutdown()
+
+	ledgerUrl := dn.network.NodeUrl(protocol.Ledger)
+	batch := dn.db.Begin()
+	want, err := indexing.OracleFeed(batch, ledgerUrl, protocol.PriceOracle()).Get()
+	batch.Discard()
+	require.NoError(t, err)
+
+	src, snapshot, rootHash, _ := takeSnapshot(t, dn)
+
+	db, err := database.Open("", true, nil)
+	require.NoError(t, err)
+	dst := abci.NewAccumulator(abci.AccumulatorOptions{
+		Chain:   mock_abci.NewMockChain(gomock.NewController(t)),
+		DB:      db,
+		Logger:  dn.logger,
+		Network: *dn.network,
+	})
+
+	offer := dst.OfferSnapshot(abcitypes.RequestOfferSnapshot{Snapshot: snapshot, AppHash: rootHash})
+	require.Equal(t, abcitypes.ResponseOfferSnapshot_ACCEPT, offer.Result)
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk := src.LoadSnapshotChunk(abcitypes.RequestLoadSnapshotChunk{Height: snapshot.Height, Format: snapshot.Format, Chunk: i}).Chunk
+		resp := dst.ApplySnapshotChunk(abcitypes.RequestApplySnapshotChunk{Index: i, Chunk: chunk, Sender: "good"})
+		require.Equal(t, abcitypes.ResponseApplySnapshotChunk_ACCEPT, resp.Result)
+	}
+
+	// The submissions of the feeds are not part of any account's state but
+	// the DN's next price depends on them
+	batch = db.Begin()
+	defer batch.Discard()
+	got, err := indexing.OracleFeed(batch, ledgerUrl, protocol.PriceOracle()).Get()
+	require.NoError(t, err)
+	require.True(t, want.Equal(got))
+}
+
+// setupSnapshotNode starts a BVN with a lite account and an ADI, and uses the
+// faucet.
+func setupSnapshotNode(t *testing.T) (*FakeNode, tmed25519.PrivKey) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	n := nodes[subnets[1]][0]
+
+	liteAccount := generateKey()
+	batch := n.db.Begin()
+	require.NoError(t, acctesting.CreateLiteTokenAccountWithCredits(batch, liteAccount, acctesting.TestTokenAmount, 1e6))
+	require.NoError(t, batch.Commit())
+
+	n.Batch(func(send func(*Tx)) {
+		adi := new(protocol.CreateIdentity)
+		adi.Url = n.ParseUrl("RoadRunner")
+		adi.KeyBookName = "book"
+		adi.KeyPageName = "page"
+
+		sponsorUrl := acctesting.AcmeLiteAddressTmPriv(liteAccount).String()
+		send(newTxn(sponsorUrl).
+			WithBody(adi).
+			SignLegacyED25519(liteAccount))
+	})
+	n.Batch(func(send func(*Tx)) {
+		send(newFaucetTxn(acctesting.AcmeLiteAddressTmPriv(generateKey())))
+	})
+	n.client.Shutdown()
+	return n, liteAccount
+}
+
+// takeSnapshot takes a snapshot of the node's committed state.
+func takeSnapshot(t *testing.T, n *FakeNode) (*abci.Accumulator, *abcitypes.Snapshot, []byte, *protocol.InternalLedger) {
+	ledgerUrl := n.network.NodeUrl(protocol.Ledger)
+	ledger := protocol.NewInternalLedger()
+	batch := n.db.Begin()
+	require.NoError(t, batch.Account(ledgerUrl).GetStateAs(ledger))
+	rootHash := batch.RootHash()
+	batch.Discard()
+
+	ctrl := gomock.NewController(t)
+	chain := mock_abci.NewMockChain(ctrl)
+	chain.EXPECT().BeginBlock(gomock.Any()).Return(abci.BeginBlockResponse{}, nil)
+	chain.EXPECT().Commit().Return(rootHash, nil)
+	chain.EXPECT().SnapshotIndexes(gomock.Any()).DoAndReturn(n.app.(*abci.Accumulator).Chain.SnapshotIndexes)
+
+	src := abci.NewAccumulator(abci.AccumulatorOptions{
+		Chain:     chain,
+		DB:        n.db,
+		Logger:    n.logger,
+		Network:   *n.network,
+		Snapshots: config.Snapshots{Enable: true, Directory: t.TempDir(), Frequency: 1},
+	})
+	src.BeginBlock(abcitypes.RequestBeginBlock{Header: tmproto.Header{Height: ledger.Index}})
+	src.Commit()
+
+	snapshots := src.ListSnapshots(abcitypes.RequestListSnapshots{}).Snapshots
+	require.Len(t, snapshots, 1)
+	snapshot := snapshots[0]
+	require.Equal(t, uint64(ledger.Index), snapshot.Height)
+	return src, snapshot, rootHash, ledger
+}
+
+func TestStateSyncSnapshotOversizedValue(t *testing.T) {
+	n, _ := setupSnapshotNode(t)
+
+	// A snapshot that claims the header is 2^62 bytes long. Its chunk hashes
+	// correctly, since the peer builds the metadata.
+	chunk := make([]byte, binary.MaxVarintLen64)
+	chunk = chunk[:binary.PutUvarint(chunk, 1<<62)]
+	metadata := sha256.Sum256(chunk)
+	hash := sha256.Sum256(metadata[:])
+	snapshot := &abcitypes.Snapshot{Height: 1, Format: 2, Chunks: 1, Metadata: metadata[:], Hash: hash[:]}
+
+	db, err := database.Open("", true, nil)
+	require.NoError(t, err)
+	dst := abci.NewAccumulator(abci.AccumulatorOptions{
+		Chain:   mock_abci.NewMockChain(gomock.NewController(t)),
+		DB:      db,
+		Logger:  n.logger,
+		Network: *n.network,
+	})
+
+	offer := dst.OfferSnapshot(abcitypes.RequestOfferSnapshot{Snapshot: snapshot, AppHash: make([]byte, 32)})
+	require.Equal(t, abcitypes.ResponseOfferSnapshot_ACCEPT, offer.Result)
+
+	// The snapshot and its sender are rejected
+	resp := dst.ApplySnapshotChunk(abcitypes.RequestApplySnapshotChunk{Index: 0, Chunk: chunk, Sender: "evil"})
+	require.Equal(t, abcitypes.ResponseApplySnapshotChunk_REJECT_SNAPSHOT, resp.Result)
+	require.Equal(t, []string{"evil"}, resp.RejectSenders)
+}
@@ -4,20 +4,31 @@ import (
 	"crypto/ed25519"
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"math/big"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+	tmed25519 "github.com/tendermint/tendermint/crypto/ed25519"
+	api2 "gitlab.com/accumulatenetwork/accumulate/internal/api/v2"
 	"gitlab.com/accumulatenetwork/accumulate/internal/database"
+	"gitlab.com/accumulatenetwork/accumulate/internal/events"
+	"gitlab.com/accumulatenetwork/accumulate/internal/indexing"
 	acctesting "gitlab.com/accumulatenetwork/accumulate/internal/testing"
 	"gitlab.com/accumulatenetwork/accumulate/internal/testing/e2e"
 	"gitlab.com/accumulatenetwork/accumulate/internal/url"
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
 	"gitlab.com/accumulatenetwork/accumulate/types"
+	"gitlab.com/accumulatenetwork/accumulate/types/api/query"
 	"gitlab.com/accumulatenetwork/accumulate/types/api/transactions"
 	randpkg "golang.org/x/exp/rand"
 )
@@ -111,6 +122,117 @@ func TestFaucet(t *testing.T) {
 	require.Equal(t, int64(protocol.AcmeFaucetAmount*protocol.AcmePrecision), n.GetLiteTokenAccount(aliceUrl.String()).Balance.Int64())
 }
 
+func TestFaucetLimits(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	n := nodes[subnets[1]][0]
+	dn := nodes[subnets[0]][0]
+
+	globals := protocol.DefaultNetworkGlobals()
+	globals.Faucet = &protocol.FaucetPolicy{
+		Amount:     10,
+		Cooldown:   time.Hour,
+		DailyLimit: 20,
+	}
+	updateGlobals(t, dn, n, globals)
+
+	alice := acctesting.AcmeLiteAddressTmPriv(generateKey())
+	bob := acctesting.AcmeLiteAddressTmPriv(generateKey())
+	charlie := acctesting.AcmeLiteAddressTmPriv(generateKey())
+	faucetBalance := new(big.Int).Set(&n.GetLiteTokenAccount(protocol.FaucetUrl.String()).Balance)
+
+	// The faucet deposits the configured amount and debits itself
+	n.Batch(func(send func(*transactions.Envelope)) { send(newFaucetTxn(alice)) })
+	require.Equal(t, int64(10*protocol.AcmePrecision), n.GetLiteTokenAccount(alice.String()).Balance.Int64())
+	faucetBalance.Sub(faucetBalance, big.NewInt(10*protocol.AcmePrecision))
+	require.Equal(t, faucetBalance.String(), n.GetLiteTokenAccount(protocol.FaucetUrl.String()).Balance.String())
+
+	// Alice must wait for the cooldown
+	res := checkTx(t, n, newFaucetTxn(alice))
+	require.Equal(t, uint32(protocol.ErrorCodeFaucetLimit), res.Code, res.Log)
+
+	// Bob is not affected by Alice's cooldown
+	n.Batch(func(send func(*transactions.Envelope)) { send(newFaucetTxn(bob)) })
+	require.Equal(t, int64(10*protocol.AcmePrecision), n.GetLiteTokenAccount(bob.String()).Balance.Int64())
+
+	// The daily limit has been reached
+	res = checkTx(t, n, newFaucetTxn(charlie))
+	require.Equal(t, uint32(protocol.ErrorCodeFaucetLimit), res.Code, res.Log)
+}
+
+func TestFaucetCooldown(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	n := nodes[subnets[1]][0]
+	dn := nodes[subnets[0]][0]
+
+	const cooldown = 2 * time.Second
+	globals := protocol.DefaultNetworkGlobals()
+	globals.Faucet = &protocol.FaucetPolicy{Amount: 10, Cooldown: cooldown}
+	updateGlobals(t, dn, n, globals)
+
+	alice := acctesting.AcmeLiteAddressTmPriv(generateKey())
+	n.Batch(func(send func(*transactions.Envelope)) { send(newFaucetTxn(alice)) })
+	require.Equal(t, int64(10*protocol.AcmePrecision), n.GetLiteTokenAccount(alice.String()).Balance.Int64())
+
+	// A second request within the cooldown is rejected
+	res := checkTx(t, n, newFaucetTxn(alice))
+	require.Equal(t, uint32(protocol.ErrorCodeFaucetLimit), res.Code, res.Log)
+	require.Contains(t, res.Log, "must wait")
+
+	// Cooldowns are measured in block time, so wait and then produce a block
+	time.Sleep(cooldown)
+	bob := acctesting.AcmeLiteAddressTmPriv(generateKey())
+	n.Batch(func(send func(*transactions.Envelope)) { send(newFaucetTxn(bob)) })
+
+	// Once the cooldown has passed, Alice can request again
+	res = checkTx(t, n, newFaucetTxn(alice))
+	require.Zero(t, res.Code, res.Log)
+	n.Batch(func(send func(*transactions.Envelope)) { send(newFaucetTxn(alice)) })
+	require.Equal(t, int64(20*protocol.AcmePrecision), n.GetLiteTokenAccount(alice.String()).Balance.Int64())
+}
+
+func newFaucetTxn(recipient *url.URL) *transactions.Envelope {
+	body := new(protocol.AcmeFaucet)
+	body.Url = recipient
+
+	faucet := protocol.Faucet.Signer()
+	return acctesting.NewTransaction().
+		WithOrigin(protocol.FaucetUrl).
+		WithNonce(faucet.Nonce()).
+		WithBody(body).
+		Sign(protocol.SignWithFaucet)
+}
+
+func checkTx(t *testing.T, n *FakeNode, env *transactions.Envelope) abcitypes.ResponseCheckTx {
+	data, err := env.MarshalBinary()
+	require.NoError(t, err)
+	return n.app.CheckTx(abcitypes.RequestCheckTx{Tx: data})
+}
+
+// updateGlobals writes the network globals to the DN and waits for the BVN to
+// activate them.
+func updateGlobals(t *testing.T, dn, bvn *FakeNode, globals *protocol.NetworkGlobals) {
+	dn.Batch(func(send func(*Tx)) {
+		wd := new(protocol.WriteData)
+		var err error
+		wd.Entry.Data, err = json.Marshal(globals)
+		require.NoError(t, err)
+
+		send(newTxn(protocol.GlobalsAuthority).
+			WithBody(wd).
+			SignLegacyED25519(dn.key.Bytes()))
+	})
+
+	require.Eventually(t, func() bool {
+		batch := bvn.db.Begin()
+		defer batch.Discard()
+		ledger := protocol.NewInternalLedger()
+		require.NoError(t, batch.Account(bvn.network.NodeUrl(protocol.Ledger)).GetStateAs(ledger))
+		return ledger.ActiveGlobals.Equal(globals)
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
 func TestAnchorChain(t *testing.T) {
 	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
 	nodes := RunTestNet(t, subnets, daemons, nil, true)
@@ -209,6 +331,263 @@ func TestAnchorChain(t *testing.T) {
 	// })
 }
 
+func TestFeeSchedule(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	n := nodes[subnets[1]][0]
+	dn := nodes[subnets[0]][0]
+
+	getSchedule := func(n *FakeNode) *protocol.FeeSchedule {
+		batch := n.db.Begin()
+		defer batch.Discard()
+		ledger := protocol.NewInternalLedger()
+		require.NoError(t, batch.Account(n.network.NodeUrl(protocol.Ledger)).GetStateAs(ledger))
+		return ledger.ActiveFeeSchedule
+	}
+
+	// Genesis uses the default schedule
+	require.Equal(t, protocol.FeeSendTokens, getSchedule(n).BaseFee(protocol.TransactionTypeSendTokens))
+
+	// An invalid schedule is rejected
+	invalid := new(protocol.WriteData)
+	invalid.Entry.Data = []byte(`{"fees":[{"type":"sendTokens","fee":1},{"type":"sendTokens","fee":2}]}`)
+	data, err := newTxn(protocol.FeeScheduleAuthority).
+		WithBody(invalid).
+		SignLegacyED25519(dn.key.Bytes()).
+		MarshalBinary()
+	require.NoError(t, err)
+	require.NotZero(t, dn.app.CheckTx(abcitypes.RequestCheckTx{Tx: data}).Code)
+
+	// Update the schedule on the DN
+	schedule := protocol.DefaultFeeSchedule()
+	for i := range schedule.Fees {
+		if schedule.Fees[i].Type == protocol.TransactionTypeSendTokens {
+			schedule.Fees[i].Fee = 1000
+		}
+	}
+	dn.Batch(func(send func(*Tx)) {
+		wd := new(protocol.WriteData)
+		var err error
+		wd.Entry.Data, err = json.Marshal(schedule)
+		require.NoError(t, err)
+
+		send(newTxn(protocol.FeeScheduleAuthority).
+			WithBody(wd).
+			SignLegacyED25519(dn.key.Bytes()))
+	})
+
+	// The update reaches the BVN through the anchor
+	require.Eventually(t, func() bool {
+		return getSchedule(n).BaseFee(protocol.TransactionTypeSendTokens) == 1000
+	}, 10*time.Second, 100*time.Millisecond)
+	require.Equal(t, protocol.Fee(1000), getSchedule(dn).BaseFee(protocol.TransactionTypeSendTokens))
+}
+
+func TestRoutingTable(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 2, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	dn := nodes[subnets[0]][0]
+
+	getLedger := func(n *FakeNode) *protocol.InternalLedger {
+		batch := n.db.Begin()
+		defer batch.Discard()
+		ledger := protocol.NewInternalLedger()
+		require.NoError(t, batch.Account(n.network.NodeUrl(protocol.Ledger)).GetStateAs(ledger))
+		return ledger
+	}
+
+	// Without a routing table, accounts are routed modulo the number of BVNs
+	alice := generateKey()
+	aliceUrl := acctesting.AcmeLiteAddressTmPriv(alice)
+	subnet, err := dn.router.Route(aliceUrl)
+	require.NoError(t, err)
+	src := nodes[subnet][0]
+	var dst *FakeNode
+	for _, s := range subnets[1:] {
+		if s != subnet {
+			dst = nodes[s][0]
+		}
+	}
+
+	batch := src.db.Begin()
+	require.NoError(t, acctesting.CreateLiteTokenAccountWithCredits(batch, alice, acctesting.TestTokenAmount, 1e9))
+	require.NoError(t, batch.Commit())
+
+	// Use the account so it is added to the BPT
+	bob := acctesting.AcmeLiteAddressTmPriv(generateKey())
+	src.Batch(func(send func(*Tx)) {
+		exch := new(protocol.SendTokens)
+		exch.AddRecipient(bob, big.NewInt(1000))
+		send(newTxn(aliceUrl.String()).
+			WithBody(exch).
+			SignLegacyED25519(alice))
+	})
+	balance := new(big.Int).SetUint64(acctesting.TestTokenAmount * protocol.AcmePrecision)
+	balance.Sub(balance, big.NewInt(1000))
+
+	// An invalid table is rejected
+	for _, invalid := range []string{
+		`{"routes":[{"subnet":"Foo"}]}`,
+		`{"routes":[{"length":1,"subnet":"` + dst.network.LocalSubnetID + `"}]}`,
+	} {
+		wd := new(protocol.WriteData)
+		wd.Entry.Data = []byte(invalid)
+		data, err := newTxn(protocol.RoutingTableAuthority).
+			WithBody(wd).
+			SignLegacyED25519(dn.key.Bytes()).
+			MarshalBinary()
+		require.NoError(t, err)
+		require.NotZero(t, dn.app.CheckTx(abcitypes.RequestCheckTx{Tx: data}).Code, invalid)
+	}
+
+	// Route everything to the other BVN
+	table := new(protocol.RoutingTable)
+	table.Routes = []protocol.Route{{Subnet: dst.network.LocalSubnetID}}
+	dn.Batch(func(send func(*Tx)) {
+		wd := new(protocol.WriteData)
+		wd.Entry.Data, err = json.Marshal(table)
+		require.NoError(t, err)
+
+		send(newTxn(protocol.RoutingTableAuthority).
+			WithBody(wd).
+			SignLegacyED25519(dn.key.Bytes()))
+	})
+
+	// The table reaches the BVNs through the anchors and the account is
+	// migrated
+	require.Eventually(t, func() bool {
+		ledger := getLedger(src)
+		return ledger.ActiveRoutingTable != nil && !ledger.RoutingMigration
+	}, 10*time.Second, 100*time.Millisecond)
+	require.True(t, table.Equal(getLedger(dst).ActiveRoutingTable))
+
+	subnet, err = src.router.Route(aliceUrl)
+	require.NoError(t, err)
+	require.Equal(t, dst.network.LocalSubnetID, subnet)
+
+	require.Eventually(t, func() bool {
+		batch := dst.db.Begin()
+		defer batch.Discard()
+		account := new(protocol.LiteTokenAccount)
+		err := batch.Account(aliceUrl).GetStateAs(account)
+		return err == nil && account.Balance.Cmp(balance) == 0
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// The account is no longer used on the source
+	batch = src.db.Begin()
+	defer batch.Discard()
+	migrated, err := batch.Account(aliceUrl).Index("Migrated").Get()
+	require.NoError(t, err)
+	require.NotEmpty(t, migrated)
+
+	// The account can be used on its new subnet
+	charlie := acctesting.AcmeLiteAddressTmPriv(generateKey())
+	dst.Batch(func(send func(*Tx)) {
+		exch := new(protocol.SendTokens)
+		exch.AddRecipient(charlie, big.NewInt(1000))
+		send(newTxn(aliceUrl.String()).
+			WithBody(exch).
+			SignLegacyED25519(alice))
+	})
+	require.Eventually(t, func() bool {
+		batch := dst.db.Begin()
+		defer batch.Discard()
+		account := new(protocol.LiteTokenAccount)
+		err := batch.Account(charlie).GetStateAs(account)
+		return err == nil && account.Balance.Int64() == 1000
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func TestRoutingTableCollision(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 2, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	dn := nodes[subnets[0]][0]
+
+	getLedger := func(n *FakeNode) *protocol.InternalLedger {
+		batch := n.db.Begin()
+		defer batch.Discard()
+		ledger := protocol.NewInternalLedger()
+		require.NoError(t, batch.Account(n.network.NodeUrl(protocol.Ledger)).GetStateAs(ledger))
+		return ledger
+	}
+	getBalance := func(n *FakeNode, u *url.URL) *big.Int {
+		batch := n.db.Begin()
+		defer batch.Discard()
+		account := new(protocol.LiteTokenAccount)
+		require.NoError(t, batch.Account(u).GetStateAs(account))
+		return &account.Balance
+	}
+	isMigrated := func(n *FakeNode, u *url.URL) bool {
+		batch := n.db.Begin()
+		defer batch.Discard()
+		v, err := batch.Account(u).Index("Migrated").Get()
+		if errors.Is(err, storage.ErrNotFound) {
+			return false
+		}
+		require.NoError(t, err)
+		return len(v) > 0
+	}
+
+	alice := generateKey()
+	aliceUrl := acctesting.AcmeLiteAddressTmPriv(alice)
+	subnet, err := dn.router.Route(aliceUrl)
+	require.NoError(t, err)
+	src := nodes[subnet][0]
+	var dst *FakeNode
+	for _, s := range subnets[1:] {
+		if s != subnet {
+			dst = nodes[s][0]
+		}
+	}
+
+	// The account exists on both BVNs
+	batch := src.db.Begin()
+	require.NoError(t, acctesting.CreateLiteTokenAccountWithCredits(batch, alice, acctesting.TestTokenAmount, 1e9))
+	require.NoError(t, batch.Commit())
+	batch = dst.db.Begin()
+	require.NoError(t, acctesting.CreateLiteTokenAccountWithCredits(batch, alice, 2*acctesting.TestTokenAmount, 1e9))
+	require.NoError(t, batch.Commit())
+
+	// Use the account so it is added to the BPT
+	bob := acctesting.AcmeLiteAddressTmPriv(generateKey())
+	src.Batch(func(send func(*Tx)) {
+		exch := new(protocol.SendTokens)
+		exch.AddRecipient(bob, big.NewInt(1000))
+		send(newTxn(aliceUrl.String()).
+			WithBody(exch).
+			SignLegacyED25519(alice))
+	})
+	srcBalance := getBalance(src, aliceUrl)
+	dstBalance := getBalance(dst, aliceUrl)
+
+	// Route everything to the other BVN
+	table := new(protocol.RoutingTable)
+	table.Routes = []protocol.Route{{Subnet: dst.network.LocalSubnetID}}
+	dn.Batch(func(send func(*Tx)) {
+		wd := new(protocol.WriteData)
+		wd.Entry.Data, err = json.Marshal(table)
+		require.NoError(t, err)
+
+		send(newTxn(protocol.RoutingTableAuthority).
+			WithBody(wd).
+			SignLegacyED25519(dn.key.Bytes()))
+	})
+
+	// The account is migrated
+	require.Eventually(t, func() bool {
+		ledger := getLedger(src)
+		return ledger.ActiveRoutingTable != nil && !ledger.RoutingMigration
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// The destination keeps its account and returns the migrated one, so the
+	// source clears the mark
+	require.Eventually(t, func() bool {
+		return !isMigrated(src, aliceUrl)
+	}, 10*time.Second, 100*time.Millisecond)
+	require.Equal(t, srcBalance, getBalance(src, aliceUrl))
+	require.Equal(t, dstBalance, getBalance(dst, aliceUrl))
+}
+
 func TestCreateADI(t *testing.T) {
 	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
 	nodes := RunTestNet(t, subnets, daemons, nil, true)
@@ -515,6 +894,41 @@ func TestLiteAccountTx(t *testing.T) {
 	require.Equal(t, int64(2000), n.GetLiteTokenAccount(charlieUrl).Balance.Int64())
 }
 
+func TestFailingEventSubscriber(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	n := nodes[subnets[1]][0]
+
+	alice, bob := generateKey(), generateKey()
+	batch := n.db.Begin()
+	require.NoError(t, acctesting.CreateLiteTokenAccountWithCredits(batch, alice, acctesting.TestTokenAmount, 1e9))
+	require.NoError(t, batch.Commit())
+	aliceUrl := acctesting.AcmeLiteAddressTmPriv(alice).String()
+	bobUrl := acctesting.AcmeLiteAddressTmPriv(bob).String()
+
+	// A subscriber that fails must not halt the node, which would fail the
+	// test
+	var calls int32
+	n.events.Subscribe(func(events.Event) {
+		atomic.AddInt32(&calls, 1)
+		panic("subscriber failed")
+	})
+
+	for i := 0; i < 2; i++ {
+		n.Batch(func(send func(*transactions.Envelope)) {
+			exch := new(protocol.SendTokens)
+			exch.AddRecipient(n.ParseUrl(bobUrl), big.NewInt(1000))
+			send(newTxn(aliceUrl).
+				WithBody(exch).
+				SignLegacyED25519(alice))
+		})
+	}
+	require.NotZero(t, atomic.LoadInt32(&calls))
+
+	// The governor must still send the synthetic deposits of committed blocks
+	require.Equal(t, int64(2000), n.GetLiteTokenAccount(bobUrl).Balance.Int64())
+}
+
 func TestAdiAccountTx(t *testing.T) {
 	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
 	nodes := RunTestNet(t, subnets, daemons, nil, true)
@@ -584,6 +998,98 @@ func TestSendCreditsFromAdiAccountToMultiSig(t *testing.T) {
 	require.Equal(t, int64(expected), balance)
 }
 
+func TestAddCreditsByAcme(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	n := nodes[subnets[1]][0]
+
+	fooKey := generateKey()
+	batch := n.db.Begin()
+	require.NoError(t, acctesting.CreateADI(batch, fooKey, "foo"))
+	require.NoError(t, acctesting.CreateTokenAccount(batch, "foo/tokens", protocol.AcmeUrl().String(), 100, false))
+	require.NoError(t, batch.Commit())
+
+	// At $0.05 per ACME, one ACME buys five credits
+	ids := n.Batch(func(send func(*transactions.Envelope)) {
+		ac := new(protocol.AddCredits)
+		ac.AcmeAmount = *big.NewInt(1 * protocol.AcmePrecision)
+		ac.Recipient = n.ParseUrl("foo/page0")
+
+		send(newTxn("foo/tokens").
+			WithBody(ac).
+			SignLegacyED25519(fooKey))
+	})
+
+	require.Equal(t, int64(5*protocol.CreditPrecision), n.GetKeyPage("foo/page0").CreditBalance.Int64())
+	require.Equal(t, int64(99*protocol.AcmePrecision), n.GetTokenAccount("foo/tokens").Balance.Int64())
+
+	result, ok := n.GetTx(ids[0][:]).Status.Result.(*protocol.AddCreditsResult)
+	require.True(t, ok)
+	require.Equal(t, int64(1*protocol.AcmePrecision), result.Amount.Int64())
+	require.Equal(t, uint64(5*protocol.CreditPrecision), result.Credits)
+	require.Equal(t, uint64(0.05*protocol.AcmeOraclePrecision), result.Oracle)
+
+	// Specifying both credits and ACME, or neither, fails
+	for _, ac := range []*protocol.AddCredits{
+		{Recipient: n.ParseUrl("foo/page0"), Amount: 1, AcmeAmount: *big.NewInt(1)},
+		{Recipient: n.ParseUrl("foo/page0")},
+		{Recipient: n.ParseUrl("foo/page0"), AcmeAmount: *big.NewInt(1)}, // Not enough to buy anything
+	} {
+		data, err := newTxn("foo/tokens").WithBody(ac).SignLegacyED25519(fooKey).MarshalBinary()
+		require.NoError(t, err)
+		require.NotZero(t, n.app.CheckTx(abcitypes.RequestCheckTx{Tx: data}).Code)
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	n := nodes[subnets[1]][0]
+
+	fooKey, barKey := generateKey(), generateKey()
+	batch := n.db.Begin()
+	require.NoError(t, acctesting.CreateAdiWithCredits(batch, fooKey, "foo", 1e9))
+	require.NoError(t, acctesting.CreateTokenAccount(batch, "foo/tokens", protocol.AcmeUrl().String(), 1, false))
+	require.NoError(t, acctesting.CreateADI(batch, barKey, "bar"))
+	require.NoError(t, acctesting.CreateTokenAccount(batch, "bar/tokens", protocol.AcmeUrl().String(), 0, false))
+	require.NoError(t, batch.Commit())
+
+	send := new(protocol.SendTokens)
+	send.AddRecipient(n.ParseUrl("bar/tokens"), big.NewInt(68))
+	env := newTxn("foo/tokens").WithBody(send).SignLegacyED25519(fooKey)
+
+	// The transaction does not need to be signed
+	env.Signatures[0].(*protocol.LegacyED25519Signature).Signature = nil
+
+	res, err := n.api.QuerySimulate(env)
+	require.NoError(t, err)
+	require.Equal(t, uint64(protocol.FeeSendTokens), res.Fee)
+	require.False(t, res.Pending)
+
+	changes := map[string]*api2.AccountChange{}
+	for _, change := range res.Changes {
+		changes[change.Url.String()] = change
+	}
+	require.Contains(t, changes, "acc://foo/tokens")
+	require.Contains(t, changes, "acc://foo/page0")
+	require.Equal(t, int64(protocol.AcmePrecision), changes["acc://foo/tokens"].Before.(*protocol.TokenAccount).Balance.Int64())
+	require.Equal(t, int64(protocol.AcmePrecision-68), changes["acc://foo/tokens"].After.(*protocol.TokenAccount).Balance.Int64())
+
+	require.Len(t, res.Synthetic, 1)
+	require.Equal(t, protocol.TransactionTypeSyntheticDepositTokens.String(), res.Synthetic[0].Type)
+	require.Equal(t, "acc://bar/tokens", res.Synthetic[0].Origin.String())
+
+	// Nothing was changed
+	require.Equal(t, int64(protocol.AcmePrecision), n.GetTokenAccount("foo/tokens").Balance.Int64())
+	require.Zero(t, n.GetTokenAccount("bar/tokens").Balance.Int64())
+
+	// A transaction that would fail cannot be simulated
+	send = new(protocol.SendTokens)
+	send.AddRecipient(n.ParseUrl("bar/tokens"), big.NewInt(2*protocol.AcmePrecision))
+	_, err = n.api.QuerySimulate(newTxn("foo/tokens").WithBody(send).SignLegacyED25519(fooKey))
+	require.Error(t, err)
+}
+
 func TestCreateKeyPage(t *testing.T) {
 	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
 	nodes := RunTestNet(t, subnets, daemons, nil, true)
@@ -776,43 +1282,480 @@ func TestRemoveKey(t *testing.T) {
 	require.Equal(t, testKey2.PubKey().Bytes(), spec.Keys[0].PublicKey)
 }
 
-func TestSignatorHeight(t *testing.T) {
+func TestMultisigPending(t *testing.T) {
 	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
 	nodes := RunTestNet(t, subnets, daemons, nil, true)
 	n := nodes[subnets[1]][0]
 
-	liteKey, fooKey := generateKey(), generateKey()
+	fooKey, testKey1, testKey2 := generateKey(), generateKey(), generateKey()
 
-	liteUrl, err := protocol.LiteTokenAddress(liteKey.PubKey().Bytes(), "ACME")
+	batch := n.db.Begin()
+	require.NoError(t, acctesting.CreateADI(batch, fooKey, "foo"))
+	require.NoError(t, acctesting.CreateKeyPage(batch, "foo/page1", testKey1.PubKey().Bytes(), testKey2.PubKey().Bytes()))
+	require.NoError(t, acctesting.CreateKeyBook(batch, "foo/book1", "foo/page1"))
+	require.NoError(t, acctesting.AddCredits(batch, n.ParseUrl("foo/page1"), 1e9))
+	page := protocol.NewKeyPage()
+	require.NoError(t, batch.Account(n.ParseUrl("foo/page1")).GetStateAs(page))
+	page.Threshold = 2
+	require.NoError(t, batch.Account(n.ParseUrl("foo/page1")).PutState(page))
+	require.NoError(t, batch.Commit())
+
+	newKey := generateKey()
+	txid := n.Batch(func(send func(*transactions.Envelope)) {
+		body := new(protocol.UpdateKeyPage)
+		body.Operation = protocol.KeyPageOperationAdd
+		body.NewKey = newKey.PubKey().Bytes()
+
+		send(newTxn("foo/page1").
+			WithBody(body).
+			SignLegacyED25519(testKey1))
+	})[0]
+	require.Len(t, n.GetKeyPage("foo/page1").Keys, 2)
+
+	// The transaction is waiting on the second key
+	res, err := n.api.QueryPendingSignatures(n.ParseUrl("foo/page1"))
 	require.NoError(t, err)
-	tokenUrl, err := url.Parse("foo/tokens")
+	require.Len(t, res.Items, 1)
+	status := res.Items[0].(*query.ResponseSignatureStatus)
+	require.Equal(t, txid, status.TxId)
+	require.Equal(t, "acc://foo/page1", status.KeyPage.String())
+	require.Equal(t, uint64(2), status.Threshold)
+	require.Equal(t, [][]byte{testKey1.PubKey().Bytes()}, status.Signed)
+	require.Equal(t, [][]byte{testKey2.PubKey().Bytes()}, status.Unsigned)
+
+	// Entries for transactions that are not pending are skipped
+	batch = n.db.Begin()
+	require.NoError(t, indexing.PendingSignatures(batch, n.ParseUrl("foo/page1")).Add(sha256.Sum256([]byte("not a transaction"))))
+	require.NoError(t, batch.Commit())
+	res, err = n.api.QueryPendingSignatures(n.ParseUrl("foo/page1"))
 	require.NoError(t, err)
-	keyPageUrl, err := url.Parse("foo/page0")
+	require.Len(t, res.Items, 1)
+
+	// Sign it with the second key
+	sign := newTxn("foo/page1").
+		WithTxnHash(txid[:]).
+		WithBody(new(protocol.SignPending)).
+		SignLegacyED25519(testKey2)
+	n.Batch(func(send func(*transactions.Envelope)) { send(sign) })
+	n.WaitForTxns(sign.EnvHash())
+	require.Len(t, n.GetKeyPage("foo/page1").Keys, 3)
+
+	res, err = n.api.QueryPendingSignatures(n.ParseUrl("foo/page1"))
 	require.NoError(t, err)
+	require.Empty(t, res.Items)
+}
+
+func TestPendingExpiry(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	n := nodes[subnets[1]][0]
+
+	fooKey, testKey1, testKey2 := generateKey(), generateKey(), generateKey()
 
 	batch := n.db.Begin()
-	require.NoError(t, acctesting.CreateLiteTokenAccountWithCredits(batch, liteKey, 1, 1e9))
+	require.NoError(t, acctesting.CreateADI(batch, fooKey, "foo"))
+	require.NoError(t, acctesting.CreateKeyPage(batch, "foo/page1", testKey1.PubKey().Bytes(), testKey2.PubKey().Bytes()))
+	require.NoError(t, acctesting.CreateKeyBook(batch, "foo/book1", "foo/page1"))
+	require.NoError(t, acctesting.AddCredits(batch, n.ParseUrl("foo/page1"), 1e9))
+	page := protocol.NewKeyPage()
+	require.NoError(t, batch.Account(n.ParseUrl("foo/page1")).GetStateAs(page))
+	page.Threshold = 2
+	require.NoError(t, batch.Account(n.ParseUrl("foo/page1")).PutState(page))
+	ledger := protocol.NewInternalLedger()
+	require.NoError(t, batch.Account(n.network.NodeUrl(protocol.Ledger)).GetStateAs(ledger))
 	require.NoError(t, batch.Commit())
 
-	getHeight := func(u *url.URL) uint64 {
+	// Submit a transaction that expires a few blocks from now
+	txid := n.Batch(func(send func(*transactions.Envelope)) {
+		body := new(protocol.UpdateKeyPage)
+		body.Operation = protocol.KeyPageOperationAdd
+		body.NewKey = generateKey().PubKey().Bytes()
+
+		tb := newTxn("foo/page1").WithBody(body)
+		tb.Transaction.Expiry = uint64(ledger.Index) + 20
+		send(tb.SignLegacyED25519(testKey1))
+	})[0]
+
+	getStatus := func() *protocol.TransactionStatus {
 		batch := n.db.Begin()
 		defer batch.Discard()
-		chain, err := batch.Account(u).ReadChain(protocol.MainChain)
+		status, err := batch.Transaction(txid[:]).GetStatus()
 		require.NoError(t, err)
-		return uint64(chain.Height())
+		return status
 	}
+	require.True(t, getStatus().Pending)
 
-	n.Batch(func(send func(*transactions.Envelope)) {
-		adi := new(protocol.CreateIdentity)
-		adi.Url = n.ParseUrl("foo")
-		adi.PublicKey = fooKey.PubKey().Bytes()
-		adi.KeyBookName = "book"
-		adi.KeyPageName = "page0"
+	// Wait for it to expire
+	require.Eventually(t, func() bool { return !getStatus().Pending }, 10*time.Second, 100*time.Millisecond)
+	status := getStatus()
+	require.Equal(t, protocol.ErrorCodeExpired.ID(), status.Code)
+	require.Equal(t, uint64(ledger.Index)+20, status.Expiry)
 
-		send(newTxn(liteUrl.String()).
-			WithBody(adi).
-			SignLegacyED25519(liteKey))
-	})
+	// It is no longer pending
+	res, err := n.api.QueryPendingSignatures(n.ParseUrl("foo/page1"))
+	require.NoError(t, err)
+	require.Empty(t, res.Items)
+	require.Len(t, n.GetKeyPage("foo/page1").Keys, 2)
+
+	// And it can no longer be signed
+	sign := newTxn("foo/page1").
+		WithTxnHash(txid[:]).
+		WithBody(new(protocol.SignPending)).
+		SignLegacyED25519(testKey2)
+	data, err := sign.MarshalBinary()
+	require.NoError(t, err)
+	require.NotZero(t, n.app.CheckTx(abcitypes.RequestCheckTx{Tx: data}).Code)
+
+	// An expiry beyond the network's limit is capped
+	txid = n.Batch(func(send func(*transactions.Envelope)) {
+		body := new(protocol.UpdateKeyPage)
+		body.Operation = protocol.KeyPageOperationAdd
+		body.NewKey = generateKey().PubKey().Bytes()
+
+		tb := newTxn("foo/page1").WithBody(body)
+		tb.Transaction.Expiry = math.MaxUint64
+		send(tb.SignLegacyED25519(testKey1))
+	})[0]
+	batch = n.db.Begin()
+	defer batch.Discard()
+	require.NoError(t, batch.Account(n.network.NodeUrl(protocol.Ledger)).GetStateAs(ledger))
+	status = getStatus()
+	require.True(t, status.Pending)
+	require.LessOrEqual(t, status.Expiry, uint64(ledger.Index)+protocol.DefaultPendingExpiry)
+}
+
+func TestNetworkGlobals(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	n := nodes[subnets[1]][0]
+	dn := nodes[subnets[0]][0]
+
+	getGlobals := func(n *FakeNode) *protocol.NetworkGlobals {
+		batch := n.db.Begin()
+		defer batch.Discard()
+		ledger := protocol.NewInternalLedger()
+		require.NoError(t, batch.Account(n.network.NodeUrl(protocol.Ledger)).GetStateAs(ledger))
+		return ledger.ActiveGlobals
+	}
+
+	// Genesis uses the default globals
+	require.Equal(t, uint64(protocol.DefaultPendingExpiry), getGlobals(n).PendingExpiry)
+
+	// An invalid entry is rejected
+	invalid := newTxn(protocol.GlobalsAuthority).
+		WithBody(&protocol.WriteData{Entry: protocol.DataEntry{Data: []byte("not JSON")}}).
+		SignLegacyED25519(dn.key.Bytes())
+	data, err := invalid.MarshalBinary()
+	require.NoError(t, err)
+	require.NotZero(t, dn.app.CheckTx(abcitypes.RequestCheckTx{Tx: data}).Code)
+
+	// Update the globals on the DN
+	dn.Batch(func(send func(*Tx)) {
+		wd := new(protocol.WriteData)
+		var err error
+		wd.Entry.Data, err = json.Marshal(&protocol.NetworkGlobals{PendingExpiry: 10})
+		require.NoError(t, err)
+
+		send(newTxn(protocol.GlobalsAuthority).
+			WithBody(wd).
+			SignLegacyED25519(dn.key.Bytes()))
+	})
+
+	// The update reaches the BVN through the anchor
+	require.Eventually(t, func() bool {
+		return getGlobals(n).PendingExpiry == 10
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// A pending transaction that does not specify an expiry uses the expiry
+	// of the globals
+	fooKey, testKey1, testKey2 := generateKey(), generateKey(), generateKey()
+	batch := n.db.Begin()
+	require.NoError(t, acctesting.CreateADI(batch, fooKey, "foo"))
+	require.NoError(t, acctesting.CreateKeyPage(batch, "foo/page1", testKey1.PubKey().Bytes(), testKey2.PubKey().Bytes()))
+	require.NoError(t, acctesting.CreateKeyBook(batch, "foo/book1", "foo/page1"))
+	require.NoError(t, acctesting.AddCredits(batch, n.ParseUrl("foo/page1"), 1e9))
+	page := protocol.NewKeyPage()
+	require.NoError(t, batch.Account(n.ParseUrl("foo/page1")).GetStateAs(page))
+	page.Threshold = 2
+	require.NoError(t, batch.Account(n.ParseUrl("foo/page1")).PutState(page))
+	ledger := protocol.NewInternalLedger()
+	require.NoError(t, batch.Account(n.network.NodeUrl(protocol.Ledger)).GetStateAs(ledger))
+	require.NoError(t, batch.Commit())
+
+	txid := n.Batch(func(send func(*transactions.Envelope)) {
+		body := new(protocol.UpdateKeyPage)
+		body.Operation = protocol.KeyPageOperationAdd
+		body.NewKey = generateKey().PubKey().Bytes()
+		send(newTxn("foo/page1").WithBody(body).SignLegacyED25519(testKey1))
+	})[0]
+
+	batch = n.db.Begin()
+	defer batch.Discard()
+	status, err := batch.Transaction(txid[:]).GetStatus()
+	require.NoError(t, err)
+	require.True(t, status.Pending)
+	delivered := status.Expiry - 10
+	require.Greater(t, delivered, uint64(ledger.Index))
+	require.NoError(t, batch.Account(n.network.NodeUrl(protocol.Ledger)).GetStateAs(ledger))
+	require.LessOrEqual(t, delivered, uint64(ledger.Index))
+}
+
+func TestTimeLockedKeyPageOperations(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	n := nodes[subnets[1]][0]
+
+	fooKey, testKey1, testKey2 := generateKey(), generateKey(), generateKey()
+
+	batch := n.db.Begin()
+	require.NoError(t, acctesting.CreateADI(batch, fooKey, "foo"))
+	require.NoError(t, acctesting.CreateKeyPage(batch, "foo/page1", testKey1.PubKey().Bytes(), testKey2.PubKey().Bytes()))
+	require.NoError(t, acctesting.CreateKeyBook(batch, "foo/book1", "foo/page1"))
+	require.NoError(t, acctesting.AddCredits(batch, n.ParseUrl("foo/page1"), 1e9))
+	page := protocol.NewKeyPage()
+	require.NoError(t, batch.Account(n.ParseUrl("foo/page1")).GetStateAs(page))
+	page.Threshold = 2
+	require.NoError(t, batch.Account(n.ParseUrl("foo/page1")).PutState(page))
+	require.NoError(t, batch.Commit())
+
+	update := func(body *protocol.UpdateKeyPage, keys ...tmed25519.PrivKey) *transactions.Envelope {
+		height := n.QueryAccount("foo/page1").MainChain.Height
+		tb := newTxn("foo/page1").WithKeyPage(0, height).WithBody(body)
+		env := tb.SignLegacyED25519(keys[0])
+		for _, key := range keys[1:] {
+			sig := new(protocol.LegacyED25519Signature)
+			require.NoError(t, sig.Sign(env.Transaction.Nonce, key, env.GetTxHash()))
+			env.Signatures = append(env.Signatures, sig)
+		}
+		return env
+	}
+
+	// Schedule adding a key
+	newKey := generateKey()
+	add := new(protocol.UpdateKeyPage)
+	add.Operation = protocol.KeyPageOperationAdd
+	add.NewKey = newKey.PubKey().Bytes()
+	add.Delay = 5
+	n.Batch(func(send func(*transactions.Envelope)) { send(update(add, testKey1, testKey2)) })
+
+	// The key is not added until the delay has passed
+	page = n.GetKeyPage("foo/page1")
+	require.Len(t, page.Keys, 2)
+	require.Len(t, page.PendingOperations, 1)
+	require.Eventually(t, func() bool { return len(n.GetKeyPage("foo/page1").Keys) == 3 }, 10*time.Second, 100*time.Millisecond)
+	require.Empty(t, n.GetKeyPage("foo/page1").PendingOperations)
+
+	// Schedule removing a key and cancel it with another single key
+	remove := new(protocol.UpdateKeyPage)
+	remove.Operation = protocol.KeyPageOperationRemove
+	remove.Key = testKey2.PubKey().Bytes()
+	remove.Delay = 1000
+	txid := n.Batch(func(send func(*transactions.Envelope)) { send(update(remove, testKey1, testKey2)) })[0]
+	require.Len(t, n.GetKeyPage("foo/page1").PendingOperations, 1)
+
+	cancel := new(protocol.UpdateKeyPage)
+	cancel.Operation = protocol.KeyPageOperationCancel
+	cancel.PendingTxid = txid
+	n.Batch(func(send func(*transactions.Envelope)) { send(update(cancel, newKey)) })
+	page = n.GetKeyPage("foo/page1")
+	require.Empty(t, page.PendingOperations)
+	require.Len(t, page.Keys, 3)
+
+	// Freeze the page with a single key
+	freeze := new(protocol.UpdateKeyPage)
+	freeze.Operation = protocol.KeyPageOperationFreeze
+	n.Batch(func(send func(*transactions.Envelope)) { send(update(freeze, newKey)) })
+	page = n.GetKeyPage("foo/page1")
+	require.True(t, page.Frozen)
+	require.True(t, page.FrozenBySingleSignature)
+
+	// Freeze the page with the threshold
+	n.Batch(func(send func(*transactions.Envelope)) { send(update(freeze, testKey1, testKey2)) })
+	page = n.GetKeyPage("foo/page1")
+	require.True(t, page.Frozen)
+	require.False(t, page.FrozenBySingleSignature)
+
+	// Nothing else can be signed by a page frozen by the threshold
+	setThreshold := new(protocol.UpdateKeyPage)
+	setThreshold.Operation = protocol.KeyPageOperationSetThreshold
+	setThreshold.Threshold = 1
+	data, err := update(setThreshold, testKey1, testKey2).MarshalBinary()
+	require.NoError(t, err)
+	require.NotZero(t, n.app.CheckTx(abcitypes.RequestCheckTx{Tx: data}).Code)
+
+	// Unfreezing requires the threshold
+	unfreeze := new(protocol.UpdateKeyPage)
+	unfreeze.Operation = protocol.KeyPageOperationUnfreeze
+	n.Batch(func(send func(*transactions.Envelope)) { send(update(unfreeze, testKey1, testKey2)) })
+	require.False(t, n.GetKeyPage("foo/page1").Frozen)
+}
+
+func TestKeyPageCompromisedKey(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	n := nodes[subnets[1]][0]
+
+	fooKey, testKey1, testKey2, attacker := generateKey(), generateKey(), generateKey(), generateKey()
+
+	batch := n.db.Begin()
+	require.NoError(t, acctesting.CreateADI(batch, fooKey, "foo"))
+	require.NoError(t, acctesting.CreateKeyPage(batch, "foo/page1", testKey1.PubKey().Bytes(), testKey2.PubKey().Bytes(), attacker.PubKey().Bytes()))
+	require.NoError(t, acctesting.CreateKeyBook(batch, "foo/book1", "foo/page1"))
+	require.NoError(t, acctesting.AddCredits(batch, n.ParseUrl("foo/page1"), 1e9))
+	page := protocol.NewKeyPage()
+	require.NoError(t, batch.Account(n.ParseUrl("foo/page1")).GetStateAs(page))
+	page.Threshold = 2
+	require.NoError(t, batch.Account(n.ParseUrl("foo/page1")).PutState(page))
+	require.NoError(t, batch.Commit())
+
+	update := func(body *protocol.UpdateKeyPage, keys ...tmed25519.PrivKey) *transactions.Envelope {
+		height := n.QueryAccount("foo/page1").MainChain.Height
+		tb := newTxn("foo/page1").WithKeyPage(0, height).WithBody(body)
+		env := tb.SignLegacyED25519(keys[0])
+		for _, key := range keys[1:] {
+			sig := new(protocol.LegacyED25519Signature)
+			require.NoError(t, sig.Sign(env.Transaction.Nonce, key, env.GetTxHash()))
+			env.Signatures = append(env.Signatures, sig)
+		}
+		return env
+	}
+
+	// The attacker freezes the page with its key
+	freeze := new(protocol.UpdateKeyPage)
+	freeze.Operation = protocol.KeyPageOperationFreeze
+	n.Batch(func(send func(*transactions.Envelope)) { send(update(freeze, attacker)) })
+	require.True(t, n.GetKeyPage("foo/page1").Frozen)
+
+	// The other keys can still schedule the attacker's removal, since they
+	// meet the threshold
+	remove := new(protocol.UpdateKeyPage)
+	remove.Operation = protocol.KeyPageOperationRemove
+	remove.Key = attacker.PubKey().Bytes()
+	remove.Delay = 5
+	txid := n.Batch(func(send func(*transactions.Envelope)) { send(update(remove, testKey1, testKey2)) })[0]
+	require.Len(t, n.GetKeyPage("foo/page1").PendingOperations, 1)
+
+	// The attacker cannot cancel its own removal
+	cancel := new(protocol.UpdateKeyPage)
+	cancel.Operation = protocol.KeyPageOperationCancel
+	cancel.PendingTxid = txid
+	data, err := update(cancel, attacker).MarshalBinary()
+	require.NoError(t, err)
+	require.NotZero(t, n.app.CheckTx(abcitypes.RequestCheckTx{Tx: data}).Code)
+
+	// The removal takes effect even though the page is frozen
+	require.Eventually(t, func() bool { return len(n.GetKeyPage("foo/page1").Keys) == 2 }, 10*time.Second, 100*time.Millisecond)
+	require.True(t, n.GetKeyPage("foo/page1").Frozen)
+
+	// And the remaining keys can unfreeze the page
+	unfreeze := new(protocol.UpdateKeyPage)
+	unfreeze.Operation = protocol.KeyPageOperationUnfreeze
+	n.Batch(func(send func(*transactions.Envelope)) { send(update(unfreeze, testKey1, testKey2)) })
+	page = n.GetKeyPage("foo/page1")
+	require.False(t, page.Frozen)
+	require.False(t, page.FrozenBySingleSignature)
+}
+
+func TestKeyPageMinDelay(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	n := nodes[subnets[1]][0]
+
+	fooKey, testKey := generateKey(), generateKey()
+
+	batch := n.db.Begin()
+	require.NoError(t, acctesting.CreateADI(batch, fooKey, "foo"))
+	require.NoError(t, acctesting.CreateKeyPage(batch, "foo/page1", testKey.PubKey().Bytes()))
+	require.NoError(t, acctesting.CreateKeyBook(batch, "foo/book1", "foo/page1"))
+	require.NoError(t, acctesting.AddCredits(batch, n.ParseUrl("foo/page1"), 1e9))
+	require.NoError(t, batch.Commit())
+
+	update := func(body *protocol.UpdateKeyPage) *transactions.Envelope {
+		height := n.QueryAccount("foo/page1").MainChain.Height
+		return newTxn("foo/page1").WithKeyPage(0, height).WithBody(body).SignLegacyED25519(testKey)
+	}
+
+	requireRejected := func(body *protocol.UpdateKeyPage) {
+		t.Helper()
+		data, err := update(body).MarshalBinary()
+		require.NoError(t, err)
+		require.NotZero(t, n.app.CheckTx(abcitypes.RequestCheckTx{Tx: data}).Code)
+	}
+
+	// Without a minimum delay, setting one takes effect immediately
+	setMin := new(protocol.UpdateKeyPage)
+	setMin.Operation = protocol.KeyPageOperationSetMinDelay
+	setMin.MinDelay = 1000
+	n.Batch(func(send func(*transactions.Envelope)) { send(update(setMin)) })
+	require.Equal(t, uint64(1000), n.GetKeyPage("foo/page1").MinDelay)
+
+	// Operations that are not delayed long enough are rejected
+	add := new(protocol.UpdateKeyPage)
+	add.Operation = protocol.KeyPageOperationAdd
+	add.NewKey = generateKey().PubKey().Bytes()
+	requireRejected(add)
+	add.Delay = 999
+	requireRejected(add)
+
+	lower := new(protocol.UpdateKeyPage)
+	lower.Operation = protocol.KeyPageOperationSetMinDelay
+	requireRejected(lower)
+
+	// So is unfreezing
+	freeze := new(protocol.UpdateKeyPage)
+	freeze.Operation = protocol.KeyPageOperationFreeze
+	n.Batch(func(send func(*transactions.Envelope)) { send(update(freeze)) })
+	require.True(t, n.GetKeyPage("foo/page1").Frozen)
+
+	unfreeze := new(protocol.UpdateKeyPage)
+	unfreeze.Operation = protocol.KeyPageOperationUnfreeze
+	requireRejected(unfreeze)
+
+	// An operation delayed by at least the minimum is scheduled
+	unfreeze.Delay = 1000
+	n.Batch(func(send func(*transactions.Envelope)) { send(update(unfreeze)) })
+	page := n.GetKeyPage("foo/page1")
+	require.True(t, page.Frozen)
+	require.Len(t, page.PendingOperations, 1)
+}
+
+func TestSignatorHeight(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	n := nodes[subnets[1]][0]
+
+	liteKey, fooKey := generateKey(), generateKey()
+
+	liteUrl, err := protocol.LiteTokenAddress(liteKey.PubKey().Bytes(), "ACME")
+	require.NoError(t, err)
+	tokenUrl, err := url.Parse("foo/tokens")
+	require.NoError(t, err)
+	keyPageUrl, err := url.Parse("foo/page0")
+	require.NoError(t, err)
+
+	batch := n.db.Begin()
+	require.NoError(t, acctesting.CreateLiteTokenAccountWithCredits(batch, liteKey, 1, 1e9))
+	require.NoError(t, batch.Commit())
+
+	getHeight := func(u *url.URL) uint64 {
+		batch := n.db.Begin()
+		defer batch.Discard()
+		chain, err := batch.Account(u).ReadChain(protocol.MainChain)
+		require.NoError(t, err)
+		return uint64(chain.Height())
+	}
+
+	n.Batch(func(send func(*transactions.Envelope)) {
+		adi := new(protocol.CreateIdentity)
+		adi.Url = n.ParseUrl("foo")
+		adi.PublicKey = fooKey.PubKey().Bytes()
+		adi.KeyBookName = "book"
+		adi.KeyPageName = "page0"
+
+		send(newTxn(liteUrl.String()).
+			WithBody(adi).
+			SignLegacyED25519(liteKey))
+	})
 
 	batch = n.db.Begin()
 	require.NoError(t, acctesting.AddCredits(batch, n.ParseUrl("foo/page0"), 1e9))
@@ -832,6 +1775,58 @@ func TestSignatorHeight(t *testing.T) {
 	require.Equal(t, keyPageHeight, getHeight(keyPageUrl), "Key page height changed")
 }
 
+func TestSECP256K1Key(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	n := nodes[subnets[1]][0]
+
+	liteKey := generateKey()
+	seed := sha256.Sum256([]byte("foo"))
+	fooKey, fooPub := btcec.PrivKeyFromBytes(btcec.S256(), seed[:])
+
+	liteUrl, err := protocol.LiteTokenAddress(liteKey.PubKey().Bytes(), "ACME")
+	require.NoError(t, err)
+
+	batch := n.db.Begin()
+	require.NoError(t, acctesting.CreateLiteTokenAccountWithCredits(batch, liteKey, 1, 1e9))
+	require.NoError(t, batch.Commit())
+
+	n.Batch(func(send func(*transactions.Envelope)) {
+		adi := new(protocol.CreateIdentity)
+		adi.Url = n.ParseUrl("foo")
+		adi.PublicKey = fooPub.SerializeCompressed()
+		adi.KeyType = protocol.SignatureTypeSECP256K1
+		adi.KeyBookName = "book"
+		adi.KeyPageName = "page0"
+
+		send(newTxn(liteUrl.String()).
+			WithBody(adi).
+			SignLegacyED25519(liteKey))
+	})
+
+	page := n.GetKeyPage("foo/page0")
+	require.Len(t, page.Keys, 1)
+	require.Equal(t, protocol.SignatureTypeSECP256K1, page.Keys[0].KeyType)
+
+	batch = n.db.Begin()
+	require.NoError(t, acctesting.AddCredits(batch, n.ParseUrl("foo/page0"), 1e9))
+	require.NoError(t, batch.Commit())
+
+	n.Batch(func(send func(*transactions.Envelope)) {
+		tac := new(protocol.CreateTokenAccount)
+		tac.Url = n.ParseUrl("foo/tokens")
+		tac.TokenUrl = protocol.AcmeUrl()
+		send(newTxn("foo").
+			WithBody(tac).
+			Sign(func(nonce uint64, hash []byte) (protocol.Signature, error) {
+				sig := new(protocol.SECP256K1Signature)
+				return sig, sig.Sign(nonce, fooKey.Serialize(), hash)
+			}))
+	})
+
+	n.GetTokenAccount("foo/tokens")
+}
+
 func TestCreateToken(t *testing.T) {
 	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
 	nodes := RunTestNet(t, subnets, daemons, nil, true)
@@ -885,6 +1880,306 @@ func TestIssueTokens(t *testing.T) {
 	require.Equal(t, int64(123), account.Balance.Int64())
 }
 
+func TestIssueTokensSupplyLimit(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	n := nodes[subnets[1]][0]
+
+	fooKey, liteKey := generateKey(), generateKey()
+	batch := n.db.Begin()
+	require.NoError(t, acctesting.CreateAdiWithCredits(batch, fooKey, "foo", 1e9))
+	require.NoError(t, acctesting.CreateTokenIssuer(batch, "foo/tokens", "FOO", 10))
+	issuer := new(protocol.TokenIssuer)
+	require.NoError(t, batch.Account(n.ParseUrl("foo/tokens")).GetStateAs(issuer))
+	issuer.HasSupplyLimit = true
+	issuer.Supply.SetUint64(100)
+	require.NoError(t, batch.Account(n.ParseUrl("foo/tokens")).PutState(issuer))
+	require.NoError(t, batch.Commit())
+
+	liteAddr, err := protocol.LiteTokenAddress(liteKey[32:], "foo/tokens")
+	require.NoError(t, err)
+
+	issue := func(amount uint64) *transactions.Envelope {
+		body := new(protocol.IssueTokens)
+		body.Recipient = liteAddr
+		body.Amount.SetUint64(amount)
+		return newTxn("foo/tokens").WithBody(body).SignLegacyED25519(fooKey)
+	}
+
+	n.Batch(func(send func(*transactions.Envelope)) {
+		send(issue(60))
+	})
+	require.Equal(t, int64(60), n.GetTokenIssuer("foo/tokens").Issued.Int64())
+
+	// Issuing more than the remaining supply fails
+	data, err := issue(50).MarshalBinary()
+	require.NoError(t, err)
+	require.NotZero(t, n.app.CheckTx(abcitypes.RequestCheckTx{Tx: data}).Code)
+
+	// Burning returns tokens to the issuable pool
+	batch = n.db.Begin()
+	lite := new(protocol.LiteTokenAccount)
+	require.NoError(t, batch.Account(liteAddr).GetStateAs(lite))
+	lite.CreditBalance.SetUint64(1e9)
+	require.NoError(t, batch.Account(liteAddr).PutState(lite))
+	require.NoError(t, batch.Commit())
+
+	n.Batch(func(send func(*transactions.Envelope)) {
+		body := new(protocol.BurnTokens)
+		body.Amount.SetUint64(20)
+		send(newTxn(liteAddr.String()).WithBody(body).SignLegacyED25519(liteKey))
+	})
+	issuer = n.GetTokenIssuer("foo/tokens")
+	require.Equal(t, int64(40), issuer.Issued.Int64())
+	require.Equal(t, int64(60), issuer.RemainingSupply().Int64())
+
+	n.Batch(func(send func(*transactions.Envelope)) {
+		send(issue(60))
+	})
+	issuer = n.GetTokenIssuer("foo/tokens")
+	require.Equal(t, int64(100), issuer.Issued.Int64())
+	require.Zero(t, issuer.RemainingSupply().Int64())
+	require.Equal(t, int64(100), n.GetLiteTokenAccount(liteAddr.String()).Balance.Int64())
+
+	// Burning more than the circulating supply is rejected before the holder
+	// is debited
+	batch = n.db.Begin()
+	require.NoError(t, batch.Account(liteAddr).GetStateAs(lite))
+	lite.Balance.SetUint64(150)
+	require.NoError(t, batch.Account(liteAddr).PutState(lite))
+	require.NoError(t, batch.Commit())
+
+	burn := new(protocol.BurnTokens)
+	burn.Amount.SetUint64(120)
+	data, err = newTxn(liteAddr.String()).WithBody(burn).SignLegacyED25519(liteKey).MarshalBinary()
+	require.NoError(t, err)
+	require.NotZero(t, n.app.CheckTx(abcitypes.RequestCheckTx{Tx: data}).Code)
+	require.Equal(t, int64(150), n.GetLiteTokenAccount(liteAddr.String()).Balance.Int64())
+	require.Equal(t, int64(100), n.GetTokenIssuer("foo/tokens").Issued.Int64())
+}
+
+func TestBatch(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	n := nodes[subnets[1]][0]
+
+	liteKey := generateKey()
+	batch := n.db.Begin()
+	require.NoError(t, acctesting.CreateLiteTokenAccountWithCredits(batch, liteKey, acctesting.TestTokenAmount, 1e6))
+	require.NoError(t, batch.Commit())
+	liteUrl := acctesting.AcmeLiteAddressTmPriv(liteKey)
+	keyHash := sha256.Sum256(liteKey.PubKey().Bytes())
+
+	t.Run("Onboarding", func(t *testing.T) {
+		adi := new(protocol.CreateIdentity)
+		adi.Url = n.ParseUrl("RoadRunner")
+		adi.PublicKey = keyHash[:]
+		adi.KeyBookName = "book"
+		adi.KeyPageName = "page"
+
+		tac := new(protocol.CreateTokenAccount)
+		tac.Url = n.ParseUrl("RoadRunner/tokens")
+		tac.TokenUrl = protocol.AcmeUrl()
+
+		ac := new(protocol.AddCredits)
+		ac.Recipient = n.ParseUrl("RoadRunner/page")
+		ac.Amount = 55
+
+		body := new(protocol.Batch)
+		body.Operations = []*protocol.BatchOperation{
+			{Body: adi},
+			{Origin: n.ParseUrl("RoadRunner"), Body: tac},
+			{Body: ac},
+		}
+
+		credits := n.GetLiteTokenAccount(liteUrl.String()).CreditBalance.Int64()
+		id := n.Batch(func(send func(*transactions.Envelope)) {
+			send(newTxn(liteUrl.String()).WithBody(body).SignLegacyED25519(liteKey))
+		})[0]
+
+		require.Equal(t, "acc://RoadRunner", n.GetADI("RoadRunner").Url.String())
+		require.Equal(t, protocol.AcmeUrl().String(), n.GetTokenAccount("RoadRunner/tokens").TokenUrl.String())
+		require.Equal(t, int64(55), n.GetKeyPage("RoadRunner/page").CreditBalance.Int64())
+
+		// A single fee is charged for the batch
+		fee := int64(protocol.FeeCreateIdentity + protocol.FeeCreateTokenAccount + protocol.FeeAddCredits)
+		require.Equal(t, credits-fee, n.GetLiteTokenAccount(liteUrl.String()).CreditBalance.Int64())
+
+		tx := n.GetTx(id[:])
+		require.Zero(t, tx.Status.Code, tx.Status.Message)
+		result, ok := tx.Status.Result.(*protocol.BatchResult)
+		require.True(t, ok, "want %T, got %T", new(protocol.BatchResult), tx.Status.Result)
+		require.Len(t, result.Results, 3)
+	})
+
+	t.Run("Atomic", func(t *testing.T) {
+		balance := n.GetLiteTokenAccount(liteUrl.String()).Balance.Int64()
+		fooUrl := acctesting.AcmeLiteAddressTmPriv(generateKey())
+		barUrl := acctesting.AcmeLiteAddressTmPriv(generateKey())
+
+		// Each envelope is valid when checked against the committed state,
+		// but once the first is delivered, the second operation of the batch
+		// overdraws the account
+		first := new(protocol.SendTokens)
+		first.AddRecipient(fooUrl, big.NewInt(balance/2+1))
+
+		second := new(protocol.Batch)
+		op1, op2 := new(protocol.SendTokens), new(protocol.SendTokens)
+		op1.AddRecipient(barUrl, big.NewInt(1))
+		op2.AddRecipient(barUrl, big.NewInt(balance/2+1))
+		second.Operations = []*protocol.BatchOperation{{Body: op1}, {Body: op2}}
+
+		ids := n.Batch(func(send func(*transactions.Envelope)) {
+			send(newTxn(liteUrl.String()).WithBody(first).SignLegacyED25519(liteKey))
+			send(newTxn(liteUrl.String()).WithBody(second).SignLegacyED25519(liteKey))
+		})
+
+		require.Zero(t, n.GetTx(ids[0][:]).Status.Code)
+		require.NotZero(t, n.GetTx(ids[1][:]).Status.Code)
+
+		// None of the operations of the failed batch took effect
+		require.Equal(t, balance-(balance/2+1), n.GetLiteTokenAccount(liteUrl.String()).Balance.Int64())
+		batch := n.db.Begin()
+		defer batch.Discard()
+		_, err := batch.Account(barUrl).GetState()
+		require.Error(t, err)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		// Batches cannot be nested
+		inner := new(protocol.Batch)
+		inner.Operations = []*protocol.BatchOperation{{Body: new(protocol.AcmeFaucet)}}
+		body := new(protocol.Batch)
+		body.Operations = []*protocol.BatchOperation{{Body: inner}}
+		data, err := newTxn(liteUrl.String()).WithBody(body).SignLegacyED25519(liteKey).MarshalBinary()
+		require.NoError(t, err)
+		require.NotZero(t, n.app.CheckTx(abcitypes.RequestCheckTx{Tx: data}).Code)
+
+		// An operation cannot use an origin the signer does not control
+		fooKey := generateKey()
+		batch := n.db.Begin()
+		require.NoError(t, acctesting.CreateADI(batch, fooKey, "foo"))
+		require.NoError(t, batch.Commit())
+
+		tac := new(protocol.CreateTokenAccount)
+		tac.Url = n.ParseUrl("foo/tokens")
+		tac.TokenUrl = protocol.AcmeUrl()
+		body = new(protocol.Batch)
+		body.Operations = []*protocol.BatchOperation{{Origin: n.ParseUrl("foo"), Body: tac, KeyPageHeight: 1}}
+		data, err = newTxn(liteUrl.String()).WithBody(body).SignLegacyED25519(liteKey).MarshalBinary()
+		require.NoError(t, err)
+		require.NotZero(t, n.app.CheckTx(abcitypes.RequestCheckTx{Tx: data}).Code)
+
+		// A key counts once towards the threshold of the operation's page
+		otherKey := generateKey()
+		batch = n.db.Begin()
+		require.NoError(t, acctesting.CreateADI(batch, liteKey, "bar"))
+		page := protocol.NewKeyPage()
+		require.NoError(t, batch.Account(n.ParseUrl("bar/page0")).GetStateAs(page))
+		page.Keys = append(page.Keys, &protocol.KeySpec{PublicKey: otherKey.PubKey().Bytes()})
+		page.Threshold = 2
+		require.NoError(t, batch.Account(n.ParseUrl("bar/page0")).PutState(page))
+		require.NoError(t, batch.Commit())
+
+		tac.Url = n.ParseUrl("bar/tokens")
+		body.Operations = []*protocol.BatchOperation{{Origin: n.ParseUrl("bar"), Body: tac, KeyPageHeight: 1}}
+		env := newTxn(liteUrl.String()).WithBody(body).SignLegacyED25519(liteKey)
+		env.Signatures = append(env.Signatures, env.Signatures[0])
+		data, err = env.MarshalBinary()
+		require.NoError(t, err)
+		res := n.app.CheckTx(abcitypes.RequestCheckTx{Tx: data})
+		require.NotZero(t, res.Code)
+		require.Contains(t, res.Log, "requires 2 signatures, got 1")
+
+		// The operation must specify the height of its page
+		batch = n.db.Begin()
+		page.Threshold = 1
+		require.NoError(t, batch.Account(n.ParseUrl("bar/page0")).PutState(page))
+		require.NoError(t, batch.Commit())
+
+		body.Operations = []*protocol.BatchOperation{{Origin: n.ParseUrl("bar"), Body: tac, KeyPageHeight: 100}}
+		data, err = newTxn(liteUrl.String()).WithBody(body).SignLegacyED25519(liteKey).MarshalBinary()
+		require.NoError(t, err)
+		res = n.app.CheckTx(abcitypes.RequestCheckTx{Tx: data})
+		require.NotZero(t, res.Code)
+		require.Contains(t, res.Log, "invalid height")
+	})
+}
+
+func TestQueryAtHeight(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, openArchive, true)
+	n := nodes[subnets[1]][0]
+
+	alice, bob := generateKey(), generateKey()
+	batch := n.db.Begin()
+	require.NoError(t, acctesting.CreateLiteTokenAccountWithCredits(batch, alice, acctesting.TestTokenAmount, 1e9))
+	require.NoError(t, batch.Commit())
+
+	aliceUrl := acctesting.AcmeLiteAddressTmPriv(alice).String()
+	bobUrl := acctesting.AcmeLiteAddressTmPriv(bob).String()
+	balance := int64(acctesting.TestTokenAmount * acctesting.TokenMx)
+
+	lastBlock := func() uint64 {
+		batch := n.db.Begin()
+		defer batch.Discard()
+		ledger := protocol.NewInternalLedger()
+		require.NoError(t, batch.Account(n.network.NodeUrl(protocol.Ledger)).GetStateAs(ledger))
+		return uint64(ledger.Index)
+	}
+
+	send := func(amount int64) uint64 {
+		n.Batch(func(send func(*transactions.Envelope)) {
+			exch := new(protocol.SendTokens)
+			exch.AddRecipient(n.ParseUrl(bobUrl), big.NewInt(amount))
+			send(newTxn(aliceUrl).
+				WithBody(exch).
+				SignLegacyED25519(alice))
+		})
+		return lastBlock()
+	}
+
+	queryAt := func(u string, height uint64) (*protocol.LiteTokenAccount, error) {
+		r, err := n.api.QueryUrl(n.ParseUrl(u), api2.QueryOptions{Height: height})
+		if err != nil {
+			return nil, err
+		}
+		n.Require().IsType((*api2.ChainQueryResponse)(nil), r)
+		data, err := json.Marshal(r.(*api2.ChainQueryResponse).Data)
+		require.NoError(t, err)
+		account := new(protocol.LiteTokenAccount)
+		require.NoError(t, json.Unmarshal(data, account))
+		return account, nil
+	}
+
+	first := send(1000)
+	second := send(2000)
+	require.Equal(t, balance-3000, n.GetLiteTokenAccount(aliceUrl).Balance.Int64())
+
+	// The state as of the first block reflects only the first transaction
+	account, err := queryAt(aliceUrl, first)
+	require.NoError(t, err)
+	require.Equal(t, balance-1000, account.Balance.Int64())
+
+	account, err = queryAt(aliceUrl, second)
+	require.NoError(t, err)
+	require.Equal(t, balance-3000, account.Balance.Int64())
+
+	// Bob was created by the first transaction
+	account, err = queryAt(bobUrl, first)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), account.Balance.Int64())
+
+	// Only account URLs can be queried at a height
+	_, err = n.api.QueryUrl(n.ParseUrl(aliceUrl+"#chain/main"), api2.QueryOptions{Height: first})
+	require.Error(t, err)
+
+	// Other queries fail instead of ignoring the height
+	_, err = n.api.QueryDirectory(n.ParseUrl(aliceUrl), api2.QueryPagination{Count: 10}, api2.QueryOptions{Height: first})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "cannot be made at a height")
+}
+
 func TestInvalidDeposit(t *testing.T) {
 	// The lite address ends with `foo/tokens` but the token is `foo2/tokens` so
 	// the synthetic transaction will fail. This test verifies that the
@@ -916,6 +2211,137 @@ func TestInvalidDeposit(t *testing.T) {
 	require.NotZero(t, tx.Status.Code)
 }
 
+func TestSyntheticOutbox(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	n := nodes[subnets[1]][0]
+
+	alice, bob := generateKey(), generateKey()
+	batch := n.db.Begin()
+	require.NoError(t, acctesting.CreateLiteTokenAccountWithCredits(batch, alice, acctesting.TestTokenAmount, 1e9))
+	require.NoError(t, batch.Commit())
+	aliceUrl := acctesting.AcmeLiteAddressTmPriv(alice).String()
+	bobUrl := acctesting.AcmeLiteAddressTmPriv(bob)
+
+	txid := n.Batch(func(send func(*transactions.Envelope)) {
+		exch := new(protocol.SendTokens)
+		exch.AddRecipient(bobUrl, big.NewInt(1000))
+		send(newTxn(aliceUrl).
+			WithBody(exch).
+			SignLegacyED25519(alice))
+	})[0]
+	synth := n.GetTx(txid[:]).SyntheticTxids
+	require.Len(t, synth, 1)
+
+	// The deposit was delivered locally so it has been acknowledged
+	ledgerUrl := n.network.NodeUrl(protocol.Ledger)
+	batch = n.db.Begin()
+	deposit, err := indexing.SyntheticOutbox(batch, ledgerUrl).Get(synth[0])
+	require.ErrorIs(t, err, storage.ErrNotFound)
+
+	// A copy of the deposit is rejected
+	pending, _, signatures, err := batch.Transaction(synth[0][:]).Get()
+	require.NoError(t, err)
+	batch.Discard()
+	env := pending.Restore()
+	env.Signatures = signatures
+	data, err := env.MarshalBinary()
+	require.NoError(t, err)
+	require.NotZero(t, n.app.CheckTx(abcitypes.RequestCheckTx{Tx: data}).Code)
+
+	// Simulate a transaction that has not been acknowledged
+	stuck := new(indexing.SyntheticOutboxEntry)
+	stuck.Transaction = sha256.Sum256([]byte("stuck"))
+	stuck.Type = protocol.TransactionTypeSyntheticDepositTokens
+	stuck.Destination = bobUrl
+	stuck.Attempts = 3
+	stuck.NextAttempt = 1 << 40
+	batch = n.db.Begin()
+	require.NoError(t, indexing.SyntheticOutbox(batch, ledgerUrl).Put(stuck))
+	require.NoError(t, batch.Commit())
+
+	ledger := protocol.NewInternalLedger()
+	n.QueryAccountAs(ledgerUrl.String(), ledger)
+	res, err := n.api.QuerySyntheticOutbox("", 1)
+	require.NoError(t, err)
+	var item *query.StuckSyntheticTransaction
+	for _, v := range res.Items {
+		if v := v.(*query.StuckSyntheticTransaction); v.Transaction == stuck.Transaction {
+			item = v
+		}
+	}
+	require.NotNil(t, item)
+	require.True(t, bobUrl.Equal(item.Destination))
+	require.True(t, n.network.NodeUrl().Equal(item.Source))
+	require.GreaterOrEqual(t, item.Age, uint64(ledger.Index))
+	require.Equal(t, uint64(3), item.Attempts)
+
+	// An anchor that acknowledges the transaction removes it from the outbox
+	n.Batch(func(send func(*transactions.Envelope)) {
+		body := new(protocol.SyntheticAnchor)
+		body.Source = protocol.DnUrl()
+		body.Acknowledgements = [][32]byte{stuck.Transaction}
+		send(newTxn(n.network.NodeUrl(protocol.AnchorPool).String()).
+			WithBody(body).
+			SignLegacyED25519(n.key.Bytes()))
+	})
+	waitForOutbox(t, n, stuck.Transaction)
+
+	// Simulate a lost acknowledgement of the deposit
+	deposit = new(indexing.SyntheticOutboxEntry)
+	deposit.Transaction = synth[0]
+	deposit.Type = protocol.TransactionTypeSyntheticDepositTokens
+	deposit.Destination = bobUrl
+	deposit.Attempts = 1
+	deposit.NextAttempt = 1 << 40
+	batch = n.db.Begin()
+	require.NoError(t, indexing.SyntheticOutbox(batch, ledgerUrl).Put(deposit))
+	require.NoError(t, batch.Commit())
+
+	// A subnet that is asked to acknowledge a transaction it has received
+	// acknowledges it again in its anchor, which the directory relays back
+	n.Batch(func(send func(*transactions.Envelope)) {
+		body := new(protocol.SyntheticAnchor)
+		body.Source = protocol.DnUrl()
+		body.Unacknowledged = [][32]byte{deposit.Transaction}
+		send(newTxn(n.network.NodeUrl(protocol.AnchorPool).String()).
+			WithBody(body).
+			SignLegacyED25519(n.key.Bytes()))
+	})
+	waitForOutbox(t, n, deposit.Transaction)
+
+	// Removing a transaction clears its entry
+	batch = n.db.Begin()
+	defer batch.Discard()
+	outbox := indexing.SyntheticOutbox(batch, ledgerUrl)
+	require.NoError(t, outbox.Put(stuck))
+	removed, err := outbox.Remove(stuck.Transaction)
+	require.NoError(t, err)
+	require.Equal(t, 1, removed)
+	data, err = batch.Account(ledgerUrl).Index("SyntheticOutbox", stuck.Transaction).Get()
+	require.NoError(t, err)
+	require.Empty(t, data)
+}
+
+// waitForOutbox waits for a transaction to be removed from the synthetic
+// outbox.
+func waitForOutbox(t *testing.T, n *FakeNode, txid [32]byte) {
+	t.Helper()
+
+	ledgerUrl := n.network.NodeUrl(protocol.Ledger)
+	for i := 0; i < 50; i++ {
+		batch := n.db.Begin()
+		_, err := indexing.SyntheticOutbox(batch, ledgerUrl).Get(txid)
+		batch.Discard()
+		if errors.Is(err, storage.ErrNotFound) {
+			return
+		}
+		require.NoError(t, err)
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Fatalf("%X is still in the synthetic outbox", txid)
+}
+
 func DumpAccount(t *testing.T, batch *database.Batch, accountUrl *url.URL) {
 	account := batch.Account(accountUrl)
 	state, err := account.GetState()
@@ -952,3 +2378,228 @@ func DumpAccount(t *testing.T, batch *database.Batch, accountUrl *url.URL) {
 		}
 	}
 }
+
+func TestQueryBlock(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	n := nodes[subnets[1]][0]
+
+	alice, bob := generateKey(), generateKey()
+	batch := n.db.Begin()
+	require.NoError(t, acctesting.CreateLiteTokenAccountWithCredits(batch, alice, acctesting.TestTokenAmount, 1e9))
+	require.NoError(t, batch.Commit())
+	aliceUrl := acctesting.AcmeLiteAddressTmPriv(alice)
+	bobUrl := acctesting.AcmeLiteAddressTmPriv(bob)
+
+	txid := n.Batch(func(send func(*transactions.Envelope)) {
+		exch := new(protocol.SendTokens)
+		exch.AddRecipient(bobUrl, big.NewInt(1000))
+		send(newTxn(aliceUrl.String()).
+			WithBody(exch).
+			SignLegacyED25519(alice))
+	})[0]
+	synth := n.GetTx(txid[:]).SyntheticTxids
+	require.Len(t, synth, 1)
+
+	// Find the blocks that delivered the transaction and its deposit
+	ledger := protocol.NewInternalLedger()
+	n.QueryAccountAs(n.network.NodeUrl(protocol.Ledger).String(), ledger)
+	res, err := n.api.QueryBlockRange("", api2.QueryPagination{Start: 1, Count: uint64(ledger.Index)})
+	require.NoError(t, err)
+	require.Equal(t, uint64(ledger.Index), res.Total)
+	require.NotEmpty(t, res.Items)
+
+	var sent, deposited *query.ResponseBlock
+	for _, item := range res.Items {
+		block := item.(*query.ResponseBlock)
+		for _, id := range block.Transactions {
+			switch id {
+			case txid:
+				sent = block
+			case synth[0]:
+				deposited = block
+			}
+		}
+	}
+	require.NotNil(t, sent, "The send was not found in any block")
+	require.NotNil(t, deposited, "The deposit was not found in any block")
+	require.Contains(t, sent.SyntheticTransactions, synth[0])
+
+	var updated bool
+	for _, u := range sent.Updates {
+		if u.Account.Equal(aliceUrl) && u.Name == protocol.MainChain {
+			updated = true
+		}
+	}
+	require.True(t, updated, "The block that delivered the send did not update the sender")
+
+	// Query the block directly
+	r, err := n.api.QueryBlock("", sent.Height)
+	require.NoError(t, err)
+	block := r.Data.(*query.ResponseBlock)
+	require.Equal(t, sent.Height, block.Height)
+	require.Equal(t, sent.RootAnchor, block.RootAnchor)
+	require.NotZero(t, block.RootAnchor)
+	require.Contains(t, block.Transactions, txid)
+
+	// Blocks that did not change the state are not indexed
+	_, err = n.api.QueryBlock("", uint64(ledger.Index)+100)
+	require.Error(t, err)
+}
+
+func TestQueryKey(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	n := nodes[subnets[1]][0]
+
+	fooKey, testKey, alice := generateKey(), generateKey(), generateKey()
+	batch := n.db.Begin()
+	require.NoError(t, acctesting.CreateAdiWithCredits(batch, fooKey, "foo", 1e9))
+	require.NoError(t, acctesting.CreateKeyPage(batch, "foo/page1", testKey.PubKey().Bytes()))
+	require.NoError(t, acctesting.CreateKeyBook(batch, "foo/book1", "foo/page1"))
+	require.NoError(t, acctesting.AddCredits(batch, n.ParseUrl("foo/page1"), 1e9))
+	require.NoError(t, acctesting.CreateLiteTokenAccountWithCredits(batch, alice, acctesting.TestTokenAmount, 1e9))
+	require.NoError(t, batch.Commit())
+
+	// Add the key to a page, create a page with the key, and send tokens to the
+	// lite account of the key
+	key := generateKey()
+	n.Batch(func(send func(*transactions.Envelope)) {
+		body := new(protocol.UpdateKeyPage)
+		body.Operation = protocol.KeyPageOperationAdd
+		body.NewKey = key.PubKey().Bytes()
+		send(newTxn("foo/page1").
+			WithBody(body).
+			SignLegacyED25519(testKey))
+	})
+	n.Batch(func(send func(*transactions.Envelope)) {
+		cms := new(protocol.CreateKeyPage)
+		cms.Url = n.ParseUrl("foo/page2")
+		cms.Keys = append(cms.Keys, &protocol.KeySpecParams{PublicKey: key.PubKey().Bytes()})
+		send(newTxn("foo").
+			WithBody(cms).
+			SignLegacyED25519(fooKey))
+
+		exch := new(protocol.SendTokens)
+		exch.AddRecipient(acctesting.AcmeLiteAddressTmPriv(key), big.NewInt(1000))
+		send(newTxn(acctesting.AcmeLiteAddressTmPriv(alice).String()).
+			WithBody(exch).
+			SignLegacyED25519(alice))
+	})
+
+	accounts := func(key, keyHash []byte) []string {
+		res, err := n.api.QueryKey(key, keyHash)
+		require.NoError(t, err)
+		var urls []string
+		for _, item := range res.Items {
+			urls = append(urls, item.(*query.KeyAccount).Url.String())
+		}
+		return urls
+	}
+
+	liteAccount := acctesting.AcmeLiteAddressTmPriv(key)
+	found := accounts(key.PubKey().Bytes(), nil)
+	require.ElementsMatch(t, []string{
+		n.ParseUrl("foo/page1").String(),
+		n.ParseUrl("foo/page2").String(),
+		liteAccount.Identity().String(),
+		liteAccount.String(),
+	}, found)
+
+	keyHash := sha256.Sum256(key.PubKey().Bytes())
+	require.ElementsMatch(t, found, accounts(nil, keyHash[:]))
+
+	// Replacing the key removes the page from the index
+	n.Batch(func(send func(*transactions.Envelope)) {
+		body := new(protocol.UpdateKeyPage)
+		body.Operation = protocol.KeyPageOperationUpdate
+		body.Key = key.PubKey().Bytes()
+		body.NewKey = generateKey().PubKey().Bytes()
+		send(newTxn("foo/page1").
+			WithKeyPage(0, 2).
+			WithBody(body).
+			SignLegacyED25519(testKey))
+	})
+	require.NotContains(t, accounts(key.PubKey().Bytes(), nil), n.ParseUrl("foo/page1").String())
+
+	// Genesis indexes the keys of the validator page
+	validators := n.network.NodeUrl(protocol.ValidatorBook + "0")
+	require.Contains(t, accounts(n.key.PubKey().Bytes(), nil), validators.String())
+
+	// The key or its hash is required
+	_, err := n.api.QueryKey(nil, nil)
+	require.Error(t, err)
+}
+
+func TestOracleFeeds(t *testing.T) {
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	nodes := RunTestNet(t, subnets, daemons, nil, true)
+	dn := nodes[subnets[0]][0]
+	n := nodes[subnets[1]][0]
+
+	writeJson := func(account *url.URL, v interface{}) {
+		t.Helper()
+		wd := new(protocol.WriteData)
+		var err error
+		wd.Entry.Data, err = json.Marshal(v)
+		require.NoError(t, err)
+		dn.Batch(func(send func(*transactions.Envelope)) {
+			send(newTxn(account.String()).
+				WithBody(wd).
+				SignLegacyED25519(dn.key.Bytes()))
+		})
+	}
+
+	// Create two more feeds and authorize them
+	feed1, feed2 := protocol.DnUrl().JoinPath("feed1"), protocol.DnUrl().JoinPath("feed2")
+	dn.Batch(func(send func(*transactions.Envelope)) {
+		for _, feed := range []*url.URL{feed1, feed2} {
+			body := new(protocol.CreateDataAccount)
+			body.Url = feed
+			send(newTxn(protocol.DnUrl().String()).
+				WithBody(body).
+				SignLegacyED25519(dn.key.Bytes()))
+		}
+	})
+
+	// An invalid policy is rejected
+	invalid := new(protocol.WriteData)
+	invalid.Entry.Data = []byte(`{"feeds":["acc://dn/feed1","acc://dn/FEED1"]}`)
+	data, err := newTxn(protocol.OracleFeedsAuthority).
+		WithBody(invalid).
+		SignLegacyED25519(dn.key.Bytes()).
+		MarshalBinary()
+	require.NoError(t, err)
+	require.NotZero(t, dn.app.CheckTx(abcitypes.RequestCheckTx{Tx: data}).Code)
+
+	writeJson(protocol.OracleFeedsUrl(), &protocol.OraclePolicy{
+		Feeds:        []*url.URL{protocol.PriceOracle(), feed1, feed2},
+		Window:       60 * 60,
+		MaxDeviation: 2000, // 20%
+	})
+
+	// The genesis price of $0.05 is rejected as an outlier, so the price is the
+	// median of the other two
+	writeJson(feed1, &protocol.AcmeOracle{Price: 1.00 * protocol.AcmeOraclePrecision})
+	writeJson(feed2, &protocol.AcmeOracle{Price: 1.10 * protocol.AcmeOraclePrecision})
+	expected := uint64(1.05 * protocol.AcmeOraclePrecision)
+
+	res, err := dn.api.QueryOracle("", api2.QueryPagination{Count: 10})
+	require.NoError(t, err)
+	oracle := res.Data.(*query.ResponseOracle)
+	require.Equal(t, expected, oracle.Active)
+	require.Equal(t, expected, oracle.Pending)
+	require.Len(t, oracle.Sources, 2)
+	require.True(t, oracle.Sources[0].Equal(feed1))
+	require.True(t, oracle.Sources[1].Equal(feed2))
+	require.Len(t, oracle.Feeds, 3)
+	require.NotEmpty(t, oracle.History)
+	require.Equal(t, expected, oracle.History[len(oracle.History)-1].Price)
+
+	// The BVN uses the price once it receives the DN's anchor
+	require.Eventually(t, func() bool {
+		res, err := n.api.QueryOracle("", api2.QueryPagination{})
+		require.NoError(t, err)
+		return res.Data.(*query.ResponseOracle).Active == expected
+	}, 5*time.Second, 100*time.Millisecond)
+}
@@ -24,6 +24,7 @@ import (
 	"gitlab.com/accumulatenetwork/accumulate/internal/chain"
 	"gitlab.com/accumulatenetwork/accumulate/internal/connections"
 	"gitlab.com/accumulatenetwork/accumulate/internal/database"
+	"gitlab.com/accumulatenetwork/accumulate/internal/events"
 	"gitlab.com/accumulatenetwork/accumulate/internal/genesis"
 	"gitlab.com/accumulatenetwork/accumulate/internal/logging"
 	"gitlab.com/accumulatenetwork/accumulate/internal/routing"
@@ -49,6 +50,7 @@ type FakeNode struct {
 	api     api2.Querier
 	logger  log.Logger
 	router  routing.Router
+	events  *events.Bus
 
 	assert  *assert.Assertions
 	require *require.Assertions
@@ -143,12 +145,14 @@ func (n *FakeNode) Start(appChan chan<- abcitypes.Application, connMgr connectio
 		Network:           n.network,
 		ConnectionManager: connMgr,
 	}
+	n.events = events.NewBus()
 	mgr, err := chain.NewNodeExecutor(chain.ExecutorOptions{
-		DB:      n.db,
-		Logger:  n.logger,
-		Key:     n.key.Bytes(),
-		Network: *n.network,
-		Router:  n.router,
+		DB:       n.db,
+		Logger:   n.logger,
+		Key:      n.key.Bytes(),
+		Network:  *n.network,
+		Router:   n.router,
+		EventBus: n.events,
 	})
 	n.Require().NoError(err)
 
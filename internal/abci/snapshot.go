@@ -0,0 +1,346 @@
+package abci
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"gitlab.com/accumulatenetwork/accumulate/internal/database"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+)
+
+// snapshotFormat is the format of snapshots served to Tendermint. It must be
+// incremented whenever database.SnapshotVersion changes.
+const snapshotFormat = 2
+
+// snapshotChunkSize is the size of the chunks snapshots are split into.
+const snapshotChunkSize = 4 << 20
+
+const snapshotExt = ".snapshot"
+
+// snapshotRestore tracks a snapshot that is being restored.
+type snapshotRestore struct {
+	snapshot *abci.Snapshot
+	appHash  []byte
+	next     uint32
+	data     *bytes.Buffer
+}
+
+func (app *Accumulator) snapshotFile(height uint64) string {
+	return filepath.Join(app.Snapshots.Directory, strconv.FormatUint(height, 10)+snapshotExt)
+}
+
+// shouldSnapshot returns true if a snapshot should be taken at the given
+// height.
+func (app *Accumulator) shouldSnapshot(height int64) bool {
+	return app.Snapshots.Enable && app.Snapshots.Frequency > 0 && height > 0 && height%app.Snapshots.Frequency == 0
+}
+
+// takeSnapshot writes a snapshot of the committed state and removes snapshots
+// beyond the retention limit. The snapshot is taken synchronously, since the
+// next block would otherwise modify the state while it is being written.
+func (app *Accumulator) takeSnapshot(height int64) error {
+	err := os.MkdirAll(app.Snapshots.Directory, 0755)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(app.Snapshots.Directory, "*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	batch := app.DB.Begin()
+	defer batch.Discard()
+
+	indexes, err := app.Chain.SnapshotIndexes(batch)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	err = batch.SaveSnapshot(w, app.Network.NodeUrl(protocol.Ledger), indexes)
+	if err != nil {
+		return err
+	}
+
+	err = w.Flush()
+	if err != nil {
+		return err
+	}
+
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(f.Name(), app.snapshotFile(uint64(height)))
+	if err != nil {
+		return err
+	}
+
+	return app.pruneSnapshots()
+}
+
+// snapshotHeights returns the heights of the available snapshots, in
+// ascending order.
+func (app *Accumulator) snapshotHeights() ([]uint64, error) {
+	entries, err := os.ReadDir(app.Snapshots.Directory)
+	switch {
+	case err == nil:
+	case os.IsNotExist(err):
+		return nil, nil
+	default:
+		return nil, err
+	}
+
+	var heights []uint64
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), snapshotExt) {
+			continue
+		}
+
+		height, err := strconv.ParseUint(strings.TrimSuffix(e.Name(), snapshotExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		heights = append(heights, height)
+	}
+
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights, nil
+}
+
+// pruneSnapshots removes all but the most recent snapshots.
+func (app *Accumulator) pruneSnapshots() error {
+	if app.Snapshots.Retain <= 0 {
+		return nil
+	}
+
+	heights, err := app.snapshotHeights()
+	if err != nil {
+		return err
+	}
+
+	for len(heights) > app.Snapshots.Retain {
+		err = os.Remove(app.snapshotFile(heights[0]))
+		if err != nil {
+			return err
+		}
+
+		app.snapshotMu.Lock()
+		delete(app.snapshots, heights[0])
+		app.snapshotMu.Unlock()
+		heights = heights[1:]
+	}
+	return nil
+}
+
+// loadSnapshot builds the description of a snapshot. The metadata of the
+// snapshot is the list of chunk hashes and the hash of the snapshot is the
+// hash of the metadata, so a syncing node can verify each chunk as it arrives.
+func (app *Accumulator) loadSnapshot(height uint64) (*abci.Snapshot, error) {
+	app.snapshotMu.Lock()
+	defer app.snapshotMu.Unlock()
+	if s, ok := app.snapshots[height]; ok {
+		return s, nil
+	}
+
+	f, err := os.Open(app.snapshotFile(height))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	s := new(abci.Snapshot)
+	s.Height = height
+	s.Format = snapshotFormat
+
+	chunk := make([]byte, snapshotChunkSize)
+	for {
+		n, err := io.ReadFull(f, chunk)
+		if n > 0 {
+			h := sha256.Sum256(chunk[:n])
+			s.Metadata = append(s.Metadata, h[:]...)
+			s.Chunks++
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	h := sha256.Sum256(s.Metadata)
+	s.Hash = h[:]
+
+	if app.snapshots == nil {
+		app.snapshots = map[uint64]*abci.Snapshot{}
+	}
+	app.snapshots[height] = s
+	return s, nil
+}
+
+// ListSnapshots implements github.com/tendermint/tendermint/abci/types.Application.
+func (app *Accumulator) ListSnapshots(req abci.RequestListSnapshots) abci.ResponseListSnapshots {
+	if !app.Snapshots.Enable {
+		return abci.ResponseListSnapshots{}
+	}
+
+	heights, err := app.snapshotHeights()
+	if err != nil {
+		app.logger.Error("Failed to list snapshots", "error", err)
+		return abci.ResponseListSnapshots{}
+	}
+
+	var resp abci.ResponseListSnapshots
+	for _, height := range heights {
+		s, err := app.loadSnapshot(height)
+		if err != nil {
+			app.logger.Error("Failed to load snapshot", "height", height, "error", err)
+			continue
+		}
+		resp.Snapshots = append(resp.Snapshots, s)
+	}
+	return resp
+}
+
+// LoadSnapshotChunk implements github.com/tendermint/tendermint/abci/types.Application.
+func (app *Accumulator) LoadSnapshotChunk(req abci.RequestLoadSnapshotChunk) abci.ResponseLoadSnapshotChunk {
+	if !app.Snapshots.Enable || req.Format != snapshotFormat {
+		return abci.ResponseLoadSnapshotChunk{}
+	}
+
+	f, err := os.Open(app.snapshotFile(req.Height))
+	if err != nil {
+		app.logger.Error("Failed to load snapshot chunk", "height", req.Height, "chunk", req.Chunk, "error", err)
+		return abci.ResponseLoadSnapshotChunk{}
+	}
+	defer f.Close()
+
+	chunk := make([]byte, snapshotChunkSize)
+	n, err := f.ReadAt(chunk, int64(req.Chunk)*snapshotChunkSize)
+	if err != nil && err != io.EOF {
+		app.logger.Error("Failed to load snapshot chunk", "height", req.Height, "chunk", req.Chunk, "error", err)
+		return abci.ResponseLoadSnapshotChunk{}
+	}
+
+	return abci.ResponseLoadSnapshotChunk{Chunk: chunk[:n]}
+}
+
+// OfferSnapshot implements github.com/tendermint/tendermint/abci/types.Application.
+func (app *Accumulator) OfferSnapshot(req abci.RequestOfferSnapshot) abci.ResponseOfferSnapshot {
+	s := req.Snapshot
+	switch {
+	case s == nil:
+		return abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_REJECT}
+	case s.Format != snapshotFormat:
+		return abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_REJECT_FORMAT}
+	case s.Chunks == 0 || len(s.Metadata) != sha256.Size*int(s.Chunks):
+		return abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_REJECT}
+	}
+
+	h := sha256.Sum256(s.Metadata)
+	if !bytes.Equal(h[:], s.Hash) {
+		return abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_REJECT}
+	}
+
+	app.logger.Info("Restoring snapshot", "height", s.Height, "chunks", s.Chunks)
+	app.restore = &snapshotRestore{
+		snapshot: s,
+		appHash:  req.AppHash,
+		data:     new(bytes.Buffer),
+	}
+	return abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ACCEPT}
+}
+
+// ApplySnapshotChunk implements github.com/tendermint/tendermint/abci/types.Application.
+//
+// Chunks are verified against the hashes in the snapshot metadata as they
+// arrive. Once the last chunk has been received, the database is restored from
+// the snapshot and verified against the trusted app hash. A snapshot that
+// cannot be restored is rejected along with the peer that sent the last chunk.
+func (app *Accumulator) ApplySnapshotChunk(req abci.RequestApplySnapshotChunk) (resp abci.ResponseApplySnapshotChunk) {
+	// The snapshot comes from a peer, so a malformed snapshot must not crash
+	// the node
+	defer func() {
+		if r := recover(); r != nil {
+			app.restore = nil
+			app.logger.Error("Panicked while restoring snapshot", "chunk", req.Index, "sender", req.Sender, "error", r)
+			resp = rejectSnapshot(req.Sender)
+		}
+	}()
+
+	r := app.restore
+	if r == nil {
+		return abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ABORT}
+	}
+
+	if req.Index != r.next {
+		return abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_RETRY, RefetchChunks: []uint32{r.next}}
+	}
+
+	h := sha256.Sum256(req.Chunk)
+	if !bytes.Equal(h[:], r.snapshot.Metadata[req.Index*sha256.Size:(req.Index+1)*sha256.Size]) {
+		app.logger.Info("Rejecting invalid snapshot chunk", "height", r.snapshot.Height, "chunk", req.Index, "sender", req.Sender)
+		return abci.ResponseApplySnapshotChunk{
+			Result:        abci.ResponseApplySnapshotChunk_RETRY,
+			RefetchChunks: []uint32{req.Index},
+			RejectSenders: []string{req.Sender},
+		}
+	}
+
+	r.data.Write(req.Chunk)
+	r.next++
+	if r.next < r.snapshot.Chunks {
+		return abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT}
+	}
+
+	app.restore = nil
+	err := app.restoreSnapshot(r)
+	if err != nil {
+		app.logger.Error("Failed to restore snapshot", "height", r.snapshot.Height, "sender", req.Sender, "error", err)
+		return rejectSnapshot(req.Sender)
+	}
+
+	app.logger.Info("Restored snapshot", "height", r.snapshot.Height)
+	return abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT}
+}
+
+func rejectSnapshot(sender string) abci.ResponseApplySnapshotChunk {
+	return abci.ResponseApplySnapshotChunk{
+		Result:        abci.ResponseApplySnapshotChunk_REJECT_SNAPSHOT,
+		RejectSenders: []string{sender},
+	}
+}
+
+func (app *Accumulator) restoreSnapshot(r *snapshotRestore) error {
+	header, err := database.ReadSnapshotHeader(bytes.NewReader(r.data.Bytes()))
+	if err != nil {
+		return err
+	}
+
+	if header.Height != r.snapshot.Height {
+		return fmt.Errorf("snapshot height does not match: want %d, got %d", r.snapshot.Height, header.Height)
+	}
+	if !bytes.Equal(header.RootHash[:], r.appHash) {
+		return fmt.Errorf("snapshot root hash does not match the app hash: want %X, got %X", r.appHash, header.RootHash[:])
+	}
+	if !header.Ledger.Equal(app.Network.NodeUrl(protocol.Ledger)) {
+		return fmt.Errorf("snapshot is for %v, not %v", header.Ledger, app.Network.NodeUrl(protocol.Ledger))
+	}
+
+	_, err = app.DB.RestoreSnapshot(r.data)
+	return err
+}
@@ -4,6 +4,8 @@ import (
 	"crypto/ed25519"
 
 	tmed25519 "github.com/tendermint/tendermint/crypto/ed25519"
+	"gitlab.com/accumulatenetwork/accumulate/internal/accumulated"
+	"gitlab.com/accumulatenetwork/accumulate/internal/database"
 	acctesting "gitlab.com/accumulatenetwork/accumulate/internal/testing"
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
 )
@@ -15,6 +17,16 @@ func generateKey() tmed25519.PrivKey {
 	return tmed25519.PrivKey(key)
 }
 
+// openArchive opens an in-memory database in archive mode.
+func openArchive(d *accumulated.Daemon) (*database.Database, error) {
+	db, err := database.Open("", true, d.Logger)
+	if err != nil {
+		return nil, err
+	}
+	db.EnableArchive()
+	return db, nil
+}
+
 func edSigner(key tmed25519.PrivKey) func(nonce uint64, hash []byte) (protocol.Signature, error) {
 	return func(nonce uint64, hash []byte) (protocol.Signature, error) {
 		sig := new(protocol.LegacyED25519Signature)
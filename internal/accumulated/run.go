@@ -25,6 +25,7 @@ import (
 	"gitlab.com/accumulatenetwork/accumulate/internal/connections"
 	statuschk "gitlab.com/accumulatenetwork/accumulate/internal/connections/status"
 	"gitlab.com/accumulatenetwork/accumulate/internal/database"
+	"gitlab.com/accumulatenetwork/accumulate/internal/events"
 	"gitlab.com/accumulatenetwork/accumulate/internal/logging"
 	"gitlab.com/accumulatenetwork/accumulate/internal/node"
 	"gitlab.com/accumulatenetwork/accumulate/internal/routing"
@@ -41,6 +42,8 @@ type Daemon struct {
 	pv   *privval.FilePV
 	jrpc *api.JrpcMethods
 
+	events *events.Bus
+
 	// knobs for tests
 	// IsTest   bool
 	UseMemDB bool
@@ -113,11 +116,18 @@ func (d *Daemon) Start() (err error) {
 		defer sentry.Flush(2 * time.Second)
 	}
 
-	dbPath := filepath.Join(d.Config.RootDir, "valacc.db")
-	d.db, err = database.Open(dbPath, d.UseMemDB, d.Logger)
+	dbType := d.Config.Accumulate.Storage.Type
+	if d.UseMemDB {
+		dbType = config.MemoryStorage
+	}
+	dbPath := d.Config.Accumulate.Storage.Path(d.Config.RootDir)
+	d.db, err = database.OpenType(dbType, dbPath, d.Logger)
 	if err != nil {
 		return fmt.Errorf("failed to open database %s: %v", dbPath, err)
 	}
+	if d.Config.Accumulate.Storage.Archive {
+		d.db.EnableArchive()
+	}
 
 	// Close the database if start fails (mostly for tests)
 	defer func() {
@@ -141,12 +151,14 @@ func (d *Daemon) Start() (err error) {
 		ConnectionManager: connectionManager,
 		Network:           &d.Config.Accumulate.Network,
 	}
+	d.events = events.NewBus()
 	execOpts := chain.ExecutorOptions{
-		DB:      d.db,
-		Logger:  d.Logger,
-		Key:     d.Key().Bytes(),
-		Network: d.Config.Accumulate.Network,
-		Router:  &router,
+		DB:       d.db,
+		Logger:   d.Logger,
+		Key:      d.Key().Bytes(),
+		Network:  d.Config.Accumulate.Network,
+		Router:   &router,
+		EventBus: d.events,
 	}
 	exec, err := chain.NewNodeExecutor(execOpts)
 	if err != nil {
@@ -158,12 +170,18 @@ func (d *Daemon) Start() (err error) {
 		return fmt.Errorf("failed to start chain executor: %v", err)
 	}
 
+	snapshots := d.Config.Accumulate.Snapshots
+	if !filepath.IsAbs(snapshots.Directory) {
+		snapshots.Directory = filepath.Join(d.Config.RootDir, snapshots.Directory)
+	}
+
 	app := abci.NewAccumulator(abci.AccumulatorOptions{
-		DB:      d.db,
-		Address: d.Key().PubKey().Address(),
-		Chain:   exec,
-		Logger:  d.Logger,
-		Network: d.Config.Accumulate.Network,
+		DB:        d.db,
+		Address:   d.Key().PubKey().Address(),
+		Chain:     exec,
+		Logger:    d.Logger,
+		Network:   d.Config.Accumulate.Network,
+		Snapshots: snapshots,
 	})
 
 	// Create node
@@ -208,6 +226,7 @@ func (d *Daemon) Start() (err error) {
 		Router:           &router,
 		PrometheusServer: d.Config.Accumulate.API.PrometheusServer,
 		TxMaxWaitTime:    d.Config.Accumulate.API.TxMaxWaitTime,
+		EventBus:         d.events,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to start API: %v", err)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 	"gitlab.com/accumulatenetwork/accumulate/config"
+	"gitlab.com/accumulatenetwork/accumulate/internal/events"
 	"gitlab.com/accumulatenetwork/accumulate/internal/routing"
 )
 
@@ -18,4 +19,8 @@ type Options struct {
 	Router           routing.Router
 	TxMaxWaitTime    time.Duration
 	PrometheusServer string
+
+	// EventBus is the bus the executor publishes events to. Subscriptions are
+	// disabled if it is nil.
+	EventBus *events.Bus
 }
@@ -10,12 +10,13 @@ import (
 
 func (m *JrpcMethods) populateMethodTable() jsonrpc2.MethodMap {
 	if m.methods == nil {
-		m.methods = make(jsonrpc2.MethodMap, 30)
+		m.methods = make(jsonrpc2.MethodMap, 43)
 	}
 
 	m.methods["describe"] = m.Describe
 	m.methods["execute"] = m.Execute
 	m.methods["add-credits"] = m.ExecuteAddCredits
+	m.methods["batch"] = m.ExecuteBatch
 	m.methods["burn-tokens"] = m.ExecuteBurnTokens
 	m.methods["create-adi"] = m.ExecuteCreateAdi
 	m.methods["create-data-account"] = m.ExecuteCreateDataAccount
@@ -34,13 +35,25 @@ func (m *JrpcMethods) populateMethodTable() jsonrpc2.MethodMap {
 	m.methods["faucet"] = m.Faucet
 	m.methods["metrics"] = m.Metrics
 	m.methods["query"] = m.Query
+	m.methods["query-anchor"] = m.QueryAnchor
+	m.methods["query-block"] = m.QueryBlock
+	m.methods["query-block-range"] = m.QueryBlockRange
 	m.methods["query-chain"] = m.QueryChain
 	m.methods["query-data"] = m.QueryData
 	m.methods["query-data-set"] = m.QueryDataSet
 	m.methods["query-directory"] = m.QueryDirectory
+	m.methods["query-key"] = m.QueryKey
 	m.methods["query-key-index"] = m.QueryKeyPageIndex
+	m.methods["query-oracle"] = m.QueryOracle
+	m.methods["query-pending"] = m.QueryPendingSignatures
+	m.methods["query-proof"] = m.QueryProof
+	m.methods["query-signatures"] = m.QuerySignatures
+	m.methods["query-synthetic-outbox"] = m.QuerySyntheticOutbox
 	m.methods["query-tx"] = m.QueryTx
 	m.methods["query-tx-history"] = m.QueryTxHistory
+	m.methods["quote-credits"] = m.QuoteCredits
+	m.methods["sign-pending"] = m.SignPending
+	m.methods["simulate"] = m.Simulate
 	m.methods["status"] = m.Status
 	m.methods["version"] = m.Version
 
@@ -79,6 +92,10 @@ func (m *JrpcMethods) ExecuteAddCredits(ctx context.Context, params json.RawMess
 	return m.executeWith(ctx, params, new(protocol.AddCredits))
 }
 
+func (m *JrpcMethods) ExecuteBatch(ctx context.Context, params json.RawMessage) interface{} {
+	return m.executeWith(ctx, params, new(protocol.Batch))
+}
+
 func (m *JrpcMethods) ExecuteBurnTokens(ctx context.Context, params json.RawMessage) interface{} {
 	return m.executeWith(ctx, params, new(protocol.BurnTokens))
 }
@@ -149,6 +166,36 @@ func (m *JrpcMethods) Query(_ context.Context, params json.RawMessage) interface
 	return jrpcFormatResponse(m.querier.QueryUrl(req.Url, req.QueryOptions))
 }
 
+func (m *JrpcMethods) QueryAnchor(_ context.Context, params json.RawMessage) interface{} {
+	req := new(AnchorQuery)
+	err := m.parse(params, req)
+	if err != nil {
+		return err
+	}
+
+	return jrpcFormatResponse(m.querier.QueryAnchor(req.Anchor))
+}
+
+func (m *JrpcMethods) QueryBlock(_ context.Context, params json.RawMessage) interface{} {
+	req := new(BlockQuery)
+	err := m.parse(params, req)
+	if err != nil {
+		return err
+	}
+
+	return jrpcFormatResponse(m.querier.QueryBlock(req.Subnet, req.Height))
+}
+
+func (m *JrpcMethods) QueryBlockRange(_ context.Context, params json.RawMessage) interface{} {
+	req := new(BlockRangeQuery)
+	err := m.parse(params, req)
+	if err != nil {
+		return err
+	}
+
+	return jrpcFormatResponse(m.querier.QueryBlockRange(req.Subnet, req.QueryPagination))
+}
+
 func (m *JrpcMethods) QueryChain(_ context.Context, params json.RawMessage) interface{} {
 	req := new(ChainIdQuery)
 	err := m.parse(params, req)
@@ -189,6 +236,16 @@ func (m *JrpcMethods) QueryDirectory(_ context.Context, params json.RawMessage)
 	return jrpcFormatResponse(m.querier.QueryDirectory(req.Url, req.QueryPagination, req.QueryOptions))
 }
 
+func (m *JrpcMethods) QueryKey(_ context.Context, params json.RawMessage) interface{} {
+	req := new(KeyQuery)
+	err := m.parse(params, req)
+	if err != nil {
+		return err
+	}
+
+	return jrpcFormatResponse(m.querier.QueryKey(req.Key, req.KeyHash))
+}
+
 func (m *JrpcMethods) QueryKeyPageIndex(_ context.Context, params json.RawMessage) interface{} {
 	req := new(KeyPageIndexQuery)
 	err := m.parse(params, req)
@@ -199,6 +256,56 @@ func (m *JrpcMethods) QueryKeyPageIndex(_ context.Context, params json.RawMessag
 	return jrpcFormatResponse(m.querier.QueryKeyPageIndex(req.Url, req.Key))
 }
 
+func (m *JrpcMethods) QueryOracle(_ context.Context, params json.RawMessage) interface{} {
+	req := new(OracleQuery)
+	err := m.parse(params, req)
+	if err != nil {
+		return err
+	}
+
+	return jrpcFormatResponse(m.querier.QueryOracle(req.Subnet, req.QueryPagination))
+}
+
+func (m *JrpcMethods) QueryPendingSignatures(_ context.Context, params json.RawMessage) interface{} {
+	req := new(UrlQuery)
+	err := m.parse(params, req)
+	if err != nil {
+		return err
+	}
+
+	return jrpcFormatResponse(m.querier.QueryPendingSignatures(req.Url))
+}
+
+func (m *JrpcMethods) QueryProof(_ context.Context, params json.RawMessage) interface{} {
+	req := new(ProofQuery)
+	err := m.parse(params, req)
+	if err != nil {
+		return err
+	}
+
+	return jrpcFormatResponse(m.querier.QueryProof(req.Url, req.Txid))
+}
+
+func (m *JrpcMethods) QuerySignatures(_ context.Context, params json.RawMessage) interface{} {
+	req := new(TxnQuery)
+	err := m.parse(params, req)
+	if err != nil {
+		return err
+	}
+
+	return jrpcFormatResponse(m.querier.QuerySignatures(req.Txid))
+}
+
+func (m *JrpcMethods) QuerySyntheticOutbox(_ context.Context, params json.RawMessage) interface{} {
+	req := new(SyntheticOutboxQuery)
+	err := m.parse(params, req)
+	if err != nil {
+		return err
+	}
+
+	return jrpcFormatResponse(m.querier.QuerySyntheticOutbox(req.Subnet, req.MinAge))
+}
+
 func (m *JrpcMethods) QueryTx(_ context.Context, params json.RawMessage) interface{} {
 	req := new(TxnQuery)
 	err := m.parse(params, req)
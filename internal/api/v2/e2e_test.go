@@ -3,6 +3,7 @@ package api_test
 import (
 	"context"
 	"crypto/ed25519"
+	"encoding/hex"
 	"encoding/json"
 	"math/big"
 	"testing"
@@ -39,7 +40,10 @@ func TestValidate(t *testing.T) {
 	acctesting.SkipPlatform(t, "darwin", "flaky")
 	acctesting.SkipPlatformCI(t, "darwin", "requires setting up localhost aliases")
 
-	subnets, daemons := acctesting.CreateTestNet(t, 2, 2, 0)
+	// The faucet subtest funds the same account repeatedly
+	globals := protocol.DefaultNetworkGlobals()
+	globals.Faucet.Cooldown = 0
+	subnets, daemons := acctesting.CreateTestNetWithGlobals(t, 2, 2, 0, globals)
 	acctesting.RunTestNet(t, subnets, daemons)
 	japi := daemons[protocol.Directory][0].Jrpc_TESTONLY()
 
@@ -88,6 +92,41 @@ func TestValidate(t *testing.T) {
 		queryRecord(t, japi, "query-chain", &api.ChainIdQuery{ChainId: liteUrl.AccountID()})
 	})
 
+	t.Run("Quote Credits", func(t *testing.T) {
+		// The genesis oracle price is $0.05 per ACME
+		quote := new(api.CreditsQuote)
+		callApi(t, japi, "quote-credits", &api.CreditsQuoteQuery{Acme: *big.NewInt(AcmePrecision)}, quote)
+		assert.Equal(t, uint64(5*CreditPrecision), quote.Credits)
+		assert.Equal(t, quote.Credits, quote.Fiat)
+
+		quote = new(api.CreditsQuote)
+		callApi(t, japi, "quote-credits", &api.CreditsQuoteQuery{Credits: 5 * CreditPrecision}, quote)
+		assert.Equal(t, int64(AcmePrecision), quote.Acme.Int64())
+	})
+
+	t.Run("Simulate", func(t *testing.T) {
+		recipient := makeLiteUrl(t, newKey([]byte(t.Name())), ACME)
+		send := new(SendTokens)
+		send.AddRecipient(recipient, big.NewInt(1))
+		req := prepareTx(t, japi, execParams{
+			Origin:  liteUrl.String(),
+			Key:     liteKey,
+			Payload: send,
+		})
+		body, err := send.MarshalBinary()
+		require.NoError(t, err)
+		req.Payload = hex.EncodeToString(body)
+		req.Signature = nil // The transaction does not need to be signed
+
+		res := new(api.SimulateResponse)
+		callApi(t, japi, "simulate", req, res)
+		assert.Equal(t, uint64(FeeSendTokens), res.Fee)
+		require.Len(t, res.Changes, 1)
+		assert.Equal(t, liteUrl.String(), res.Changes[0].Url.String())
+		require.Len(t, res.Synthetic, 1)
+		assert.Equal(t, recipient.String(), res.Synthetic[0].Origin.String())
+	})
+
 	var adiKey ed25519.PrivateKey
 	var adiName = &url.URL{Authority: "keytest"}
 	t.Run("Create ADI", func(t *testing.T) {
@@ -78,6 +78,7 @@ func (m *JrpcMethods) NewMux() *http.ServeMux {
 	mux.Handle("/status", m.jrpc2http(m.Status))
 	mux.Handle("/version", m.jrpc2http(m.Version))
 	mux.Handle("/v2", jsonrpc2.HTTPRequestHandler(m.methods, stdlog.New(os.Stdout, "", 0)))
+	mux.HandleFunc("/ws", m.serveWebSocket)
 	return mux
 }
 
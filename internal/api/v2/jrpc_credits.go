@@ -0,0 +1,67 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+	"gitlab.com/accumulatenetwork/accumulate/types/api/query"
+)
+
+// QuoteCredits converts an amount of credits, ACME, or fiat into the others at
+// the active oracle price of the subnet, using the same rounding rules as
+// AddCredits.
+func (m *JrpcMethods) QuoteCredits(_ context.Context, params json.RawMessage) interface{} {
+	req := new(CreditsQuoteQuery)
+	err := m.parse(params, req)
+	if err != nil {
+		return err
+	}
+
+	var set int
+	if req.Credits != 0 {
+		set++
+	}
+	if req.Acme.Sign() != 0 {
+		set++
+	}
+	if req.Fiat != 0 {
+		set++
+	}
+	if set != 1 {
+		return validatorError(errors.New("must specify exactly one of credits, acme, or fiat"))
+	}
+
+	res, err := m.querier.QueryOracle(req.Subnet, QueryPagination{})
+	if err != nil {
+		return accumulateError(err)
+	}
+	oracle, ok := res.Data.(*query.ResponseOracle)
+	if !ok {
+		return internalError(fmt.Errorf("unknown response type: want %T, got %T", new(query.ResponseOracle), res.Data))
+	}
+
+	quote := new(CreditsQuote)
+	quote.Oracle = oracle.Active
+	switch {
+	case req.Acme.Sign() != 0:
+		quote.Acme = req.Acme
+		quote.Credits, err = protocol.AcmeToCredits(&req.Acme, quote.Oracle)
+		if err != nil {
+			return validatorError(err)
+		}
+
+	default:
+		// A credit balance and the fiat amount it is worth have the same value
+		quote.Credits = req.Credits + req.Fiat
+		acme, err := protocol.CreditsToAcme(quote.Credits, quote.Oracle)
+		if err != nil {
+			return accumulateError(err)
+		}
+		quote.Acme = *acme
+	}
+	quote.Fiat = quote.Credits
+	return quote
+}
@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
 	"gitlab.com/accumulatenetwork/accumulate/types/api/transactions"
@@ -37,6 +38,43 @@ func (m *JrpcMethods) Execute(ctx context.Context, params json.RawMessage) inter
 	return m.execute(ctx, req, data)
 }
 
+// Simulate executes a transaction against a throwaway copy of the state of its
+// origin's subnet, without submitting it. The transaction does not need to be
+// signed, but the signer's public key is required.
+func (m *JrpcMethods) Simulate(_ context.Context, params json.RawMessage) interface{} {
+	var payload string
+	req := new(TxRequest)
+	req.Payload = &payload
+	err := json.Unmarshal(params, req)
+	if err != nil {
+		return validatorError(err)
+	}
+
+	err = m.validate.StructPartial(req, "Origin", "Payload")
+	if err != nil {
+		return validatorError(err)
+	}
+
+	data, err := hex.DecodeString(payload)
+	if err != nil {
+		return validatorError(err)
+	}
+
+	envs, err := buildEnvelopes(req, data)
+	if err != nil {
+		return err
+	}
+	if len(envs) != 1 {
+		return validatorError(fmt.Errorf("can only simulate one transaction, got %d", len(envs)))
+	}
+
+	res, err := m.querier.QuerySimulate(envs[0])
+	if err != nil {
+		return accumulateError(err)
+	}
+	return res
+}
+
 func (m *JrpcMethods) executeWith(ctx context.Context, params json.RawMessage, payload protocol.TransactionPayload, validateFields ...string) interface{} {
 	var raw json.RawMessage
 	req := new(TxRequest)
@@ -59,6 +97,38 @@ func (m *JrpcMethods) executeWith(ctx context.Context, params json.RawMessage, p
 	return m.execute(ctx, req, b)
 }
 
+// SignPending adds a signature to a pending transaction. The origin and key
+// page of the transaction are looked up, so the caller only needs to provide
+// the transaction hash and the signature.
+func (m *JrpcMethods) SignPending(ctx context.Context, params json.RawMessage) interface{} {
+	req := new(SignPendingRequest)
+	err := m.parse(params, req)
+	if err != nil {
+		return err
+	}
+
+	txn, err := m.querier.QueryTx(req.Txid, 0, QueryOptions{})
+	if err != nil {
+		return accumulateError(err)
+	}
+
+	txrq := new(TxRequest)
+	txrq.CheckOnly = req.CheckOnly
+	txrq.Origin = txn.Origin
+	txrq.Signer = req.Signer
+	txrq.Signature = req.Signature
+	txrq.TxHash = req.Txid
+	if txn.KeyPage != nil {
+		txrq.KeyPage = *txn.KeyPage
+	}
+
+	body, err := new(protocol.SignPending).MarshalBinary()
+	if err != nil {
+		return accumulateError(err)
+	}
+	return m.execute(ctx, txrq, body)
+}
+
 func (m *JrpcMethods) Faucet(ctx context.Context, params json.RawMessage) interface{} {
 	req := new(protocol.AcmeFaucet)
 	err := m.parse(params, req)
@@ -84,6 +154,7 @@ func (m *JrpcMethods) Faucet(ctx context.Context, params json.RawMessage) interf
 	txrq.Origin = tx.Transaction.Origin
 	txrq.Signer.Nonce = tx.Transaction.Nonce
 	txrq.Signer.PublicKey = tx.Signatures[0].GetPublicKey()
+	txrq.Signer.SignatureType = tx.Signatures[0].Type()
 	txrq.KeyPage.Height = tx.Transaction.KeyPageHeight
 	txrq.Signature = tx.Signatures[0].GetSignature()
 
@@ -102,35 +173,9 @@ func (m *JrpcMethods) execute(ctx context.Context, req *TxRequest, payload []byt
 		return validatorError(err)
 	}
 
-	var envs []*transactions.Envelope
-	if req.IsEnvelope {
-		// Unmarshal all the envelopes
-		envs, err = transactions.UnmarshalAll(payload)
-		if err != nil {
-			return accumulateError(err)
-		}
-	} else {
-		body, err := protocol.UnmarshalTransaction(payload)
-		if err != nil {
-			return accumulateError(err)
-		}
-
-		// Build the envelope
-		env := new(transactions.Envelope)
-		env.TxHash = req.TxHash
-		env.Transaction = new(transactions.Transaction)
-		env.Transaction.Body = body
-		env.Transaction.Origin = req.Origin
-		env.Transaction.Nonce = req.Signer.Nonce
-		env.Transaction.KeyPageHeight = req.KeyPage.Height
-		env.Transaction.KeyPageIndex = req.KeyPage.Index
-		envs = append(envs, env)
-
-		ed := new(protocol.LegacyED25519Signature)
-		ed.Nonce = req.Signer.Nonce
-		ed.PublicKey = req.Signer.PublicKey
-		ed.Signature = req.Signature
-		env.Signatures = append(env.Signatures, ed)
+	envs, err := buildEnvelopes(req, payload)
+	if err != nil {
+		return err
 	}
 
 	// Marshal the envelope(s)
@@ -196,3 +241,53 @@ func (m *JrpcMethods) execute(ctx context.Context, req *TxRequest, payload []byt
 		return res
 	}
 }
+
+// buildEnvelopes unmarshals the envelopes of the request, or builds an envelope
+// from the request's payload and signature.
+func buildEnvelopes(req *TxRequest, payload []byte) ([]*transactions.Envelope, error) {
+	if req.IsEnvelope {
+		// Unmarshal all the envelopes
+		envs, err := transactions.UnmarshalAll(payload)
+		if err != nil {
+			return nil, accumulateError(err)
+		}
+		return envs, nil
+	}
+
+	body, err := protocol.UnmarshalTransaction(payload)
+	if err != nil {
+		return nil, accumulateError(err)
+	}
+
+	// Build the envelope
+	env := new(transactions.Envelope)
+	env.TxHash = req.TxHash
+	env.Transaction = new(transactions.Transaction)
+	env.Transaction.Body = body
+	env.Transaction.Origin = req.Origin
+	env.Transaction.Nonce = req.Signer.Nonce
+	env.Transaction.KeyPageHeight = req.KeyPage.Height
+	env.Transaction.KeyPageIndex = req.KeyPage.Index
+
+	switch req.Signer.SignatureType {
+	case protocol.SignatureTypeUnknown, protocol.SignatureTypeLegacyED25519:
+		ed := new(protocol.LegacyED25519Signature)
+		ed.Nonce = req.Signer.Nonce
+		ed.PublicKey = req.Signer.PublicKey
+		ed.Signature = req.Signature
+		env.Signatures = append(env.Signatures, ed)
+	case protocol.SignatureTypeED25519:
+		ed := new(protocol.ED25519Signature)
+		ed.PublicKey = req.Signer.PublicKey
+		ed.Signature = req.Signature
+		env.Signatures = append(env.Signatures, ed)
+	case protocol.SignatureTypeSECP256K1:
+		sig := new(protocol.SECP256K1Signature)
+		sig.PublicKey = req.Signer.PublicKey
+		sig.Signature = req.Signature
+		env.Signatures = append(env.Signatures, sig)
+	default:
+		return nil, validatorError(fmt.Errorf("unsupported signature type %v", req.Signer.SignatureType))
+	}
+	return []*transactions.Envelope{env}, nil
+}
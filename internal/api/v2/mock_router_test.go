@@ -13,6 +13,7 @@ import (
 	coretypes "github.com/tendermint/tendermint/rpc/core/types"
 	routing "gitlab.com/accumulatenetwork/accumulate/internal/routing"
 	url "gitlab.com/accumulatenetwork/accumulate/internal/url"
+	protocol "gitlab.com/accumulatenetwork/accumulate/protocol"
 )
 
 // MockRouter is a mock of Router interface.
@@ -82,3 +83,83 @@ func (mr *MockRouterMockRecorder) Submit(ctx, subnet, tx, pretend, async interfa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockRouter)(nil).Submit), ctx, subnet, tx, pretend, async)
 }
+
+// MockTableRouter is a mock of TableRouter interface.
+type MockTableRouter struct {
+	ctrl     *gomock.Controller
+	recorder *MockTableRouterMockRecorder
+}
+
+// MockTableRouterMockRecorder is the mock recorder for MockTableRouter.
+type MockTableRouterMockRecorder struct {
+	mock *MockTableRouter
+}
+
+// NewMockTableRouter creates a new mock instance.
+func NewMockTableRouter(ctrl *gomock.Controller) *MockTableRouter {
+	mock := &MockTableRouter{ctrl: ctrl}
+	mock.recorder = &MockTableRouterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTableRouter) EXPECT() *MockTableRouterMockRecorder {
+	return m.recorder
+}
+
+// Query mocks base method.
+func (m *MockTableRouter) Query(ctx context.Context, subnet string, query []byte, opts client.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", ctx, subnet, query, opts)
+	ret0, _ := ret[0].(*coretypes.ResultABCIQuery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockTableRouterMockRecorder) Query(ctx, subnet, query, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockTableRouter)(nil).Query), ctx, subnet, query, opts)
+}
+
+// Route mocks base method.
+func (m *MockTableRouter) Route(account *url.URL) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Route", account)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Route indicates an expected call of Route.
+func (mr *MockTableRouterMockRecorder) Route(account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Route", reflect.TypeOf((*MockTableRouter)(nil).Route), account)
+}
+
+// SetRoutingTable mocks base method.
+func (m *MockTableRouter) SetRoutingTable(table *protocol.RoutingTable) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetRoutingTable", table)
+}
+
+// SetRoutingTable indicates an expected call of SetRoutingTable.
+func (mr *MockTableRouterMockRecorder) SetRoutingTable(table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoutingTable", reflect.TypeOf((*MockTableRouter)(nil).SetRoutingTable), table)
+}
+
+// Submit mocks base method.
+func (m *MockTableRouter) Submit(ctx context.Context, subnet string, tx []byte, pretend, async bool) (*routing.ResponseSubmit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Submit", ctx, subnet, tx, pretend, async)
+	ret0, _ := ret[0].(*routing.ResponseSubmit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Submit indicates an expected call of Submit.
+func (mr *MockTableRouterMockRecorder) Submit(ctx, subnet, tx, pretend, async interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockTableRouter)(nil).Submit), ctx, subnet, tx, pretend, async)
+}
@@ -19,6 +19,19 @@ func packStateResponse(obj *state.Object, chain state.Chain) (*ChainQueryRespons
 	if err == nil {
 		res.ChainId = u.AccountID()
 	}
+
+	if issuer, ok := chain.(*protocol.TokenIssuer); ok {
+		res.Supply = new(TokenSupply)
+		res.Supply.HasCirculating = issuer.TracksCirculatingSupply()
+		if issuer.TracksCirculatingSupply() {
+			res.Supply.Circulating = issuer.Issued
+		}
+		res.Supply.HasLimit = issuer.HasSupplyLimit
+		if issuer.HasSupplyLimit {
+			res.Supply.Limit = issuer.Supply
+			res.Supply.Remaining = *issuer.RemainingSupply()
+		}
+	}
 	return res, nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+	"gitlab.com/accumulatenetwork/accumulate/types/api/transactions"
 )
 
 type Querier interface {
@@ -15,6 +16,16 @@ type Querier interface {
 	QueryData(url *url.URL, entryHash [32]byte) (*ChainQueryResponse, error)
 	QueryDataSet(url *url.URL, pagination QueryPagination, opts QueryOptions) (*MultiResponse, error)
 	QueryKeyPageIndex(url *url.URL, key []byte) (*ChainQueryResponse, error)
+	QueryKey(key, keyHash []byte) (*MultiResponse, error)
+	QueryPendingSignatures(url *url.URL) (*MultiResponse, error)
+	QuerySignatures(id []byte) (*ChainQueryResponse, error)
+	QueryProof(url *url.URL, txid []byte) (*ChainQueryResponse, error)
+	QueryAnchor(anchor []byte) (*ChainQueryResponse, error)
+	QuerySyntheticOutbox(subnet string, minAge uint64) (*MultiResponse, error)
+	QueryBlock(subnet string, height uint64) (*ChainQueryResponse, error)
+	QueryBlockRange(subnet string, pagination QueryPagination) (*MultiResponse, error)
+	QueryOracle(subnet string, pagination QueryPagination) (*ChainQueryResponse, error)
+	QuerySimulate(env *transactions.Envelope) (*SimulateResponse, error)
 }
 
 func NewQueryDirect(subnet string, opts Options) Querier {
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding"
 	"encoding/hex"
 	"errors"
@@ -15,6 +16,7 @@ import (
 	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
 	"gitlab.com/accumulatenetwork/accumulate/types"
 	"gitlab.com/accumulatenetwork/accumulate/types/api/query"
+	"gitlab.com/accumulatenetwork/accumulate/types/api/transactions"
 )
 
 type queryDirect struct {
@@ -511,3 +513,341 @@ func (q *queryDirect) QueryKeyPageIndex(u *url.URL, key []byte) (*ChainQueryResp
 	res.Type = "key-page-index"
 	return res, nil
 }
+
+func (q *queryDirect) QueryPendingSignatures(u *url.URL) (*MultiResponse, error) {
+	req := new(query.RequestPendingSignatures)
+	req.Url = u
+	k, v, err := q.query(req, QueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if k != "pending-signatures" {
+		return nil, fmt.Errorf("unknown response type: want pending-signatures, got %q", k)
+	}
+
+	qr := new(query.ResponsePendingSignatures)
+	err = qr.UnmarshalBinary(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response: %v", err)
+	}
+
+	res := new(MultiResponse)
+	res.Type = "pendingSignatures"
+	res.Count = uint64(len(qr.Transactions))
+	res.Total = uint64(len(qr.Transactions))
+	res.Items = make([]interface{}, len(qr.Transactions))
+	for i, txn := range qr.Transactions {
+		res.Items[i] = txn
+	}
+	return res, nil
+}
+
+func (q *queryDirect) QuerySignatures(id []byte) (*ChainQueryResponse, error) {
+	if len(id) != 32 {
+		return nil, fmt.Errorf("invalid TX ID: wanted 32 bytes, got %d", len(id))
+	}
+
+	req := new(query.RequestSignatureStatus)
+	copy(req.TxId[:], id)
+	k, v, err := q.query(req, QueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if k != "signature-status" {
+		return nil, fmt.Errorf("unknown response type: want signature-status, got %q", k)
+	}
+
+	qr := new(query.ResponseSignatureStatus)
+	err = qr.UnmarshalBinary(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response: %v", err)
+	}
+
+	res := new(ChainQueryResponse)
+	res.Type = "signatureStatus"
+	res.Data = qr
+	return res, nil
+}
+
+func (q *queryDirect) QueryProof(u *url.URL, txid []byte) (*ChainQueryResponse, error) {
+	if u == nil && len(txid) == 0 {
+		return nil, fmt.Errorf("a URL or a transaction ID is required")
+	}
+	if len(txid) != 0 && len(txid) != 32 {
+		return nil, fmt.Errorf("invalid TX ID: wanted 32 bytes, got %d", len(txid))
+	}
+
+	req := new(query.RequestProof)
+	req.Url = u
+	req.TxId = txid
+	k, v, err := q.query(req, QueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if k != "proof" {
+		return nil, fmt.Errorf("unknown response type: want proof, got %q", k)
+	}
+
+	qr := new(query.ResponseProof)
+	err = qr.UnmarshalBinary(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response: %v", err)
+	}
+
+	res := new(ChainQueryResponse)
+	res.Type = "proof"
+	res.Data = qr
+	return res, nil
+}
+
+func (q *queryDirect) QueryAnchor(anchor []byte) (*ChainQueryResponse, error) {
+	req := new(query.RequestAnchorProof)
+	req.Anchor = anchor
+	k, v, err := q.query(req, QueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if k != "anchor-proof" {
+		return nil, fmt.Errorf("unknown response type: want anchor-proof, got %q", k)
+	}
+
+	qr := new(query.ResponseAnchorProof)
+	err = qr.UnmarshalBinary(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response: %v", err)
+	}
+
+	res := new(ChainQueryResponse)
+	res.Type = "anchorProof"
+	res.Data = qr
+	return res, nil
+}
+
+func (q *queryDirect) QuerySyntheticOutbox(_ string, minAge uint64) (*MultiResponse, error) {
+	req := new(query.RequestSyntheticOutbox)
+	req.MinAge = minAge
+	k, v, err := q.query(req, QueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if k != "synthetic-outbox" {
+		return nil, fmt.Errorf("unknown response type: want synthetic-outbox, got %q", k)
+	}
+
+	qr := new(query.ResponseSyntheticOutbox)
+	err = qr.UnmarshalBinary(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response: %v", err)
+	}
+
+	res := new(MultiResponse)
+	res.Type = "syntheticOutbox"
+	res.Items = make([]interface{}, len(qr.Transactions))
+	res.Count = uint64(len(qr.Transactions))
+	res.Total = uint64(len(qr.Transactions))
+	for i, txn := range qr.Transactions {
+		res.Items[i] = txn
+	}
+	return res, nil
+}
+
+func (q *queryDirect) QueryBlock(_ string, height uint64) (*ChainQueryResponse, error) {
+	req := new(query.RequestBlock)
+	req.Height = height
+	k, v, err := q.query(req, QueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if k != "block" {
+		return nil, fmt.Errorf("unknown response type: want block, got %q", k)
+	}
+
+	qr := new(query.ResponseBlock)
+	err = qr.UnmarshalBinary(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response: %v", err)
+	}
+
+	res := new(ChainQueryResponse)
+	res.Type = "block"
+	res.Data = qr
+	return res, nil
+}
+
+func (q *queryDirect) QueryBlockRange(_ string, pagination QueryPagination) (*MultiResponse, error) {
+	if pagination.Count == 0 {
+		return nil, validatorError(errors.New("count must be greater than 0"))
+	}
+
+	req := new(query.RequestBlockRange)
+	req.Start = pagination.Start
+	req.Count = pagination.Count
+	k, v, err := q.query(req, QueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if k != "block-range" {
+		return nil, fmt.Errorf("unknown response type: want block-range, got %q", k)
+	}
+
+	qr := new(query.ResponseBlockRange)
+	err = qr.UnmarshalBinary(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response: %v", err)
+	}
+
+	res := new(MultiResponse)
+	res.Type = "blockRange"
+	res.Items = make([]interface{}, len(qr.Blocks))
+	res.Start = pagination.Start
+	res.Count = pagination.Count
+	res.Total = qr.Total
+	for i, block := range qr.Blocks {
+		res.Items[i] = block
+	}
+	return res, nil
+}
+
+func (q *queryDirect) QueryOracle(_ string, pagination QueryPagination) (*ChainQueryResponse, error) {
+	req := new(query.RequestOracle)
+	req.Start = pagination.Start
+	req.Count = pagination.Count
+	k, v, err := q.query(req, QueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if k != "oracle" {
+		return nil, fmt.Errorf("unknown response type: want oracle, got %q", k)
+	}
+
+	qr := new(query.ResponseOracle)
+	err = qr.UnmarshalBinary(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response: %v", err)
+	}
+
+	res := new(ChainQueryResponse)
+	res.Type = "oracle"
+	res.Data = qr
+	return res, nil
+}
+
+func (q *queryDirect) QuerySimulate(env *transactions.Envelope) (*SimulateResponse, error) {
+	var err error
+	req := new(query.RequestSimulate)
+	req.Envelope, err = env.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal envelope: %v", err)
+	}
+
+	k, v, err := q.query(req, QueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if k != "simulate" {
+		return nil, fmt.Errorf("unknown response type: want simulate, got %q", k)
+	}
+
+	qr := new(query.ResponseSimulate)
+	err = qr.UnmarshalBinary(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response: %v", err)
+	}
+
+	res := new(SimulateResponse)
+	res.Fee = qr.Fee
+	res.Pending = qr.Pending
+
+	result, err := protocol.UnmarshalTransactionResult(qr.Result)
+	if err != nil {
+		return nil, fmt.Errorf("invalid result: %v", err)
+	}
+	if _, ok := result.(*protocol.EmptyResult); !ok {
+		res.Result = result
+	}
+
+	res.Changes = make([]*AccountChange, len(qr.Changes))
+	for i, qc := range qr.Changes {
+		change := new(AccountChange)
+		change.Url = qc.Url
+		if len(qc.Before) > 0 {
+			change.Before, err = protocol.UnmarshalAccount(qc.Before)
+			if err != nil {
+				return nil, fmt.Errorf("invalid state of %v: %v", qc.Url, err)
+			}
+		}
+		change.After, err = protocol.UnmarshalAccount(qc.After)
+		if err != nil {
+			return nil, fmt.Errorf("invalid state of %v: %v", qc.Url, err)
+		}
+		res.Changes[i] = change
+	}
+
+	res.Synthetic = make([]*TransactionQueryResponse, len(qr.Synthetic))
+	for i, data := range qr.Synthetic {
+		synth := new(transactions.Envelope)
+		err = synth.UnmarshalBinary(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid synthetic transaction: %v", err)
+		}
+
+		txr := new(TransactionQueryResponse)
+		txr.Type = synth.Transaction.Type().String()
+		txr.Data = synth.Transaction.Body
+		txr.Origin = synth.Transaction.Origin
+		txr.TransactionHash = synth.GetTxHash()
+		res.Synthetic[i] = txr
+	}
+
+	return res, nil
+}
+
+// keyHashOf returns the key hash of a key query. Either the public key or its
+// SHA-256 hash must be specified.
+func keyHashOf(key, keyHash []byte) ([32]byte, error) {
+	switch {
+	case len(key) > 0 && len(keyHash) > 0:
+		return [32]byte{}, validatorError(errors.New("key and key hash cannot both be specified"))
+	case len(key) > 0:
+		return sha256.Sum256(key), nil
+	case len(keyHash) == 32:
+		return *(*[32]byte)(keyHash), nil
+	case len(keyHash) > 0:
+		return [32]byte{}, validatorError(fmt.Errorf("invalid key hash: want 32 bytes, got %d", len(keyHash)))
+	default:
+		return [32]byte{}, validatorError(errors.New("key or key hash is required"))
+	}
+}
+
+func (q *queryDirect) QueryKey(key, keyHash []byte) (*MultiResponse, error) {
+	hash, err := keyHashOf(key, keyHash)
+	if err != nil {
+		return nil, err
+	}
+
+	req := new(query.RequestKey)
+	req.KeyHash = hash
+	k, v, err := q.query(req, QueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if k != "key" {
+		return nil, fmt.Errorf("unknown response type: want key, got %q", k)
+	}
+
+	qr := new(query.ResponseKey)
+	err = qr.UnmarshalBinary(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response: %v", err)
+	}
+
+	res := new(MultiResponse)
+	res.Type = "keyAccounts"
+	res.Items = make([]interface{}, len(qr.Accounts))
+	res.Count = uint64(len(qr.Accounts))
+	res.Total = uint64(len(qr.Accounts))
+	for i, account := range qr.Accounts {
+		res.Items[i] = account
+	}
+	return res, nil
+}
@@ -2,11 +2,14 @@ package api
 
 import (
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
 	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
 	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
+	"gitlab.com/accumulatenetwork/accumulate/types/api/transactions"
 )
 
 type queryDispatch struct {
@@ -74,6 +77,34 @@ func (q *queryDispatch) queryAll(query func(*queryDirect) (interface{}, error))
 	}
 }
 
+// queryEach runs the query on every subnet and returns every result. Subnets
+// that return not found are skipped.
+func (q *queryDispatch) queryEach(query func(*queryDirect) (interface{}, error)) ([]interface{}, error) {
+	results := make([]interface{}, len(q.Network.Subnets))
+	errs := make([]error, len(q.Network.Subnets))
+	wg := new(sync.WaitGroup)
+	wg.Add(len(q.Network.Subnets))
+
+	for i, subnet := range q.Network.Subnets {
+		go func(i int, subnetId string) {
+			defer wg.Done()
+			results[i], errs[i] = query(q.direct(subnetId))
+		}(i, subnet.ID)
+	}
+	wg.Wait()
+
+	var found []interface{}
+	for i, err := range errs {
+		switch {
+		case err == nil:
+			found = append(found, results[i])
+		case !errors.Is(err, storage.ErrNotFound):
+			return nil, err
+		}
+	}
+	return found, nil
+}
+
 func (q *queryDispatch) QueryUrl(url *url.URL, opts QueryOptions) (interface{}, error) {
 	r, err := q.Router.Route(url)
 	if err != nil {
@@ -92,6 +123,131 @@ func (q *queryDispatch) QueryKeyPageIndex(url *url.URL, key []byte) (*ChainQuery
 	return q.direct(r).QueryKeyPageIndex(url, key)
 }
 
+func (q *queryDispatch) QueryPendingSignatures(url *url.URL) (*MultiResponse, error) {
+	r, err := q.Router.Route(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return q.direct(r).QueryPendingSignatures(url)
+}
+
+func (q *queryDispatch) QuerySignatures(id []byte) (*ChainQueryResponse, error) {
+	res, err := q.queryAll(func(q *queryDirect) (interface{}, error) {
+		return q.QuerySignatures(id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*ChainQueryResponse), nil
+}
+
+func (q *queryDispatch) QueryProof(url *url.URL, txid []byte) (*ChainQueryResponse, error) {
+	if url != nil {
+		r, err := q.Router.Route(url)
+		if err != nil {
+			return nil, err
+		}
+
+		return q.direct(r).QueryProof(url, txid)
+	}
+
+	res, err := q.queryAll(func(q *queryDirect) (interface{}, error) {
+		return q.QueryProof(nil, txid)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*ChainQueryResponse), nil
+}
+
+func (q *queryDispatch) QuerySyntheticOutbox(subnet string, minAge uint64) (*MultiResponse, error) {
+	if subnet != "" {
+		return q.direct(subnet).QuerySyntheticOutbox(subnet, minAge)
+	}
+
+	// Collect stuck transactions from every subnet
+	res := new(MultiResponse)
+	res.Type = "syntheticOutbox"
+	for _, s := range q.Network.Subnets {
+		r, err := q.direct(s.ID).QuerySyntheticOutbox(s.ID, minAge)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query %s: %w", s.ID, err)
+		}
+		res.Items = append(res.Items, r.Items...)
+	}
+	res.Count = uint64(len(res.Items))
+	res.Total = uint64(len(res.Items))
+	return res, nil
+}
+
+// localSubnet returns the subnet to query for blocks and other per-subnet
+// records. These are queried from the local subnet unless another is
+// specified.
+func (q *queryDispatch) localSubnet(subnet string) string {
+	if subnet != "" {
+		return subnet
+	}
+	return q.Network.LocalSubnetID
+}
+
+func (q *queryDispatch) QueryBlock(subnet string, height uint64) (*ChainQueryResponse, error) {
+	return q.direct(q.localSubnet(subnet)).QueryBlock(subnet, height)
+}
+
+func (q *queryDispatch) QueryBlockRange(subnet string, pagination QueryPagination) (*MultiResponse, error) {
+	return q.direct(q.localSubnet(subnet)).QueryBlockRange(subnet, pagination)
+}
+
+func (q *queryDispatch) QueryOracle(subnet string, pagination QueryPagination) (*ChainQueryResponse, error) {
+	return q.direct(q.localSubnet(subnet)).QueryOracle(subnet, pagination)
+}
+
+func (q *queryDispatch) QuerySimulate(env *transactions.Envelope) (*SimulateResponse, error) {
+	r, err := q.Router.Route(env.Transaction.Origin)
+	if err != nil {
+		return nil, err
+	}
+
+	return q.direct(r).QuerySimulate(env)
+}
+
+func (q *queryDispatch) QueryKey(key, keyHash []byte) (*MultiResponse, error) {
+	// Validate the request before querying every subnet
+	_, err := keyHashOf(key, keyHash)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := q.queryEach(func(q *queryDirect) (interface{}, error) {
+		return q.QueryKey(key, keyHash)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(MultiResponse)
+	res.Type = "keyAccounts"
+	for _, r := range results {
+		res.Items = append(res.Items, r.(*MultiResponse).Items...)
+	}
+	res.Count = uint64(len(res.Items))
+	res.Total = uint64(len(res.Items))
+	return res, nil
+}
+
+func (q *queryDispatch) QueryAnchor(anchor []byte) (*ChainQueryResponse, error) {
+	// Only the directory records the anchors of the subnets
+	r, err := q.Router.Route(protocol.DnUrl())
+	if err != nil {
+		return nil, err
+	}
+
+	return q.direct(r).QueryAnchor(anchor)
+}
+
 func (q *queryDispatch) QueryChain(id []byte) (*ChainQueryResponse, error) {
 	res, err := q.queryAll(func(q *queryDirect) (interface{}, error) {
 		return q.QueryChain(id)
@@ -19,6 +19,34 @@ import (
 	"gitlab.com/accumulatenetwork/accumulate/types/api/query"
 )
 
+type AccountChange struct {
+	Url    *url.URL    `json:"url,omitempty" form:"url" query:"url" validate:"required"`
+	Before interface{} `json:"before,omitempty" form:"before" query:"before" validate:"required"`
+	After  interface{} `json:"after,omitempty" form:"after" query:"after" validate:"required"`
+}
+
+type AnchorQuery struct {
+	Anchor []byte `json:"anchor,omitempty" form:"anchor" query:"anchor" validate:"required"`
+}
+
+type BlockNotification struct {
+	Subscription uint64                    `json:"subscription,omitempty" form:"subscription" query:"subscription" validate:"required"`
+	Height       uint64                    `json:"height,omitempty" form:"height" query:"height" validate:"required"`
+	Time         time.Time                 `json:"time,omitempty" form:"time" query:"time" validate:"required"`
+	RootAnchor   []byte                    `json:"rootAnchor,omitempty" form:"rootAnchor" query:"rootAnchor" validate:"required"`
+	Anchors      []protocol.AnchorMetadata `json:"anchors,omitempty" form:"anchors" query:"anchors" validate:"required"`
+}
+
+type BlockQuery struct {
+	Subnet string `json:"subnet,omitempty" form:"subnet" query:"subnet"`
+	Height uint64 `json:"height,omitempty" form:"height" query:"height" validate:"required"`
+}
+
+type BlockRangeQuery struct {
+	QueryPagination
+	Subnet string `json:"subnet,omitempty" form:"subnet" query:"subnet"`
+}
+
 type ChainIdQuery struct {
 	ChainId []byte `json:"chainId,omitempty" form:"chainId" query:"chainId" validate:"required"`
 }
@@ -28,6 +56,21 @@ type ChainQueryResponse struct {
 	MainChain *MerkleState `json:"mainChain,omitempty" form:"mainChain" query:"mainChain" validate:"required"`
 	Data      interface{}  `json:"data,omitempty" form:"data" query:"data" validate:"required"`
 	ChainId   []byte       `json:"chainId,omitempty" form:"chainId" query:"chainId" validate:"required"`
+	Supply    *TokenSupply `json:"supply,omitempty" form:"supply" query:"supply"`
+}
+
+type CreditsQuote struct {
+	Oracle  uint64  `json:"oracle,omitempty" form:"oracle" query:"oracle" validate:"required"`
+	Credits uint64  `json:"credits,omitempty" form:"credits" query:"credits" validate:"required"`
+	Acme    big.Int `json:"acme,omitempty" form:"acme" query:"acme" validate:"required"`
+	Fiat    uint64  `json:"fiat,omitempty" form:"fiat" query:"fiat" validate:"required"`
+}
+
+type CreditsQuoteQuery struct {
+	Subnet  string  `json:"subnet,omitempty" form:"subnet" query:"subnet"`
+	Credits uint64  `json:"credits,omitempty" form:"credits" query:"credits"`
+	Acme    big.Int `json:"acme,omitempty" form:"acme" query:"acme"`
+	Fiat    uint64  `json:"fiat,omitempty" form:"fiat" query:"fiat"`
 }
 
 type DataEntry struct {
@@ -80,6 +123,11 @@ type KeyPageIndexQuery struct {
 	Key []byte `json:"key,omitempty" form:"key" query:"key" validate:"required"`
 }
 
+type KeyQuery struct {
+	Key     []byte `json:"key,omitempty" form:"key" query:"key"`
+	KeyHash []byte `json:"keyHash,omitempty" form:"keyHash" query:"keyHash"`
+}
+
 type MerkleState struct {
 	Height uint64   `json:"height,omitempty" form:"height" query:"height" validate:"required"`
 	Roots  [][]byte `json:"roots,omitempty" form:"roots" query:"roots" validate:"required"`
@@ -103,6 +151,16 @@ type MultiResponse struct {
 	OtherItems []interface{} `json:"otherItems,omitempty" form:"otherItems" query:"otherItems" validate:"required"`
 }
 
+type OracleQuery struct {
+	QueryPagination
+	Subnet string `json:"subnet,omitempty" form:"subnet" query:"subnet"`
+}
+
+type ProofQuery struct {
+	Url  *url.URL `json:"url,omitempty" form:"url" query:"url"`
+	Txid []byte   `json:"txid,omitempty" form:"txid" query:"txid"`
+}
+
 type QueryOptions struct {
 	Expand bool   `json:"expand,omitempty" form:"expand" query:"expand"`
 	Height uint64 `json:"height,omitempty" form:"height" query:"height"`
@@ -114,15 +172,52 @@ type QueryPagination struct {
 	Count uint64 `json:"count,omitempty" form:"count" query:"count"`
 }
 
+type SignPendingRequest struct {
+	CheckOnly bool   `json:"checkOnly,omitempty" form:"checkOnly" query:"checkOnly"`
+	Txid      []byte `json:"txid,omitempty" form:"txid" query:"txid" validate:"required"`
+	Signer    Signer `json:"signer,omitempty" form:"signer" query:"signer" validate:"required"`
+	Signature []byte `json:"signature,omitempty" form:"signature" query:"signature" validate:"required"`
+}
+
 type Signer struct {
-	PublicKey []byte `json:"publicKey,omitempty" form:"publicKey" query:"publicKey" validate:"required"`
-	Nonce     uint64 `json:"nonce,omitempty" form:"nonce" query:"nonce" validate:"required"`
+	PublicKey     []byte                 `json:"publicKey,omitempty" form:"publicKey" query:"publicKey" validate:"required"`
+	Nonce         uint64                 `json:"nonce,omitempty" form:"nonce" query:"nonce" validate:"required"`
+	SignatureType protocol.SignatureType `json:"signatureType,omitempty" form:"signatureType" query:"signatureType"`
+}
+
+type SimulateResponse struct {
+	Fee       uint64                      `json:"fee,omitempty" form:"fee" query:"fee" validate:"required"`
+	Pending   bool                        `json:"pending,omitempty" form:"pending" query:"pending" validate:"required"`
+	Result    interface{}                 `json:"result,omitempty" form:"result" query:"result" validate:"required"`
+	Changes   []*AccountChange            `json:"changes,omitempty" form:"changes" query:"changes" validate:"required"`
+	Synthetic []*TransactionQueryResponse `json:"synthetic,omitempty" form:"synthetic" query:"synthetic" validate:"required"`
 }
 
 type StatusResponse struct {
 	Ok bool `json:"ok,omitempty" form:"ok" query:"ok" validate:"required"`
 }
 
+type SubscribeAccountRequest struct {
+	Url *url.URL `json:"url,omitempty" form:"url" query:"url" validate:"required"`
+}
+
+type SubscribeTransactionRequest struct {
+	Txid []byte `json:"txid,omitempty" form:"txid" query:"txid" validate:"required"`
+}
+
+type SubscriptionRequest struct {
+	Subscription uint64 `json:"subscription,omitempty" form:"subscription" query:"subscription" validate:"required"`
+}
+
+type SubscriptionResponse struct {
+	Subscription uint64 `json:"subscription,omitempty" form:"subscription" query:"subscription" validate:"required"`
+}
+
+type SyntheticOutboxQuery struct {
+	Subnet string `json:"subnet,omitempty" form:"subnet" query:"subnet"`
+	MinAge uint64 `json:"minAge,omitempty" form:"minAge" query:"minAge"`
+}
+
 type TokenDeposit struct {
 	Url    *url.URL `json:"url,omitempty" form:"url" query:"url" validate:"required"`
 	Amount big.Int  `json:"amount,omitempty" form:"amount" query:"amount" validate:"required"`
@@ -134,6 +229,25 @@ type TokenSend struct {
 	To   []TokenDeposit `json:"to,omitempty" form:"to" query:"to" validate:"required"`
 }
 
+type TokenSupply struct {
+	HasCirculating bool    `json:"hasCirculating,omitempty" form:"hasCirculating" query:"hasCirculating"`
+	Circulating    big.Int `json:"circulating,omitempty" form:"circulating" query:"circulating" validate:"required"`
+	HasLimit       bool    `json:"hasLimit,omitempty" form:"hasLimit" query:"hasLimit"`
+	Limit          big.Int `json:"limit,omitempty" form:"limit" query:"limit"`
+	Remaining      big.Int `json:"remaining,omitempty" form:"remaining" query:"remaining"`
+}
+
+type TransactionNotification struct {
+	Subscription   uint64                      `json:"subscription,omitempty" form:"subscription" query:"subscription" validate:"required"`
+	Txid           []byte                      `json:"txid,omitempty" form:"txid" query:"txid" validate:"required"`
+	Origin         *url.URL                    `json:"origin,omitempty" form:"origin" query:"origin" validate:"required"`
+	Type           string                      `json:"type,omitempty" form:"type" query:"type" validate:"required"`
+	Status         *protocol.TransactionStatus `json:"status,omitempty" form:"status" query:"status" validate:"required"`
+	SyntheticTxids [][32]byte                  `json:"syntheticTxids,omitempty" form:"syntheticTxids" query:"syntheticTxids" validate:"required"`
+	Accounts       []*url.URL                  `json:"accounts,omitempty" form:"accounts" query:"accounts" validate:"required"`
+	Done           bool                        `json:"done,omitempty" form:"done" query:"done"`
+}
+
 type TransactionQueryResponse struct {
 	Type               string                      `json:"type,omitempty" form:"type" query:"type" validate:"required"`
 	MainChain          *MerkleState                `json:"mainChain,omitempty" form:"mainChain" query:"mainChain" validate:"required"`
@@ -419,6 +533,54 @@ func (v *DataEntryQueryResponse) UnmarshalBinaryFrom(rd io.Reader) error {
 	return err
 }
 
+func (v *AccountChange) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Url    *url.URL    `json:"url,omitempty"`
+		Before interface{} `json:"before,omitempty"`
+		After  interface{} `json:"after,omitempty"`
+	}{}
+	u.Url = v.Url
+	u.Before = encoding.AnyToJSON(v.Before)
+	u.After = encoding.AnyToJSON(v.After)
+	return json.Marshal(&u)
+}
+
+func (v *AnchorQuery) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Anchor *string `json:"anchor,omitempty"`
+	}{}
+	u.Anchor = encoding.BytesToJSON(v.Anchor)
+	return json.Marshal(&u)
+}
+
+func (v *BlockNotification) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Subscription uint64                    `json:"subscription,omitempty"`
+		Height       uint64                    `json:"height,omitempty"`
+		Time         time.Time                 `json:"time,omitempty"`
+		RootAnchor   *string                   `json:"rootAnchor,omitempty"`
+		Anchors      []protocol.AnchorMetadata `json:"anchors,omitempty"`
+	}{}
+	u.Subscription = v.Subscription
+	u.Height = v.Height
+	u.Time = v.Time
+	u.RootAnchor = encoding.BytesToJSON(v.RootAnchor)
+	u.Anchors = v.Anchors
+	return json.Marshal(&u)
+}
+
+func (v *BlockRangeQuery) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Start  uint64 `json:"start,omitempty"`
+		Count  uint64 `json:"count,omitempty"`
+		Subnet string `json:"subnet,omitempty"`
+	}{}
+	u.Start = v.QueryPagination.Start
+	u.Count = v.QueryPagination.Count
+	u.Subnet = v.Subnet
+	return json.Marshal(&u)
+}
+
 func (v *ChainIdQuery) MarshalJSON() ([]byte, error) {
 	u := struct {
 		ChainId *string `json:"chainId,omitempty"`
@@ -434,12 +596,42 @@ func (v *ChainQueryResponse) MarshalJSON() ([]byte, error) {
 		MerkleState *MerkleState `json:"merkleState,omitempty"`
 		Data        interface{}  `json:"data,omitempty"`
 		ChainId     *string      `json:"chainId,omitempty"`
+		Supply      *TokenSupply `json:"supply,omitempty"`
 	}{}
 	u.Type = v.Type
 	u.MainChain = v.MainChain
 	u.MerkleState = v.MainChain
 	u.Data = encoding.AnyToJSON(v.Data)
 	u.ChainId = encoding.BytesToJSON(v.ChainId)
+	u.Supply = v.Supply
+	return json.Marshal(&u)
+}
+
+func (v *CreditsQuote) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Oracle  uint64  `json:"oracle,omitempty"`
+		Credits uint64  `json:"credits,omitempty"`
+		Acme    *string `json:"acme,omitempty"`
+		Fiat    uint64  `json:"fiat,omitempty"`
+	}{}
+	u.Oracle = v.Oracle
+	u.Credits = v.Credits
+	u.Acme = encoding.BigintToJSON(&v.Acme)
+	u.Fiat = v.Fiat
+	return json.Marshal(&u)
+}
+
+func (v *CreditsQuoteQuery) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Subnet  string  `json:"subnet,omitempty"`
+		Credits uint64  `json:"credits,omitempty"`
+		Acme    *string `json:"acme,omitempty"`
+		Fiat    uint64  `json:"fiat,omitempty"`
+	}{}
+	u.Subnet = v.Subnet
+	u.Credits = v.Credits
+	u.Acme = encoding.BigintToJSON(&v.Acme)
+	u.Fiat = v.Fiat
 	return json.Marshal(&u)
 }
 
@@ -484,6 +676,7 @@ func (v *DataEntrySetQuery) MarshalJSON() ([]byte, error) {
 		Expand       bool     `json:"expand,omitempty"`
 		ExpandChains bool     `json:"expandChains,omitempty"`
 		Height       uint64   `json:"height,omitempty"`
+		Block        uint64   `json:"block,omitempty"`
 		Prove        bool     `json:"prove,omitempty"`
 	}{}
 	u.Url = v.UrlQuery.Url
@@ -492,6 +685,7 @@ func (v *DataEntrySetQuery) MarshalJSON() ([]byte, error) {
 	u.Expand = v.QueryOptions.Expand
 	u.ExpandChains = v.QueryOptions.Expand
 	u.Height = v.QueryOptions.Height
+	u.Block = v.QueryOptions.Height
 	u.Prove = v.QueryOptions.Prove
 	return json.Marshal(&u)
 }
@@ -504,6 +698,7 @@ func (v *DirectoryQuery) MarshalJSON() ([]byte, error) {
 		Expand       bool     `json:"expand,omitempty"`
 		ExpandChains bool     `json:"expandChains,omitempty"`
 		Height       uint64   `json:"height,omitempty"`
+		Block        uint64   `json:"block,omitempty"`
 		Prove        bool     `json:"prove,omitempty"`
 	}{}
 	u.Url = v.UrlQuery.Url
@@ -512,6 +707,7 @@ func (v *DirectoryQuery) MarshalJSON() ([]byte, error) {
 	u.Expand = v.QueryOptions.Expand
 	u.ExpandChains = v.QueryOptions.Expand
 	u.Height = v.QueryOptions.Height
+	u.Block = v.QueryOptions.Height
 	u.Prove = v.QueryOptions.Prove
 	return json.Marshal(&u)
 }
@@ -522,12 +718,14 @@ func (v *GeneralQuery) MarshalJSON() ([]byte, error) {
 		Expand       bool     `json:"expand,omitempty"`
 		ExpandChains bool     `json:"expandChains,omitempty"`
 		Height       uint64   `json:"height,omitempty"`
+		Block        uint64   `json:"block,omitempty"`
 		Prove        bool     `json:"prove,omitempty"`
 	}{}
 	u.Url = v.UrlQuery.Url
 	u.Expand = v.QueryOptions.Expand
 	u.ExpandChains = v.QueryOptions.Expand
 	u.Height = v.QueryOptions.Height
+	u.Block = v.QueryOptions.Height
 	u.Prove = v.QueryOptions.Prove
 	return json.Marshal(&u)
 }
@@ -542,6 +740,16 @@ func (v *KeyPageIndexQuery) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&u)
 }
 
+func (v *KeyQuery) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Key     *string `json:"key,omitempty"`
+		KeyHash *string `json:"keyHash,omitempty"`
+	}{}
+	u.Key = encoding.BytesToJSON(v.Key)
+	u.KeyHash = encoding.BytesToJSON(v.KeyHash)
+	return json.Marshal(&u)
+}
+
 func (v *MerkleState) MarshalJSON() ([]byte, error) {
 	u := struct {
 		Height uint64    `json:"height,omitempty"`
@@ -599,27 +807,91 @@ func (v *MultiResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&u)
 }
 
+func (v *OracleQuery) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Start  uint64 `json:"start,omitempty"`
+		Count  uint64 `json:"count,omitempty"`
+		Subnet string `json:"subnet,omitempty"`
+	}{}
+	u.Start = v.QueryPagination.Start
+	u.Count = v.QueryPagination.Count
+	u.Subnet = v.Subnet
+	return json.Marshal(&u)
+}
+
+func (v *ProofQuery) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Url  *url.URL `json:"url,omitempty"`
+		Txid *string  `json:"txid,omitempty"`
+	}{}
+	u.Url = v.Url
+	u.Txid = encoding.BytesToJSON(v.Txid)
+	return json.Marshal(&u)
+}
+
 func (v *QueryOptions) MarshalJSON() ([]byte, error) {
 	u := struct {
 		Expand       bool   `json:"expand,omitempty"`
 		ExpandChains bool   `json:"expandChains,omitempty"`
 		Height       uint64 `json:"height,omitempty"`
+		Block        uint64 `json:"block,omitempty"`
 		Prove        bool   `json:"prove,omitempty"`
 	}{}
 	u.Expand = v.Expand
 	u.ExpandChains = v.Expand
 	u.Height = v.Height
+	u.Block = v.Height
 	u.Prove = v.Prove
 	return json.Marshal(&u)
 }
 
+func (v *SignPendingRequest) MarshalJSON() ([]byte, error) {
+	u := struct {
+		CheckOnly bool    `json:"checkOnly,omitempty"`
+		Txid      *string `json:"txid,omitempty"`
+		Signer    Signer  `json:"signer,omitempty"`
+		Signature *string `json:"signature,omitempty"`
+	}{}
+	u.CheckOnly = v.CheckOnly
+	u.Txid = encoding.BytesToJSON(v.Txid)
+	u.Signer = v.Signer
+	u.Signature = encoding.BytesToJSON(v.Signature)
+	return json.Marshal(&u)
+}
+
 func (v *Signer) MarshalJSON() ([]byte, error) {
 	u := struct {
-		PublicKey *string `json:"publicKey,omitempty"`
-		Nonce     uint64  `json:"nonce,omitempty"`
+		PublicKey     *string                `json:"publicKey,omitempty"`
+		Nonce         uint64                 `json:"nonce,omitempty"`
+		SignatureType protocol.SignatureType `json:"signatureType,omitempty"`
 	}{}
 	u.PublicKey = encoding.BytesToJSON(v.PublicKey)
 	u.Nonce = v.Nonce
+	u.SignatureType = v.SignatureType
+	return json.Marshal(&u)
+}
+
+func (v *SimulateResponse) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Fee       uint64                      `json:"fee,omitempty"`
+		Pending   bool                        `json:"pending,omitempty"`
+		Result    interface{}                 `json:"result,omitempty"`
+		Changes   []*AccountChange            `json:"changes,omitempty"`
+		Synthetic []*TransactionQueryResponse `json:"synthetic,omitempty"`
+	}{}
+	u.Fee = v.Fee
+	u.Pending = v.Pending
+	u.Result = encoding.AnyToJSON(v.Result)
+	u.Changes = v.Changes
+	u.Synthetic = v.Synthetic
+	return json.Marshal(&u)
+}
+
+func (v *SubscribeTransactionRequest) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Txid *string `json:"txid,omitempty"`
+	}{}
+	u.Txid = encoding.BytesToJSON(v.Txid)
 	return json.Marshal(&u)
 }
 
@@ -635,6 +907,47 @@ func (v *TokenDeposit) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&u)
 }
 
+func (v *TokenSupply) MarshalJSON() ([]byte, error) {
+	u := struct {
+		HasCirculating bool    `json:"hasCirculating,omitempty"`
+		Circulating    *string `json:"circulating,omitempty"`
+		HasLimit       bool    `json:"hasLimit,omitempty"`
+		Limit          *string `json:"limit,omitempty"`
+		Remaining      *string `json:"remaining,omitempty"`
+	}{}
+	u.HasCirculating = v.HasCirculating
+	u.Circulating = encoding.BigintToJSON(&v.Circulating)
+	u.HasLimit = v.HasLimit
+	u.Limit = encoding.BigintToJSON(&v.Limit)
+	u.Remaining = encoding.BigintToJSON(&v.Remaining)
+	return json.Marshal(&u)
+}
+
+func (v *TransactionNotification) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Subscription   uint64                      `json:"subscription,omitempty"`
+		Txid           *string                     `json:"txid,omitempty"`
+		Origin         *url.URL                    `json:"origin,omitempty"`
+		Type           string                      `json:"type,omitempty"`
+		Status         *protocol.TransactionStatus `json:"status,omitempty"`
+		SyntheticTxids []string                    `json:"syntheticTxids,omitempty"`
+		Accounts       []*url.URL                  `json:"accounts,omitempty"`
+		Done           bool                        `json:"done,omitempty"`
+	}{}
+	u.Subscription = v.Subscription
+	u.Txid = encoding.BytesToJSON(v.Txid)
+	u.Origin = v.Origin
+	u.Type = v.Type
+	u.Status = v.Status
+	u.SyntheticTxids = make([]string, len(v.SyntheticTxids))
+	for i, x := range v.SyntheticTxids {
+		u.SyntheticTxids[i] = encoding.ChainToJSON(x)
+	}
+	u.Accounts = v.Accounts
+	u.Done = v.Done
+	return json.Marshal(&u)
+}
+
 func (v *TransactionQueryResponse) MarshalJSON() ([]byte, error) {
 	u := struct {
 		Type               string                      `json:"type,omitempty"`
@@ -746,6 +1059,7 @@ func (v *TxnQuery) MarshalJSON() ([]byte, error) {
 		Expand       bool        `json:"expand,omitempty"`
 		ExpandChains bool        `json:"expandChains,omitempty"`
 		Height       uint64      `json:"height,omitempty"`
+		Block        uint64      `json:"block,omitempty"`
 		Prove        bool        `json:"prove,omitempty"`
 		Txid         *string     `json:"txid,omitempty"`
 		Wait         interface{} `json:"wait,omitempty"`
@@ -753,12 +1067,101 @@ func (v *TxnQuery) MarshalJSON() ([]byte, error) {
 	u.Expand = v.QueryOptions.Expand
 	u.ExpandChains = v.QueryOptions.Expand
 	u.Height = v.QueryOptions.Height
+	u.Block = v.QueryOptions.Height
 	u.Prove = v.QueryOptions.Prove
 	u.Txid = encoding.BytesToJSON(v.Txid)
 	u.Wait = encoding.DurationToJSON(v.Wait)
 	return json.Marshal(&u)
 }
 
+func (v *AccountChange) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Url    *url.URL    `json:"url,omitempty"`
+		Before interface{} `json:"before,omitempty"`
+		After  interface{} `json:"after,omitempty"`
+	}{}
+	u.Url = v.Url
+	u.Before = encoding.AnyToJSON(v.Before)
+	u.After = encoding.AnyToJSON(v.After)
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Url = u.Url
+	if x, err := encoding.AnyFromJSON(u.Before); err != nil {
+		return fmt.Errorf("error decoding Before: %w", err)
+	} else {
+		v.Before = x
+	}
+	if x, err := encoding.AnyFromJSON(u.After); err != nil {
+		return fmt.Errorf("error decoding After: %w", err)
+	} else {
+		v.After = x
+	}
+	return nil
+}
+
+func (v *AnchorQuery) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Anchor *string `json:"anchor,omitempty"`
+	}{}
+	u.Anchor = encoding.BytesToJSON(v.Anchor)
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	if x, err := encoding.BytesFromJSON(u.Anchor); err != nil {
+		return fmt.Errorf("error decoding Anchor: %w", err)
+	} else {
+		v.Anchor = x
+	}
+	return nil
+}
+
+func (v *BlockNotification) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Subscription uint64                    `json:"subscription,omitempty"`
+		Height       uint64                    `json:"height,omitempty"`
+		Time         time.Time                 `json:"time,omitempty"`
+		RootAnchor   *string                   `json:"rootAnchor,omitempty"`
+		Anchors      []protocol.AnchorMetadata `json:"anchors,omitempty"`
+	}{}
+	u.Subscription = v.Subscription
+	u.Height = v.Height
+	u.Time = v.Time
+	u.RootAnchor = encoding.BytesToJSON(v.RootAnchor)
+	u.Anchors = v.Anchors
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Subscription = u.Subscription
+	v.Height = u.Height
+	v.Time = u.Time
+	if x, err := encoding.BytesFromJSON(u.RootAnchor); err != nil {
+		return fmt.Errorf("error decoding RootAnchor: %w", err)
+	} else {
+		v.RootAnchor = x
+	}
+	v.Anchors = u.Anchors
+	return nil
+}
+
+func (v *BlockRangeQuery) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Start  uint64 `json:"start,omitempty"`
+		Count  uint64 `json:"count,omitempty"`
+		Subnet string `json:"subnet,omitempty"`
+	}{}
+	u.Start = v.QueryPagination.Start
+	u.Count = v.QueryPagination.Count
+	u.Subnet = v.Subnet
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.QueryPagination.Start = u.Start
+	v.QueryPagination.Count = u.Count
+	v.Subnet = u.Subnet
+	return nil
+}
+
 func (v *ChainIdQuery) UnmarshalJSON(data []byte) error {
 	u := struct {
 		ChainId *string `json:"chainId,omitempty"`
@@ -782,12 +1185,14 @@ func (v *ChainQueryResponse) UnmarshalJSON(data []byte) error {
 		MerkleState *MerkleState `json:"merkleState,omitempty"`
 		Data        interface{}  `json:"data,omitempty"`
 		ChainId     *string      `json:"chainId,omitempty"`
+		Supply      *TokenSupply `json:"supply,omitempty"`
 	}{}
 	u.Type = v.Type
 	u.MainChain = v.MainChain
 	u.MerkleState = v.MainChain
 	u.Data = encoding.AnyToJSON(v.Data)
 	u.ChainId = encoding.BytesToJSON(v.ChainId)
+	u.Supply = v.Supply
 	if err := json.Unmarshal(data, &u); err != nil {
 		return err
 	}
@@ -807,6 +1212,57 @@ func (v *ChainQueryResponse) UnmarshalJSON(data []byte) error {
 	} else {
 		v.ChainId = x
 	}
+	v.Supply = u.Supply
+	return nil
+}
+
+func (v *CreditsQuote) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Oracle  uint64  `json:"oracle,omitempty"`
+		Credits uint64  `json:"credits,omitempty"`
+		Acme    *string `json:"acme,omitempty"`
+		Fiat    uint64  `json:"fiat,omitempty"`
+	}{}
+	u.Oracle = v.Oracle
+	u.Credits = v.Credits
+	u.Acme = encoding.BigintToJSON(&v.Acme)
+	u.Fiat = v.Fiat
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Oracle = u.Oracle
+	v.Credits = u.Credits
+	if x, err := encoding.BigintFromJSON(u.Acme); err != nil {
+		return fmt.Errorf("error decoding Acme: %w", err)
+	} else {
+		v.Acme = *x
+	}
+	v.Fiat = u.Fiat
+	return nil
+}
+
+func (v *CreditsQuoteQuery) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Subnet  string  `json:"subnet,omitempty"`
+		Credits uint64  `json:"credits,omitempty"`
+		Acme    *string `json:"acme,omitempty"`
+		Fiat    uint64  `json:"fiat,omitempty"`
+	}{}
+	u.Subnet = v.Subnet
+	u.Credits = v.Credits
+	u.Acme = encoding.BigintToJSON(&v.Acme)
+	u.Fiat = v.Fiat
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Subnet = u.Subnet
+	v.Credits = u.Credits
+	if x, err := encoding.BigintFromJSON(u.Acme); err != nil {
+		return fmt.Errorf("error decoding Acme: %w", err)
+	} else {
+		v.Acme = *x
+	}
+	v.Fiat = u.Fiat
 	return nil
 }
 
@@ -885,6 +1341,7 @@ func (v *DataEntrySetQuery) UnmarshalJSON(data []byte) error {
 		Expand       bool     `json:"expand,omitempty"`
 		ExpandChains bool     `json:"expandChains,omitempty"`
 		Height       uint64   `json:"height,omitempty"`
+		Block        uint64   `json:"block,omitempty"`
 		Prove        bool     `json:"prove,omitempty"`
 	}{}
 	u.Url = v.UrlQuery.Url
@@ -893,6 +1350,7 @@ func (v *DataEntrySetQuery) UnmarshalJSON(data []byte) error {
 	u.Expand = v.QueryOptions.Expand
 	u.ExpandChains = v.QueryOptions.Expand
 	u.Height = v.QueryOptions.Height
+	u.Block = v.QueryOptions.Height
 	u.Prove = v.QueryOptions.Prove
 	if err := json.Unmarshal(data, &u); err != nil {
 		return err
@@ -905,7 +1363,11 @@ func (v *DataEntrySetQuery) UnmarshalJSON(data []byte) error {
 	} else {
 		v.QueryOptions.Expand = u.ExpandChains
 	}
-	v.QueryOptions.Height = u.Height
+	if u.Height != 0 {
+		v.QueryOptions.Height = u.Height
+	} else {
+		v.QueryOptions.Height = u.Block
+	}
 	v.QueryOptions.Prove = u.Prove
 	return nil
 }
@@ -918,6 +1380,7 @@ func (v *DirectoryQuery) UnmarshalJSON(data []byte) error {
 		Expand       bool     `json:"expand,omitempty"`
 		ExpandChains bool     `json:"expandChains,omitempty"`
 		Height       uint64   `json:"height,omitempty"`
+		Block        uint64   `json:"block,omitempty"`
 		Prove        bool     `json:"prove,omitempty"`
 	}{}
 	u.Url = v.UrlQuery.Url
@@ -926,6 +1389,7 @@ func (v *DirectoryQuery) UnmarshalJSON(data []byte) error {
 	u.Expand = v.QueryOptions.Expand
 	u.ExpandChains = v.QueryOptions.Expand
 	u.Height = v.QueryOptions.Height
+	u.Block = v.QueryOptions.Height
 	u.Prove = v.QueryOptions.Prove
 	if err := json.Unmarshal(data, &u); err != nil {
 		return err
@@ -938,7 +1402,11 @@ func (v *DirectoryQuery) UnmarshalJSON(data []byte) error {
 	} else {
 		v.QueryOptions.Expand = u.ExpandChains
 	}
-	v.QueryOptions.Height = u.Height
+	if u.Height != 0 {
+		v.QueryOptions.Height = u.Height
+	} else {
+		v.QueryOptions.Height = u.Block
+	}
 	v.QueryOptions.Prove = u.Prove
 	return nil
 }
@@ -949,12 +1417,14 @@ func (v *GeneralQuery) UnmarshalJSON(data []byte) error {
 		Expand       bool     `json:"expand,omitempty"`
 		ExpandChains bool     `json:"expandChains,omitempty"`
 		Height       uint64   `json:"height,omitempty"`
+		Block        uint64   `json:"block,omitempty"`
 		Prove        bool     `json:"prove,omitempty"`
 	}{}
 	u.Url = v.UrlQuery.Url
 	u.Expand = v.QueryOptions.Expand
 	u.ExpandChains = v.QueryOptions.Expand
 	u.Height = v.QueryOptions.Height
+	u.Block = v.QueryOptions.Height
 	u.Prove = v.QueryOptions.Prove
 	if err := json.Unmarshal(data, &u); err != nil {
 		return err
@@ -965,7 +1435,11 @@ func (v *GeneralQuery) UnmarshalJSON(data []byte) error {
 	} else {
 		v.QueryOptions.Expand = u.ExpandChains
 	}
-	v.QueryOptions.Height = u.Height
+	if u.Height != 0 {
+		v.QueryOptions.Height = u.Height
+	} else {
+		v.QueryOptions.Height = u.Block
+	}
 	v.QueryOptions.Prove = u.Prove
 	return nil
 }
@@ -989,6 +1463,29 @@ func (v *KeyPageIndexQuery) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (v *KeyQuery) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Key     *string `json:"key,omitempty"`
+		KeyHash *string `json:"keyHash,omitempty"`
+	}{}
+	u.Key = encoding.BytesToJSON(v.Key)
+	u.KeyHash = encoding.BytesToJSON(v.KeyHash)
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	if x, err := encoding.BytesFromJSON(u.Key); err != nil {
+		return fmt.Errorf("error decoding Key: %w", err)
+	} else {
+		v.Key = x
+	}
+	if x, err := encoding.BytesFromJSON(u.KeyHash); err != nil {
+		return fmt.Errorf("error decoding KeyHash: %w", err)
+	} else {
+		v.KeyHash = x
+	}
+	return nil
+}
+
 func (v *MerkleState) UnmarshalJSON(data []byte) error {
 	u := struct {
 		Height uint64    `json:"height,omitempty"`
@@ -1102,16 +1599,55 @@ func (v *MultiResponse) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (v *OracleQuery) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Start  uint64 `json:"start,omitempty"`
+		Count  uint64 `json:"count,omitempty"`
+		Subnet string `json:"subnet,omitempty"`
+	}{}
+	u.Start = v.QueryPagination.Start
+	u.Count = v.QueryPagination.Count
+	u.Subnet = v.Subnet
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.QueryPagination.Start = u.Start
+	v.QueryPagination.Count = u.Count
+	v.Subnet = u.Subnet
+	return nil
+}
+
+func (v *ProofQuery) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Url  *url.URL `json:"url,omitempty"`
+		Txid *string  `json:"txid,omitempty"`
+	}{}
+	u.Url = v.Url
+	u.Txid = encoding.BytesToJSON(v.Txid)
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Url = u.Url
+	if x, err := encoding.BytesFromJSON(u.Txid); err != nil {
+		return fmt.Errorf("error decoding Txid: %w", err)
+	} else {
+		v.Txid = x
+	}
+	return nil
+}
+
 func (v *QueryOptions) UnmarshalJSON(data []byte) error {
 	u := struct {
 		Expand       bool   `json:"expand,omitempty"`
 		ExpandChains bool   `json:"expandChains,omitempty"`
 		Height       uint64 `json:"height,omitempty"`
+		Block        uint64 `json:"block,omitempty"`
 		Prove        bool   `json:"prove,omitempty"`
 	}{}
 	u.Expand = v.Expand
 	u.ExpandChains = v.Expand
 	u.Height = v.Height
+	u.Block = v.Height
 	u.Prove = v.Prove
 	if err := json.Unmarshal(data, &u); err != nil {
 		return err
@@ -1121,18 +1657,53 @@ func (v *QueryOptions) UnmarshalJSON(data []byte) error {
 	} else {
 		v.Expand = u.ExpandChains
 	}
-	v.Height = u.Height
+	if u.Height != 0 {
+		v.Height = u.Height
+	} else {
+		v.Height = u.Block
+	}
 	v.Prove = u.Prove
 	return nil
 }
 
+func (v *SignPendingRequest) UnmarshalJSON(data []byte) error {
+	u := struct {
+		CheckOnly bool    `json:"checkOnly,omitempty"`
+		Txid      *string `json:"txid,omitempty"`
+		Signer    Signer  `json:"signer,omitempty"`
+		Signature *string `json:"signature,omitempty"`
+	}{}
+	u.CheckOnly = v.CheckOnly
+	u.Txid = encoding.BytesToJSON(v.Txid)
+	u.Signer = v.Signer
+	u.Signature = encoding.BytesToJSON(v.Signature)
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.CheckOnly = u.CheckOnly
+	if x, err := encoding.BytesFromJSON(u.Txid); err != nil {
+		return fmt.Errorf("error decoding Txid: %w", err)
+	} else {
+		v.Txid = x
+	}
+	v.Signer = u.Signer
+	if x, err := encoding.BytesFromJSON(u.Signature); err != nil {
+		return fmt.Errorf("error decoding Signature: %w", err)
+	} else {
+		v.Signature = x
+	}
+	return nil
+}
+
 func (v *Signer) UnmarshalJSON(data []byte) error {
 	u := struct {
-		PublicKey *string `json:"publicKey,omitempty"`
-		Nonce     uint64  `json:"nonce,omitempty"`
+		PublicKey     *string                `json:"publicKey,omitempty"`
+		Nonce         uint64                 `json:"nonce,omitempty"`
+		SignatureType protocol.SignatureType `json:"signatureType,omitempty"`
 	}{}
 	u.PublicKey = encoding.BytesToJSON(v.PublicKey)
 	u.Nonce = v.Nonce
+	u.SignatureType = v.SignatureType
 	if err := json.Unmarshal(data, &u); err != nil {
 		return err
 	}
@@ -1142,6 +1713,51 @@ func (v *Signer) UnmarshalJSON(data []byte) error {
 		v.PublicKey = x
 	}
 	v.Nonce = u.Nonce
+	v.SignatureType = u.SignatureType
+	return nil
+}
+
+func (v *SimulateResponse) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Fee       uint64                      `json:"fee,omitempty"`
+		Pending   bool                        `json:"pending,omitempty"`
+		Result    interface{}                 `json:"result,omitempty"`
+		Changes   []*AccountChange            `json:"changes,omitempty"`
+		Synthetic []*TransactionQueryResponse `json:"synthetic,omitempty"`
+	}{}
+	u.Fee = v.Fee
+	u.Pending = v.Pending
+	u.Result = encoding.AnyToJSON(v.Result)
+	u.Changes = v.Changes
+	u.Synthetic = v.Synthetic
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Fee = u.Fee
+	v.Pending = u.Pending
+	if x, err := encoding.AnyFromJSON(u.Result); err != nil {
+		return fmt.Errorf("error decoding Result: %w", err)
+	} else {
+		v.Result = x
+	}
+	v.Changes = u.Changes
+	v.Synthetic = u.Synthetic
+	return nil
+}
+
+func (v *SubscribeTransactionRequest) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Txid *string `json:"txid,omitempty"`
+	}{}
+	u.Txid = encoding.BytesToJSON(v.Txid)
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	if x, err := encoding.BytesFromJSON(u.Txid); err != nil {
+		return fmt.Errorf("error decoding Txid: %w", err)
+	} else {
+		v.Txid = x
+	}
 	return nil
 }
 
@@ -1171,6 +1787,89 @@ func (v *TokenDeposit) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (v *TokenSupply) UnmarshalJSON(data []byte) error {
+	u := struct {
+		HasCirculating bool    `json:"hasCirculating,omitempty"`
+		Circulating    *string `json:"circulating,omitempty"`
+		HasLimit       bool    `json:"hasLimit,omitempty"`
+		Limit          *string `json:"limit,omitempty"`
+		Remaining      *string `json:"remaining,omitempty"`
+	}{}
+	u.HasCirculating = v.HasCirculating
+	u.Circulating = encoding.BigintToJSON(&v.Circulating)
+	u.HasLimit = v.HasLimit
+	u.Limit = encoding.BigintToJSON(&v.Limit)
+	u.Remaining = encoding.BigintToJSON(&v.Remaining)
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.HasCirculating = u.HasCirculating
+	if x, err := encoding.BigintFromJSON(u.Circulating); err != nil {
+		return fmt.Errorf("error decoding Circulating: %w", err)
+	} else {
+		v.Circulating = *x
+	}
+	v.HasLimit = u.HasLimit
+	if x, err := encoding.BigintFromJSON(u.Limit); err != nil {
+		return fmt.Errorf("error decoding Limit: %w", err)
+	} else {
+		v.Limit = *x
+	}
+	if x, err := encoding.BigintFromJSON(u.Remaining); err != nil {
+		return fmt.Errorf("error decoding Remaining: %w", err)
+	} else {
+		v.Remaining = *x
+	}
+	return nil
+}
+
+func (v *TransactionNotification) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Subscription   uint64                      `json:"subscription,omitempty"`
+		Txid           *string                     `json:"txid,omitempty"`
+		Origin         *url.URL                    `json:"origin,omitempty"`
+		Type           string                      `json:"type,omitempty"`
+		Status         *protocol.TransactionStatus `json:"status,omitempty"`
+		SyntheticTxids []string                    `json:"syntheticTxids,omitempty"`
+		Accounts       []*url.URL                  `json:"accounts,omitempty"`
+		Done           bool                        `json:"done,omitempty"`
+	}{}
+	u.Subscription = v.Subscription
+	u.Txid = encoding.BytesToJSON(v.Txid)
+	u.Origin = v.Origin
+	u.Type = v.Type
+	u.Status = v.Status
+	u.SyntheticTxids = make([]string, len(v.SyntheticTxids))
+	for i, x := range v.SyntheticTxids {
+		u.SyntheticTxids[i] = encoding.ChainToJSON(x)
+	}
+	u.Accounts = v.Accounts
+	u.Done = v.Done
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Subscription = u.Subscription
+	if x, err := encoding.BytesFromJSON(u.Txid); err != nil {
+		return fmt.Errorf("error decoding Txid: %w", err)
+	} else {
+		v.Txid = x
+	}
+	v.Origin = u.Origin
+	v.Type = u.Type
+	v.Status = u.Status
+	v.SyntheticTxids = make([][32]byte, len(u.SyntheticTxids))
+	for i, x := range u.SyntheticTxids {
+		if x, err := encoding.ChainFromJSON(x); err != nil {
+			return fmt.Errorf("error decoding SyntheticTxids: %w", err)
+		} else {
+			v.SyntheticTxids[i] = x
+		}
+	}
+	v.Accounts = u.Accounts
+	v.Done = u.Done
+	return nil
+}
+
 func (v *TransactionQueryResponse) UnmarshalJSON(data []byte) error {
 	u := struct {
 		Type               string                      `json:"type,omitempty"`
@@ -1410,6 +2109,7 @@ func (v *TxnQuery) UnmarshalJSON(data []byte) error {
 		Expand       bool        `json:"expand,omitempty"`
 		ExpandChains bool        `json:"expandChains,omitempty"`
 		Height       uint64      `json:"height,omitempty"`
+		Block        uint64      `json:"block,omitempty"`
 		Prove        bool        `json:"prove,omitempty"`
 		Txid         *string     `json:"txid,omitempty"`
 		Wait         interface{} `json:"wait,omitempty"`
@@ -1417,6 +2117,7 @@ func (v *TxnQuery) UnmarshalJSON(data []byte) error {
 	u.Expand = v.QueryOptions.Expand
 	u.ExpandChains = v.QueryOptions.Expand
 	u.Height = v.QueryOptions.Height
+	u.Block = v.QueryOptions.Height
 	u.Prove = v.QueryOptions.Prove
 	u.Txid = encoding.BytesToJSON(v.Txid)
 	u.Wait = encoding.DurationToJSON(v.Wait)
@@ -1428,7 +2129,11 @@ func (v *TxnQuery) UnmarshalJSON(data []byte) error {
 	} else {
 		v.QueryOptions.Expand = u.ExpandChains
 	}
-	v.QueryOptions.Height = u.Height
+	if u.Height != 0 {
+		v.QueryOptions.Height = u.Height
+	} else {
+		v.QueryOptions.Height = u.Block
+	}
 	v.QueryOptions.Prove = u.Prove
 	if x, err := encoding.BytesFromJSON(u.Txid); err != nil {
 		return fmt.Errorf("error decoding Txid: %w", err)
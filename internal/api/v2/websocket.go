@@ -0,0 +1,463 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/AccumulateNetwork/jsonrpc2/v15"
+	"github.com/gorilla/websocket"
+	"gitlab.com/accumulatenetwork/accumulate/internal/events"
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
+)
+
+// MethodSubscription is the method of the notifications sent to subscribers.
+const MethodSubscription = "subscription"
+
+// wsSendQueueSize is the number of messages that can be queued for a
+// WebSocket connection. A connection that falls further behind is closed.
+const wsSendQueueSize = 256
+
+var wsUpgrader = websocket.Upgrader{
+	// The API does not use cookies, so there is nothing to protect from
+	// cross-site requests
+	CheckOrigin: func(*http.Request) bool { return true },
+}
+
+type wsMethodFunc func(c *wsConn, params json.RawMessage) interface{}
+
+// wsMethods are the methods of the WebSocket endpoint.
+var wsMethods = map[string]wsMethodFunc{
+	"subscribe-tx":      (*wsConn).subscribeTx,
+	"subscribe-account": (*wsConn).subscribeAccount,
+	"subscribe-blocks":  (*wsConn).subscribeBlocks,
+	"unsubscribe":       (*wsConn).unsubscribe,
+}
+
+// wsConn is a WebSocket connection and its subscriptions.
+type wsConn struct {
+	m      *JrpcMethods
+	conn   *websocket.Conn
+	ctx    context.Context
+	cancel context.CancelFunc
+	send   chan interface{}
+
+	mu     sync.Mutex
+	nextId uint64
+	subs   map[uint64]func()
+}
+
+// serveWebSocket serves JSON-RPC over WebSocket. The methods subscribe to
+// events of the local subnet, and notifications are sent as JSON-RPC
+// notifications with the subscription method.
+func (m *JrpcMethods) serveWebSocket(res http.ResponseWriter, req *http.Request) {
+	conn, err := wsUpgrader.Upgrade(res, req, nil)
+	if err != nil {
+		m.logDebug("WebSocket upgrade failed", "error", err)
+		return
+	}
+
+	c := new(wsConn)
+	c.m = m
+	c.conn = conn
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+	c.send = make(chan interface{}, wsSendQueueSize)
+	c.subs = map[uint64]func(){}
+
+	go c.writeLoop()
+	c.readLoop()
+}
+
+func (c *wsConn) close() {
+	c.cancel()
+	_ = c.conn.Close()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for id, unsubscribe := range c.subs {
+		unsubscribe()
+		delete(c.subs, id)
+	}
+}
+
+func (c *wsConn) readLoop() {
+	defer c.close()
+
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				c.m.logDebug("WebSocket read failed", "error", err)
+			}
+			return
+		}
+
+		var req jsonrpc2.Request
+		err = json.Unmarshal(data, &req)
+		if err != nil {
+			c.queue(jsonrpc2.Response{Error: jsonrpc2.NewError(jsonrpc2.ErrorCodeInvalidRequest, "Invalid Request", err.Error())})
+			continue
+		}
+
+		method, ok := wsMethods[req.Method]
+		if !ok {
+			c.queue(jsonrpc2.Response{ID: req.ID, Error: jsonrpc2.NewError(jsonrpc2.ErrorCodeMethodNotFound, "Method not found", req.Method)})
+			continue
+		}
+
+		params, _ := req.Params.(json.RawMessage)
+		r := method(c, params)
+		if id, _ := req.ID.(json.RawMessage); len(id) == 0 {
+			// Notification
+			continue
+		}
+
+		switch r := r.(type) {
+		case jsonrpc2.Error:
+			c.queue(jsonrpc2.Response{ID: req.ID, Error: r})
+		case error:
+			c.queue(jsonrpc2.Response{ID: req.ID, Error: jsonrpc2.NewError(ErrCodeInternal, "Internal Error", r.Error())})
+		default:
+			c.queue(jsonrpc2.Response{ID: req.ID, Result: r})
+		}
+	}
+}
+
+func (c *wsConn) writeLoop() {
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case msg := <-c.send:
+			err := c.conn.WriteJSON(msg)
+			if err != nil {
+				c.m.logDebug("WebSocket write failed", "error", err)
+				c.close()
+				return
+			}
+		}
+	}
+}
+
+// queue queues a message. If the connection is too far behind, queue closes
+// it instead of blocking the caller, which may be the executor.
+func (c *wsConn) queue(msg interface{}) {
+	select {
+	case c.send <- msg:
+	case <-c.ctx.Done():
+	default:
+		c.m.logError("WebSocket client is too slow, closing the connection")
+		go c.close()
+	}
+}
+
+func (c *wsConn) notify(v interface{}) {
+	c.queue(jsonrpc2.Request{Method: MethodSubscription, Params: v})
+}
+
+// addSubscription registers a subscription and returns its ID. The
+// subscription is ended by calling the unsubscribe function returned by
+// subscribe, which is called with the ID.
+func (c *wsConn) addSubscription(subscribe func(id uint64) (unsubscribe func())) uint64 {
+	c.mu.Lock()
+	c.nextId++
+	id := c.nextId
+	c.mu.Unlock()
+
+	unsubscribe := subscribe(id)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.ctx.Err() != nil {
+		// The connection was closed
+		unsubscribe()
+		return id
+	}
+	c.subs[id] = unsubscribe
+	return id
+}
+
+func (c *wsConn) removeSubscription(id uint64) bool {
+	c.mu.Lock()
+	unsubscribe, ok := c.subs[id]
+	delete(c.subs, id)
+	c.mu.Unlock()
+
+	if ok {
+		unsubscribe()
+	}
+	return ok
+}
+
+func (c *wsConn) parse(params json.RawMessage, target interface{}) error {
+	if len(params) == 0 {
+		return nil
+	}
+	return c.m.parse(params, target)
+}
+
+func (c *wsConn) checkBus() error {
+	if c.m.EventBus == nil {
+		return validatorError(errors.New("subscriptions are not supported by this node"))
+	}
+	return nil
+}
+
+func (c *wsConn) unsubscribe(params json.RawMessage) interface{} {
+	req := new(SubscriptionRequest)
+	err := c.parse(params, req)
+	if err != nil {
+		return err
+	}
+
+	if !c.removeSubscription(req.Subscription) {
+		return validatorError(fmt.Errorf("subscription %d not found", req.Subscription))
+	}
+	return &SubscriptionResponse{Subscription: req.Subscription}
+}
+
+func (c *wsConn) subscribeBlocks(params json.RawMessage) interface{} {
+	err := c.checkBus()
+	if err != nil {
+		return err
+	}
+
+	id := c.addSubscription(func(id uint64) func() {
+		return c.m.EventBus.Subscribe(func(e events.Event) {
+			block, ok := e.(*events.DidCommitBlock)
+			if !ok {
+				return
+			}
+
+			n := new(BlockNotification)
+			n.Subscription = id
+			n.Height = uint64(block.Index)
+			n.Time = block.Time
+			n.RootAnchor = block.RootAnchor
+			n.Anchors = block.Anchors
+			c.notify(n)
+		})
+	})
+	return &SubscriptionResponse{Subscription: id}
+}
+
+func (c *wsConn) subscribeAccount(params json.RawMessage) interface{} {
+	err := c.checkBus()
+	if err != nil {
+		return err
+	}
+
+	req := new(SubscribeAccountRequest)
+	err = c.parse(params, req)
+	if err != nil {
+		return err
+	}
+	if req.Url == nil {
+		return validatorError(errors.New("missing URL"))
+	}
+
+	id := c.addSubscription(func(id uint64) func() {
+		return c.m.EventBus.Subscribe(func(e events.Event) {
+			txn, ok := e.(*events.DidDeliverTransaction)
+			if !ok || !touches(txn, req.Url) {
+				return
+			}
+
+			c.notify(newTransactionNotification(id, txn))
+		})
+	})
+	return &SubscriptionResponse{Subscription: id}
+}
+
+// touches returns true if the transaction was sent to or recorded by the
+// account.
+func touches(txn *events.DidDeliverTransaction, account *url.URL) bool {
+	if txn.Origin != nil && txn.Origin.Equal(account) {
+		return true
+	}
+	for _, u := range txn.Accounts {
+		if u.Equal(account) {
+			return true
+		}
+	}
+	return false
+}
+
+func newTransactionNotification(id uint64, txn *events.DidDeliverTransaction) *TransactionNotification {
+	n := new(TransactionNotification)
+	n.Subscription = id
+	n.Txid = txn.Txid[:]
+	n.Origin = txn.Origin
+	n.Type = txn.Type.String()
+	n.Status = txn.Status
+	n.Accounts = txn.Accounts
+	for _, synth := range txn.Produced {
+		n.SyntheticTxids = append(n.SyntheticTxids, synth.Txid)
+	}
+	return n
+}
+
+func (c *wsConn) subscribeTx(params json.RawMessage) interface{} {
+	err := c.checkBus()
+	if err != nil {
+		return err
+	}
+
+	req := new(SubscribeTransactionRequest)
+	err = c.parse(params, req)
+	if err != nil {
+		return err
+	}
+	if len(req.Txid) != 32 {
+		return validatorError(fmt.Errorf("invalid transaction ID: want 32 bytes, got %d", len(req.Txid)))
+	}
+
+	w := new(txWatch)
+	w.c = c
+	w.waiting = map[[32]byte]bool{}
+	var txid [32]byte
+	copy(txid[:], req.Txid)
+	w.waiting[txid] = true
+
+	// The ID is set before subscribing and never changes afterwards, since
+	// the subscriber reads it from the executor's goroutine
+	id := c.addSubscription(func(id uint64) func() {
+		w.id = id
+		return c.m.EventBus.Subscribe(w.didDeliver)
+	})
+
+	// The transaction may have been delivered before the subscription was
+	// created, so check its current status
+	go w.checkDelivered(txid)
+
+	return &SubscriptionResponse{Subscription: id}
+}
+
+// txWatch watches a transaction and its synthetic transactions until all of
+// them have been delivered.
+type txWatch struct {
+	c  *wsConn
+	id uint64
+
+	mu      sync.Mutex
+	waiting map[[32]byte]bool
+	done    bool
+}
+
+// resolve marks a transaction as delivered and starts waiting for the
+// synthetic transactions it produced. It returns false if the transaction is
+// not being waited on.
+func (w *txWatch) resolve(n *TransactionNotification, produced []events.SyntheticTransaction) bool {
+	var txid [32]byte
+	copy(txid[:], n.Txid)
+
+	w.mu.Lock()
+	if w.done || !w.waiting[txid] {
+		w.mu.Unlock()
+		return false
+	}
+
+	// Keep waiting on transactions that need more signatures
+	if n.Status == nil || !n.Status.Delivered {
+		w.mu.Unlock()
+		w.c.notify(n)
+		return true
+	}
+
+	delete(w.waiting, txid)
+	var remote []events.SyntheticTransaction
+	for _, synth := range produced {
+		w.waiting[synth.Txid] = true
+		remote = append(remote, synth)
+	}
+	w.done = len(w.waiting) == 0
+	n.Done = w.done
+	w.mu.Unlock()
+
+	w.c.notify(n)
+	if n.Done {
+		w.c.removeSubscription(w.id)
+		return true
+	}
+
+	// Synthetic transactions sent to other subnets are not delivered by the
+	// local executor, so query for them
+	for _, synth := range remote {
+		if synth.Origin == nil {
+			go w.checkDelivered(synth.Txid)
+			continue
+		}
+
+		subnet, err := w.c.m.Router.Route(synth.Origin)
+		if err != nil || subnet != w.c.m.Network.LocalSubnetID {
+			go w.checkDelivered(synth.Txid)
+		}
+	}
+	return true
+}
+
+func (w *txWatch) didDeliver(e events.Event) {
+	txn, ok := e.(*events.DidDeliverTransaction)
+	if !ok {
+		return
+	}
+
+	w.resolve(newTransactionNotification(w.id, txn), txn.Produced)
+}
+
+// checkDelivered queries for a transaction until it has been delivered or
+// the maximum wait time has passed.
+func (w *txWatch) checkDelivered(txid [32]byte) {
+	var q Querier = w.c.m.querier
+	deadline := time.Now().Add(w.c.m.TxMaxWaitTime)
+	for {
+		res, err := q.QueryTx(txid[:], 0, QueryOptions{})
+		switch {
+		case err == nil && res.Status != nil && res.Status.Remote && res.Origin != nil:
+			// The subnet that produced a synthetic transaction has a copy of
+			// it, so query the subnet it was sent to
+			subnet, err := w.c.m.Router.Route(res.Origin)
+			if err == nil {
+				q = w.c.m.querier.direct(subnet)
+			}
+
+		case err == nil && res.Status != nil && res.Status.Delivered && !res.Status.Remote:
+			n := new(TransactionNotification)
+			n.Subscription = w.id
+			n.Txid = txid[:]
+			n.Origin = res.Origin
+			n.Type = res.Type
+			n.Status = res.Status
+			n.SyntheticTxids = res.SyntheticTxids
+
+			var produced []events.SyntheticTransaction
+			for _, id := range res.SyntheticTxids {
+				produced = append(produced, events.SyntheticTransaction{Txid: id})
+			}
+			w.resolve(n, produced)
+			return
+
+		case err != nil && !errors.Is(err, storage.ErrNotFound):
+			w.c.m.logError("Failed to query a subscribed transaction", "txid", fmt.Sprintf("%X", txid), "error", err)
+			return
+		}
+
+		w.mu.Lock()
+		stop := w.done || !w.waiting[txid]
+		w.mu.Unlock()
+		if stop || time.Now().After(deadline) {
+			return
+		}
+
+		select {
+		case <-w.c.ctx.Done():
+			return
+		case <-time.After(time.Second / 2):
+		}
+	}
+}
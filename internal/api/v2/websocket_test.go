@@ -0,0 +1,227 @@
+package api_test
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AccumulateNetwork/jsonrpc2/v15"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	"gitlab.com/accumulatenetwork/accumulate/config"
+	"gitlab.com/accumulatenetwork/accumulate/internal/api/v2"
+	"gitlab.com/accumulatenetwork/accumulate/internal/events"
+	acctesting "gitlab.com/accumulatenetwork/accumulate/internal/testing"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+)
+
+type wsClient struct {
+	t      *testing.T
+	conn   *websocket.Conn
+	nextId int
+
+	// pending are notifications that have not been consumed
+	pending []map[string]json.RawMessage
+}
+
+func dialWebSocket(t *testing.T, japi *api.JrpcMethods) *wsClient {
+	server := httptest.NewServer(japi.NewMux())
+	t.Cleanup(server.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/ws", nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return &wsClient{t: t, conn: conn}
+}
+
+// read reads the next message, which is either a response or a notification.
+func (c *wsClient) read() map[string]json.RawMessage {
+	c.t.Helper()
+	require.NoError(c.t, c.conn.SetReadDeadline(time.Now().Add(20*time.Second)))
+
+	var msg map[string]json.RawMessage
+	require.NoError(c.t, c.conn.ReadJSON(&msg))
+	return msg
+}
+
+// subscribe sends a request and returns the subscription ID.
+func (c *wsClient) subscribe(method string, params interface{}) uint64 {
+	c.t.Helper()
+	c.send(method, params)
+	return c.result()
+}
+
+// send sends a request without waiting for the response.
+func (c *wsClient) send(method string, params interface{}) {
+	c.t.Helper()
+
+	c.nextId++
+	require.NoError(c.t, c.conn.WriteJSON(jsonrpc2.Request{ID: c.nextId, Method: method, Params: params}))
+}
+
+// result returns the subscription ID of the next response. Responses are sent
+// in the order of the requests.
+func (c *wsClient) result() uint64 {
+	c.t.Helper()
+
+	for {
+		msg := c.read()
+		if _, ok := msg["method"]; ok {
+			c.pending = append(c.pending, msg)
+			continue
+		}
+
+		require.Nil(c.t, msg["error"], "%s", msg["error"])
+		res := new(api.SubscriptionResponse)
+		require.NoError(c.t, json.Unmarshal(msg["result"], res))
+		return res.Subscription
+	}
+}
+
+// notification returns the params of the next notification of the
+// subscription.
+func (c *wsClient) notification(id uint64, v interface{}) {
+	c.t.Helper()
+
+	for i, msg := range c.pending {
+		if c.isNotification(msg, id) {
+			c.pending = append(c.pending[:i], c.pending[i+1:]...)
+			require.NoError(c.t, json.Unmarshal(msg["params"], v))
+			return
+		}
+	}
+
+	for {
+		msg := c.read()
+		if c.isNotification(msg, id) {
+			require.NoError(c.t, json.Unmarshal(msg["params"], v))
+			return
+		}
+		c.pending = append(c.pending, msg)
+	}
+}
+
+func (c *wsClient) isNotification(msg map[string]json.RawMessage, id uint64) bool {
+	var method string
+	if json.Unmarshal(msg["method"], &method) != nil || method != api.MethodSubscription {
+		return false
+	}
+
+	var sub struct{ Subscription uint64 }
+	require.NoError(c.t, json.Unmarshal(msg["params"], &sub))
+	return sub.Subscription == id
+}
+
+func TestWebSocketSubscriptions(t *testing.T) {
+	acctesting.SkipPlatform(t, "windows", "flaky")
+	acctesting.SkipPlatformCI(t, "darwin", "requires setting up localhost aliases")
+
+	subnets, daemons := acctesting.CreateTestNet(t, 1, 1, 0)
+	acctesting.RunTestNet(t, subnets, daemons)
+	japi := daemons[subnets[1]][0].Jrpc_TESTONLY()
+	client := dialWebSocket(t, japi)
+
+	liteKey := newKey([]byte(t.Name()))
+	liteUrl := makeLiteUrl(t, liteKey, protocol.ACME)
+
+	blocks := client.subscribe("subscribe-blocks", nil)
+	account := client.subscribe("subscribe-account", &api.SubscribeAccountRequest{Url: liteUrl})
+
+	xr := new(api.TxResponse)
+	callApi(t, japi, "faucet", &protocol.AcmeFaucet{Url: liteUrl}, xr)
+	require.Zero(t, xr.Code, xr.Message)
+	txid := client.subscribe("subscribe-tx", &api.SubscribeTransactionRequest{Txid: xr.TransactionHash})
+
+	t.Run("Transaction", func(t *testing.T) {
+		var n api.TransactionNotification
+		for !n.Done {
+			n = api.TransactionNotification{}
+			client.notification(txid, &n)
+			require.NotNil(t, n.Status)
+			require.Zero(t, n.Status.Code, n.Status.Message)
+		}
+	})
+
+	t.Run("Account", func(t *testing.T) {
+		n := new(api.TransactionNotification)
+		client.notification(account, n)
+		require.Equal(t, protocol.TransactionTypeSyntheticDepositTokens.String(), n.Type)
+		require.True(t, n.Origin.Equal(liteUrl))
+	})
+
+	t.Run("Block", func(t *testing.T) {
+		n := new(api.BlockNotification)
+		client.notification(blocks, n)
+		require.NotZero(t, n.Height)
+		require.NotEmpty(t, n.RootAnchor)
+	})
+
+	t.Run("Unsubscribe", func(t *testing.T) {
+		require.Equal(t, blocks, client.subscribe("unsubscribe", &api.SubscriptionRequest{Subscription: blocks}))
+	})
+}
+
+func TestWebSocketConcurrentSubscriptions(t *testing.T) {
+	// Every query reports that the transaction has not been found, so the
+	// subscriptions are resolved by the events alone
+	router := NewMockRouter(gomock.NewController(t))
+	router.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&coretypes.ResultABCIQuery{Response: abcitypes.ResponseQuery{Code: uint32(protocol.ErrorCodeNotFound)}}, nil).
+		AnyTimes()
+
+	bus := events.NewBus()
+	japi, err := api.NewJrpc(api.Options{
+		Network:       &config.Network{LocalSubnetID: "BVN0", Subnets: []config.Subnet{{ID: "BVN0"}}},
+		Router:        router,
+		TxMaxWaitTime: time.Second,
+		EventBus:      bus,
+	})
+	require.NoError(t, err)
+	client := dialWebSocket(t, japi)
+
+	// Deliver the transactions while they are being subscribed to, as the
+	// executor would. Run with -race to check the subscriptions for data
+	// races.
+	var txids [][32]byte
+	for i := 0; i < 10; i++ {
+		txids = append(txids, sha256.Sum256([]byte(fmt.Sprint(i))))
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		for {
+			for _, txid := range txids {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				bus.Publish(&events.DidDeliverTransaction{Txid: txid, Status: &protocol.TransactionStatus{Delivered: true}})
+			}
+		}
+	}()
+
+	for _, txid := range txids {
+		client.send("subscribe-tx", &api.SubscribeTransactionRequest{Txid: txid[:]})
+	}
+	var subs []uint64
+	for range txids {
+		subs = append(subs, client.result())
+	}
+
+	// Each subscription is notified of its own transaction
+	for i, sub := range subs {
+		n := new(api.TransactionNotification)
+		client.notification(sub, n)
+		require.Equal(t, sub, n.Subscription)
+		require.Equal(t, txids[i][:], n.Txid)
+		require.True(t, n.Done)
+	}
+}
@@ -1,16 +1,25 @@
 package chain
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
+	"gitlab.com/accumulatenetwork/accumulate/internal/indexing"
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
 	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
 	"gitlab.com/accumulatenetwork/accumulate/types"
 	"gitlab.com/accumulatenetwork/accumulate/types/api/transactions"
 )
 
+// AcmeFaucet deposits ACME into a lite token account, subject to the faucet
+// policy of the network globals. The faucet keeps its accounting in an index of
+// the faucet account: a ledger of the current block and day, and the time of
+// the last deposit to each recipient that is still cooling down. Snapshots
+// include the ledger, since it decides whether a request succeeds.
 type AcmeFaucet struct{}
 
 func (AcmeFaucet) Type() types.TxType { return types.TxTypeAcmeFaucet }
@@ -61,19 +70,136 @@ func (AcmeFaucet) Validate(st *StateManager, tx *transactions.Envelope) (protoco
 		return nil, fmt.Errorf("failed to load faucet: %v", err)
 	}
 
-	// Attach this TX to the faucet (don't bother debiting)
+	// Check and update the faucet's accounting
+	amount, err := checkFaucetLimits(st, u)
+	if err != nil {
+		return nil, err
+	}
+
+	// Debit the faucet
+	if faucet.Balance.Cmp(amount) < 0 {
+		return nil, faucetError("the faucet is empty")
+	}
+	faucet.Balance.Sub(&faucet.Balance, amount)
 	st.Update(faucet)
 
 	// Submit a synthetic deposit token TX
-	amount := new(big.Int).SetUint64(protocol.AcmeFaucetAmount * protocol.AcmePrecision)
 	deposit := new(protocol.SyntheticDepositTokens)
 	copy(deposit.Cause[:], tx.GetTxHash())
 	deposit.Token = protocol.AcmeUrl()
 	deposit.Amount = *amount
 	st.Submit(u, deposit)
 
-	// deposit := synthetic.NewTokenTransactionDeposit(txid[:], types.String(protocol.FaucetUrl.String()), types.String(u.String()))
-	// err = deposit.SetDeposit(protocol.ACME, amount)
-
 	return nil, nil
 }
+
+// faucetLedgerKey is the key of the faucet's accounting within the indices of
+// the faucet account.
+var faucetLedgerKey = []interface{}{"Faucet", "Ledger"}
+
+// checkFaucetLimits enforces the faucet policy of the active network globals
+// for a deposit to the recipient and records the deposit. checkFaucetLimits
+// returns the amount to deposit.
+func checkFaucetLimits(st *StateManager, recipient *url.URL) (*big.Int, error) {
+	// Days and cooldowns are measured in block time
+	ledgerState := protocol.NewInternalLedger()
+	err := st.LoadUrlAs(st.nodeUrl.JoinPath(protocol.Ledger), ledgerState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the ledger: %v", err)
+	}
+
+	policy := protocol.DefaultFaucetPolicy()
+	if ledgerState.ActiveGlobals != nil && ledgerState.ActiveGlobals.Faucet != nil {
+		policy = ledgerState.ActiveGlobals.Faucet
+	}
+	amount := new(big.Int).SetUint64(policy.Amount * protocol.AcmePrecision)
+	now := ledgerState.Timestamp
+	day := uint64(now.Unix() / (24 * 60 * 60))
+
+	// Load the faucet's accounting, resetting the counters of a previous block
+	// or day
+	ledgerIndex := st.RecordIndex(protocol.FaucetUrl, faucetLedgerKey...)
+	ledger := new(indexing.FaucetLedger)
+	err = loadIndex(ledgerIndex, ledger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the faucet ledger: %v", err)
+	}
+	if ledger.Block != uint64(ledgerState.Index) {
+		ledger.Block = uint64(ledgerState.Index)
+		ledger.BlockRequests = 0
+	}
+	if ledger.Day != day {
+		ledger.Day = day
+		ledger.DayTotal.SetUint64(0)
+	}
+
+	// Forget recipients whose cooldown has passed, so the ledger does not grow
+	// without bound
+	var last *indexing.FaucetRecipient
+	recipients := ledger.Recipients
+	ledger.Recipients = make([]*indexing.FaucetRecipient, 0, len(recipients)+1)
+	for _, r := range recipients {
+		if !now.Before(r.LastDeposit.Add(policy.Cooldown)) {
+			continue
+		}
+		if r.Recipient.Equal(recipient) {
+			last = r
+		}
+		ledger.Recipients = append(ledger.Recipients, r)
+	}
+
+	// Enforce the policy
+	if policy.BlockLimit != 0 && ledger.BlockRequests >= policy.BlockLimit {
+		return nil, faucetError("the faucet has reached its limit of %d requests per block", policy.BlockLimit)
+	}
+
+	dayTotal := new(big.Int).Add(&ledger.DayTotal, amount)
+	if policy.DailyLimit != 0 && dayTotal.Cmp(new(big.Int).SetUint64(policy.DailyLimit*protocol.AcmePrecision)) > 0 {
+		return nil, faucetError("the faucet has reached its daily limit of %d ACME", policy.DailyLimit)
+	}
+
+	if last != nil {
+		next := last.LastDeposit.Add(policy.Cooldown)
+		return nil, faucetError("%v must wait %v before requesting from the faucet again", recipient, next.Sub(now).Round(time.Second))
+	}
+
+	// Record the deposit
+	ledger.BlockRequests++
+	ledger.DayTotal = *dayTotal
+	if policy.Cooldown != 0 {
+		ledger.Recipients = append(ledger.Recipients, &indexing.FaucetRecipient{Recipient: recipient, LastDeposit: now})
+	}
+
+	err = storeIndex(ledgerIndex, ledger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store the faucet ledger: %v", err)
+	}
+
+	return amount, nil
+}
+
+func faucetError(format string, args ...interface{}) error {
+	return &protocol.Error{Code: protocol.ErrorCodeFaucetLimit, Message: fmt.Errorf(format, args...)}
+}
+
+func loadIndex(index *writeIndex, v encoding.BinaryUnmarshaler) error {
+	data, err := index.Get()
+	switch {
+	case err == nil:
+		return v.UnmarshalBinary(data)
+	case errors.Is(err, storage.ErrNotFound):
+		return nil
+	default:
+		return err
+	}
+}
+
+func storeIndex(index *writeIndex, v encoding.BinaryMarshaler) error {
+	data, err := v.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	index.Put(data)
+	return nil
+}
@@ -3,6 +3,7 @@ package chain
 import (
 	"errors"
 	"fmt"
+	"math/big"
 
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
 	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
@@ -26,29 +27,35 @@ func (AddCredits) Validate(st *StateManager, tx *transactions.Envelope) (protoco
 		return nil, err
 	}
 
-	if ledgerState.ActiveOracle == 0 {
-		return nil, fmt.Errorf("cannot purchase credits: acme oracle price has not been set")
-	}
-
-	// tokens = credits / (credits to dollars) / (dollars per token)
-	amount := types.NewAmount(protocol.AcmePrecision) // Do everything with ACME precision
-
-	// credits wanted
-	amount.Mul(int64(body.Amount)) // Amount in credits
-	amount.Div(protocol.CreditsPerFiatUnit)
+	// Credits can be bought either by specifying the number of credits, which
+	// costs the ACME rounded up, or by specifying the ACME to spend, which buys
+	// the credits rounded down
+	var amount *big.Int
+	var credits uint64
+	switch {
+	case body.Amount != 0 && body.AcmeAmount.Sign() != 0:
+		return nil, fmt.Errorf("cannot specify both an amount of credits and an amount of ACME")
+
+	case body.Amount != 0:
+		credits = body.Amount
+		amount, err = protocol.CreditsToAcme(credits, ledgerState.ActiveOracle)
+		if err != nil {
+			return nil, fmt.Errorf("cannot purchase credits: %v", err)
+		}
 
-	//dollars / token
-	amount.Mul(protocol.AcmeOraclePrecision)
-	amount.Div(int64(ledgerState.ActiveOracle)) // Amount in acme
+	case body.AcmeAmount.Sign() > 0:
+		amount = &body.AcmeAmount
+		credits, err = protocol.AcmeToCredits(amount, ledgerState.ActiveOracle)
+		if err != nil {
+			return nil, fmt.Errorf("cannot purchase credits: %v", err)
+		}
+		if credits == 0 {
+			return nil, fmt.Errorf("cannot purchase credits: %v ACME units is not enough to buy any credits", amount)
+		}
 
-	// TODO: convert credit purchase from buying exact number of credits to
-	// specifying amount of acme to spend. body.Amount needs to be converted to bigint
-	// If specifying amount of acme to spend, do this instead of amount calculation above:
-	//credits := types.NewAmount(protocol.CreditsPerFiatUnit) // want to obtain credits
-	//credits.Mul(int64(ledgerState.ActiveOracle))            // fiat units / acme
-	//credits.Mul(body.Amount)                                // acme the user wants to spend
-	//credits.Div(protocol.AcmeOraclePrecision)               // adjust the precision of oracle to real units
-	//credits.Div(protocol.AcmePrecision)                     // adjust the precision of acme to spend to real units
+	default:
+		return nil, fmt.Errorf("must specify a positive amount of credits or ACME")
+	}
 
 	recv, err := st.LoadUrl(body.Recipient)
 	if err == nil {
@@ -92,11 +99,11 @@ func (AddCredits) Validate(st *StateManager, tx *transactions.Envelope) (protoco
 		return nil, fmt.Errorf("%q tokens cannot be converted into credits", tokenUrl.String())
 	}
 
-	if !account.CanDebitTokens(&amount.Int) {
-		return nil, fmt.Errorf("insufficient balance: have %v, want %v", account.TokenBalance(), &amount.Int)
+	if !account.CanDebitTokens(amount) {
+		return nil, fmt.Errorf("insufficient balance: have %v, want %v", account.TokenBalance(), amount)
 	}
 
-	if !account.DebitTokens(&amount.Int) {
+	if !account.DebitTokens(amount) {
 		return nil, fmt.Errorf("failed to debit %v", tx.Transaction.Origin)
 	}
 	st.Update(account)
@@ -104,14 +111,18 @@ func (AddCredits) Validate(st *StateManager, tx *transactions.Envelope) (protoco
 	// Create the synthetic transaction
 	sdc := new(protocol.SyntheticDepositCredits)
 	copy(sdc.Cause[:], tx.GetTxHash())
-	sdc.Amount = body.Amount
+	sdc.Amount = credits
 	st.Submit(body.Recipient, sdc)
 
 	//Create synthetic burn token
 	burnAcme := new(protocol.SyntheticBurnTokens)
 	copy(sdc.Cause[:], tx.GetTxHash())
-	burnAcme.Amount = amount.Int
+	burnAcme.Amount = *amount
 	st.Submit(tokenUrl, burnAcme)
 
-	return nil, nil
+	res := new(protocol.AddCreditsResult)
+	res.Amount = *amount
+	res.Credits = credits
+	res.Oracle = ledgerState.ActiveOracle
+	return res, nil
 }
@@ -0,0 +1,247 @@
+package chain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
+	"gitlab.com/accumulatenetwork/accumulate/types/api/transactions"
+	"gitlab.com/accumulatenetwork/accumulate/types/state"
+)
+
+// Batch executes the operations of a batch in order, against the state
+// manager of the batch. Since the state manager is only committed if every
+// operation succeeds, either all of the operations take effect or none do.
+type Batch struct {
+	executors map[protocol.TransactionType]TxExecutor
+}
+
+func (Batch) Type() protocol.TransactionType { return protocol.TransactionTypeBatch }
+
+func (x Batch) Validate(st *StateManager, tx *transactions.Envelope) (protocol.TransactionResult, error) {
+	body, ok := tx.Transaction.Body.(*protocol.Batch)
+	if !ok {
+		return nil, fmt.Errorf("invalid payload: want %T, got %T", new(protocol.Batch), tx.Transaction.Body)
+	}
+
+	if len(body.Operations) == 0 {
+		return nil, fmt.Errorf("batch has no operations")
+	}
+
+	// Restore the origin of the batch once the operations are done
+	origin, originUrl, originChainId := st.Origin, st.OriginUrl, st.OriginChainId
+	defer func() {
+		st.Origin, st.OriginUrl, st.OriginChainId = origin, originUrl, originChainId
+	}()
+
+	// Accounts created by the operations of the batch
+	created := map[[32]byte]bool{}
+
+	result := new(protocol.BatchResult)
+	for i, op := range body.Operations {
+		if op.Body == nil {
+			return nil, fmt.Errorf("operation %d: missing body", i)
+		}
+
+		typ := op.Body.GetType()
+		if !typ.IsUser() || typ == protocol.TransactionTypeBatch {
+			return nil, fmt.Errorf("operation %d: %v cannot be part of a batch", i, typ)
+		}
+
+		executor, ok := x.executors[typ]
+		if !ok {
+			return nil, fmt.Errorf("operation %d: unsupported TX type: %v", i, typ)
+		}
+
+		// Each operation sees the changes made by the operations before it
+		opUrl := originUrl
+		if op.Origin != nil {
+			opUrl = op.Origin
+		}
+		var err error
+		st.Origin, err = st.LoadUrl(opUrl)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d: invalid origin record: %q %w", i, opUrl, err)
+		}
+		st.OriginUrl = opUrl
+		copy(st.OriginChainId[:], opUrl.AccountID())
+
+		if !opUrl.Equal(originUrl) {
+			err = authorizeBatchOperation(st, tx, op, st.Origin)
+			if err != nil {
+				return nil, fmt.Errorf("operation %d: %v", i, err)
+			}
+		}
+
+		// Execute the operation as if it were a transaction, with the hash of
+		// the batch
+		opEnv := new(transactions.Envelope)
+		opEnv.Signatures = tx.Signatures
+		opEnv.TxHash = tx.GetTxHash()
+		opEnv.Transaction = new(transactions.Transaction)
+		opEnv.Transaction.TransactionHeader = tx.Transaction.TransactionHeader
+		opEnv.Transaction.Origin = opUrl
+		opEnv.Transaction.Body = op.Body
+
+		opStart, submitStart := len(st.operations), len(st.submissions)
+		r, err := executor.Validate(st, opEnv)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d (%v): %v", i, typ, err)
+		}
+
+		for _, op := range st.operations[opStart:] {
+			create, ok := op.(*createRecords)
+			if !ok {
+				continue
+			}
+			for _, record := range create.records {
+				created[record.Header().Url.AccountID32()] = true
+			}
+		}
+
+		st.submissions, err = applyBatchDeposits(st, created, st.submissions, submitStart)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d (%v): %v", i, typ, err)
+		}
+
+		if r == nil {
+			r = new(protocol.EmptyResult)
+		}
+		result.Results = append(result.Results, r)
+	}
+
+	return result, nil
+}
+
+// applyBatchDeposits applies deposits into accounts created by the batch
+// directly, instead of submitting them. The accounts are created by a
+// synthetic transaction once the batch is committed, so a synthetic deposit
+// could arrive before the account exists. The created records are held by the
+// state manager until the batch is committed, so they are modified in place.
+func applyBatchDeposits(st *StateManager, created map[[32]byte]bool, submissions []*submission, start int) ([]*submission, error) {
+	kept := submissions[:start]
+	for _, sub := range submissions[start:] {
+		if !created[sub.Url.AccountID32()] {
+			kept = append(kept, sub)
+			continue
+		}
+
+		account, err := st.LoadUrl(sub.Url)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %q: %v", sub.Url, err)
+		}
+
+		switch body := sub.Body.(type) {
+		case *protocol.SyntheticDepositCredits:
+			account, ok := account.(creditChain)
+			if !ok {
+				return nil, fmt.Errorf("cannot deposit credits into %q: want account type %v or %v, got %v", sub.Url, protocol.AccountTypeLiteTokenAccount, protocol.AccountTypeKeyPage, account.GetType())
+			}
+			account.CreditCredits(body.Amount)
+
+		case *protocol.SyntheticDepositTokens:
+			account, ok := account.(tokenChain)
+			if !ok {
+				return nil, fmt.Errorf("cannot deposit tokens into %q: want account type %v or %v, got %v", sub.Url, protocol.AccountTypeLiteTokenAccount, protocol.AccountTypeTokenAccount, account.GetType())
+			}
+			if !account.CreditTokens(&body.Amount) {
+				return nil, fmt.Errorf("unable to add deposit balance to %q", sub.Url)
+			}
+
+		default:
+			return nil, fmt.Errorf("cannot send %v to %q, which is created by the batch", sub.Body.GetType(), sub.Url)
+		}
+	}
+	return kept, nil
+}
+
+// authorizeBatchOperation verifies that the signatures of the batch authorize
+// an operation whose origin is not the origin of the batch, using the page of
+// the origin's key book selected by the operation. The origin may have been
+// created by a previous operation of the batch. Signatures that were collected
+// while the batch was pending count towards the threshold. Each key counts
+// once, and signatures of keys that are not on the page are ignored, since they
+// may authorize the origin of the batch.
+func authorizeBatchOperation(st *StateManager, tx *transactions.Envelope, op *protocol.BatchOperation, origin state.Chain) error {
+	sigs, err := st.batch.Transaction(tx.GetTxHash()).GetSignatures()
+	switch {
+	case err == nil:
+		// Signatures of this envelope have already been added
+	case errors.Is(err, storage.ErrNotFound):
+		sigs = tx.Signatures
+	default:
+		return fmt.Errorf("failed to load signatures: %v", err)
+	}
+
+	if lite, ok := origin.(*protocol.LiteTokenAccount); ok {
+		urlKH, _, err := protocol.ParseLiteTokenAddress(lite.Url)
+		if err != nil {
+			return fmt.Errorf("invalid lite token URL: %v", err)
+		}
+
+		for _, sig := range sigs {
+			sigKH := sha256.Sum256(sig.GetPublicKey())
+			if bytes.Equal(urlKH, sigKH[:20]) {
+				return nil
+			}
+		}
+		return fmt.Errorf("no signature matches %q", lite.Url)
+	}
+
+	var bookUrl *url.URL
+	switch origin := origin.(type) {
+	case *protocol.KeyBook:
+		bookUrl = origin.Url
+	case *protocol.ADI, *protocol.TokenAccount, *protocol.KeyPage, *protocol.DataAccount, *protocol.TokenIssuer:
+		bookUrl = origin.Header().KeyBook
+	default:
+		return fmt.Errorf("account type %v cannot be the origininator of transactions", origin.GetType())
+	}
+	if bookUrl == nil {
+		return fmt.Errorf("%q has not been assigned to a key book", origin.Header().Url)
+	}
+
+	book := new(protocol.KeyBook)
+	err = st.LoadUrlAs(bookUrl, book)
+	if err != nil {
+		return fmt.Errorf("invalid key book %q: %v", bookUrl, err)
+	}
+	if op.KeyPageIndex >= uint64(len(book.Pages)) {
+		return fmt.Errorf("invalid sig spec index")
+	}
+
+	pageUrl := book.Pages[op.KeyPageIndex]
+	page := new(protocol.KeyPage)
+	err = st.LoadUrlAs(pageUrl, page)
+	if err != nil {
+		return fmt.Errorf("invalid key page %q: %v", pageUrl, err)
+	}
+	if page.Frozen {
+		return fmt.Errorf("key page %q is frozen", pageUrl)
+	}
+
+	height, err := st.GetHeight(pageUrl)
+	if err != nil {
+		return fmt.Errorf("failed to get the height of %q: %v", pageUrl, err)
+	}
+	if height != op.KeyPageHeight {
+		return fmt.Errorf("invalid height of %q: want %d, got %d", pageUrl, height, op.KeyPageHeight)
+	}
+
+	keys := map[*protocol.KeySpec]bool{}
+	for _, sig := range sigs {
+		ks := page.FindKeyOfType(sig.Type(), sig.GetPublicKey())
+		if ks != nil {
+			keys[ks] = true
+		}
+	}
+
+	if len(keys) < int(page.Threshold) {
+		return fmt.Errorf("%q requires %d signatures, got %d", pageUrl, page.Threshold, len(keys))
+	}
+	return nil
+}
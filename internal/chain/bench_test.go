@@ -18,6 +18,7 @@ import (
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
 	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
 	"gitlab.com/accumulatenetwork/accumulate/smt/storage/badger"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage/bolt"
 	"gitlab.com/accumulatenetwork/accumulate/smt/storage/memory"
 )
 
@@ -33,6 +34,11 @@ func BenchmarkExecuteSendTokens(b *testing.B) {
 			require.NoError(b, db.InitDB(filepath.Join(b.TempDir(), "valacc.db"), logger))
 			return db
 		}},
+		"Bolt": {NewStorage: func(logger log.Logger) storage.KeyValueStore {
+			db := new(bolt.DB)
+			require.NoError(b, db.InitDB(filepath.Join(b.TempDir(), "valacc.bolt"), logger))
+			return db
+		}},
 	}
 
 	for name, tc := range testCases {
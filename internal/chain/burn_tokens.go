@@ -1,9 +1,11 @@
 package chain
 
 import (
+	"errors"
 	"fmt"
 
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
 	"gitlab.com/accumulatenetwork/accumulate/types"
 	"gitlab.com/accumulatenetwork/accumulate/types/api/transactions"
 )
@@ -33,6 +35,21 @@ func (BurnTokens) Validate(st *StateManager, tx *transactions.Envelope) (protoco
 		return nil, fmt.Errorf("invalid token url: %v", err)
 	}
 
+	// A synthetic burn that fails does not return the tokens, so reject a burn
+	// the issuer would reject before debiting the account. An issuer on
+	// another subnet cannot be checked.
+	issuer := new(protocol.TokenIssuer)
+	err = st.LoadUrlAs(tokenUrl, issuer)
+	switch {
+	case err == nil:
+		err = issuer.CanBurn(&body.Amount)
+		if err != nil {
+			return nil, err
+		}
+	case !errors.Is(err, storage.ErrNotFound):
+		return nil, fmt.Errorf("failed to load %v: %v", tokenUrl, err)
+	}
+
 	burn := new(protocol.SyntheticBurnTokens)
 	copy(burn.Cause[:], tx.GetTxHash())
 	burn.Amount = body.Amount
@@ -24,8 +24,10 @@ func NewNodeExecutor(opts ExecutorOptions) (*Executor, error) {
 			InternalTransactionsSigned{},
 			InternalTransactionsSent{},
 
-			// for data accounts
-			WriteData{},
+			// for data accounts, such as oracle feeds
+			CreateDataAccount{},
+			SyntheticCreateChain{},
+			WriteData{Network: &opts.Network},
 
 			// for ACME
 			IssueTokens{},
@@ -45,10 +47,11 @@ func NewNodeExecutor(opts ExecutorOptions) (*Executor, error) {
 			IssueTokens{},
 			SendTokens{},
 			UpdateKeyPage{},
-			WriteData{},
+			WriteData{Network: &opts.Network},
 			WriteDataTo{},
 			UpdateManager{},
 			RemoveManager{},
+			Batch{},
 
 			SyntheticAnchor{Network: &opts.Network},
 			SyntheticBurnTokens{},
@@ -57,10 +60,13 @@ func NewNodeExecutor(opts ExecutorOptions) (*Executor, error) {
 			SyntheticDepositTokens{},
 			SyntheticMirror{},
 			SyntheticWriteData{},
+			SyntheticMigrateAccounts{},
+			SyntheticReturnAccounts{},
 
 			InternalSendTransactions{},
 			InternalTransactionsSigned{},
 			InternalTransactionsSent{},
+			InternalMigrateAccounts{},
 
 			// TODO Only for TestNet
 			AcmeFaucet{},
@@ -44,8 +44,14 @@ func (CreateIdentity) Validate(st *StateManager, tx *transactions.Envelope) (pro
 		pageUrl = body.Url.JoinPath(body.KeyPageName)
 	}
 
+	err = protocol.ValidateKeyType(body.KeyType)
+	if err != nil {
+		return nil, err
+	}
+
 	keySpec := new(protocol.KeySpec)
 	keySpec.PublicKey = body.PublicKey
+	keySpec.KeyType = body.KeyType
 
 	page := protocol.NewKeyPage()
 	page.Url = pageUrl // TODO Allow override
@@ -63,8 +63,14 @@ func (CreateKeyPage) Validate(st *StateManager, tx *transactions.Envelope) (prot
 	}
 
 	for _, sig := range body.Keys {
+		err := protocol.ValidateKeyType(sig.KeyType)
+		if err != nil {
+			return nil, err
+		}
+
 		ss := new(protocol.KeySpec)
 		ss.PublicKey = sig.PublicKey
+		ss.KeyType = sig.KeyType
 		page.Keys = append(page.Keys, ss)
 	}
 
@@ -22,11 +22,19 @@ func (CreateToken) Validate(st *StateManager, tx *transactions.Envelope) (protoc
 		return nil, fmt.Errorf("precision must be in range 0 to 18")
 	}
 
+	if body.HasSupplyLimit && body.InitialSupply.Sign() <= 0 {
+		return nil, fmt.Errorf("a token with a supply limit must have a positive initial supply")
+	}
+	if body.InitialSupply.Sign() < 0 {
+		return nil, fmt.Errorf("initial supply must not be negative")
+	}
+
 	token := protocol.NewTokenIssuer()
 	token.Url = body.Url
 	token.Precision = body.Precision
 	token.Supply = body.InitialSupply
 	token.HasSupplyLimit = body.HasSupplyLimit
+	token.SupplyVersion = protocol.TokenSupplyVersionCirculating
 	token.Symbol = body.Symbol
 	token.Properties = body.Properties
 	token.ManagerKeyBook = body.Manager
@@ -3,7 +3,6 @@ package chain
 import (
 	"bytes"
 	"crypto/ed25519"
-	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -15,9 +14,11 @@ import (
 	"gitlab.com/accumulatenetwork/accumulate/config"
 	"gitlab.com/accumulatenetwork/accumulate/internal/abci"
 	"gitlab.com/accumulatenetwork/accumulate/internal/database"
+	"gitlab.com/accumulatenetwork/accumulate/internal/events"
 	"gitlab.com/accumulatenetwork/accumulate/internal/indexing"
 	"gitlab.com/accumulatenetwork/accumulate/internal/logging"
 	"gitlab.com/accumulatenetwork/accumulate/internal/routing"
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
 	"gitlab.com/accumulatenetwork/accumulate/smt/pmt"
 	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
@@ -42,6 +43,14 @@ type Executor struct {
 	blockBatch  *database.Batch
 	blockMeta   blockMetadata
 
+	// lastBlockIndex is the index of the last block that changed the state
+	lastBlockIndex int64
+
+	// blockEvents are the transaction events of the current block, in order.
+	// They are published once the block is committed.
+	blockEvents   []*events.DidDeliverTransaction
+	blockEventMap map[[32]byte]*events.DidDeliverTransaction
+
 	newValidators []tmed25519.PubKey
 }
 
@@ -54,6 +63,9 @@ type ExecutorOptions struct {
 	Router  routing.Router
 	Network config.Network
 
+	// EventBus receives the events of the executor. It may be nil.
+	EventBus *events.Bus
+
 	isGenesis bool
 }
 
@@ -77,6 +89,11 @@ func newExecutor(opts ExecutorOptions, executors ...TxExecutor) (*Executor, erro
 		m.executors[x.Type()] = x
 	}
 
+	// The batch executor delegates its operations to the other executors
+	if _, ok := m.executors[protocol.TransactionTypeBatch]; ok {
+		m.executors[protocol.TransactionTypeBatch] = Batch{executors: m.executors}
+	}
+
 	batch := m.DB.Begin()
 	defer batch.Discard()
 
@@ -86,6 +103,7 @@ func newExecutor(opts ExecutorOptions, executors ...TxExecutor) (*Executor, erro
 	switch {
 	case err == nil:
 		height = ledger.Index
+		m.setRoutingTable(ledger.ActiveRoutingTable)
 	case errors.Is(err, storage.ErrNotFound):
 		height = 0
 	default:
@@ -243,6 +261,8 @@ func (m *Executor) BeginBlock(req abci.BeginBlockRequest) (abci.BeginBlockRespon
 	m.blockTime = req.Time
 	m.blockBatch = m.DB.Begin()
 	m.blockMeta = blockMetadata{}
+	m.blockEvents = m.blockEvents[:0]
+	m.blockEventMap = map[[32]byte]*events.DidDeliverTransaction{}
 	m.newValidators = m.newValidators[:0]
 
 	m.governor.DidBeginBlock(req.IsLeader, req.Height, req.Time)
@@ -263,6 +283,7 @@ func (m *Executor) BeginBlock(req abci.BeginBlockRequest) (abci.BeginBlockRespon
 		if ledgerState.Index >= m.blockIndex {
 			panic(fmt.Errorf("Current height is %d but the next block height is %d!", ledgerState.Index, m.blockIndex))
 		}
+		m.lastBlockIndex = ledgerState.Index
 
 	case m.isGenesis && errors.Is(err, storage.ErrNotFound):
 		// OK
@@ -271,11 +292,16 @@ func (m *Executor) BeginBlock(req abci.BeginBlockRequest) (abci.BeginBlockRespon
 		return abci.BeginBlockResponse{}, err
 	}
 
+	// Load the routing table
+	m.setRoutingTable(ledgerState.ActiveRoutingTable)
+
 	// Reset transient values
 	ledgerState.Index = m.blockIndex
 	ledgerState.Timestamp = m.blockTime
 	ledgerState.Updates = nil
 	ledgerState.Synthetic.Produced = nil
+	ledgerState.Synthetic.Acknowledgements = nil
+	ledgerState.Synthetic.Unacknowledged = nil
 
 	err = ledger.PutState(ledgerState)
 	if err != nil {
@@ -286,10 +312,20 @@ func (m *Executor) BeginBlock(req abci.BeginBlockRequest) (abci.BeginBlockRespon
 }
 
 // EndBlock implements ./abci.Chain
-func (m *Executor) EndBlock(req abci.EndBlockRequest) abci.EndBlockResponse {
+func (m *Executor) EndBlock(req abci.EndBlockRequest) (abci.EndBlockResponse, error) {
+	err := m.expirePendingTransactions()
+	if err != nil {
+		return abci.EndBlockResponse{}, fmt.Errorf("failed to expire pending transactions: %v", err)
+	}
+
+	err = m.applyKeyPageOperations()
+	if err != nil {
+		return abci.EndBlockResponse{}, fmt.Errorf("failed to apply time-locked key page operations: %v", err)
+	}
+
 	return abci.EndBlockResponse{
 		NewValidators: m.newValidators,
-	}
+	}, nil
 }
 
 // Commit implements ./abci.Chain
@@ -306,8 +342,37 @@ func (m *Executor) Commit() ([]byte, error) {
 	}
 
 	//set active oracle from pending
+	if ledgerState.ActiveOracle != ledgerState.PendingOracle {
+		err = indexing.OracleHistory(m.blockBatch, m.Network.NodeUrl(protocol.Ledger)).Append(&indexing.OracleHistoryEntry{
+			Block: uint64(m.blockIndex),
+			Time:  m.blockTime,
+			Price: ledgerState.PendingOracle,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
 	ledgerState.ActiveOracle = ledgerState.PendingOracle
 
+	// Activate the pending fee schedule, if there is one
+	if ledgerState.PendingFeeSchedule != nil {
+		ledgerState.ActiveFeeSchedule = ledgerState.PendingFeeSchedule
+	}
+
+	// Activate the pending network globals, if there are any
+	if ledgerState.PendingGlobals != nil {
+		ledgerState.ActiveGlobals = ledgerState.PendingGlobals
+	}
+
+	// Activate the pending routing table, if it has changed. Each BVN then
+	// migrates the accounts that the new table routes elsewhere.
+	var migrate bool
+	if pending := ledgerState.PendingRoutingTable; pending != nil && (ledgerState.ActiveRoutingTable == nil || !pending.Equal(ledgerState.ActiveRoutingTable)) {
+		m.logInfo("Activating routing table", "routes", len(pending.Routes))
+		ledgerState.ActiveRoutingTable = pending
+		migrate = m.Network.Type == config.BlockValidator
+	}
+
 	// Deduplicate the update list
 	updatedMap := make(map[string]bool, len(ledgerState.Updates))
 	updatedSlice := make([]protocol.AnchorMetadata, 0, len(ledgerState.Updates))
@@ -322,17 +387,29 @@ func (m *Executor) Commit() ([]byte, error) {
 	}
 	ledgerState.Updates = updatedSlice
 
-	if m.blockMeta.Empty() && len(updatedSlice) == 0 && len(ledgerState.Synthetic.Produced) == 0 {
+	if m.blockMeta.Empty() && len(updatedSlice) == 0 && len(ledgerState.Synthetic.Produced) == 0 && len(ledgerState.Synthetic.Acknowledgements) == 0 && len(ledgerState.Synthetic.Unacknowledged) == 0 {
 		m.logInfo("Committed empty transaction")
 	} else {
-		m.logInfo("Committing", "height", m.blockIndex, "delivered", m.blockMeta.Delivered, "signed", m.blockMeta.SynthSigned, "sent", m.blockMeta.SynthSent, "updated", len(updatedSlice), "submitted", len(ledgerState.Synthetic.Produced))
+		m.logInfo("Committing", "height", m.blockIndex, "delivered", m.blockMeta.Delivered, "signed", m.blockMeta.SynthSigned, "sent", m.blockMeta.SynthSent, "expired", m.blockMeta.Expired, "updated", len(updatedSlice), "submitted", len(ledgerState.Synthetic.Produced))
 		t := time.Now()
 
+		m.didUpdateAccounts(ledgerState.Updates)
+
 		err := m.doCommit(ledgerState)
 		if err != nil {
 			return nil, err
 		}
 
+		// Queue the accounts that the new routing table routes elsewhere, once
+		// the accounts updated by this block are in the BPT
+		if migrate {
+			count, err := m.queueMigration(ledgerState.ActiveRoutingTable)
+			if err != nil {
+				return nil, err
+			}
+			ledgerState.RoutingMigration = count > 0
+		}
+
 		// Write the updated ledger
 		err = ledger.PutState(ledgerState)
 		if err != nil {
@@ -345,6 +422,14 @@ func (m *Executor) Commit() ([]byte, error) {
 		}
 
 		m.logInfo("Committed", "height", m.blockIndex, "duration", time.Since(t))
+		m.setRoutingTable(ledgerState.ActiveRoutingTable)
+
+		// The block has been committed, so failing to notify subscribers must
+		// not halt the node
+		err = m.publishBlockEvents(ledgerState)
+		if err != nil {
+			m.logError("Failed to publish the events of the block", "height", m.blockIndex, "error", err)
+		}
 	}
 
 	if !m.isGenesis {
@@ -360,29 +445,234 @@ func (m *Executor) Commit() ([]byte, error) {
 	return batch.RootHash(), nil
 }
 
-func (m *Executor) updateOraclePrice(ledgerState *protocol.InternalLedger) error {
-	data, err := m.blockBatch.Account(protocol.PriceOracle()).Data()
+// updateOraclePrice records the submissions of the oracle feeds that were
+// written to in this block and, if any were or if the oracle policy changed,
+// sets the pending oracle price to the aggregate of the feeds.
+func (m *Executor) updateOraclePrice(ledgerState *protocol.InternalLedger, accountSeen map[string]bool) error {
+	policy, err := loadOraclePolicy(m.blockBatch)
 	if err != nil {
-		return fmt.Errorf("cannot retrieve oracle data entry: %v", err)
+		return err
+	}
+
+	ledgerUrl := m.Network.NodeUrl(protocol.Ledger)
+	changed := accountSeen[protocol.OracleFeedsAuthority]
+	for _, feed := range policy.Feeds {
+		if !accountSeen[strings.ToLower(feed.String())] {
+			continue
+		}
+
+		changed = true
+		price, err := m.loadOracleSubmission(feed)
+		if err != nil {
+			m.logError("Invalid oracle submission", "feed", feed, "error", err)
+			continue
+		}
+
+		err = indexing.OracleFeed(m.blockBatch, ledgerUrl, feed).Put(&protocol.OracleSubmission{
+			Feed:  feed,
+			Price: price,
+			Time:  m.blockTime,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record oracle submission of %v: %v", feed, err)
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	var submissions []*protocol.OracleSubmission
+	for _, feed := range policy.Feeds {
+		sub, err := indexing.OracleFeed(m.blockBatch, ledgerUrl, feed).Get()
+		switch {
+		case err == nil:
+			submissions = append(submissions, sub)
+		case errors.Is(err, storage.ErrNotFound):
+			// The feed has not submitted anything
+		default:
+			return fmt.Errorf("failed to load oracle submission of %v: %v", feed, err)
+		}
+	}
+
+	price, sources, err := policy.Aggregate(m.blockTime, submissions)
+	if err != nil {
+		return err
+	}
+
+	ledgerState.PendingOracle = price
+	ledgerState.OracleSources = sources
+	return nil
+}
+
+// loadOraclePolicy loads the latest oracle policy. If no policy has been
+// written, the price oracle account is the only feed.
+func loadOraclePolicy(batch *database.Batch) (*protocol.OraclePolicy, error) {
+	data, err := batch.Account(protocol.OracleFeedsUrl()).Data()
+	if err != nil {
+		return nil, fmt.Errorf("cannot retrieve oracle policy data entry: %v", err)
+	}
+	_, e, err := data.GetLatest()
+	if errors.Is(err, storage.ErrNotFound) {
+		return protocol.DefaultOraclePolicy(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot retrieve latest oracle policy data entry: data batch at height %d: %v", data.Height(), err)
+	}
+
+	return parseOraclePolicy(e)
+}
+
+// loadOracleSubmission loads the latest price written to an oracle feed.
+func (m *Executor) loadOracleSubmission(feed *url.URL) (uint64, error) {
+	data, err := m.blockBatch.Account(feed).Data()
+	if err != nil {
+		return 0, fmt.Errorf("cannot retrieve oracle data entry: %v", err)
 	}
 	_, e, err := data.GetLatest()
 	if err != nil {
-		return fmt.Errorf("cannot retrieve latest oracle data entry: data batch at height %d: %v", data.Height(), err)
+		return 0, fmt.Errorf("cannot retrieve latest oracle data entry: data batch at height %d: %v", data.Height(), err)
 	}
 
 	o := protocol.AcmeOracle{}
 	err = json.Unmarshal(e.Data, &o)
 	if err != nil {
-		return fmt.Errorf("cannot unmarshal oracle data entry %x", e.Data)
+		return 0, fmt.Errorf("cannot unmarshal oracle data entry %x", e.Data)
 	}
 
 	if o.Price == 0 {
-		return fmt.Errorf("invalid oracle price, must be > 0")
+		return 0, fmt.Errorf("invalid oracle price, must be > 0")
+	}
+
+	return o.Price, nil
+}
+
+// updateFeeSchedule sets the pending fee schedule to the latest entry of the
+// fee schedule account. The entry was validated when it was written, so an
+// error here means the database is broken.
+func (m *Executor) updateFeeSchedule(ledgerState *protocol.InternalLedger) error {
+	data, err := m.blockBatch.Account(protocol.FeeScheduleUrl()).Data()
+	if err != nil {
+		return fmt.Errorf("cannot retrieve fee schedule data entry: %v", err)
+	}
+	_, e, err := data.GetLatest()
+	if err != nil {
+		return fmt.Errorf("cannot retrieve latest fee schedule data entry: data batch at height %d: %v", data.Height(), err)
+	}
+
+	schedule, err := parseFeeSchedule(e)
+	if err != nil {
+		return err
+	}
+
+	ledgerState.PendingFeeSchedule = schedule
+	return nil
+}
+
+// loadFeeSchedule loads the fee schedule that is active in the given batch.
+// The schedule is read from the batch instead of being cached on the
+// executor, since CheckTx and queries run concurrently with the block. If it
+// is nil, the default fees apply.
+func (m *Executor) loadFeeSchedule(batch *database.Batch) (*protocol.FeeSchedule, error) {
+	ledger, err := m.loadLedger(batch)
+	if err != nil {
+		return nil, err
+	}
+	return ledger.ActiveFeeSchedule, nil
+}
+
+func (m *Executor) updateRoutingTable(ledgerState *protocol.InternalLedger) error {
+	// The routing table account does not have a data chain until the routing
+	// table is set. Do not call Data before then, because that would add the
+	// chain to the account after its BPT entry has been written.
+	record := m.blockBatch.Account(protocol.RoutingTableUrl())
+	meta, err := record.GetObject()
+	if err != nil {
+		return fmt.Errorf("cannot load the routing table account: %v", err)
+	}
+	var hasData bool
+	for _, c := range meta.Chains {
+		if c.Name == protocol.DataChain {
+			hasData = true
+		}
+	}
+	if !hasData {
+		return nil
+	}
+
+	data, err := record.Data()
+	if err != nil {
+		return fmt.Errorf("cannot retrieve routing table data entry: %v", err)
+	}
+	_, e, err := data.GetLatest()
+	if errors.Is(err, storage.ErrNotFound) {
+		// The routing table has not been set
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("cannot retrieve latest routing table data entry: data batch at height %d: %v", data.Height(), err)
 	}
 
-	ledgerState.PendingOracle = o.Price
+	table, err := parseRoutingTable(&m.Network, e)
+	if err != nil {
+		return err
+	}
+
+	ledgerState.PendingRoutingTable = table
 	return nil
 }
+
+// updateGlobals sets the pending network globals to the latest entry of the
+// globals account. The entry was validated when it was written, so an error
+// here means the database is broken.
+func (m *Executor) updateGlobals(ledgerState *protocol.InternalLedger) error {
+	data, err := m.blockBatch.Account(protocol.GlobalsUrl()).Data()
+	if err != nil {
+		return fmt.Errorf("cannot retrieve network globals data entry: %v", err)
+	}
+	_, e, err := data.GetLatest()
+	if err != nil {
+		return fmt.Errorf("cannot retrieve latest network globals data entry: data batch at height %d: %v", data.Height(), err)
+	}
+
+	globals, err := parseGlobals(e)
+	if err != nil {
+		return err
+	}
+
+	ledgerState.PendingGlobals = globals
+	return nil
+}
+
+// loadGlobals loads the network globals that are active in the given batch.
+// Ledgers created before the globals were introduced use the defaults.
+func (m *Executor) loadGlobals(batch *database.Batch) (*protocol.NetworkGlobals, error) {
+	ledger, err := m.loadLedger(batch)
+	if err != nil {
+		return nil, err
+	}
+
+	if ledger.ActiveGlobals == nil {
+		return protocol.DefaultNetworkGlobals(), nil
+	}
+	return ledger.ActiveGlobals, nil
+}
+
+func (m *Executor) loadLedger(batch *database.Batch) (*protocol.InternalLedger, error) {
+	ledger := protocol.NewInternalLedger()
+	err := batch.Account(m.Network.NodeUrl(protocol.Ledger)).GetStateAs(ledger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the ledger: %v", err)
+	}
+	return ledger, nil
+}
+
+// setRoutingTable updates the routing table of the router.
+func (m *Executor) setRoutingTable(table *protocol.RoutingTable) {
+	if r, ok := m.Router.(routing.TableRouter); ok {
+		r.SetRoutingTable(table)
+	}
+}
+
 func (m *Executor) doCommit(ledgerState *protocol.InternalLedger) error {
 	// Load the main chain of the minor root
 	ledgerUrl := m.Network.NodeUrl(protocol.Ledger)
@@ -401,6 +691,7 @@ func (m *Executor) doCommit(ledgerState *protocol.InternalLedger) error {
 
 	// Add an anchor to the root chain for every updated chain
 	accountSeen := map[string]bool{}
+	var updatedAccounts []*url.URL
 	updates := ledgerState.Updates
 	ledgerState.Updates = make([]protocol.AnchorMetadata, 0, len(updates))
 	for _, u := range updates {
@@ -445,53 +736,51 @@ func (m *Executor) doCommit(ledgerState *protocol.InternalLedger) error {
 			continue
 		}
 		accountSeen[s] = true
+		updatedAccounts = append(updatedAccounts, u.Account)
 
-		// Load the state
-		state, err := record.GetState()
+		// Write the hash of the state and chain anchors to the BPT
+		hash, err := record.StateHash()
 		if err != nil {
 			return err
 		}
+		record.PutBpt(hash)
 
-		// Marshal it
-		data, err := state.MarshalBinary()
+		// Keep a copy of the state as of this block (archive mode only)
+		err = record.ArchiveState(uint64(m.blockIndex))
 		if err != nil {
 			return err
 		}
+	}
 
-		// Hash it
-		var hashes []byte
-		h := sha256.Sum256(data)
-		hashes = append(hashes, h[:]...)
+	if m.Network.Type == config.Directory {
+		//if things go south here, don't return and error, instead, just log one
+		err := m.updateOraclePrice(ledgerState, accountSeen)
+		if err != nil {
+			m.logError("Failed to update the oracle price", "error", err)
+		}
+	}
 
-		// Load the object metadata
-		objMeta, err := record.GetObject()
+	// The DN mirrors its accounts to the BVNs, so only the DN reads the fee
+	// schedule and the globals from the accounts. The BVNs receive them with
+	// the anchor.
+	if m.Network.Type == config.Directory && accountSeen[protocol.FeeScheduleAuthority] {
+		err := m.updateFeeSchedule(ledgerState)
 		if err != nil {
 			return err
 		}
+	}
 
-		// For each chain
-		for _, chainMeta := range objMeta.Chains {
-			// Load the chain
-			recordChain, err := record.ReadChain(chainMeta.Name)
-			if err != nil {
-				return err
-			}
-
-			// Get the anchor
-			anchor := recordChain.Anchor()
-			h := sha256.Sum256(anchor)
-			hashes = append(hashes, h[:]...)
+	if m.Network.Type == config.Directory && accountSeen[protocol.GlobalsAuthority] {
+		err := m.updateGlobals(ledgerState)
+		if err != nil {
+			return err
 		}
-
-		// Write the hash of the hashes to the BPT
-		record.PutBpt(sha256.Sum256(hashes))
 	}
 
-	if accountSeen[protocol.PriceOracleAuthority] {
-		//if things go south here, don't return and error, instead, just log one
-		err := m.updateOraclePrice(ledgerState)
+	if accountSeen[protocol.RoutingTableAuthority] {
+		err := m.updateRoutingTable(ledgerState)
 		if err != nil {
-			m.logError(fmt.Sprintf("%v", err))
+			m.logError("Failed to update the routing table", "error", err)
 		}
 	}
 
@@ -530,11 +819,39 @@ func (m *Executor) doCommit(ledgerState *protocol.InternalLedger) error {
 		Index:   uint64(m.blockIndex - 1),
 	})
 
+	bptEntry := uint64(rootChain.Height())
 	err = rootChain.AddEntry(m.blockBatch.RootHash(), false)
 	if err != nil {
 		return err
 	}
 
+	// In archive mode, record a receipt from the BPT entry of every updated
+	// account to the BPT root, so that proofs of account state can be built
+	// once the directory anchors the block
+	if m.DB.IsArchive() {
+		for _, account := range updatedAccounts {
+			receipt, err := m.blockBatch.Account(account).BptReceipt()
+			if err != nil {
+				return err
+			}
+
+			err = indexing.StateReceipt(m.blockBatch, account).Put(uint64(m.blockIndex), convertReceipt(receipt))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Index the root chain entry of the BPT, linked to the previous block that
+	// changed the state, so that proofs of account state can find it
+	err = indexing.BlockRoot(m.blockBatch, ledgerUrl).Add(uint64(m.blockIndex), &indexing.BlockRootIndex{
+		BptEntry:      bptEntry,
+		PreviousBlock: uint64(m.lastBlockIndex),
+	})
+	if err != nil {
+		return err
+	}
+
 	// Update the transaction-chain index
 	for _, e := range txChainEntries {
 		e.RootAnchor = uint64(rootChain.Height()) - 1
@@ -565,5 +882,22 @@ func (m *Executor) doCommit(ledgerState *protocol.InternalLedger) error {
 		}
 	}
 
-	return nil
+	// Index the block
+	block := new(indexing.BlockIndex)
+	block.Height = uint64(m.blockIndex)
+	block.Time = m.blockTime
+	copy(block.RootAnchor[:], rootChain.Anchor())
+	block.Updates = ledgerState.Updates
+	for _, e := range m.blockEvents {
+		// Transactions that were only produced were not delivered
+		if e.Status != nil {
+			block.DeliveredTxns = append(block.DeliveredTxns, e.Txid)
+		}
+	}
+	for _, e := range blockState.ProducedSynthTxns {
+		var txid [32]byte
+		copy(txid[:], e.Transaction)
+		block.ProducedSynthTxns = append(block.ProducedSynthTxns, txid)
+	}
+	return indexing.Block(m.blockBatch, ledgerUrl).Put(block)
 }
@@ -0,0 +1,104 @@
+package chain
+
+import (
+	"fmt"
+
+	"gitlab.com/accumulatenetwork/accumulate/internal/events"
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+	"gitlab.com/accumulatenetwork/accumulate/types/api/transactions"
+)
+
+// blockEvent returns the event of the transaction, creating it if it has not
+// been recorded in the current block.
+func (m *Executor) blockEvent(txid []byte) *events.DidDeliverTransaction {
+	var id [32]byte
+	copy(id[:], txid)
+
+	if m.blockEventMap == nil {
+		m.blockEventMap = map[[32]byte]*events.DidDeliverTransaction{}
+	}
+
+	e, ok := m.blockEventMap[id]
+	if !ok {
+		e = new(events.DidDeliverTransaction)
+		e.Txid = id
+		m.blockEventMap[id] = e
+		m.blockEvents = append(m.blockEvents, e)
+	}
+	return e
+}
+
+// didRecordTransaction records the status of a transaction for the block's
+// events. If the status is recorded more than once, the last one wins.
+func (m *Executor) didRecordTransaction(txid []byte, origin *url.URL, typ protocol.TransactionType, status *protocol.TransactionStatus) {
+	e := m.blockEvent(txid)
+	e.Origin = origin
+	e.Type = typ
+	e.Status = status
+}
+
+// didProduce records a synthetic transaction produced by a transaction.
+func (m *Executor) didProduce(cause []byte, env *transactions.Envelope) {
+	synth := events.SyntheticTransaction{Origin: env.Transaction.Origin}
+	copy(synth.Txid[:], env.GetTxHash())
+
+	e := m.blockEvent(cause)
+	e.Produced = append(e.Produced, synth)
+}
+
+// didUpdateAccounts records the accounts whose main chain recorded each of the
+// block's transactions.
+func (m *Executor) didUpdateAccounts(updates []protocol.AnchorMetadata) {
+	for _, u := range updates {
+		if u.Name != protocol.MainChain || u.Type != protocol.ChainTypeTransaction {
+			continue
+		}
+
+		var id [32]byte
+		copy(id[:], u.Entry)
+		e, ok := m.blockEventMap[id]
+		if ok {
+			e.Accounts = append(e.Accounts, u.Account)
+		}
+	}
+}
+
+// publishBlockEvents publishes the events of a committed block. A subscriber
+// that panics is reported as an error.
+func (m *Executor) publishBlockEvents(ledgerState *protocol.InternalLedger) (err error) {
+	if m.EventBus == nil {
+		return nil
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("event subscriber panicked: %v", r)
+		}
+	}()
+
+	for _, e := range m.blockEvents {
+		// Transactions that were only produced, such as synthetic transactions
+		// of the genesis block, have no status
+		if e.Status == nil {
+			continue
+		}
+		m.EventBus.Publish(e)
+	}
+
+	block := new(events.DidCommitBlock)
+	block.Index = m.blockIndex
+	block.Time = m.blockTime
+	block.Anchors = ledgerState.Updates
+
+	batch := m.DB.Begin()
+	defer batch.Discard()
+	rootChain, err := batch.Account(m.Network.NodeUrl(protocol.Ledger)).ReadChain(protocol.MinorRootChain)
+	if err != nil {
+		return fmt.Errorf("failed to load the root chain: %v", err)
+	}
+	block.RootAnchor = rootChain.Anchor()
+
+	m.EventBus.Publish(block)
+	return nil
+}
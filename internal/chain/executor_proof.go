@@ -0,0 +1,349 @@
+package chain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"gitlab.com/accumulatenetwork/accumulate/config"
+	"gitlab.com/accumulatenetwork/accumulate/internal/database"
+	"gitlab.com/accumulatenetwork/accumulate/internal/indexing"
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+	"gitlab.com/accumulatenetwork/accumulate/smt/managed"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
+	"gitlab.com/accumulatenetwork/accumulate/types/api/query"
+	"gitlab.com/accumulatenetwork/accumulate/types/proof"
+)
+
+// queryProof builds a proof of a transaction, if a transaction ID is
+// specified, or of the current state of an account. If both are specified, the
+// proof of the transaction is built from a chain of the given account.
+func (m *Executor) queryProof(batch *database.Batch, u *url.URL, txid []byte) (*query.ResponseProof, error) {
+	if len(txid) == 0 {
+		if u == nil {
+			return nil, fmt.Errorf("a URL or a transaction ID is required")
+		}
+		return m.proveAccountState(batch, u)
+	}
+
+	chainIndex, err := indexing.TransactionChain(batch, txid).Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load transaction chain index: %v", err)
+	}
+
+	// Prefer the main chain over the pending chain
+	var found *indexing.TransactionChainEntry
+	for _, entry := range chainIndex.Entries {
+		if u != nil && !u.Equal(entry.Account) {
+			continue
+		}
+		if found == nil || found.Chain != protocol.MainChain && entry.Chain == protocol.MainChain {
+			found = entry
+		}
+	}
+
+	if found != nil {
+		rootChain, err := batch.Account(m.Network.NodeUrl(protocol.Ledger)).ReadChain(protocol.MinorRootChain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the minor root chain: %v", err)
+		}
+
+		return m.proveTransaction(batch, rootChain, txid, found)
+	}
+
+	if u != nil {
+		return nil, fmt.Errorf("transaction %X has not been recorded on a chain of %v: %w", txid, u, storage.ErrNotFound)
+	}
+	return nil, fmt.Errorf("transaction %X has not been recorded on a chain: %w", txid, storage.ErrNotFound)
+}
+
+// proveTransaction builds a proof that goes from a transaction, through the
+// chain entry and the minor root chain, to the directory root anchor that
+// anchors the block.
+func (m *Executor) proveTransaction(batch *database.Batch, rootChain *database.Chain, txid []byte, entry *indexing.TransactionChainEntry) (*query.ResponseProof, error) {
+	anchor, err := indexing.DirectoryAnchor(batch, m.Network.NodeUrl(protocol.Ledger)).AnchorForLocalBlock(entry.Block, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read anchor for block %d: %v", entry.Block, err)
+	}
+
+	accountChain, err := batch.Account(entry.Account).ReadChain(entry.Chain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chain %s of %v: %v", entry.Chain, entry.Account, err)
+	}
+
+	accountReceipt, err := accountChain.Receipt(int64(entry.ChainEntry), int64(entry.ChainAnchor))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a receipt %s of %v from %d to %d: %v", entry.Chain, entry.Account, entry.ChainEntry, entry.ChainAnchor, err)
+	}
+
+	if !bytes.Equal(accountReceipt.Element, txid) {
+		return nil, fmt.Errorf("invalid receipt start: want %X, got %X", txid, accountReceipt.Element)
+	}
+
+	rootReceipt, err := rootChain.Receipt(int64(entry.RootEntry), int64(entry.RootAnchor))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a receipt for the minor root chain from %d to %d: %v", entry.RootEntry, entry.RootAnchor, err)
+	}
+
+	resp, err := buildChainedProof(entry.Account, entry.Chain, entry.Block, anchor, accountReceipt, rootReceipt)
+	if err != nil {
+		return nil, err
+	}
+
+	// Include the transaction so the proof can be verified against it
+	txState, err := batch.Transaction(txid).GetState()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load transaction %X: %w", txid, err)
+	}
+	resp.Transaction, err = txState.Restore().Transaction.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal transaction %X: %v", txid, err)
+	}
+
+	return resp, nil
+}
+
+// proveAccountState builds a proof that goes from the BPT entry of an account,
+// through the BPT and the minor root chain, to a directory root anchor. The BPT
+// receipt is recorded when a block updates the account, so the proof can be
+// built once the directory has anchored that block or a later one. Receipts
+// are only recorded in archive mode.
+func (m *Executor) proveAccountState(batch *database.Batch, u *url.URL) (*query.ResponseProof, error) {
+	if !m.DB.IsArchive() {
+		return nil, fmt.Errorf("proofs of account state are not available: the database is not in archive mode")
+	}
+
+	ledgerUrl := m.Network.NodeUrl(protocol.Ledger)
+	if u.Equal(ledgerUrl) {
+		return nil, fmt.Errorf("the state of %v is not recorded in the BPT", u)
+	}
+
+	stateReceipt, err := indexing.StateReceipt(batch, u).Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the state receipt of %v: %w", u, err)
+	}
+
+	state, chainAnchors, err := loadAccountState(batch.Account(u))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the state of %v: %w", u, err)
+	}
+	bptReceipt := stateReceipt.Receipt.Convert()
+	if !bytes.Equal(proof.StateHash(state, chainAnchors), bptReceipt.Element) {
+		return nil, fmt.Errorf("the state receipt of %v is out of date", u)
+	}
+
+	stateRoot, err := indexing.BlockRoot(batch, ledgerUrl).Get(stateReceipt.Block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the root index of block %d: %w", stateReceipt.Block, err)
+	}
+
+	ledgerState := protocol.NewInternalLedger()
+	err = batch.Account(ledgerUrl).GetStateAs(ledgerState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the ledger: %v", err)
+	}
+
+	// Find the first block, at or after the one that updated the account, that
+	// has been anchored by the directory
+	var anchor *protocol.SyntheticAnchor
+	var anchorRoot *indexing.BlockRootIndex
+	var anchorBlock uint64
+	for block := uint64(ledgerState.Index); block >= stateReceipt.Block; {
+		entry, err := indexing.BlockRoot(batch, ledgerUrl).Get(block)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the root index of block %d: %w", block, err)
+		}
+
+		a, err := indexing.DirectoryAnchor(batch, ledgerUrl).AnchorForLocalBlock(block, false)
+		switch {
+		case err == nil:
+			anchor, anchorRoot, anchorBlock = a, entry, block
+		case !errors.Is(err, storage.ErrNotFound):
+			return nil, fmt.Errorf("failed to read anchor for block %d: %v", block, err)
+		}
+
+		if block == stateReceipt.Block {
+			break
+		}
+		block = entry.PreviousBlock
+	}
+	if anchor == nil {
+		return nil, fmt.Errorf("the state of %v has not been anchored by the directory: %w", u, storage.ErrNotFound)
+	}
+
+	rootChain, err := batch.Account(ledgerUrl).ReadChain(protocol.MinorRootChain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the minor root chain: %v", err)
+	}
+
+	rootReceipt, err := rootChain.Receipt(int64(stateRoot.BptEntry), int64(anchorRoot.BptEntry))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a receipt for the minor root chain from %d to %d: %v", stateRoot.BptEntry, anchorRoot.BptEntry, err)
+	}
+
+	resp, err := buildChainedProof(u, "bpt", anchorBlock, anchor, bptReceipt, rootReceipt)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.State = state
+	resp.ChainAnchors = chainAnchors
+	return resp, nil
+}
+
+// proveAnchor builds a proof that the directory has recorded the root anchor of
+// a subnet. The proof goes from the anchor to the anchor of the chain of the
+// directory's anchor pool that recorded it, and from the BPT entry of the
+// anchor pool to the BPT root. The BPT root is the app hash of the directory
+// as of the last block that changed the state, so it can be checked against a
+// header signed by the validators of the directory.
+func (m *Executor) proveAnchor(batch *database.Batch, anchor []byte) (*query.ResponseAnchorProof, error) {
+	if m.Network.Type != config.Directory {
+		return nil, fmt.Errorf("anchors can only be proven by the directory")
+	}
+
+	poolUrl := m.Network.NodeUrl(protocol.AnchorPool)
+	record := batch.Account(poolUrl)
+	objMeta, err := record.GetObject()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the metadata of %v: %v", poolUrl, err)
+	}
+
+	// Find the anchor chain that recorded the anchor
+	resp := new(query.ResponseAnchorProof)
+	for _, chainMeta := range objMeta.Chains {
+		if chainMeta.Type != protocol.ChainTypeAnchor {
+			continue
+		}
+
+		chain, err := record.ReadChain(chainMeta.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read chain %s of %v: %v", chainMeta.Name, poolUrl, err)
+		}
+
+		height, err := chain.HeightOf(anchor)
+		if errors.Is(err, storage.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to find %X in chain %s of %v: %v", anchor, chainMeta.Name, poolUrl, err)
+		}
+
+		receipt, err := chain.Receipt(height, chain.Height()-1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get a receipt %s of %v from %d to %d: %v", chainMeta.Name, poolUrl, height, chain.Height()-1, err)
+		}
+
+		resp.Chain = chainMeta.Name
+		resp.ChainReceipt = *convertReceipt(receipt)
+		break
+	}
+	if resp.Chain == "" {
+		return nil, fmt.Errorf("anchor %X has not been recorded by the directory: %w", anchor, storage.ErrNotFound)
+	}
+
+	resp.State, resp.ChainAnchors, err = loadAccountState(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the state of %v: %w", poolUrl, err)
+	}
+
+	bptReceipt, err := record.BptReceipt()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(proof.StateHash(resp.State, resp.ChainAnchors), bptReceipt.Element) {
+		return nil, fmt.Errorf("the BPT entry of %v is out of date", poolUrl)
+	}
+
+	ledgerState := protocol.NewInternalLedger()
+	err = batch.Account(m.Network.NodeUrl(protocol.Ledger)).GetStateAs(ledgerState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the ledger: %v", err)
+	}
+
+	resp.Anchor = anchor
+	resp.Account = poolUrl
+	resp.Block = uint64(ledgerState.Index)
+	resp.StateReceipt = *convertReceipt(bptReceipt)
+	return resp, nil
+}
+
+// loadAccountState returns the marshalled state of the account and the anchors
+// of its chains, which together make up the account's BPT entry.
+func loadAccountState(record *database.Account) ([]byte, [][]byte, error) {
+	state, err := record.GetState()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := state.MarshalBinary()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	objMeta, err := record.GetObject()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	anchors := make([][]byte, 0, len(objMeta.Chains))
+	for _, chainMeta := range objMeta.Chains {
+		chain, err := record.ReadChain(chainMeta.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		anchors = append(anchors, chain.Anchor())
+	}
+
+	return data, anchors, nil
+}
+
+// buildChainedProof combines the receipts with the receipt of the directory
+// anchor and verifies that the result ends at the directory root anchor.
+func buildChainedProof(account *url.URL, chain string, block uint64, anchor *protocol.SyntheticAnchor, receipts ...*managed.Receipt) (*query.ResponseProof, error) {
+	receipts = append(receipts, anchor.Receipt.Convert())
+
+	resp := new(query.ResponseProof)
+	resp.Account = account
+	resp.Chain = chain
+	resp.LocalBlock = block
+	resp.DirectoryBlock = anchor.Block
+	resp.DirectoryAnchor = anchor.RootAnchor[:]
+	resp.Segments = make([]*protocol.Receipt, len(receipts))
+
+	var err error
+	r := receipts[0]
+	for i, receipt := range receipts {
+		resp.Segments[i] = convertReceipt(receipt)
+		if i == 0 {
+			continue
+		}
+
+		r, err = r.Combine(receipt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to combine receipts: %v", err)
+		}
+	}
+
+	if !bytes.Equal(anchor.RootAnchor[:], r.MDRoot) {
+		return nil, fmt.Errorf("invalid receipt end: want %X, got %X", anchor.RootAnchor, r.MDRoot)
+	}
+
+	if !r.Validate() {
+		return nil, fmt.Errorf("receipt is invalid")
+	}
+
+	resp.Start = r.Element
+	resp.Receipt = *convertReceipt(r)
+	return resp, nil
+}
+
+func convertReceipt(r *managed.Receipt) *protocol.Receipt {
+	receipt := new(protocol.Receipt)
+	receipt.Start = r.Element
+	receipt.Entries = make([]protocol.ReceiptEntry, len(r.Nodes))
+	for i, node := range r.Nodes {
+		receipt.Entries[i] = protocol.ReceiptEntry{Hash: node.Hash, Right: node.Right}
+	}
+	return receipt
+}
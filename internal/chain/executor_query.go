@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"gitlab.com/accumulatenetwork/accumulate/config"
 	"gitlab.com/accumulatenetwork/accumulate/internal/database"
 	"gitlab.com/accumulatenetwork/accumulate/internal/indexing"
 	"gitlab.com/accumulatenetwork/accumulate/internal/url"
@@ -16,6 +17,7 @@ import (
 	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
 	"gitlab.com/accumulatenetwork/accumulate/types"
 	"gitlab.com/accumulatenetwork/accumulate/types/api/query"
+	"gitlab.com/accumulatenetwork/accumulate/types/api/transactions"
 	"gitlab.com/accumulatenetwork/accumulate/types/state"
 )
 
@@ -393,6 +395,267 @@ func (m *Executor) queryByChainId(batch *database.Batch, chainId []byte) (*query
 	return &qr, nil
 }
 
+// queryByUrlAt returns the state of an account as of the given block. Only
+// plain account URLs can be queried at a height, and only if the database is
+// in archive mode.
+func (m *Executor) queryByUrlAt(batch *database.Batch, u *url.URL, height uint64) ([]byte, encoding.BinaryMarshaler, error) {
+	if u.Fragment != "" || u.Query != "" {
+		return nil, nil, fmt.Errorf("cannot query %v at a height: only account URLs can be queried at a height", u)
+	}
+
+	obj, err := batch.Account(u).GetStateAt(height)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load the state of %v at height %d: %w", u, height, err)
+	}
+
+	qr := new(query.ResponseByChainId)
+	qr.Object.Entry, err = obj.MarshalBinary()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal %T (%v): %v", obj, u, err)
+	}
+
+	return []byte("chain"), qr, nil
+}
+
+// querySyntheticOutbox returns the synthetic transactions that have not been
+// acknowledged minAge or more blocks after they were produced. If minAge is
+// zero, transactions that have not been acknowledged by the time they are
+// first resent are returned.
+func (m *Executor) querySyntheticOutbox(batch *database.Batch, minAge uint64) (*query.ResponseSyntheticOutbox, error) {
+	ledger := protocol.NewInternalLedger()
+	err := batch.Account(m.Network.NodeUrl(protocol.Ledger)).GetStateAs(ledger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the ledger: %v", err)
+	}
+
+	if minAge == 0 {
+		minAge = synthRetryDelay
+	}
+
+	outbox, err := indexing.SyntheticOutbox(batch, m.Network.NodeUrl(protocol.Ledger)).Entries()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the synthetic outbox: %v", err)
+	}
+
+	res := new(query.ResponseSyntheticOutbox)
+	res.Block = uint64(ledger.Index)
+	res.Time = ledger.Timestamp
+	for _, entry := range outbox {
+		age := entry.Age(res.Block)
+		if age < minAge {
+			continue
+		}
+
+		stuck := new(query.StuckSyntheticTransaction)
+		stuck.Transaction = entry.Transaction
+		stuck.Source = m.Network.NodeUrl()
+		stuck.Type = entry.Type
+		stuck.Destination = entry.Destination
+		stuck.ProducedBlock = entry.ProducedBlock
+		stuck.ProducedTime = entry.ProducedTime
+		stuck.Age = age
+		stuck.Attempts = entry.Attempts
+		stuck.NextAttempt = entry.NextAttempt
+		res.Transactions = append(res.Transactions, stuck)
+	}
+
+	return res, nil
+}
+
+// maxBlockRange is the maximum number of blocks that can be queried at once.
+const maxBlockRange = 1000
+
+func newBlockResponse(block *indexing.BlockIndex) *query.ResponseBlock {
+	res := new(query.ResponseBlock)
+	res.Height = block.Height
+	res.Time = block.Time
+	res.RootAnchor = block.RootAnchor
+	res.Updates = block.Updates
+	res.Transactions = block.DeliveredTxns
+	res.SyntheticTransactions = block.ProducedSynthTxns
+	return res
+}
+
+// queryBlock returns what happened in a block. Blocks that did not change the
+// state are not indexed.
+func (m *Executor) queryBlock(batch *database.Batch, height uint64) (*query.ResponseBlock, error) {
+	block, err := indexing.Block(batch, m.Network.NodeUrl(protocol.Ledger)).Get(height)
+	if err != nil {
+		return nil, err
+	}
+
+	return newBlockResponse(block), nil
+}
+
+// queryBlockRange returns what happened in the blocks from start to start +
+// count. Blocks that did not change the state are skipped.
+func (m *Executor) queryBlockRange(batch *database.Batch, start, count uint64) (*query.ResponseBlockRange, error) {
+	if count > maxBlockRange {
+		return nil, fmt.Errorf("count must be %d or less", maxBlockRange)
+	}
+
+	ledger := protocol.NewInternalLedger()
+	err := batch.Account(m.Network.NodeUrl(protocol.Ledger)).GetStateAs(ledger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the ledger: %v", err)
+	}
+
+	res := new(query.ResponseBlockRange)
+	res.Total = uint64(ledger.Index)
+
+	end := start + count
+	if end > res.Total+1 {
+		end = res.Total + 1
+	}
+
+	indexer := indexing.Block(batch, m.Network.NodeUrl(protocol.Ledger))
+	for height := start; height < end; height++ {
+		block, err := indexer.Get(height)
+		switch {
+		case err == nil:
+			res.Blocks = append(res.Blocks, newBlockResponse(block))
+		case errors.Is(err, storage.ErrNotFound):
+			continue
+		default:
+			return nil, fmt.Errorf("failed to load block %d: %v", height, err)
+		}
+	}
+
+	return res, nil
+}
+
+// queryOracle returns the active and pending oracle prices, the history of
+// the active price, and, on the DN, the feeds the pending price was aggregated
+// from and the latest submission of each feed.
+func (m *Executor) queryOracle(batch *database.Batch, start, count uint64) (*query.ResponseOracle, error) {
+	if count > maxBlockRange {
+		return nil, fmt.Errorf("count must be %d or less", maxBlockRange)
+	}
+
+	ledgerUrl := m.Network.NodeUrl(protocol.Ledger)
+	ledger := protocol.NewInternalLedger()
+	err := batch.Account(ledgerUrl).GetStateAs(ledger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the ledger: %v", err)
+	}
+
+	res := new(query.ResponseOracle)
+	res.Active = ledger.ActiveOracle
+	res.Pending = ledger.PendingOracle
+	res.Sources = ledger.OracleSources
+
+	if m.Network.Type == config.Directory {
+		policy, err := loadOraclePolicy(batch)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, feed := range policy.Feeds {
+			sub, err := indexing.OracleFeed(batch, ledgerUrl, feed).Get()
+			switch {
+			case err == nil:
+				res.Feeds = append(res.Feeds, sub)
+			case errors.Is(err, storage.ErrNotFound):
+				continue
+			default:
+				return nil, fmt.Errorf("failed to load the submission of %v: %v", feed, err)
+			}
+		}
+	}
+
+	history := indexing.OracleHistory(batch, ledgerUrl)
+	res.Total, err = history.Count()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the oracle history: %v", err)
+	}
+
+	end := start + count
+	if end > res.Total {
+		end = res.Total
+	}
+	for i := start; i < end; i++ {
+		entry, err := history.Get(i)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load oracle history entry %d: %v", i, err)
+		}
+		res.History = append(res.History, &query.OraclePrice{Block: entry.Block, Time: entry.Time, Price: entry.Price})
+	}
+
+	return res, nil
+}
+
+// queryKey returns the key pages of the subnet that contain the key with the
+// given hash, and the lite identity and lite accounts of the key, if they are
+// on the subnet. Pages that have been migrated to another subnet are skipped.
+func (m *Executor) queryKey(batch *database.Batch, keyHash [32]byte) (*query.ResponseKey, error) {
+	pages, err := indexing.KeyHash(batch, m.Network.NodeUrl(protocol.Ledger), keyHash).Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the key index: %v", err)
+	}
+
+	res := new(query.ResponseKey)
+	for _, u := range pages {
+		migrated, err := isMigrated(batch.Account(u).Index(migratedIndex))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the migration status of %v: %v", u, err)
+		}
+		if migrated {
+			continue
+		}
+
+		page := new(protocol.KeyPage)
+		err = batch.Account(u).GetStateAs(page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %v: %v", u, err)
+		}
+
+		res.Accounts = append(res.Accounts, &query.KeyAccount{Url: u, Type: page.GetType(), KeyBook: page.KeyBook})
+	}
+
+	// The lite accounts of the key are found by their URL
+	liteId := protocol.LiteIdentityForKeyHash(keyHash[:])
+	lite, err := batch.Account(liteId).GetState()
+	switch {
+	case err == nil:
+		res.Accounts = append(res.Accounts, &query.KeyAccount{Url: liteId, Type: lite.GetType()})
+	case errors.Is(err, storage.ErrNotFound):
+		return res, nil
+	default:
+		return nil, fmt.Errorf("failed to load %v: %v", liteId, err)
+	}
+
+	dir, err := m.queryDirectoryByChainId(batch, liteId.AccountID(), 0, ^uint64(0))
+	switch {
+	case err == nil:
+		// OK
+	case errors.Is(err, storage.ErrNotFound):
+		return res, nil
+	default:
+		return nil, fmt.Errorf("failed to load the directory of %v: %v", liteId, err)
+	}
+
+	for _, entry := range dir.Entries {
+		u, err := url.Parse(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid directory entry %q: %v", entry, err)
+		}
+
+		// The lite identity may be listed in its own directory
+		if u.Equal(liteId) {
+			continue
+		}
+
+		account, err := batch.Account(u).GetState()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %v: %v", u, err)
+		}
+
+		res.Accounts = append(res.Accounts, &query.KeyAccount{Url: u, Type: account.GetType()})
+	}
+
+	return res, nil
+}
+
 func (m *Executor) queryDirectoryByChainId(batch *database.Batch, chainId []byte, start uint64, limit uint64) (*protocol.DirectoryQueryResult, error) {
 	md, err := loadDirectoryMetadata(batch, chainId)
 	if err != nil {
@@ -439,7 +702,18 @@ func (m *Executor) queryByTxId(batch *database.Batch, txid []byte, prove bool) (
 	status, err := tx.GetStatus()
 	if err != nil {
 		return nil, fmt.Errorf("invalid query from GetTx in state database, %v", err)
-	} else if !status.Delivered && status.Remote {
+	}
+
+	// When a pending transaction expires, only the status stored against the
+	// transaction hash is updated, so check it when querying by envelope hash
+	if txHash := txState.Restore().GetTxHash(); status.Pending && !bytes.Equal(txid, txHash) {
+		status, err = batch.Transaction(txHash).GetStatus()
+		if err != nil {
+			return nil, fmt.Errorf("invalid query from GetTx in state database, %v", err)
+		}
+	}
+
+	if !status.Delivered && status.Remote {
 		// If the transaction is a synthetic transaction produced by this BVN
 		// and has not been delivered, pretend like it doesn't exist
 		return nil, fmt.Errorf("tx %X %w", txid, storage.ErrNotFound)
@@ -509,55 +783,17 @@ func (m *Executor) queryByTxId(batch *database.Batch, txid []byte, prove bool) (
 
 	qr.Receipts = make([]*query.TxReceipt, len(chainIndex.Entries))
 	for i, entry := range chainIndex.Entries {
-		receipt := new(query.TxReceipt)
-		qr.Receipts[i] = receipt
-		receipt.Account = entry.Account
-		receipt.Chain = entry.Chain
-		receipt.Receipt.Start = txid
-
-		anchor, err := indexing.DirectoryAnchor(batch, m.Network.NodeUrl(protocol.Ledger)).AnchorForLocalBlock(entry.Block, false)
-		if err != nil {
-			return nil, fmt.Errorf("failed to read anchor for block %d: %v", entry.Block, err)
-		}
-		receipt.DirectoryBlock = anchor.Block
-
-		accountChain, err := batch.Account(entry.Account).ReadChain(entry.Chain)
-		if err != nil {
-			return nil, fmt.Errorf("failed to read chain %s of %v: %v", entry.Chain, entry.Account, err)
-		}
-
-		accountReceipt, err := accountChain.Receipt(int64(entry.ChainEntry), int64(entry.ChainAnchor))
-		if err != nil {
-			return nil, fmt.Errorf("failed to get a receipt %s of %v from %d to %d: %v", entry.Chain, entry.Account, entry.ChainEntry, entry.ChainAnchor, err)
-		}
-
-		rootReceipt, err := rootChain.Receipt(int64(entry.RootEntry), int64(entry.RootAnchor))
-		if err != nil {
-			return nil, fmt.Errorf("failed to get a receipt the minor root chain from %d to %d: %v", entry.RootEntry, entry.RootAnchor, err)
-		}
-
-		r, err := accountReceipt.Combine(rootReceipt)
-		if err != nil {
-			return nil, fmt.Errorf("failed to combined receipts: %v", err)
-		}
-
-		r, err = r.Combine(anchor.Receipt.Convert())
+		proof, err := m.proveTransaction(batch, rootChain, txid, entry)
 		if err != nil {
-			return nil, fmt.Errorf("failed to combined receipts: %v", err)
-		}
-
-		if !bytes.Equal(anchor.RootAnchor[:], r.MDRoot) {
-			return nil, fmt.Errorf("invalid receipt end: want %X, got %X", anchor.RootAnchor, r.MDRoot)
-		}
-
-		if !r.Validate() {
-			return nil, fmt.Errorf("receipt is invalid")
+			return nil, err
 		}
 
-		receipt.Receipt.Entries = make([]protocol.ReceiptEntry, len(r.Nodes))
-		for i, node := range r.Nodes {
-			receipt.Receipt.Entries[i] = protocol.ReceiptEntry{Hash: node.Hash, Right: node.Right}
-		}
+		receipt := new(query.TxReceipt)
+		qr.Receipts[i] = receipt
+		receipt.Account = proof.Account
+		receipt.Chain = proof.Chain
+		receipt.DirectoryBlock = proof.DirectoryBlock
+		receipt.Receipt = proof.Receipt
 	}
 
 	return &qr, nil
@@ -657,10 +893,117 @@ func (m *Executor) queryDataSet(batch *database.Batch, u *url.URL, start int64,
 	return &qr, nil
 }
 
-func (m *Executor) Query(q *query.Query, _ int64, prove bool) (k, v []byte, err *protocol.Error) {
+func (m *Executor) querySignatureStatus(batch *database.Batch, txid []byte) (*query.ResponseSignatureStatus, error) {
+	tx := batch.Transaction(txid)
+	txState, err := tx.GetState()
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, fmt.Errorf("tx %X %w", txid, storage.ErrNotFound)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to load transaction %X: %v", txid, err)
+	}
+
+	status, err := tx.GetStatus()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load status of transaction %X: %v", txid, err)
+	}
+	if !status.Pending {
+		return nil, fmt.Errorf("transaction %X is not pending", txid)
+	}
+
+	signatures, err := tx.GetSignatures()
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, fmt.Errorf("failed to load signatures of transaction %X: %v", txid, err)
+	}
+
+	res := new(query.ResponseSignatureStatus)
+	copy(res.TxId[:], txid)
+	res.Origin = txState.SigInfo.Origin
+
+	var page *protocol.KeyPage
+	res.KeyPage, page, res.Invalidated, err = getSignatorPage(batch, txState.SigInfo)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, fmt.Errorf("%v does not have a key book", res.Origin)
+	}
+	res.Threshold = page.Threshold
+
+	for _, key := range page.Keys {
+		var signed bool
+		for _, sig := range signatures {
+			if bytes.Equal(sig.GetPublicKey(), key.PublicKey) {
+				signed = true
+				break
+			}
+		}
+
+		if signed {
+			res.Signed = append(res.Signed, key.PublicKey)
+		} else {
+			res.Unsigned = append(res.Unsigned, key.PublicKey)
+		}
+	}
+
+	return res, nil
+}
+
+// queryPendingSignatures returns the signature status of the pending
+// transactions of an account. For a key page, these are the transactions
+// waiting on signatures from the page. For any other account, these are the
+// pending transactions originated by the account.
+func (m *Executor) queryPendingSignatures(batch *database.Batch, u *url.URL) (*query.ResponsePendingSignatures, error) {
+	account, err := batch.Account(u).GetState()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %v: %w", u, err)
+	}
+
+	var txids [][32]byte
+	if account.GetType() == protocol.AccountTypeKeyPage {
+		txids, err = indexing.PendingSignatures(batch, u).Get()
+	} else {
+		txids, err = indexing.PendingTransactions(batch, u).Get()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the pending transactions of %v: %v", u, err)
+	}
+
+	res := new(query.ResponsePendingSignatures)
+	res.Url = u
+	for _, txid := range txids {
+		// The index may refer to a transaction that is no longer pending, for
+		// example one that expired or was executed by a different page, so
+		// skip those instead of failing the whole query
+		txStatus, err := batch.Transaction(txid[:]).GetStatus()
+		switch {
+		case errors.Is(err, storage.ErrNotFound):
+			continue
+		case err != nil:
+			return nil, fmt.Errorf("failed to load status of transaction %X: %v", txid, err)
+		case !txStatus.Pending:
+			continue
+		}
+
+		status, err := m.querySignatureStatus(batch, txid[:])
+		if err != nil {
+			return nil, err
+		}
+		res.Transactions = append(res.Transactions, status)
+	}
+
+	return res, nil
+}
+
+func (m *Executor) Query(q *query.Query, height int64, prove bool) (k, v []byte, err *protocol.Error) {
 	batch := m.DB.Begin()
 	defer batch.Discard()
 
+	// Only account URLs can be queried at a height. Other queries would
+	// silently return the current state.
+	if height > 0 && q.Type != types.QueryTypeUrl {
+		return nil, nil, &protocol.Error{Code: protocol.ErrorCodeHeightNotSupported, Message: fmt.Errorf("%v queries cannot be made at a height", q.Type)}
+	}
+
 	switch q.Type {
 	case types.QueryTypeTxId:
 		txr := query.RequestByTxId{}
@@ -706,7 +1049,11 @@ func (m *Executor) Query(q *query.Query, _ int64, prove bool) (k, v []byte, err
 		}
 
 		var obj encoding.BinaryMarshaler
-		k, obj, err = m.queryByUrl(batch, u, prove)
+		if height > 0 {
+			k, obj, err = m.queryByUrlAt(batch, u, uint64(height))
+		} else {
+			k, obj, err = m.queryByUrl(batch, u, prove)
+		}
 		if err != nil {
 			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeTxnQueryError, Message: err}
 		}
@@ -854,6 +1201,174 @@ func (m *Executor) Query(q *query.Query, _ int64, prove bool) (k, v []byte, err
 		if err != nil {
 			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeMarshallingError, Message: err}
 		}
+	case types.QueryTypePendingSignatures:
+		chr := query.RequestPendingSignatures{}
+		err := chr.UnmarshalBinary(q.Content)
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeUnMarshallingError, Message: err}
+		}
+		res, err := m.queryPendingSignatures(batch, chr.Url)
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeNotFound, Message: err}
+		} else if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeTxnQueryError, Message: err}
+		}
+		k = []byte("pending-signatures")
+		v, err = res.MarshalBinary()
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeMarshallingError, Message: err}
+		}
+	case types.QueryTypeSignatureStatus:
+		chr := query.RequestSignatureStatus{}
+		err := chr.UnmarshalBinary(q.Content)
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeUnMarshallingError, Message: err}
+		}
+		res, err := m.querySignatureStatus(batch, chr.TxId[:])
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeNotFound, Message: err}
+		} else if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeTxnQueryError, Message: err}
+		}
+		k = []byte("signature-status")
+		v, err = res.MarshalBinary()
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeMarshallingError, Message: err}
+		}
+	case types.QueryTypeProof:
+		chr := query.RequestProof{}
+		err := chr.UnmarshalBinary(q.Content)
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeUnMarshallingError, Message: err}
+		}
+		res, err := m.queryProof(batch, chr.Url, chr.TxId)
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeNotFound, Message: err}
+		} else if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeTxnQueryError, Message: err}
+		}
+		k = []byte("proof")
+		v, err = res.MarshalBinary()
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeMarshallingError, Message: err}
+		}
+	case types.QueryTypeAnchorProof:
+		chr := query.RequestAnchorProof{}
+		err := chr.UnmarshalBinary(q.Content)
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeUnMarshallingError, Message: err}
+		}
+		res, err := m.proveAnchor(batch, chr.Anchor)
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeNotFound, Message: err}
+		} else if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeTxnQueryError, Message: err}
+		}
+		k = []byte("anchor-proof")
+		v, err = res.MarshalBinary()
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeMarshallingError, Message: err}
+		}
+	case types.QueryTypeSyntheticOutbox:
+		chr := query.RequestSyntheticOutbox{}
+		err := chr.UnmarshalBinary(q.Content)
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeUnMarshallingError, Message: err}
+		}
+		res, err := m.querySyntheticOutbox(batch, chr.MinAge)
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeTxnQueryError, Message: err}
+		}
+		k = []byte("synthetic-outbox")
+		v, err = res.MarshalBinary()
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeMarshallingError, Message: err}
+		}
+	case types.QueryTypeBlock:
+		chr := query.RequestBlock{}
+		err := chr.UnmarshalBinary(q.Content)
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeUnMarshallingError, Message: err}
+		}
+		res, err := m.queryBlock(batch, chr.Height)
+		switch {
+		case err == nil:
+			// OK
+		case errors.Is(err, storage.ErrNotFound):
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeNotFound, Message: fmt.Errorf("block %d not found", chr.Height)}
+		default:
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeTxnQueryError, Message: err}
+		}
+		k = []byte("block")
+		v, err = res.MarshalBinary()
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeMarshallingError, Message: err}
+		}
+	case types.QueryTypeBlockRange:
+		chr := query.RequestBlockRange{}
+		err := chr.UnmarshalBinary(q.Content)
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeUnMarshallingError, Message: err}
+		}
+		res, err := m.queryBlockRange(batch, chr.Start, chr.Count)
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeTxnQueryError, Message: err}
+		}
+		k = []byte("block-range")
+		v, err = res.MarshalBinary()
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeMarshallingError, Message: err}
+		}
+	case types.QueryTypeKey:
+		chr := query.RequestKey{}
+		err := chr.UnmarshalBinary(q.Content)
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeUnMarshallingError, Message: err}
+		}
+		res, err := m.queryKey(batch, chr.KeyHash)
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeTxnQueryError, Message: err}
+		}
+		k = []byte("key")
+		v, err = res.MarshalBinary()
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeMarshallingError, Message: err}
+		}
+	case types.QueryTypeOracle:
+		chr := query.RequestOracle{}
+		err := chr.UnmarshalBinary(q.Content)
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeUnMarshallingError, Message: err}
+		}
+		res, err := m.queryOracle(batch, chr.Start, chr.Count)
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeTxnQueryError, Message: err}
+		}
+		k = []byte("oracle")
+		v, err = res.MarshalBinary()
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeMarshallingError, Message: err}
+		}
+	case types.QueryTypeSimulate:
+		chr := query.RequestSimulate{}
+		err := chr.UnmarshalBinary(q.Content)
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeUnMarshallingError, Message: err}
+		}
+		env := new(transactions.Envelope)
+		err = env.UnmarshalBinary(chr.Envelope)
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeUnMarshallingError, Message: err}
+		}
+		res, perr := m.simulate(batch, env)
+		if perr != nil {
+			return nil, nil, perr
+		}
+		k = []byte("simulate")
+		v, err = res.MarshalBinary()
+		if err != nil {
+			return nil, nil, &protocol.Error{Code: protocol.ErrorCodeMarshallingError, Message: err}
+		}
 	default:
 		return nil, nil, &protocol.Error{Code: protocol.ErrorCodeInvalidQueryType, Message: fmt.Errorf("unable to query for type, %s (%d)", q.Type.Name(), q.Type.AsUint64())}
 	}
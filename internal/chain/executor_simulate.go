@@ -0,0 +1,178 @@
+package chain
+
+import (
+	"errors"
+	"fmt"
+
+	"gitlab.com/accumulatenetwork/accumulate/internal/database"
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
+	"gitlab.com/accumulatenetwork/accumulate/types/api/query"
+	"gitlab.com/accumulatenetwork/accumulate/types/api/transactions"
+)
+
+// simulate executes the transaction the same way DeliverTx does, against the
+// given batch, which the caller must discard. The signatures are not verified,
+// so that a transaction can be simulated before it is signed, but the signer
+// must be authorized and must be able to pay the fee. The response includes
+// the fee, the result, the state of every account that would change before
+// and after the transaction, and the synthetic transactions that would be
+// produced.
+func (m *Executor) simulate(batch *database.Batch, env *transactions.Envelope) (*query.ResponseSimulate, *protocol.Error) {
+	if env.Transaction.Type().IsSynthetic() || env.Transaction.Type().IsInternal() {
+		return nil, &protocol.Error{Code: protocol.ErrorCodeCheckTxError, Message: fmt.Errorf("cannot simulate a %v transaction", env.Transaction.Type())}
+	}
+
+	res := new(query.ResponseSimulate)
+	schedule, err := m.loadFeeSchedule(batch)
+	if err != nil {
+		return nil, &protocol.Error{Code: protocol.ErrorCodeTxnStateError, Message: err}
+	}
+	fee, err := schedule.ComputeFee(env)
+	if err != nil {
+		return nil, &protocol.Error{Code: protocol.ErrorCodeCheckTxError, Message: err}
+	}
+	res.Fee = uint64(fee)
+
+	st, executor, hasEnoughSigs, err := m.validate(batch, env, false)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, &protocol.Error{Code: protocol.ErrorCodeNotFound, Message: err}
+	}
+	if err != nil {
+		return nil, &protocol.Error{Code: protocol.ErrorCodeCheckTxError, Message: fmt.Errorf("txn check failed : %v", err)}
+	}
+
+	// A transaction that does not have enough signatures only updates the
+	// signer
+	var result protocol.TransactionResult = new(protocol.EmptyResult)
+	if hasEnoughSigs {
+		r, err := executor.Validate(st, env)
+		if err != nil {
+			return nil, validationError(protocol.ErrorCodeInvalidTxnError, fmt.Errorf("txn validation failed : %w", err))
+		}
+		if r != nil {
+			result = r
+		}
+	} else {
+		res.Pending = true
+	}
+
+	res.Result, err = result.MarshalBinary()
+	if err != nil {
+		return nil, &protocol.Error{Code: protocol.ErrorCodeMarshallingError, Message: err}
+	}
+
+	// Record the state of the updated accounts before the updates are applied
+	changes, err := simulatedChanges(batch, &st.stateCache)
+	if err != nil {
+		return nil, &protocol.Error{Code: protocol.ErrorCodeTxnStateError, Message: err}
+	}
+
+	submitted, err := st.Commit()
+	if err != nil {
+		return nil, &protocol.Error{Code: protocol.ErrorCodeRecordTxnError, Message: err}
+	}
+
+	// Record the state of the updated accounts after the updates are applied.
+	// Accounts that are created by a synthetic transaction are not written to
+	// the batch, so their state is the record that was created.
+	for _, change := range changes {
+		if change.After != nil {
+			continue
+		}
+
+		account, err := batch.Account(change.Url).GetState()
+		if err != nil {
+			return nil, &protocol.Error{Code: protocol.ErrorCodeTxnStateError, Message: fmt.Errorf("failed to load %v: %v", change.Url, err)}
+		}
+		change.After, err = account.MarshalBinary()
+		if err != nil {
+			return nil, &protocol.Error{Code: protocol.ErrorCodeMarshallingError, Message: err}
+		}
+	}
+	res.Changes = changes
+
+	for _, sub := range submitted {
+		synth, err := m.buildSynthTxn(&st.stateCache, sub.Url, sub.Body)
+		if err != nil {
+			return nil, &protocol.Error{Code: protocol.ErrorCodeSyntheticTxnError, Message: err}
+		}
+
+		data, err := synth.MarshalBinary()
+		if err != nil {
+			return nil, &protocol.Error{Code: protocol.ErrorCodeMarshallingError, Message: err}
+		}
+		res.Synthetic = append(res.Synthetic, data)
+	}
+
+	return res, nil
+}
+
+// simulatedChanges returns the accounts updated or created by the pending
+// operations of the state cache, with their current state. The state after
+// the operations is only set for created accounts.
+func simulatedChanges(batch *database.Batch, st *stateCache) ([]*query.AccountChange, error) {
+	var changes []*query.AccountChange
+	seen := map[[32]byte]*query.AccountChange{}
+	add := func(u *url.URL) (*query.AccountChange, error) {
+		if change, ok := seen[u.AccountID32()]; ok {
+			return change, nil
+		}
+
+		change := new(query.AccountChange)
+		change.Url = u
+		seen[u.AccountID32()] = change
+		changes = append(changes, change)
+
+		account, err := batch.Account(u).GetState()
+		switch {
+		case err == nil:
+			change.Before, err = account.MarshalBinary()
+			if err != nil {
+				return nil, err
+			}
+		case errors.Is(err, storage.ErrNotFound):
+			// The account does not exist yet
+		default:
+			return nil, fmt.Errorf("failed to load %v: %v", u, err)
+		}
+		return change, nil
+	}
+
+	for _, op := range st.operations {
+		switch op := op.(type) {
+		case *updateRecord:
+			_, err := add(op.url)
+			if err != nil {
+				return nil, err
+			}
+
+		case *updateSignator:
+			_, err := add(op.url)
+			if err != nil {
+				return nil, err
+			}
+
+		case *createRecords:
+			for _, record := range op.records {
+				u, err := record.Header().ParseUrl()
+				if err != nil {
+					return nil, err
+				}
+
+				change, err := add(u)
+				if err != nil {
+					return nil, err
+				}
+
+				change.After, err = record.MarshalBinary()
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return changes, nil
+}
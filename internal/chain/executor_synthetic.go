@@ -3,6 +3,7 @@ package chain
 import (
 	"fmt"
 
+	"gitlab.com/accumulatenetwork/accumulate/internal/indexing"
 	"gitlab.com/accumulatenetwork/accumulate/internal/url"
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
 	"gitlab.com/accumulatenetwork/accumulate/types"
@@ -15,6 +16,7 @@ func (m *Executor) addSynthTxns(st *stateCache, submissions []*submission) error
 	// Need to pass this to a threaded batcher / dispatcher to do both signing
 	// and sending of synth tx. No need to spend valuable time here doing that.
 	ids := make([][32]byte, len(submissions))
+	var outbox []*indexing.SyntheticOutboxEntry
 	for i, sub := range submissions {
 		// Generate a synthetic tx and send to the router. Need to track txid to
 		// make sure they get processed.
@@ -39,6 +41,16 @@ func (m *Executor) addSynthTxns(st *stateCache, submissions []*submission) error
 		}
 
 		copy(ids[i][:], tx.GetTxHash())
+		m.didProduce(st.txHash[:], tx)
+
+		// Track the transaction until the destination acknowledges it
+		if protocol.TracksDelivery(tx.Transaction.Type()) {
+			entry := new(indexing.SyntheticOutboxEntry)
+			entry.Transaction = ids[i]
+			entry.Type = tx.Transaction.Type()
+			entry.Destination = sub.Url
+			outbox = append(outbox, entry)
+		}
 	}
 
 	ledgerState := protocol.NewInternalLedger()
@@ -47,6 +59,15 @@ func (m *Executor) addSynthTxns(st *stateCache, submissions []*submission) error
 		return err
 	}
 
+	for _, entry := range outbox {
+		entry.ProducedBlock = uint64(ledgerState.Index)
+		entry.ProducedTime = ledgerState.Timestamp
+		err = st.SyntheticOutbox().Put(entry)
+		if err != nil {
+			return err
+		}
+	}
+
 	ledgerState.Synthetic.Produced = append(ledgerState.Synthetic.Produced, ids...)
 	st.Update(ledgerState)
 	st.AddSyntheticTxns(st.txHash[:], ids)
@@ -89,3 +110,54 @@ func (opts *ExecutorOptions) buildSynthTxn(st *stateCache, dest *url.URL, body p
 	st.Update(ledgerState)
 	return env, nil
 }
+
+// acknowledgeSynthetic records that a synthetic transaction has been received,
+// so that the anchor of the block acknowledges it to the subnet that produced
+// it. If it was produced by this subnet, it is removed from the outbox
+// directly.
+func (m *Executor) acknowledgeSynthetic(env *transactions.Envelope) error {
+	if !protocol.TracksDelivery(env.Transaction.Type()) {
+		return nil
+	}
+
+	ledgerUrl := m.Network.NodeUrl(protocol.Ledger)
+	txid := types.Bytes(env.GetTxHash()).AsBytes32()
+	removed, err := indexing.SyntheticOutbox(m.blockBatch, ledgerUrl).Remove(txid)
+	if err != nil {
+		return fmt.Errorf("failed to update the synthetic outbox: %v", err)
+	}
+	if removed > 0 {
+		return nil
+	}
+
+	ledger := m.blockBatch.Account(ledgerUrl)
+	ledgerState := protocol.NewInternalLedger()
+	err = ledger.GetStateAs(ledgerState)
+	if err != nil {
+		return fmt.Errorf("failed to load the ledger: %v", err)
+	}
+
+	ledgerState.Synthetic.Acknowledgements = append(ledgerState.Synthetic.Acknowledgements, txid)
+	return ledger.PutState(ledgerState)
+}
+
+// Synthetic transactions that have not been acknowledged are resent after
+// synthRetryDelay blocks, doubling after every attempt up to synthMaxRetryDelay
+// blocks.
+const (
+	synthRetryDelay    = 20
+	synthMaxRetryDelay = 2000
+)
+
+// nextSynthAttempt returns the block after which a synthetic transaction that
+// has been sent the given number of times should be sent again.
+func nextSynthAttempt(block, attempts uint64) uint64 {
+	delay := uint64(synthRetryDelay)
+	for i := uint64(1); i < attempts && delay < synthMaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > synthMaxRetryDelay {
+		delay = synthMaxRetryDelay
+	}
+	return block + delay
+}
@@ -10,6 +10,7 @@ import (
 	"gitlab.com/accumulatenetwork/accumulate/internal/database"
 	"gitlab.com/accumulatenetwork/accumulate/internal/indexing"
 	"gitlab.com/accumulatenetwork/accumulate/internal/logging"
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
 	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
 	"gitlab.com/accumulatenetwork/accumulate/types"
@@ -22,7 +23,7 @@ func (m *Executor) CheckTx(env *transactions.Envelope) (protocol.TransactionResu
 	batch := m.DB.Begin()
 	defer batch.Discard()
 
-	st, executor, hasEnoughSigs, err := m.validate(batch, env)
+	st, executor, hasEnoughSigs, err := m.validate(batch, env, true)
 	if errors.Is(err, storage.ErrNotFound) {
 		return nil, &protocol.Error{Code: protocol.ErrorCodeNotFound, Message: err}
 	}
@@ -51,7 +52,7 @@ func (m *Executor) CheckTx(env *transactions.Envelope) (protocol.TransactionResu
 
 	result, err := executor.Validate(st, env)
 	if err != nil {
-		return nil, &protocol.Error{Code: protocol.ErrorCodeValidateTxnError, Message: err}
+		return nil, validationError(protocol.ErrorCodeValidateTxnError, err)
 	}
 	if result == nil {
 		result = new(protocol.EmptyResult)
@@ -67,7 +68,13 @@ func (m *Executor) DeliverTx(env *transactions.Envelope) (protocol.TransactionRe
 	// }
 
 	// Set up the state manager and validate the signatures
-	st, executor, hasEnoughSigs, err := m.validate(m.blockBatch, env)
+	st, executor, hasEnoughSigs, err := m.validate(m.blockBatch, env, true)
+	if errors.Is(err, errDuplicateSynthetic) {
+		// The transaction has already been delivered, so do not overwrite its
+		// status. If the source is sending it again, the source asks for it to
+		// be acknowledged again in its anchor.
+		return nil, &protocol.Error{Code: protocol.ErrorCodeCheckTxError, Message: fmt.Errorf("txn check failed : %v", err)}
+	}
 	if err != nil {
 		return nil, m.recordTransactionError(nil, env, nil, nil, false, &protocol.Error{Code: protocol.ErrorCodeCheckTxError, Message: fmt.Errorf("txn check failed : %v", err)})
 	}
@@ -80,6 +87,11 @@ func (m *Executor) DeliverTx(env *transactions.Envelope) (protocol.TransactionRe
 		}
 
 		status := &protocol.TransactionStatus{Pending: true}
+		err = m.setPendingExpiry(env, status)
+		if err != nil {
+			return nil, &protocol.Error{Code: protocol.ErrorCodeTxnStateError, Message: err}
+		}
+
 		err = m.putTransaction(st, env, nil, nil, status, false)
 		if err != nil {
 			return nil, &protocol.Error{Code: protocol.ErrorCodeTxnStateError, Message: err}
@@ -89,7 +101,12 @@ func (m *Executor) DeliverTx(env *transactions.Envelope) (protocol.TransactionRe
 
 	result, err := executor.Validate(st, env)
 	if err != nil {
-		return nil, m.recordTransactionError(st, env, nil, nil, false, &protocol.Error{Code: protocol.ErrorCodeInvalidTxnError, Message: fmt.Errorf("txn validation failed : %v", err)})
+		// A synthetic transaction that fails has still been delivered
+		ackErr := m.acknowledgeSynthetic(env)
+		if ackErr != nil {
+			return nil, &protocol.Error{Code: protocol.ErrorCodeTxnStateError, Message: ackErr}
+		}
+		return nil, m.recordTransactionError(st, env, nil, nil, false, validationError(protocol.ErrorCodeInvalidTxnError, fmt.Errorf("txn validation failed : %w", err)))
 	}
 	if result == nil {
 		result = new(protocol.EmptyResult)
@@ -141,21 +158,31 @@ func (m *Executor) DeliverTx(env *transactions.Envelope) (protocol.TransactionRe
 		return nil, m.recordTransactionError(st, env, txAccepted, txPending, true, &protocol.Error{Code: protocol.ErrorCodeRecordTxnError, Message: err})
 	}
 
+	err = m.acknowledgeSynthetic(env)
+	if err != nil {
+		return nil, &protocol.Error{Code: protocol.ErrorCodeTxnStateError, Message: err}
+	}
+
 	m.blockMeta.Delivered++
 	return result, nil
 }
 
 // validate validates signatures, verifies they are authorized,
-// updates the nonce, and charges the fee.
-func (m *Executor) validate(batch *database.Batch, env *transactions.Envelope) (st *StateManager, executor TxExecutor, hasEnoughSigs bool, err error) {
+// updates the nonce, and charges the fee. If verify is false, the signatures
+// are not verified, so that an unsigned transaction can be simulated.
+func (m *Executor) validate(batch *database.Batch, env *transactions.Envelope, verify bool) (st *StateManager, executor TxExecutor, hasEnoughSigs bool, err error) {
 	// Basic validation
-	err = m.validateBasic(batch, env)
+	err = m.validateBasic(batch, env, verify)
 	if err != nil {
 		return nil, nil, false, err
 	}
 
 	// Calculate the fee before modifying the transaction
-	fee, err := protocol.ComputeFee(env)
+	schedule, err := m.loadFeeSchedule(batch)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	fee, err := schedule.ComputeFee(env)
 	if err != nil {
 		return nil, nil, false, err
 	}
@@ -185,6 +212,8 @@ func (m *Executor) validate(batch *database.Batch, env *transactions.Envelope) (
 		switch txt {
 		case types.TxTypeSyntheticCreateChain, types.TxTypeSyntheticDepositTokens, types.TxTypeSyntheticWriteData:
 			// TX does not require the origin record to exist
+		case protocol.TransactionTypeSyntheticMigrateAccounts:
+			// The identity may be migrated after its accounts
 		default:
 			return nil, nil, false, fmt.Errorf("origin record not found: %w", err)
 		}
@@ -193,6 +222,18 @@ func (m *Executor) validate(batch *database.Batch, env *transactions.Envelope) (
 	}
 	st.logger.L = m.logger
 
+	// An account that has been migrated to another subnet is left in place but
+	// is no longer used
+	if st.Origin != nil && txt != protocol.TransactionTypeSyntheticMigrateAccounts {
+		migrated, err := isMigrated(batch.Account(st.OriginUrl).Index(migratedIndex))
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("failed to load the migration status of %v: %v", st.OriginUrl, err)
+		}
+		if migrated {
+			return nil, nil, false, fmt.Errorf("%v has been migrated to another subnet", st.OriginUrl)
+		}
+	}
+
 	// Validate the transaction
 	if txt.IsSynthetic() {
 		return st, executor, true, m.validateSynthetic(st, env)
@@ -229,7 +270,7 @@ func (m *Executor) validate(batch *database.Batch, env *transactions.Envelope) (
 	return st, executor, hasEnoughSigs, err
 }
 
-func (m *Executor) validateBasic(batch *database.Batch, env *transactions.Envelope) error {
+func (m *Executor) validateBasic(batch *database.Batch, env *transactions.Envelope, verify bool) error {
 	// If the transaction is borked, the transaction type is probably invalid,
 	// so check that first. "Invalid transaction type" is a more useful error
 	// than "invalid signature" if the real error is the transaction got borked.
@@ -272,8 +313,13 @@ func (m *Executor) validateBasic(batch *database.Batch, env *transactions.Envelo
 		return fmt.Errorf("invalid signature transaction: missing transaction hash")
 	}
 
+	// The transaction must not have expired
+	if env.Transaction.Expiry != 0 && env.Transaction.Expiry < uint64(m.blockIndex) {
+		return fmt.Errorf("transaction has expired")
+	}
+
 	// Verify the transaction's signatures
-	if !env.Verify() {
+	if verify && !env.Verify() {
 		return fmt.Errorf("invalid signature(s)")
 	}
 
@@ -293,6 +339,9 @@ func (m *Executor) validateBasic(batch *database.Batch, env *transactions.Envelo
 	status, err := batch.Transaction(env.GetTxHash()).GetStatus()
 	switch {
 	case err == nil:
+		if status.Code == protocol.ErrorCodeExpired.ID() {
+			return fmt.Errorf("transaction has expired")
+		}
 		if status.Delivered {
 			return fmt.Errorf("transaction has already been delivered")
 		}
@@ -307,6 +356,216 @@ func (m *Executor) validateBasic(batch *database.Batch, env *transactions.Envelo
 	return nil
 }
 
+// setPendingExpiry sets the height at which a pending transaction expires. A
+// transaction that is already pending keeps the expiry it was first given.
+// Otherwise the expiry is taken from the transaction header or, if that is
+// unset, from the network globals.
+func (m *Executor) setPendingExpiry(env *transactions.Envelope, status *protocol.TransactionStatus) error {
+	prev, err := m.blockBatch.Transaction(env.GetTxHash()).GetStatus()
+	switch {
+	case err == nil && prev.Pending:
+		status.Expiry = prev.Expiry
+		return nil
+	case err == nil, errors.Is(err, storage.ErrNotFound):
+		// New pending transaction
+	default:
+		return fmt.Errorf("failed to load transaction status: %v", err)
+	}
+
+	globals, err := m.loadGlobals(m.blockBatch)
+	if err != nil {
+		return err
+	}
+
+	// The expiry of the header is capped by the network's limit, otherwise a
+	// transaction could be kept pending indefinitely
+	limit := uint64(m.blockIndex) + globals.PendingExpiry
+	switch {
+	case env.Transaction.Expiry != 0 && (globals.PendingExpiry == 0 || env.Transaction.Expiry < limit):
+		status.Expiry = env.Transaction.Expiry
+	case globals.PendingExpiry != 0:
+		status.Expiry = limit
+	default:
+		return nil
+	}
+
+	var txid [32]byte
+	copy(txid[:], env.GetTxHash())
+	err = indexing.ExpiringTransactions(m.blockBatch, m.Network.NodeUrl(protocol.Ledger), status.Expiry).Add(txid)
+	if err != nil {
+		return fmt.Errorf("failed to add transaction to the expiry index: %v", err)
+	}
+	return nil
+}
+
+// expirePendingTransactions gives every transaction that expires in the
+// current block and is still pending a terminal status, and removes it from
+// the pending lists.
+func (m *Executor) expirePendingTransactions() error {
+	expiring := indexing.ExpiringTransactions(m.blockBatch, m.Network.NodeUrl(protocol.Ledger), uint64(m.blockIndex))
+	txids, err := expiring.Get()
+	if err != nil {
+		return fmt.Errorf("failed to load the expiry index: %v", err)
+	}
+	if len(txids) == 0 {
+		return nil
+	}
+
+	for _, txid := range txids {
+		record := m.blockBatch.Transaction(txid[:])
+		status, err := record.GetStatus()
+		if err != nil {
+			return fmt.Errorf("failed to load status of %X: %v", txid, err)
+		}
+
+		// Skip transactions that have been executed since
+		if !status.Pending {
+			continue
+		}
+
+		txState, err := record.GetState()
+		if err != nil {
+			return fmt.Errorf("failed to load state of %X: %v", txid, err)
+		}
+
+		status.Pending = false
+		status.Delivered = true
+		status.Code = protocol.ErrorCodeExpired.ID()
+		status.Message = "transaction expired"
+		err = record.PutStatus(status)
+		if err != nil {
+			return fmt.Errorf("failed to store status of %X: %v", txid, err)
+		}
+		m.didRecordTransaction(txid[:], txState.SigInfo.Origin, txState.Transaction.GetType(), status)
+
+		err = indexing.PendingTransactions(m.blockBatch, txState.SigInfo.Origin).Remove(txid)
+		if err != nil {
+			return fmt.Errorf("failed to remove %X from the pending list: %v", txid, err)
+		}
+
+		pageUrl, _, _, err := getSignatorPage(m.blockBatch, txState.SigInfo)
+		if err != nil {
+			return fmt.Errorf("failed to load the key page of %X: %v", txid, err)
+		}
+		if pageUrl != nil {
+			err = indexing.PendingSignatures(m.blockBatch, pageUrl).Remove(txid)
+			if err != nil {
+				return fmt.Errorf("failed to remove %X from the key page's pending list: %v", txid, err)
+			}
+		}
+
+		m.blockMeta.Expired++
+	}
+
+	return expiring.Clear()
+}
+
+// applyKeyPageOperations applies the time-locked key page operations that
+// take effect in the current block.
+func (m *Executor) applyKeyPageOperations() error {
+	maturing := indexing.MaturingKeyPageOperations(m.blockBatch, m.Network.NodeUrl(protocol.Ledger), uint64(m.blockIndex))
+	pages, err := maturing.Get()
+	if err != nil {
+		return fmt.Errorf("failed to load the key page operation index: %v", err)
+	}
+	if len(pages) == 0 {
+		return nil
+	}
+
+	for _, pageUrl := range pages {
+		page := new(protocol.KeyPage)
+		err = m.blockBatch.Account(pageUrl).GetStateAs(page)
+		if err != nil {
+			m.logError("Failed to load a key page with time-locked operations", "page", pageUrl, "error", err)
+			continue
+		}
+
+		// Operations are applied in the order they were scheduled. Cancelled
+		// operations are no longer on the page.
+		var due []*protocol.PendingKeyPageOperation
+		for _, op := range page.PendingOperations {
+			if op.EffectiveAt <= uint64(m.blockIndex) {
+				due = append(due, op)
+			}
+		}
+
+		for _, op := range due {
+			err = m.applyKeyPageOperation(pageUrl, op)
+			if err != nil {
+				m.logError("Failed to apply a time-locked key page operation", "txid", logging.AsHex(op.Txid), "page", pageUrl, "error", err)
+			}
+		}
+	}
+
+	return maturing.Clear()
+}
+
+// applyKeyPageOperation applies a time-locked key page operation as if it were
+// delivered by the transaction that scheduled it.
+func (m *Executor) applyKeyPageOperation(pageUrl *url.URL, op *protocol.PendingKeyPageOperation) error {
+	env := new(transactions.Envelope)
+	env.TxHash = op.Txid[:]
+	env.Transaction = new(transactions.Transaction)
+	env.Transaction.Origin = pageUrl
+	env.Transaction.Body = op.Operation
+
+	st, err := NewStateManager(m.blockBatch, m.Network.NodeUrl(), env)
+	if err != nil {
+		return err
+	}
+	st.logger.L = m.logger
+
+	page, ok := st.Origin.(*protocol.KeyPage)
+	if !ok {
+		return fmt.Errorf("invalid origin record: want account type %v, got %v", protocol.AccountTypeKeyPage, st.Origin.GetType())
+	}
+	page.RemovePendingOperation(op.Txid)
+
+	// Operations other than unfreezing do not take effect while the page is
+	// frozen, unless it was frozen by a single signature. Time-locked
+	// operations are signed by the threshold, so they override such a freeze.
+	switch {
+	case page.Frozen && !page.FrozenBySingleSignature && op.Operation.Operation != protocol.KeyPageOperationUnfreeze:
+		m.logInfo("Dropping a time-locked operation of a frozen key page", "txid", logging.AsHex(op.Txid), "page", pageUrl)
+
+	default:
+		bookUrl, priority, err := keyPagePriority(st, page)
+		if err != nil {
+			return err
+		}
+
+		// Apply the operation to a copy so that, if it is no longer valid, it
+		// is dropped without modifying the page
+		updated := new(protocol.KeyPage)
+		err = copyAccount(updated, page)
+		if err != nil {
+			return err
+		}
+		err = applyKeyPageOperation(updated, op.Operation, priority)
+		if err != nil {
+			m.logInfo("Dropping an invalid time-locked key page operation", "txid", logging.AsHex(op.Txid), "page", pageUrl, "error", err)
+			break
+		}
+
+		page = updated
+		for _, key := range page.Keys {
+			key.Nonce = 0
+		}
+		addKeyPageValidator(st, op.Operation, bookUrl)
+	}
+
+	st.Update(page)
+	_, err = st.Commit()
+	if err != nil {
+		return err
+	}
+
+	m.newValidators = append(m.newValidators, st.newValidators...)
+	return nil
+}
+
+var errDuplicateSynthetic = errors.New("duplicate synthetic transaction")
+
 func (m *Executor) validateSynthetic(st *StateManager, env *transactions.Envelope) error {
 	//placeholder for special validation rules for synthetic transactions.
 	//need to verify the sender is a legit bvc validator also need the dbvc receipt
@@ -319,7 +578,7 @@ func (m *Executor) validateSynthetic(st *StateManager, env *transactions.Envelop
 	v := st.RecordIndex(m.Network.NodeUrl(), "SeenSynth", env.GetTxHash())
 	_, err := v.Get()
 	if err == nil {
-		return fmt.Errorf("duplicate synthetic transaction %X", env.GetTxHash())
+		return fmt.Errorf("%w %X", errDuplicateSynthetic, env.GetTxHash())
 	} else if errors.Is(err, storage.ErrNotFound) {
 		v.Put([]byte{1})
 	} else {
@@ -348,6 +607,14 @@ func (m *Executor) validateAgainstBook(st *StateManager, env *transactions.Envel
 		return false, fmt.Errorf("invalid sig spec: %v", err)
 	}
 
+	// A frozen page can only sign the operations that manage the freeze. If
+	// the page was frozen by a single signature, it can also sign other key
+	// page operations, which must meet its threshold.
+	updatePage, _ := env.Transaction.Body.(*protocol.UpdateKeyPage)
+	if page.Frozen && (updatePage == nil || !updatePage.Operation.AllowedWhileFrozen() && !page.FrozenBySingleSignature) {
+		return false, fmt.Errorf("key page %q is frozen", st.SignatorUrl)
+	}
+
 	height, err := st.GetHeight(st.SignatorUrl)
 	if err != nil {
 		return false, err
@@ -357,7 +624,7 @@ func (m *Executor) validateAgainstBook(st *StateManager, env *transactions.Envel
 	}
 
 	for i, sig := range env.Signatures {
-		ks := page.FindKey(sig.GetPublicKey())
+		ks := page.FindKeyOfType(sig.Type(), sig.GetPublicKey())
 		if ks == nil {
 			return false, fmt.Errorf("no key spec matches signature %d", i)
 		}
@@ -386,6 +653,11 @@ func (m *Executor) validateAgainstBook(st *StateManager, env *transactions.Envel
 		return false, fmt.Errorf("failed to add signatures: %v", err)
 	}
 
+	// Any key of the page can cancel a time-locked operation or freeze the page
+	if updatePage != nil && updatePage.Operation.AllowsSingleSignature() {
+		return sigCount >= 1, nil
+	}
+
 	// If the number of signatures is less than the threshold, the transaction is pending
 	return sigCount >= int(page.Threshold), nil
 }
@@ -466,6 +738,8 @@ func (m *Executor) putTransaction(st *StateManager, env *transactions.Envelope,
 		return fmt.Errorf("failed to store transaction: %v", err)
 	}
 
+	m.didRecordTransaction(env.GetTxHash(), env.Transaction.Origin, txt, status)
+
 	if st == nil {
 		return nil // Check failed
 	}
@@ -488,6 +762,22 @@ func (m *Executor) putTransaction(st *StateManager, env *transactions.Envelope,
 		}
 	}
 
+	// Update the key page's list of transactions waiting on its signatures
+	if st.SignatorUrl != nil && !txt.IsSynthetic() {
+		waiting := indexing.PendingSignatures(m.blockBatch, st.SignatorUrl)
+		if status.Pending {
+			err := waiting.Add(st.txHash)
+			if err != nil {
+				return fmt.Errorf("failed to add transaction to the key page's pending list: %v", err)
+			}
+		} else if status.Delivered {
+			err := waiting.Remove(st.txHash)
+			if err != nil {
+				return fmt.Errorf("failed to remove transaction from the key page's pending list: %v", err)
+			}
+		}
+	}
+
 	// Add the transaction to the origin's main chain, unless it's pending
 	if !status.Pending {
 		err = addChainEntry(m.Network.NodeUrl(), m.blockBatch, st.OriginUrl, protocol.MainChain, protocol.ChainTypeTransaction, env.GetTxHash(), 0, 0)
@@ -536,7 +826,11 @@ func (m *Executor) putTransaction(st *StateManager, env *transactions.Envelope,
 		return err
 	}
 
-	fee, err := protocol.ComputeFee(env)
+	schedule, err := m.loadFeeSchedule(m.blockBatch)
+	if err != nil {
+		return err
+	}
+	fee, err := schedule.ComputeFee(env)
 	if err != nil || fee > protocol.FeeFailedMaximum {
 		fee = protocol.FeeFailedMaximum
 	}
@@ -544,3 +838,14 @@ func (m *Executor) putTransaction(st *StateManager, env *transactions.Envelope,
 
 	return sigRecord.PutState(st.Signator)
 }
+
+// validationError wraps an error returned by a transaction executor with the
+// given code, unless the executor returned a protocol error, in which case its
+// code is preserved.
+func validationError(code protocol.ErrorCode, err error) *protocol.Error {
+	var perr *protocol.Error
+	if errors.As(err, &perr) {
+		code = perr.Code
+	}
+	return &protocol.Error{Code: code, Message: err}
+}
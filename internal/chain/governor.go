@@ -10,6 +10,7 @@ import (
 
 	"gitlab.com/accumulatenetwork/accumulate/config"
 	"gitlab.com/accumulatenetwork/accumulate/internal/database"
+	"gitlab.com/accumulatenetwork/accumulate/internal/indexing"
 	"gitlab.com/accumulatenetwork/accumulate/internal/logging"
 	"gitlab.com/accumulatenetwork/accumulate/internal/url"
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
@@ -239,6 +240,11 @@ func (g *governor) runDidCommit(msg *govDidCommit) {
 	g.signTransactions(batch, msg.ledger)
 	g.sendTransactions(batch, msg.ledger)
 
+	// Migrate accounts after the routing table changes
+	if msg.ledger.RoutingMigration {
+		g.sendMigration(batch)
+	}
+
 	// Dispatch transactions asynchronously
 	errs := g.dispatcher.Send(context.Background())
 	go func() {
@@ -294,15 +300,30 @@ func (g *governor) signTransactions(batch *database.Batch, ledger *protocol.Inte
 }
 
 func (g *governor) sendTransactions(batch *database.Batch, ledger *protocol.InternalLedger) {
-	if len(ledger.Synthetic.Unsent) == 0 {
+	// Resend transactions that have not been acknowledged in time
+	ids := ledger.Synthetic.Unsent
+	outbox, err := indexing.SyntheticOutbox(batch, g.Network.NodeUrl(protocol.Ledger)).Entries()
+	if err != nil {
+		g.logger.Error("Failed to load the synthetic outbox", "error", err)
+	}
+	for _, entry := range outbox {
+		if entry.Attempts == 0 || entry.NextAttempt > uint64(ledger.Index) {
+			continue
+		}
+
+		g.logger.Info("Resending synth txn", "txid", logging.AsHex(entry.Transaction), "destination", entry.Destination, "attempts", entry.Attempts, "age", entry.Age(uint64(ledger.Index)))
+		ids = append(ids[:len(ids):len(ids)], entry.Transaction)
+	}
+
+	if len(ids) == 0 {
 		return
 	}
 
 	body := new(protocol.InternalTransactionsSent)
-	body.Transactions = make([][32]byte, 0, len(ledger.Synthetic.Unsent))
+	body.Transactions = make([][32]byte, 0, len(ids))
 
 	// For each unsent synthetic transaction
-	for _, id := range ledger.Synthetic.Unsent {
+	for _, id := range ids {
 		// Load it
 		pending, _, signatures, err := batch.Transaction(id[:]).Get()
 		if err != nil {
@@ -353,6 +374,8 @@ func (g *governor) sendAnchor(batch *database.Batch, msg *govDidCommit, synthCou
 	body.Source = g.Network.NodeUrl()
 	body.RootIndex = uint64(msg.rootHeight - 1)
 	body.Block = uint64(msg.ledger.Index)
+	body.Acknowledgements = msg.ledger.Synthetic.Acknowledgements
+	body.Unacknowledged = msg.ledger.Synthetic.Unacknowledged
 	copy(body.RootAnchor[:], msg.rootAnchor)
 
 	kv := []interface{}{"root", logging.AsHex(body.RootAnchor)}
@@ -374,6 +397,9 @@ func (g *governor) sendAnchor(batch *database.Batch, msg *govDidCommit, synthCou
 	case config.Directory:
 		// If we are the dn, we need to include the ACME oracle price
 		body.AcmeOraclePrice = msg.ledger.PendingOracle
+		body.FeeSchedule = msg.ledger.PendingFeeSchedule
+		body.RoutingTable = msg.ledger.PendingRoutingTable
+		body.Globals = msg.ledger.PendingGlobals
 
 		// Send anchors from DN to all BVNs
 		bvnNames := g.Network.GetBvnNames()
@@ -403,6 +429,19 @@ func (g *governor) sendAnchor(batch *database.Batch, msg *govDidCommit, synthCou
 	g.sendInternal(batch, txns)
 }
 
+func (g *governor) sendMigration(batch *database.Batch) {
+	accounts, err := indexing.MigrationQueue(batch, g.Network.NodeUrl(protocol.Ledger)).Peek(maxMigrationBatch)
+	if err != nil {
+		g.logger.Error("Failed to load the migration queue", "error", err)
+		return
+	}
+
+	g.logger.Info("Migrating accounts", "count", len(accounts))
+	body := new(protocol.InternalMigrateAccounts)
+	body.Accounts = accounts
+	g.sendInternal(batch, body)
+}
+
 func (g *governor) sendMirror(batch *database.Batch) {
 	mirror := new(protocol.SyntheticMirror)
 
@@ -1,9 +1,11 @@
 package chain
 
 import (
+	"errors"
 	"fmt"
 
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
 	"gitlab.com/accumulatenetwork/accumulate/types"
 	"gitlab.com/accumulatenetwork/accumulate/types/api/transactions"
 )
@@ -38,6 +40,31 @@ func (InternalTransactionsSent) Validate(st *StateManager, tx *transactions.Enve
 		}
 	}
 
+	// Schedule the next attempt of transactions that are waiting to be
+	// acknowledged. If a transaction is being resent, its destination may have
+	// received it and the acknowledgement may have been lost, so the anchor of
+	// the block asks the destination to acknowledge it again.
+	outbox := st.SyntheticOutbox()
+	for _, id := range body.Transactions {
+		entry, err := outbox.Get(id)
+		if errors.Is(err, storage.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to load the outbox entry of %X: %v", id, err)
+		}
+
+		if entry.Attempts > 0 {
+			ledger.Synthetic.Unacknowledged = append(ledger.Synthetic.Unacknowledged, id)
+		}
+
+		entry.Attempts++
+		entry.NextAttempt = nextSynthAttempt(uint64(ledger.Index), entry.Attempts)
+		err = outbox.Put(entry)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update the outbox entry of %X: %v", id, err)
+		}
+	}
+
 	st.Update(ledger)
 	return nil, nil
 }
@@ -23,10 +23,13 @@ func (IssueTokens) Validate(st *StateManager, tx *transactions.Envelope) (protoc
 		return nil, fmt.Errorf("invalid origin record: want chain type %v, got %v", protocol.AccountTypeTokenIssuer, st.Origin.GetType())
 	}
 
-	if issuer.Supply.Cmp(&body.Amount) < 0 && issuer.HasSupplyLimit {
-		return nil, fmt.Errorf("can't issue more than the limited supply")
+	if body.Amount.Sign() <= 0 {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+
+	if !issuer.Issue(&body.Amount) {
+		return nil, fmt.Errorf("can't issue %s, the remaining supply is %s", body.Amount.String(), issuer.RemainingSupply().String())
 	}
-	issuer.Supply.Sub(&issuer.Supply, &body.Amount)
 
 	deposit := new(protocol.SyntheticDepositTokens)
 	copy(deposit.Cause[:], tx.GetTxHash())
@@ -0,0 +1,63 @@
+package chain
+
+import (
+	"crypto/sha256"
+
+	"gitlab.com/accumulatenetwork/accumulate/internal/database"
+	"gitlab.com/accumulatenetwork/accumulate/internal/indexing"
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+	"gitlab.com/accumulatenetwork/accumulate/types/state"
+)
+
+// keyHashes returns the hashes of the keys of a key page. Other accounts have
+// no keys.
+func keyHashes(record state.Chain) map[[32]byte]bool {
+	page, ok := record.(*protocol.KeyPage)
+	if !ok {
+		return nil
+	}
+
+	hashes := make(map[[32]byte]bool, len(page.Keys))
+	for _, key := range page.Keys {
+		hashes[sha256.Sum256(key.PublicKey)] = true
+	}
+	return hashes
+}
+
+// updateKeyHashIndex updates the key hash index when an account is written.
+// Every write of a key page goes through here, whether the page is created by
+// genesis or CreateKeyPage, or updated by UpdateKeyPage, so the index tracks
+// the keys of every page of the subnet. Old is nil if the account is new.
+func updateKeyHashIndex(batch *database.Batch, nodeUrl, account *url.URL, old, record state.Chain) error {
+	var oldHashes map[[32]byte]bool
+	if old != nil {
+		oldHashes = keyHashes(old)
+	}
+	newHashes := keyHashes(record)
+
+	ledger := nodeUrl.JoinPath(protocol.Ledger)
+	for hash := range oldHashes {
+		if newHashes[hash] {
+			continue
+		}
+
+		err := indexing.KeyHash(batch, ledger, hash).Remove(account)
+		if err != nil {
+			return err
+		}
+	}
+
+	for hash := range newHashes {
+		if oldHashes[hash] {
+			continue
+		}
+
+		err := indexing.KeyHash(batch, ledger, hash).Add(account)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,314 @@
+package chain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gitlab.com/accumulatenetwork/accumulate/internal/database"
+	"gitlab.com/accumulatenetwork/accumulate/internal/indexing"
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
+	"gitlab.com/accumulatenetwork/accumulate/types/api/transactions"
+)
+
+// When the routing table changes, each BVN queues the accounts that the new
+// table routes to another subnet. The BPT is walked once, when the table is
+// activated. Every block, the governor of the BVN sends an internal transaction
+// for up to maxMigrationBatch accounts from the front of the queue. The
+// executor sends the state of the accounts to their new subnet, marks them as
+// migrated, and removes them from the queue. A migrated account is left in
+// place, but transactions for it are rejected. Once the queue is empty, the
+// migration ends.
+//
+// If an account already exists on its new subnet, the new subnet keeps its
+// own account and returns the migrated one. The source then clears the mark,
+// so the state of the account is not lost, though transactions for it are
+// routed to the other subnet until the conflict is resolved.
+//
+// The history of a migrated account (its chains) is not moved. Since the BVNs
+// do not activate the new table in the same block, a transaction that is
+// routed to the new subnet before the account arrives may fail.
+const maxMigrationBatch = 20
+
+// migratedIndex is the index that marks an account as migrated.
+const migratedIndex = "Migrated"
+
+// isMigrated returns true if the migrated index of an account is set.
+func isMigrated(index interface{ Get() ([]byte, error) }) (bool, error) {
+	v, err := index.Get()
+	switch {
+	case err == nil:
+		return len(v) > 0, nil
+	case errors.Is(err, storage.ErrNotFound):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// isMigratable returns false for system accounts, which are not migrated, and
+// for the faucet, which exists on every BVN.
+func isMigratable(u *url.URL) bool {
+	if _, ok := protocol.ParseBvnUrl(u); ok {
+		return false
+	}
+	return !protocol.BelongsToDn(u) && !u.Equal(protocol.FaucetUrl)
+}
+
+// queueMigration queues the accounts that the routing table routes to another
+// subnet and returns the number of accounts in the queue.
+func (m *Executor) queueMigration(table *protocol.RoutingTable) (uint64, error) {
+	var accounts []*url.URL
+	err := m.blockBatch.ForEachAccount(func(record *database.Account) error {
+		state, err := record.GetState()
+		if err != nil {
+			return err
+		}
+
+		u := state.Header().Url
+		if !isMigratable(u) {
+			return nil
+		}
+
+		subnet, err := table.Route(u.Routing())
+		if err != nil {
+			return err
+		}
+		if strings.EqualFold(subnet, m.Network.LocalSubnetID) {
+			return nil
+		}
+
+		migrated, err := isMigrated(record.Index(migratedIndex))
+		if err != nil || migrated {
+			return err
+		}
+
+		accounts = append(accounts, u)
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to find accounts to migrate: %v", err)
+	}
+
+	// Replace the queue of a previous table
+	queue := indexing.MigrationQueue(m.blockBatch, m.Network.NodeUrl(protocol.Ledger))
+	err = queue.Clear()
+	if err != nil {
+		return 0, fmt.Errorf("failed to clear the migration queue: %v", err)
+	}
+	err = queue.Push(accounts...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to queue accounts to migrate: %v", err)
+	}
+
+	m.logInfo("Queued accounts to migrate", "count", len(accounts))
+	return uint64(len(accounts)), nil
+}
+
+type InternalMigrateAccounts struct{}
+
+func (InternalMigrateAccounts) Type() protocol.TransactionType {
+	return protocol.TransactionTypeInternalMigrateAccounts
+}
+
+func (InternalMigrateAccounts) Validate(st *StateManager, tx *transactions.Envelope) (protocol.TransactionResult, error) {
+	body, ok := tx.Transaction.Body.(*protocol.InternalMigrateAccounts)
+	if !ok {
+		return nil, fmt.Errorf("invalid payload: want %T, got %T", new(protocol.InternalMigrateAccounts), tx.Transaction.Body)
+	}
+
+	// The accounts must be the front of the queue
+	queue := st.MigrationQueue()
+	head, err := queue.Peek(uint64(len(body.Accounts)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the migration queue: %v", err)
+	}
+	if len(head) != len(body.Accounts) {
+		return nil, fmt.Errorf("want at most %d accounts, got %d", len(head), len(body.Accounts))
+	}
+	for i, u := range body.Accounts {
+		if !u.Equal(head[i]) {
+			return nil, fmt.Errorf("%v is not at the front of the migration queue", u)
+		}
+	}
+
+	// Accounts of the same identity are routed together, so group them by
+	// identity
+	var identities []*url.URL
+	migrations := map[string]*protocol.SyntheticMigrateAccounts{}
+	for _, u := range body.Accounts {
+		index := st.RecordIndex(u, migratedIndex)
+		migrated, err := isMigrated(index)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the migration status of %v: %v", u, err)
+		}
+		if migrated {
+			continue
+		}
+
+		record, err := mirrorRecord(st.batch, u)
+		if err != nil {
+			return nil, err
+		}
+
+		id := u.Identity()
+		key := strings.ToLower(id.String())
+		migration, ok := migrations[key]
+		if !ok {
+			migration = new(protocol.SyntheticMigrateAccounts)
+			copy(migration.Cause[:], tx.GetTxHash())
+			migration.Source = st.nodeUrl
+			migrations[key] = migration
+			identities = append(identities, id)
+		}
+
+		st.logger.Info("Migrating account", "url", u)
+		migration.Accounts = append(migration.Accounts, record)
+		index.Put([]byte{1})
+	}
+
+	for _, id := range identities {
+		st.Submit(id, migrations[strings.ToLower(id.String())])
+	}
+
+	err = queue.Pop(uint64(len(body.Accounts)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update the migration queue: %v", err)
+	}
+
+	// End the migration once the queue is empty
+	remaining, err := queue.Len()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the migration queue: %v", err)
+	}
+	if remaining > 0 {
+		return nil, nil
+	}
+
+	ledgerState := protocol.NewInternalLedger()
+	err = st.LoadUrlAs(st.nodeUrl.JoinPath(protocol.Ledger), ledgerState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the ledger: %v", err)
+	}
+
+	st.logger.Info("Account migration is complete")
+	ledgerState.RoutingMigration = false
+	st.Update(ledgerState)
+	return nil, nil
+}
+
+type SyntheticMigrateAccounts struct{}
+
+func (SyntheticMigrateAccounts) Type() protocol.TransactionType {
+	return protocol.TransactionTypeSyntheticMigrateAccounts
+}
+
+func (SyntheticMigrateAccounts) Validate(st *StateManager, tx *transactions.Envelope) (protocol.TransactionResult, error) {
+	body, ok := tx.Transaction.Body.(*protocol.SyntheticMigrateAccounts)
+	if !ok {
+		return nil, fmt.Errorf("invalid payload: want %T, got %T", new(protocol.SyntheticMigrateAccounts), tx.Transaction.Body)
+	}
+
+	if body.Cause == [32]byte{} {
+		return nil, fmt.Errorf("cause is missing")
+	}
+	if body.Source == nil {
+		return nil, fmt.Errorf("source is missing")
+	}
+
+	var returned []*url.URL
+	for _, obj := range body.Accounts {
+		record, err := protocol.UnmarshalAccount(obj.Record)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal record: %v", err)
+		}
+
+		u, err := record.Header().ParseUrl()
+		if err != nil {
+			return nil, fmt.Errorf("invalid chain URL: %v", record.Header().Url)
+		}
+
+		if !u.Identity().Equal(tx.Transaction.Origin) {
+			return nil, fmt.Errorf("%v does not belong to %v", u, tx.Transaction.Origin)
+		}
+
+		_, err = st.LoadUrl(u)
+		switch {
+		case err == nil:
+			// The account may be returning to this subnet
+			index := st.RecordIndex(u, migratedIndex)
+			migrated, err := isMigrated(index)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load the migration status of %v: %v", u, err)
+			}
+			if !migrated {
+				// Keep the existing account and send the migrated one back
+				st.logger.Error("Cannot migrate account: it already exists", "url", u, "source", body.Source)
+				returned = append(returned, u)
+				continue
+			}
+			index.Put(nil)
+
+		case errors.Is(err, storage.ErrNotFound):
+			err = st.AddDirectoryEntry(u)
+			if err != nil {
+				return nil, fmt.Errorf("failed to add a directory entry for %v: %v", u, err)
+			}
+
+		default:
+			return nil, fmt.Errorf("error fetching %q: %v", u, err)
+		}
+
+		st.logger.Info("Received migrated account", "url", u)
+		st.Update(record)
+	}
+
+	if len(returned) > 0 {
+		ret := new(protocol.SyntheticReturnAccounts)
+		copy(ret.Cause[:], tx.GetTxHash())
+		ret.Accounts = returned
+		st.Submit(body.Source, ret)
+	}
+
+	return nil, nil
+}
+
+type SyntheticReturnAccounts struct{}
+
+func (SyntheticReturnAccounts) Type() protocol.TransactionType {
+	return protocol.TransactionTypeSyntheticReturnAccounts
+}
+
+func (SyntheticReturnAccounts) Validate(st *StateManager, tx *transactions.Envelope) (protocol.TransactionResult, error) {
+	body, ok := tx.Transaction.Body.(*protocol.SyntheticReturnAccounts)
+	if !ok {
+		return nil, fmt.Errorf("invalid payload: want %T, got %T", new(protocol.SyntheticReturnAccounts), tx.Transaction.Body)
+	}
+
+	if body.Cause == [32]byte{} {
+		return nil, fmt.Errorf("cause is missing")
+	}
+
+	if !st.nodeUrl.Equal(tx.Transaction.Origin) {
+		return nil, fmt.Errorf("invalid origin: want %v, got %v", st.nodeUrl, tx.Transaction.Origin)
+	}
+
+	// Clear the mark so the account is used again
+	for _, u := range body.Accounts {
+		index := st.RecordIndex(u, migratedIndex)
+		migrated, err := isMigrated(index)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the migration status of %v: %v", u, err)
+		}
+		if !migrated {
+			continue
+		}
+
+		st.logger.Error("Migration of account was rejected: it already exists on its new subnet", "url", u)
+		index.Put(nil)
+	}
+
+	return nil, nil
+}
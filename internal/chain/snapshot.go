@@ -0,0 +1,75 @@
+package chain
+
+import (
+	"fmt"
+
+	"gitlab.com/accumulatenetwork/accumulate/config"
+	"gitlab.com/accumulatenetwork/accumulate/internal/database"
+	"gitlab.com/accumulatenetwork/accumulate/internal/indexing"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+)
+
+// SnapshotIndexes returns the index values that transactions depend on but
+// that are not part of any account's state. Values that have not been written,
+// such as the faucet's accounting on the DN, are skipped by the snapshot.
+func (m *Executor) SnapshotIndexes(batch *database.Batch) ([]*database.Value, error) {
+	var indexes []*database.Value
+
+	// The faucet's accounting decides whether a faucet request succeeds
+	indexes = append(indexes, batch.Account(protocol.FaucetUrl).Index(faucetLedgerKey...))
+
+	// The synthetic outbox decides which transactions are resent and which
+	// must be acknowledged again
+	ledgerUrl := m.Network.NodeUrl(protocol.Ledger)
+	keys, err := indexing.SyntheticOutbox(batch, ledgerUrl).Keys()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the synthetic outbox: %v", err)
+	}
+	for _, key := range keys {
+		indexes = append(indexes, batch.Account(ledgerUrl).Index(key...))
+	}
+
+	// The migration queue decides which accounts may be migrated
+	keys, err = indexing.MigrationQueue(batch, ledgerUrl).Keys()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the migration queue: %v", err)
+	}
+	for _, key := range keys {
+		indexes = append(indexes, batch.Account(ledgerUrl).Index(key...))
+	}
+
+	// The key hash index is how key pages are found by key
+	hashes := map[[32]byte]bool{}
+	err = batch.ForEachAccount(func(record *database.Account) error {
+		state, err := record.GetState()
+		if err != nil {
+			return err
+		}
+
+		for hash := range keyHashes(state) {
+			if hashes[hash] {
+				continue
+			}
+			hashes[hash] = true
+			indexes = append(indexes, indexing.KeyHash(batch, ledgerUrl, hash).Value())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the key hash index: %v", err)
+	}
+
+	// The DN sets the oracle price from the most recent submission of each
+	// feed
+	if m.Network.Type == config.Directory {
+		policy, err := loadOraclePolicy(batch)
+		if err != nil {
+			return nil, err
+		}
+		for _, feed := range policy.Feeds {
+			indexes = append(indexes, indexing.OracleFeed(batch, ledgerUrl, feed).Value())
+		}
+	}
+
+	return indexes, nil
+}
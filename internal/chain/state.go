@@ -107,9 +107,11 @@ func (m *StateManager) Commit() ([]*submission, error) {
 	return submitted, nil
 }
 
-// Submit queues a synthetic transaction for submission.
+// Submit queues a synthetic transaction for submission. A synthetic
+// transaction may only submit a synthetic return accounts, which does not
+// submit anything itself.
 func (m *StateManager) Submit(url *url.URL, body protocol.TransactionPayload) {
-	if m.txType.IsSynthetic() {
+	if m.txType.IsSynthetic() && body.GetType() != protocol.TransactionTypeSyntheticReturnAccounts {
 		panic("Called stateCache.Submit from a synthetic transaction!")
 	}
 	m.submissions = append(m.submissions, &submission{url, body})
@@ -6,6 +6,7 @@ import (
 	"reflect"
 
 	"gitlab.com/accumulatenetwork/accumulate/internal/database"
+	"gitlab.com/accumulatenetwork/accumulate/internal/indexing"
 	"gitlab.com/accumulatenetwork/accumulate/internal/logging"
 	"gitlab.com/accumulatenetwork/accumulate/internal/url"
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
@@ -132,6 +133,22 @@ func (c *stateCache) AddDirectoryEntry(u ...*url.URL) error {
 	}, u...)
 }
 
+// SyntheticOutbox returns the outbox of synthetic transactions of the subnet.
+// Changes are written when the cache is committed.
+func (c *stateCache) SyntheticOutbox() *indexing.SyntheticOutboxIndexer {
+	return indexing.NewSyntheticOutbox(func(key ...interface{}) indexing.Value {
+		return c.RecordIndex(c.nodeUrl.JoinPath(protocol.Ledger), key...)
+	})
+}
+
+// MigrationQueue returns the queue of accounts of the subnet that are waiting
+// to be migrated. Changes are written when the cache is committed.
+func (c *stateCache) MigrationQueue() *indexing.MigrationQueueIndexer {
+	return indexing.NewMigrationQueue(func(key ...interface{}) indexing.Value {
+		return c.RecordIndex(c.nodeUrl.JoinPath(protocol.Ledger), key...)
+	})
+}
+
 type Value interface {
 	Get() ([]byte, error)
 	Put([]byte)
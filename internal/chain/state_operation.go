@@ -79,7 +79,7 @@ func (op *updateRecord) Execute(st *stateCache) ([]state.Chain, error) {
 	// exists on disk, we have to use GetPersistentEntry.
 
 	rec := st.batch.Account(op.url)
-	_, err := rec.GetState()
+	old, err := rec.GetState()
 	switch {
 	case err == nil:
 		// If the record already exists, update it
@@ -107,6 +107,11 @@ func (op *updateRecord) Execute(st *stateCache) ([]state.Chain, error) {
 		return nil, fmt.Errorf("failed to update state of %q: %v", op.url, err)
 	}
 
+	err = updateKeyHashIndex(st.batch, st.nodeUrl, op.url, old, op.record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update the key index of %q: %v", op.url, err)
+	}
+
 	return nil, addChainEntry(st.nodeUrl, st.batch, op.url, protocol.MainChain, protocol.ChainTypeTransaction, st.txHash[:], 0, 0)
 }
 
@@ -159,11 +164,23 @@ func (m *stateCache) UpdateSignator(record state.Chain) error {
 
 func (op *updateSignator) Execute(st *stateCache) ([]state.Chain, error) {
 	record := st.batch.Account(op.url)
-	err := record.PutState(op.record)
+	old, err := record.GetState()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load state for %q", op.url)
+	}
+
+	err = record.PutState(op.record)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update state of %q: %v", op.url, err)
 	}
 
+	// The record may be the same value that is later passed to Update, in
+	// which case it has already been updated
+	err = updateKeyHashIndex(st.batch, st.nodeUrl, op.url, old, op.record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update the key index of %q: %v", op.url, err)
+	}
+
 	return nil, addChainEntry(st.nodeUrl, st.batch, op.url, protocol.PendingChain, protocol.ChainTypeTransaction, st.txHash[:], 0, 0)
 }
 
@@ -351,3 +368,21 @@ func (m *stateCache) AddDirectoryAnchor(anchor *protocol.SyntheticAnchor) {
 func (op *addDirectoryAnchor) Execute(st *stateCache) ([]protocol.Account, error) {
 	return nil, indexing.DirectoryAnchor(st.batch, st.nodeUrl.JoinPath(protocol.Ledger)).Add(op.anchor)
 }
+
+type scheduleKeyPageOperation struct {
+	height uint64
+	page   *url.URL
+}
+
+// ScheduleKeyPageOperation schedules the time-locked operations of a key page
+// to be applied at the given height.
+func (m *stateCache) ScheduleKeyPageOperation(height uint64, page *url.URL) {
+	m.operations = append(m.operations, &scheduleKeyPageOperation{
+		height: height,
+		page:   page,
+	})
+}
+
+func (op *scheduleKeyPageOperation) Execute(st *stateCache) ([]protocol.Account, error) {
+	return nil, indexing.MaturingKeyPageOperations(st.batch, st.nodeUrl.JoinPath(protocol.Ledger), op.height).Add(op.page)
+}
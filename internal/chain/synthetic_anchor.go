@@ -2,11 +2,13 @@ package chain
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 
 	"gitlab.com/accumulatenetwork/accumulate/config"
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
 	"gitlab.com/accumulatenetwork/accumulate/smt/managed"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
 )
 
 type SyntheticAnchor struct {
@@ -60,11 +62,27 @@ func (x SyntheticAnchor) Validate(st *StateManager, tx *protocol.Envelope) (prot
 				return nil, fmt.Errorf("attempting to set oracle price to 0")
 			}
 			ledgerState.PendingOracle = body.AcmeOraclePrice
+			if body.FeeSchedule != nil {
+				ledgerState.PendingFeeSchedule = body.FeeSchedule
+			}
+			if body.RoutingTable != nil {
+				ledgerState.PendingRoutingTable = body.RoutingTable
+			}
+			if body.Globals != nil {
+				ledgerState.PendingGlobals = body.Globals
+			}
 
 			st.Update(ledgerState)
 		}
 	}
 
+	if len(body.Acknowledgements) > 0 || len(body.Unacknowledged) > 0 {
+		err := x.acknowledge(st, body, fromDirectory)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Add the anchor to the chain
 	err := st.AddChainEntry(st.OriginUrl, name, protocol.ChainTypeAnchor, body.RootAnchor[:], body.RootIndex, body.Block)
 	if err != nil {
@@ -74,6 +92,43 @@ func (x SyntheticAnchor) Validate(st *StateManager, tx *protocol.Envelope) (prot
 	return nil, nil
 }
 
+// acknowledge removes synthetic transactions acknowledged by the source of the
+// anchor from the outbox, and acknowledges transactions the source is sending
+// again that have already been received. The directory relays both lists it
+// receives from a BVN to the other BVNs in its own anchor.
+func (x SyntheticAnchor) acknowledge(st *StateManager, body *protocol.SyntheticAnchor, fromDirectory bool) error {
+	ledgerState := protocol.NewInternalLedger()
+	err := st.LoadUrlAs(st.nodeUrl.JoinPath(protocol.Ledger), ledgerState)
+	if err != nil {
+		return err
+	}
+
+	_, err = st.SyntheticOutbox().Remove(body.Acknowledgements...)
+	if err != nil {
+		return fmt.Errorf("failed to update the synthetic outbox: %v", err)
+	}
+
+	relay := !fromDirectory && x.Network.Type == config.Directory
+	if relay {
+		ledgerState.Synthetic.Acknowledgements = append(ledgerState.Synthetic.Acknowledgements, body.Acknowledgements...)
+	}
+
+	for _, id := range body.Unacknowledged {
+		_, err := st.RecordIndex(st.nodeUrl, "SeenSynth", id[:]).Get()
+		switch {
+		case err == nil:
+			ledgerState.Synthetic.Acknowledgements = append(ledgerState.Synthetic.Acknowledgements, id)
+		case !errors.Is(err, storage.ErrNotFound):
+			return fmt.Errorf("failed to check if %X has been received: %v", id, err)
+		case relay:
+			ledgerState.Synthetic.Unacknowledged = append(ledgerState.Synthetic.Unacknowledged, id)
+		}
+	}
+
+	st.Update(ledgerState)
+	return nil
+}
+
 func (SyntheticAnchor) verifyReceipt(st *StateManager, body *protocol.SyntheticAnchor) error {
 	// Get the merkle state at the specified index
 	chainName := protocol.MinorRootChain
@@ -26,7 +26,10 @@ func (SyntheticBurnTokens) Validate(st *StateManager, tx *transactions.Envelope)
 		return nil, fmt.Errorf("invalid origin record: want chain type %v, got %v", protocol.AccountTypeTokenIssuer, origin.GetType())
 	}
 
-	account.Supply.Add(&account.Supply, &body.Amount)
+	err := account.Burn(&body.Amount)
+	if err != nil {
+		return nil, err
+	}
 
 	st.Update(account)
 	return nil, nil
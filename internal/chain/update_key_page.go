@@ -33,6 +33,153 @@ func (UpdateKeyPage) Validate(st *StateManager, tx *transactions.Envelope) (prot
 		key.Nonce = 0
 	}
 
+	bookUrl, priority, err := keyPagePriority(st, page)
+	if err != nil {
+		return nil, err
+	}
+
+	// 0 is the highest priority, followed by 1, etc
+	if priority >= 0 && tx.Transaction.KeyPageIndex > uint64(priority) {
+		return nil, fmt.Errorf("cannot modify %q with a lower priority key page", st.OriginUrl)
+	}
+
+	// Whether the operation is signed by the threshold matters for a frozen
+	// page and for the operations that can be signed by a single key
+	var signers [][]byte
+	var thresholdMet bool
+	if page.Frozen || body.Operation.AllowsSingleSignature() {
+		signers, thresholdMet, err = keyPageSigners(st, tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Operations signed by the threshold override a freeze by a single
+	// signature
+	if page.Frozen && !body.Operation.AllowedWhileFrozen() && !(page.FrozenBySingleSignature && thresholdMet) {
+		return nil, fmt.Errorf("key page %q is frozen", st.OriginUrl)
+	}
+
+	// A key cannot cancel an operation that targets it on its own, otherwise a
+	// compromised key could prevent its own removal
+	if body.Operation == protocol.KeyPageOperationCancel && !thresholdMet {
+		for _, op := range page.PendingOperations {
+			if op.Txid != body.PendingTxid || len(op.Operation.Key) == 0 {
+				continue
+			}
+			for _, key := range signers {
+				if bytes.Equal(key, op.Operation.Key) {
+					return nil, fmt.Errorf("%v cannot be cancelled by the key it targets without the threshold", op.Operation.Operation)
+				}
+			}
+		}
+	}
+
+	if body.Operation.CanBeDelayed() && body.Delay < page.MinDelay {
+		return nil, fmt.Errorf("%v must be time-locked for at least %d blocks", body.Operation, page.MinDelay)
+	}
+
+	if body.Delay > 0 {
+		if !body.Operation.CanBeDelayed() {
+			return nil, fmt.Errorf("%v cannot be time-locked", body.Operation)
+		}
+
+		// Verify the operation can be applied to the page as it is now
+		dryRun := new(protocol.KeyPage)
+		err = copyAccount(dryRun, page)
+		if err != nil {
+			return nil, err
+		}
+		err = applyKeyPageOperation(dryRun, body, priority)
+		if err != nil {
+			return nil, err
+		}
+
+		ledgerState := protocol.NewInternalLedger()
+		err = st.LoadUrlAs(st.nodeUrl.JoinPath(protocol.Ledger), ledgerState)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load the ledger: %v", err)
+		}
+
+		op := new(protocol.PendingKeyPageOperation)
+		op.Txid = types.Bytes(tx.GetTxHash()).AsBytes32()
+		op.EffectiveAt = uint64(ledgerState.Index) + body.Delay
+		op.Operation = body
+		page.PendingOperations = append(page.PendingOperations, op)
+		st.ScheduleKeyPageOperation(op.EffectiveAt, st.OriginUrl)
+		st.Update(page)
+		return nil, nil
+	}
+
+	err = applyKeyPageOperation(page, body, priority)
+	if err != nil {
+		return nil, err
+	}
+	if body.Operation == protocol.KeyPageOperationFreeze {
+		page.FrozenBySingleSignature = !thresholdMet
+	}
+
+	addKeyPageValidator(st, body, bookUrl)
+	st.Update(page)
+	return nil, nil
+}
+
+// keyPagePriority returns the URL of the page's key book and the page's index
+// within the book. If the page does not belong to a book, the priority is -1.
+func keyPagePriority(st *StateManager, page *protocol.KeyPage) (*url.URL, int, error) {
+	if page.KeyBook == nil {
+		return nil, -1, nil
+	}
+
+	book := new(protocol.KeyBook)
+	err := st.LoadUrlAs(page.KeyBook, book)
+	if err != nil {
+		return nil, -1, fmt.Errorf("invalid key book: %v", err)
+	}
+
+	id := page.Header().Url.AccountID32()
+	for i, p := range book.Pages {
+		if p.AccountID32() == id {
+			return book.Url, i, nil
+		}
+	}
+	return nil, -1, fmt.Errorf("cannot find %q in key book with ID %X", page.Header().Url, page.KeyBook)
+}
+
+// keyPageSigners returns the public keys that have signed the transaction and
+// whether they meet the threshold of the signing page.
+func keyPageSigners(st *StateManager, tx *transactions.Envelope) ([][]byte, bool, error) {
+	signer, ok := st.Signator.(*protocol.KeyPage)
+	if !ok {
+		return nil, false, fmt.Errorf("invalid signator: want %T, got %T", new(protocol.KeyPage), st.Signator)
+	}
+
+	signatures, err := st.batch.Transaction(tx.GetTxHash()).GetSignatures()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to load signatures: %v", err)
+	}
+
+	keys := make([][]byte, 0, len(signatures))
+	for _, sig := range signatures {
+		keys = append(keys, sig.GetPublicKey())
+	}
+	return keys, len(keys) >= int(signer.Threshold), nil
+}
+
+// addKeyPageValidator adds a key added to the validator book as a validator.
+func addKeyPageValidator(st *StateManager, body *protocol.UpdateKeyPage, bookUrl *url.URL) {
+	if body.Operation != protocol.KeyPageOperationAdd {
+		return
+	}
+
+	key := &protocol.KeySpec{KeyType: body.NewKeyType}
+	if key.Accepts(protocol.SignatureTypeED25519) && len(body.NewKey) == ed25519.PubKeySize && st.nodeUrl.JoinPath(protocol.ValidatorBook).Equal(bookUrl) {
+		st.AddValidator(ed25519.PubKey(body.NewKey))
+	}
+}
+
+// applyKeyPageOperation applies an operation to a key page.
+func applyKeyPageOperation(page *protocol.KeyPage, body *protocol.UpdateKeyPage, priority int) error {
 	// Find the old key.  Also go ahead and check cases where we must have the
 	// old key, can't have the old key, and don't care about the old key.
 	var bodyKey *protocol.KeySpec
@@ -55,31 +202,12 @@ func (UpdateKeyPage) Validate(st *StateManager, tx *transactions.Envelope) (prot
 		}
 	}
 
-	var book *protocol.KeyBook
-	var bookUrl *url.URL
-	var priority = -1
-	if page.KeyBook != nil {
-		book = new(protocol.KeyBook)
-		err := st.LoadUrlAs(page.KeyBook, book)
+	switch body.Operation {
+	case protocol.KeyPageOperationAdd, protocol.KeyPageOperationUpdate:
+		err := protocol.ValidateKeyType(body.NewKeyType)
 		if err != nil {
-			return nil, fmt.Errorf("invalid key book: %v", err)
-		}
-
-		for i, p := range book.Pages {
-			if p.AccountID32() == st.OriginChainId {
-				priority = i
-			}
-		}
-		if priority < 0 {
-			return nil, fmt.Errorf("cannot find %q in key book with ID %X", st.OriginUrl, page.KeyBook)
+			return err
 		}
-
-		// 0 is the highest priority, followed by 1, etc
-		if tx.Transaction.KeyPageIndex > uint64(priority) {
-			return nil, fmt.Errorf("cannot modify %q with a lower priority key page", st.OriginUrl)
-		}
-
-		bookUrl = book.Url
 	}
 
 	switch body.Operation {
@@ -87,35 +215,33 @@ func (UpdateKeyPage) Validate(st *StateManager, tx *transactions.Envelope) (prot
 		// Check that a NewKey was provided, and that the key isn't already on
 		// the Key Page
 		if len(body.NewKey) == 0 { // Provided
-			return nil, fmt.Errorf("must provide a new key")
+			return fmt.Errorf("must provide a new key")
 		}
 		if indexNewKey > 0 { // Not on the Key Page
-			return nil, fmt.Errorf("cannot have duplicate keys on key page")
+			return fmt.Errorf("cannot have duplicate keys on key page")
 		}
 
 		key := &protocol.KeySpec{
 			PublicKey: body.NewKey,
+			KeyType:   body.NewKeyType,
 		}
 		if body.Owner != nil {
 			key.Owner = body.Owner
 		}
 		page.Keys = append(page.Keys, key)
 
-		if len(body.NewKey) == ed25519.PubKeySize && st.nodeUrl.JoinPath(protocol.ValidatorBook).Equal(bookUrl) {
-			st.AddValidator(ed25519.PubKey(body.NewKey))
-		}
-
 	case protocol.KeyPageOperationUpdate:
 		// check that the Key to update is on the key Page, and the new Key
 		// is not already on the Key Page
 		if indexKey < 0 { // The Key to update is on key page
-			return nil, fmt.Errorf("key to be updated not found on the key page")
+			return fmt.Errorf("key to be updated not found on the key page")
 		}
 		if indexNewKey >= 0 { // The new key is not on the key page
-			return nil, fmt.Errorf("key must be updated to a key not found on key page")
+			return fmt.Errorf("key must be updated to a key not found on key page")
 		}
 
 		bodyKey.PublicKey = body.NewKey
+		bodyKey.KeyType = body.NewKeyType
 		if body.Owner != nil {
 			bodyKey.Owner = body.Owner
 		}
@@ -123,13 +249,13 @@ func (UpdateKeyPage) Validate(st *StateManager, tx *transactions.Envelope) (prot
 	case protocol.KeyPageOperationRemove:
 		// Make sure the key to be removed is on the Key Page
 		if indexKey < 0 {
-			return nil, fmt.Errorf("key to be removed not found on the key page")
+			return fmt.Errorf("key to be removed not found on the key page")
 		}
 
 		page.Keys = append(page.Keys[:indexKey], page.Keys[indexKey+1:]...)
 
 		if len(page.Keys) == 0 && priority == 0 {
-			return nil, fmt.Errorf("cannot delete last key of the highest priority page of a key book")
+			return fmt.Errorf("cannot delete last key of the highest priority page of a key book")
 		}
 
 		if page.Threshold > uint64(len(page.Keys)) {
@@ -140,13 +266,31 @@ func (UpdateKeyPage) Validate(st *StateManager, tx *transactions.Envelope) (prot
 	case protocol.KeyPageOperationSetThreshold:
 		// Don't care what values are provided by keys....
 		if err := page.SetThreshold(body.Threshold); err != nil {
-			return nil, err
+			return err
+		}
+
+	case protocol.KeyPageOperationCancel:
+		// Cancel a time-locked operation before it takes effect
+		if page.RemovePendingOperation(body.PendingTxid) == nil {
+			return fmt.Errorf("no pending operation was scheduled by %X", body.PendingTxid)
 		}
 
+	case protocol.KeyPageOperationFreeze:
+		page.Frozen = true
+
+	case protocol.KeyPageOperationUnfreeze:
+		if !page.Frozen {
+			return fmt.Errorf("key page is not frozen")
+		}
+		page.Frozen = false
+		page.FrozenBySingleSignature = false
+
+	case protocol.KeyPageOperationSetMinDelay:
+		page.MinDelay = body.MinDelay
+
 	default:
-		return nil, fmt.Errorf("invalid operation: %v", body.Operation)
+		return fmt.Errorf("invalid operation: %v", body.Operation)
 	}
 
-	st.Update(page)
-	return nil, nil
+	return nil
 }
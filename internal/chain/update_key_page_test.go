@@ -72,3 +72,40 @@ func TestUpdateKeyPage_Priority(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateKeyPage_KeyType(t *testing.T) {
+	db, err := database.Open("", true, nil)
+	require.NoError(t, err)
+
+	fooKey, testKey, newKey := generateKey(), generateKey(), generateKey()
+	batch := db.Begin()
+	require.NoError(t, acctesting.CreateADI(batch, fooKey, "foo"))
+	require.NoError(t, acctesting.CreateKeyPage(batch, "foo/page0", testKey.PubKey().Bytes()))
+	require.NoError(t, acctesting.CreateKeyBook(batch, "foo/book", "foo/page0"))
+	require.NoError(t, batch.Commit())
+
+	u, err := url.Parse("foo/page0")
+	require.NoError(t, err)
+
+	for _, op := range []protocol.KeyPageOperation{protocol.KeyPageOperationAdd, protocol.KeyPageOperationUpdate} {
+		t.Run(op.String(), func(t *testing.T) {
+			body := new(protocol.UpdateKeyPage)
+			body.Operation = op
+			body.Key = testKey.PubKey().Bytes()
+			body.NewKey = newKey.PubKey().Bytes()
+			body.NewKeyType = protocol.SignatureType(100)
+
+			env := acctesting.NewTransaction().
+				WithOrigin(u).
+				WithKeyPage(0, 1).
+				WithBody(body).
+				SignLegacyED25519(testKey)
+
+			st, err := NewStateManager(db.Begin(), protocol.BvnUrl(t.Name()), env)
+			require.NoError(t, err)
+
+			_, err = UpdateKeyPage{}.Validate(st, env)
+			require.EqualError(t, err, "unsupported key type SignatureType:100")
+		})
+	}
+}
@@ -172,9 +172,30 @@ func getPendingStatus(batch *database.Batch, header *protocol.TransactionHeader,
 		return nil
 	}
 
+	_, keyPage, invalidated, err := getSignatorPage(batch, header)
+	switch {
+	case err != nil:
+		return err
+	case keyPage == nil:
+		// Lite token accounts don't have key books (and thus can't do multisig)
+		return nil
+	case invalidated:
+		resp.Invalidated = true
+		return nil
+	}
+
+	// Set the threshold
+	resp.SignatureThreshold = keyPage.Threshold
+	return nil
+}
+
+// getSignatorPage loads the key page that must sign the transaction. It
+// returns a nil page if the origin does not have a key book. If the height of
+// the page no longer matches the transaction, the transaction is invalidated.
+func getSignatorPage(batch *database.Batch, header *protocol.TransactionHeader) (*url.URL, *protocol.KeyPage, bool, error) {
 	origin, err := batch.Account(header.Origin).GetState()
 	if err != nil {
-		return err
+		return nil, nil, false, err
 	}
 
 	// Find the origin's key book
@@ -183,43 +204,51 @@ func getPendingStatus(batch *database.Batch, header *protocol.TransactionHeader,
 	case ok:
 		// Key books are their own key books
 	case origin.Header().KeyBook == nil:
-		// Lite token accounts don't have key books (and thus can't do multisig)
-		return nil
+		// Lite token accounts don't have key books
+		return nil, nil, false, nil
 	default:
 		// Load the origin's key book
 		keyBook = new(protocol.KeyBook)
 		err := batch.Account(origin.Header().KeyBook).GetStateAs(keyBook)
 		if err != nil {
-			return fmt.Errorf("failed to load key book of %q: %v", origin.Header().Url, err)
+			return nil, nil, false, fmt.Errorf("failed to load key book of %q: %v", origin.Header().Url, err)
 		}
 	}
 
 	// Sanity check
 	if header.KeyPageIndex >= uint64(len(keyBook.Pages)) {
-		return fmt.Errorf("invalid transaction: book has %d pages, transaction specifies page %d", len(keyBook.Pages), header.KeyPageIndex)
+		return nil, nil, false, fmt.Errorf("invalid transaction: book has %d pages, transaction specifies page %d", len(keyBook.Pages), header.KeyPageIndex)
 	}
 
 	// Read the page's main chain
-	pageAcnt := batch.Account(keyBook.Pages[header.KeyPageIndex])
+	pageUrl := keyBook.Pages[header.KeyPageIndex]
+	pageAcnt := batch.Account(pageUrl)
 	pageChain, err := pageAcnt.ReadChain(protocol.MainChain)
 	if err != nil {
-		return fmt.Errorf("failed to load main chain of key page %d of %q: %v", header.KeyPageIndex, origin.Header().Url, err)
-	}
-
-	// If height no longer matches, the transaction is invalidated
-	if header.KeyPageHeight != uint64(pageChain.Height()) {
-		resp.Invalidated = true
-		return nil
+		return nil, nil, false, fmt.Errorf("failed to load main chain of key page %d of %q: %v", header.KeyPageIndex, origin.Header().Url, err)
 	}
 
 	// Load the page's state
 	keyPage := new(protocol.KeyPage)
 	err = pageAcnt.GetStateAs(keyPage)
 	if err != nil {
-		return fmt.Errorf("failed to load key page %d of %q: %v", header.KeyPageIndex, origin.Header().Url, err)
+		return nil, nil, false, fmt.Errorf("failed to load key page %d of %q: %v", header.KeyPageIndex, origin.Header().Url, err)
 	}
 
-	// Set the threshold
-	resp.SignatureThreshold = keyPage.Threshold
+	// If height no longer matches, the transaction is invalidated
+	return pageUrl, keyPage, header.KeyPageHeight != uint64(pageChain.Height()), nil
+}
+
+// copyAccount makes a deep copy of an account by round-tripping it through
+// its binary encoding.
+func copyAccount(dst, src protocol.Account) error {
+	data, err := src.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to marshal %v: %v", src.GetType(), err)
+	}
+	err = dst.UnmarshalBinary(data)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal %v: %v", src.GetType(), err)
+	}
 	return nil
 }
@@ -1,18 +1,24 @@
 package chain
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 
+	"gitlab.com/accumulatenetwork/accumulate/config"
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
 	"gitlab.com/accumulatenetwork/accumulate/types"
 	"gitlab.com/accumulatenetwork/accumulate/types/api/transactions"
 )
 
-type WriteData struct{}
+type WriteData struct {
+	Network *config.Network
+}
 
 func (WriteData) Type() types.TransactionType { return types.TxTypeWriteData }
 
-func (WriteData) Validate(st *StateManager, tx *transactions.Envelope) (protocol.TransactionResult, error) {
+func (x WriteData) Validate(st *StateManager, tx *transactions.Envelope) (protocol.TransactionResult, error) {
 	body, ok := tx.Transaction.Body.(*protocol.WriteData)
 	if !ok {
 		return nil, fmt.Errorf("invalid payload: want %T, got %T", new(protocol.WriteData), tx.Transaction.Body)
@@ -29,6 +35,11 @@ func (WriteData) Validate(st *StateManager, tx *transactions.Envelope) (protocol
 		return nil, err
 	}
 
+	err = validateSystemData(x.Network, tx.Transaction.Origin, &body.Entry)
+	if err != nil {
+		return nil, err
+	}
+
 	// now replace the transaction payload with a segregated witness to the data.
 	// This technique is used to segregate the payload from the stored transaction
 	// by replacing the data payload with a smaller reference to that data via the
@@ -56,3 +67,90 @@ func (WriteData) Validate(st *StateManager, tx *transactions.Envelope) (protocol
 	result.AccountUrl = tx.Transaction.Origin
 	return result, nil
 }
+
+// validateSystemData verifies that an entry written to one of the DN's system
+// data accounts is valid, so that an invalid entry fails the transaction
+// instead of being ignored when the block is committed.
+func validateSystemData(network *config.Network, account *url.URL, entry *protocol.DataEntry) error {
+	switch {
+	case account.Equal(protocol.RoutingTableUrl()):
+		_, err := parseRoutingTable(network, entry)
+		return err
+	case account.Equal(protocol.FeeScheduleUrl()):
+		_, err := parseFeeSchedule(entry)
+		return err
+	case account.Equal(protocol.GlobalsUrl()):
+		_, err := parseGlobals(entry)
+		return err
+	case account.Equal(protocol.OracleFeedsUrl()):
+		_, err := parseOraclePolicy(entry)
+		return err
+	}
+	return nil
+}
+
+func parseFeeSchedule(entry *protocol.DataEntry) (*protocol.FeeSchedule, error) {
+	schedule := new(protocol.FeeSchedule)
+	err := json.Unmarshal(entry.Data, schedule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fee schedule: %v", err)
+	}
+
+	err = schedule.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid fee schedule: %v", err)
+	}
+	return schedule, nil
+}
+
+func parseGlobals(entry *protocol.DataEntry) (*protocol.NetworkGlobals, error) {
+	globals := new(protocol.NetworkGlobals)
+	err := json.Unmarshal(entry.Data, globals)
+	if err != nil {
+		return nil, fmt.Errorf("invalid network globals: %v", err)
+	}
+
+	err = globals.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid network globals: %v", err)
+	}
+	return globals, nil
+}
+
+func parseOraclePolicy(entry *protocol.DataEntry) (*protocol.OraclePolicy, error) {
+	policy := new(protocol.OraclePolicy)
+	err := json.Unmarshal(entry.Data, policy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid oracle policy: %v", err)
+	}
+
+	err = policy.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid oracle policy: %v", err)
+	}
+	return policy, nil
+}
+
+func parseRoutingTable(network *config.Network, entry *protocol.DataEntry) (*protocol.RoutingTable, error) {
+	table := new(protocol.RoutingTable)
+	err := json.Unmarshal(entry.Data, table)
+	if err != nil {
+		return nil, fmt.Errorf("invalid routing table: %v", err)
+	}
+
+	err = table.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid routing table: %v", err)
+	}
+
+	bvnNames := map[string]bool{}
+	for _, bvn := range network.GetBvnNames() {
+		bvnNames[strings.ToLower(bvn)] = true
+	}
+	for _, r := range table.Routes {
+		if !bvnNames[strings.ToLower(r.Subnet)] {
+			return nil, fmt.Errorf("invalid routing table: unknown BVN %q", r.Subnet)
+		}
+	}
+	return table, nil
+}
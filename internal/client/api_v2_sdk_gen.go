@@ -43,6 +43,17 @@ func (c *Client) ExecuteAddCredits(ctx context.Context, req *api.TxRequest) (*ap
 	return &resp, nil
 }
 
+func (c *Client) ExecuteBatch(ctx context.Context, req *api.TxRequest) (*api.TxResponse, error) {
+	var resp api.TxResponse
+
+	err := c.RequestAPIv2(ctx, "batch", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
 func (c *Client) ExecuteBurnTokens(ctx context.Context, req *api.TxRequest) (*api.TxResponse, error) {
 	var resp api.TxResponse
 
@@ -241,6 +252,39 @@ func (c *Client) Query(ctx context.Context, req *api.GeneralQuery) (interface{},
 	return resp, nil
 }
 
+func (c *Client) QueryAnchor(ctx context.Context, req *api.AnchorQuery) (*api.ChainQueryResponse, error) {
+	var resp api.ChainQueryResponse
+
+	err := c.RequestAPIv2(ctx, "query-anchor", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) QueryBlock(ctx context.Context, req *api.BlockQuery) (*api.ChainQueryResponse, error) {
+	var resp api.ChainQueryResponse
+
+	err := c.RequestAPIv2(ctx, "query-block", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) QueryBlockRange(ctx context.Context, req *api.BlockRangeQuery) (*api.MultiResponse, error) {
+	var resp api.MultiResponse
+
+	err := c.RequestAPIv2(ctx, "query-block-range", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
 func (c *Client) QueryChain(ctx context.Context, req *api.ChainIdQuery) (*api.ChainQueryResponse, error) {
 	var resp api.ChainQueryResponse
 
@@ -285,6 +329,17 @@ func (c *Client) QueryDirectory(ctx context.Context, req *api.DirectoryQuery) (*
 	return &resp, nil
 }
 
+func (c *Client) QueryKey(ctx context.Context, req *api.KeyQuery) (*api.MultiResponse, error) {
+	var resp api.MultiResponse
+
+	err := c.RequestAPIv2(ctx, "query-key", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
 func (c *Client) QueryKeyPageIndex(ctx context.Context, req *api.KeyPageIndexQuery) (*api.ChainQueryResponse, error) {
 	var resp api.ChainQueryResponse
 
@@ -296,6 +351,61 @@ func (c *Client) QueryKeyPageIndex(ctx context.Context, req *api.KeyPageIndexQue
 	return &resp, nil
 }
 
+func (c *Client) QueryOracle(ctx context.Context, req *api.OracleQuery) (*api.ChainQueryResponse, error) {
+	var resp api.ChainQueryResponse
+
+	err := c.RequestAPIv2(ctx, "query-oracle", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) QueryPendingSignatures(ctx context.Context, req *api.UrlQuery) (*api.MultiResponse, error) {
+	var resp api.MultiResponse
+
+	err := c.RequestAPIv2(ctx, "query-pending", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) QueryProof(ctx context.Context, req *api.ProofQuery) (*api.ChainQueryResponse, error) {
+	var resp api.ChainQueryResponse
+
+	err := c.RequestAPIv2(ctx, "query-proof", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) QuerySignatures(ctx context.Context, req *api.TxnQuery) (*api.ChainQueryResponse, error) {
+	var resp api.ChainQueryResponse
+
+	err := c.RequestAPIv2(ctx, "query-signatures", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) QuerySyntheticOutbox(ctx context.Context, req *api.SyntheticOutboxQuery) (*api.MultiResponse, error) {
+	var resp api.MultiResponse
+
+	err := c.RequestAPIv2(ctx, "query-synthetic-outbox", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
 func (c *Client) QueryTx(ctx context.Context, req *api.TxnQuery) (*api.TransactionQueryResponse, error) {
 	var resp api.TransactionQueryResponse
 
@@ -318,6 +428,39 @@ func (c *Client) QueryTxHistory(ctx context.Context, req *api.TxHistoryQuery) (*
 	return &resp, nil
 }
 
+func (c *Client) QuoteCredits(ctx context.Context, req *api.CreditsQuoteQuery) (*api.CreditsQuote, error) {
+	var resp api.CreditsQuote
+
+	err := c.RequestAPIv2(ctx, "quote-credits", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) SignPending(ctx context.Context, req *api.SignPendingRequest) (*api.TxResponse, error) {
+	var resp api.TxResponse
+
+	err := c.RequestAPIv2(ctx, "sign-pending", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) Simulate(ctx context.Context, req *api.TxRequest) (*api.SimulateResponse, error) {
+	var resp api.SimulateResponse
+
+	err := c.RequestAPIv2(ctx, "simulate", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
 func (c *Client) Status(ctx context.Context) (*api.StatusResponse, error) {
 	var req struct{}
 	var resp api.StatusResponse
@@ -0,0 +1,148 @@
+package light
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/tendermint/tendermint/types"
+	api "gitlab.com/accumulatenetwork/accumulate/internal/api/v2"
+	"gitlab.com/accumulatenetwork/accumulate/types/api/query"
+	"gitlab.com/accumulatenetwork/accumulate/types/proof"
+)
+
+// HeaderSource provides the signed headers of the directory. The light block
+// provider of Tendermint's light client implements HeaderSource.
+type HeaderSource interface {
+	LightBlock(ctx context.Context, height int64) (*types.LightBlock, error)
+}
+
+// DirectoryAnchors verifies that root anchors of subnets have been recorded by
+// the directory network. The directory records the anchors on its anchor pool,
+// the state of which is included in the BPT. The directory node proves that
+// the anchor is included in the BPT root, and the BPT root is checked against
+// the app hash of a header signed by a trusted validator set. Neither the
+// directory node nor the header source need to be trusted.
+type DirectoryAnchors struct {
+	node       Node
+	headers    HeaderSource
+	chainID    string
+	validators *types.ValidatorSet
+	mu         sync.Mutex
+	known      map[[32]byte]struct{}
+}
+
+// NewDirectoryAnchors returns a tracker that verifies anchors with proofs from
+// the given directory node and headers from the given source, signed by the
+// given validators of the directory's chain. The validator set is not updated,
+// so a new tracker must be created when the validators of the directory
+// change.
+func NewDirectoryAnchors(dn Node, headers HeaderSource, chainID string, validators *types.ValidatorSet) *DirectoryAnchors {
+	a := new(DirectoryAnchors)
+	a.node = dn
+	a.headers = headers
+	a.chainID = chainID
+	a.validators = validators
+	a.known = map[[32]byte]struct{}{}
+	return a
+}
+
+// Add adds an anchor that has been obtained out of band from a trusted source.
+func (a *DirectoryAnchors) Add(anchor []byte) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.known[toHash(anchor)] = struct{}{}
+}
+
+// Has returns true if the anchor is known.
+func (a *DirectoryAnchors) Has(anchor []byte) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	_, ok := a.known[toHash(anchor)]
+	return ok
+}
+
+// Verify returns nil if the anchor has been recorded by the directory. If the
+// anchor is not known, Verify requests a proof from the directory and checks it
+// against a signed header of the directory.
+func (a *DirectoryAnchors) Verify(ctx context.Context, anchor []byte) error {
+	if a.Has(anchor) {
+		return nil
+	}
+
+	resp, err := a.node.QueryAnchor(ctx, &api.AnchorQuery{Anchor: anchor})
+	if err != nil {
+		return fmt.Errorf("failed to query the proof of anchor %X: %w", anchor, err)
+	}
+	if resp.Type != "anchorProof" {
+		return fmt.Errorf("invalid response: want anchorProof, got %q", resp.Type)
+	}
+
+	p := new(query.ResponseAnchorProof)
+	err = remarshal(resp.Data, p)
+	if err != nil {
+		return fmt.Errorf("invalid anchor proof: %v", err)
+	}
+
+	root, err := proof.VerifyAnchor(p, anchor)
+	if err != nil {
+		return err
+	}
+
+	// The app hash of a block is recorded in the header of the next block
+	appHash, err := a.appHash(ctx, int64(p.Block)+1)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(root, appHash) {
+		return fmt.Errorf("%w: the proof of %X ends at %X but the app hash of block %d is %X", ErrUntrusted, anchor, root, p.Block, appHash)
+	}
+
+	a.Add(anchor)
+	return nil
+}
+
+// appHash returns the app hash of the header at the given height, once the
+// signatures of the header have been verified.
+func (a *DirectoryAnchors) appHash(ctx context.Context, height int64) ([]byte, error) {
+	block, err := a.headers.LightBlock(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the header at %d: %w", height, err)
+	}
+	if block.SignedHeader == nil {
+		return nil, fmt.Errorf("%w: missing signed header at %d", ErrUntrusted, height)
+	}
+	if block.Height != height {
+		return nil, fmt.Errorf("%w: want header at %d, got %d", ErrUntrusted, height, block.Height)
+	}
+
+	// Check that the commit is for the header, then check the signatures
+	err = block.SignedHeader.ValidateBasic(a.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid header at %d: %v", ErrUntrusted, height, err)
+	}
+	err = a.validators.VerifyCommitLight(a.chainID, block.Commit.BlockID, height, block.Commit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: header at %d is not signed by the validators: %v", ErrUntrusted, height, err)
+	}
+
+	return block.AppHash, nil
+}
+
+// remarshal converts a response, which may have been decoded as a generic JSON
+// value, into the given type.
+func remarshal(src, dst interface{}) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
+
+func toHash(b []byte) [32]byte {
+	var h [32]byte
+	copy(h[:], b)
+	return h
+}
@@ -0,0 +1,136 @@
+// Package light implements a light client that does not trust the node it
+// queries. Every account and transaction is returned with a chain of Merkle
+// receipts: for an account, a BPT receipt (see smt/pmt) from the account's
+// state to the BPT root; for a transaction, a receipt from the transaction to
+// the anchor of an account chain. Both continue through the minor root chain of
+// the subnet to the root anchor of the subnet, and from there to a root anchor
+// of the directory. The light client verifies the receipts, then verifies that
+// the directory recorded the root anchor of the subnet against a header signed
+// by the validators of the directory, before returning anything.
+package light
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	api "gitlab.com/accumulatenetwork/accumulate/internal/api/v2"
+	"gitlab.com/accumulatenetwork/accumulate/internal/client"
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+	"gitlab.com/accumulatenetwork/accumulate/types/api/query"
+	"gitlab.com/accumulatenetwork/accumulate/types/proof"
+)
+
+// ErrUntrusted is returned when a response cannot be traced to a header signed
+// by the trusted validators of the directory.
+var ErrUntrusted = errors.New("untrusted anchor")
+
+// Node is the subset of the API v2 client used by the light client.
+type Node interface {
+	QueryAnchor(ctx context.Context, req *api.AnchorQuery) (*api.ChainQueryResponse, error)
+	QueryProof(ctx context.Context, req *api.ProofQuery) (*api.ChainQueryResponse, error)
+}
+
+var _ Node = (*client.Client)(nil)
+
+// Client queries an untrusted node and verifies the responses against the
+// anchors of the directory.
+type Client struct {
+	node    Node
+	anchors *DirectoryAnchors
+}
+
+// New returns a light client that queries the given node and verifies the
+// responses against the given directory anchors.
+func New(node Node, anchors *DirectoryAnchors) *Client {
+	c := new(Client)
+	c.node = node
+	c.anchors = anchors
+	return c
+}
+
+// Anchors returns the directory anchors used to verify responses.
+func (c *Client) Anchors() *DirectoryAnchors {
+	return c.anchors
+}
+
+// QueryAccount queries an account and verifies its state. The state is only
+// available once the directory has anchored the block that last updated the
+// account, and only from nodes that run in archive mode.
+func (c *Client) QueryAccount(ctx context.Context, u *url.URL) (protocol.Account, *query.ResponseProof, error) {
+	p, err := c.queryProof(ctx, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	account, err := proof.VerifyAccount(p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !u.Equal(account.Header().Url) {
+		return nil, nil, fmt.Errorf("%w: want account %v, got %v", proof.ErrInvalidProof, u, account.Header().Url)
+	}
+
+	err = c.verifyAnchor(ctx, p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return account, p, nil
+}
+
+// QueryTransaction queries a transaction and verifies that it has been
+// recorded on a chain.
+func (c *Client) QueryTransaction(ctx context.Context, txid []byte) (*protocol.Transaction, *query.ResponseProof, error) {
+	p, err := c.queryProof(ctx, nil, txid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txn, err := proof.VerifyTransaction(p, txid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = c.verifyAnchor(ctx, p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return txn, p, nil
+}
+
+// verifyAnchor verifies that the directory recorded the root anchor of the
+// subnet the proof goes through. The last segment of the proof goes from that
+// anchor to the root anchor of the directory.
+func (c *Client) verifyAnchor(ctx context.Context, p *query.ResponseProof) error {
+	if len(p.Segments) < 2 {
+		return fmt.Errorf("%w: the proof does not go through the root anchor of a subnet", proof.ErrInvalidProof)
+	}
+
+	return c.anchors.Verify(ctx, p.Segments[len(p.Segments)-1].Start)
+}
+
+func (c *Client) queryProof(ctx context.Context, u *url.URL, txid []byte) (*query.ResponseProof, error) {
+	req := new(api.ProofQuery)
+	req.Url = u
+	req.Txid = txid
+	resp, err := c.node.QueryProof(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Type != "proof" {
+		return nil, fmt.Errorf("invalid response: want proof, got %q", resp.Type)
+	}
+
+	p := new(query.ResponseProof)
+	err = remarshal(resp.Data, p)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proof: %v", err)
+	}
+
+	return p, nil
+}
@@ -1,10 +1,13 @@
 package database
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
+	"sort"
 
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
+	"gitlab.com/accumulatenetwork/accumulate/smt/managed"
 	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
 	"gitlab.com/accumulatenetwork/accumulate/types/state"
 )
@@ -111,6 +114,65 @@ func (r *Account) GetStateAs(state state.Chain) error {
 	return nil
 }
 
+// GetStateAt loads the record state as of the given block. GetStateAt fails if
+// the database is not in archive mode. If the record was not updated at or
+// before the given block, GetStateAt returns storage.ErrNotFound.
+func (r *Account) GetStateAt(block uint64) (state.Chain, error) {
+	if !r.batch.archive {
+		return nil, errors.New("historical state is not available: the database is not in archive mode")
+	}
+
+	versions := new(stateVersionsIndex)
+	err := r.batch.getAs(r.key.StateVersions(), versions)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the last version at or before the block
+	i := sort.Search(len(versions.Blocks), func(i int) bool { return versions.Blocks[i] > block })
+	if i == 0 {
+		return nil, fmt.Errorf("account has no state at block %d: %w", block, storage.ErrNotFound)
+	}
+
+	data, err := r.batch.store.Get(r.key.StateAt(versions.Blocks[i-1]))
+	if err != nil {
+		return nil, err
+	}
+
+	return protocol.UnmarshalAccount(data)
+}
+
+// ArchiveState records a copy of the current record state as of the given
+// block. ArchiveState does nothing if the database is not in archive mode.
+func (r *Account) ArchiveState(block uint64) error {
+	if !r.batch.archive {
+		return nil
+	}
+
+	data, err := r.batch.store.Get(r.key.State())
+	if err != nil {
+		return err
+	}
+
+	versions := new(stateVersionsIndex)
+	err = r.batch.getAs(r.key.StateVersions(), versions)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return err
+	}
+
+	// Blocks are committed in order, so the list stays sorted
+	n := len(versions.Blocks)
+	if n > 0 && versions.Blocks[n-1] > block {
+		return fmt.Errorf("cannot archive state at block %d: state was already archived at block %d", block, versions.Blocks[n-1])
+	}
+	if n == 0 || versions.Blocks[n-1] != block {
+		versions.Blocks = append(versions.Blocks, block)
+	}
+
+	r.batch.store.Put(r.key.StateAt(block), data)
+	return r.batch.putAs(r.key.StateVersions(), versions)
+}
+
 // PutState stores the record state and adds the record to the BPT (as a hash).
 func (r *Account) PutState(accountState state.Chain) error {
 	// Does the record state have a URL?
@@ -151,11 +213,65 @@ func (r *Account) PutState(accountState state.Chain) error {
 	return nil
 }
 
+// StateHash derives the record's BPT entry from the hash of its state and the
+// anchors of its chains.
+func (r *Account) StateHash() ([32]byte, error) {
+	// Load the state
+	state, err := r.GetState()
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	// Marshal it
+	data, err := state.MarshalBinary()
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	// Hash it
+	var hashes []byte
+	h := sha256.Sum256(data)
+	hashes = append(hashes, h[:]...)
+
+	// Load the object metadata
+	objMeta, err := r.GetObject()
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	// For each chain
+	for _, chainMeta := range objMeta.Chains {
+		// Load the chain
+		recordChain, err := r.ReadChain(chainMeta.Name)
+		if err != nil {
+			return [32]byte{}, err
+		}
+
+		// Get the anchor
+		anchor := recordChain.Anchor()
+		h := sha256.Sum256(anchor)
+		hashes = append(hashes, h[:]...)
+	}
+
+	// Hash the hashes
+	return sha256.Sum256(hashes), nil
+}
+
 // PutBpt writes the record's BPT entry.
 func (r *Account) PutBpt(hash [32]byte) {
 	r.batch.bpt.Bpt.Insert(r.key.Object(), hash)
 }
 
+// BptReceipt returns a receipt that proves the record's BPT entry is included
+// in the BPT root.
+func (r *Account) BptReceipt() (*managed.Receipt, error) {
+	receipt := r.batch.bpt.Bpt.GetReceipt(r.key.Object())
+	if receipt == nil {
+		return nil, fmt.Errorf("BPT entry for %v: %w", r.key.Object(), storage.ErrNotFound)
+	}
+	return receipt, nil
+}
+
 func (r *Account) chain(name string, writable bool) (*Chain, error) {
 	return newChain(r.batch.store, r.key.Chain(name), writable)
 }
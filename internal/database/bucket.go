@@ -23,6 +23,18 @@ func (b *objectBucket) State() storage.Key {
 	return b.Object().Append("State")
 }
 
+// StateAt returns the storage key for the archived copy of the object's state
+// as of the given block.
+func (b *objectBucket) StateAt(block uint64) storage.Key {
+	return b.Object().Append("State", block)
+}
+
+// StateVersions returns the storage key for the list of blocks at which the
+// object's state was archived.
+func (b *objectBucket) StateVersions() storage.Key {
+	return b.Object().Append("StateVersions")
+}
+
 // Index returns the storage key for the given index of the object.
 func (b *objectBucket) Index(key ...interface{}) storage.Key {
 	return b.Object().Append("Index").Append(key...)
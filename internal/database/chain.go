@@ -94,6 +94,21 @@ func (c *Chain) Pending() []managed.Hash {
 	return c.merkle.MS.Pending
 }
 
+// Head returns a copy of the current Merkle state.
+func (c *Chain) Head() *managed.MerkleState {
+	return c.merkle.MS.Copy()
+}
+
+// RestoreHead replaces the current Merkle state. See
+// managed.MerkleManager.RestoreHead.
+func (c *Chain) RestoreHead(head *managed.MerkleState) error {
+	if !c.writable {
+		return fmt.Errorf("chain opened as read-only")
+	}
+
+	return c.merkle.RestoreHead(head)
+}
+
 // AddEntry adds an entry to the chain
 func (c *Chain) AddEntry(entry []byte, unique bool) error {
 	if !c.writable {
@@ -2,12 +2,15 @@ package database
 
 import (
 	"encoding"
+	"fmt"
 
 	"github.com/tendermint/tendermint/libs/log"
+	"gitlab.com/accumulatenetwork/accumulate/config"
 	"gitlab.com/accumulatenetwork/accumulate/internal/url"
 	"gitlab.com/accumulatenetwork/accumulate/smt/pmt"
 	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
 	"gitlab.com/accumulatenetwork/accumulate/smt/storage/badger"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage/bolt"
 	"gitlab.com/accumulatenetwork/accumulate/smt/storage/memory"
 )
 
@@ -15,8 +18,9 @@ const markPower = 8
 
 // Database is an Accumulate database.
 type Database struct {
-	store  storage.KeyValueStore
-	logger log.Logger
+	store   storage.KeyValueStore
+	logger  log.Logger
+	archive bool
 }
 
 // New creates a new database using the given key-value store.
@@ -31,11 +35,40 @@ func New(store storage.KeyValueStore, logger log.Logger) *Database {
 	return d
 }
 
-// Open opens a key-value store and creates a new database with it.
+// Open opens a Badger or memory key-value store and creates a new database with
+// it.
 func Open(file string, useMemDB bool, logger log.Logger) (*Database, error) {
-	var store storage.KeyValueStore = new(badger.DB)
+	typ := config.BadgerStorage
 	if useMemDB {
+		typ = config.MemoryStorage
+	}
+	return OpenType(typ, file, logger)
+}
+
+// OpenType opens a key-value store of the given type and creates a new
+// database with it.
+func OpenType(typ config.StorageType, file string, logger log.Logger) (*Database, error) {
+	store, err := OpenStore(typ, file, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(store, logger), nil
+}
+
+// OpenStore opens a key-value store of the given type. An empty type is
+// Badger.
+func OpenStore(typ config.StorageType, file string, logger log.Logger) (storage.KeyValueStore, error) {
+	var store storage.KeyValueStore
+	switch typ {
+	case config.BadgerStorage, "":
+		store = new(badger.DB)
+	case config.BoltStorage:
+		store = new(bolt.DB)
+	case config.MemoryStorage:
 		store = new(memory.DB)
+	default:
+		return nil, fmt.Errorf("unknown storage type %q", typ)
 	}
 
 	var storeLogger log.Logger
@@ -48,7 +81,19 @@ func Open(file string, useMemDB bool, logger log.Logger) (*Database, error) {
 		return nil, err
 	}
 
-	return New(store, logger), nil
+	return store, nil
+}
+
+// EnableArchive turns on archive mode. In archive mode, a copy of the state of
+// every account updated by a block is kept, keyed by the block index, so that
+// the state of the account can be queried as of any later block.
+func (d *Database) EnableArchive() {
+	d.archive = true
+}
+
+// IsArchive returns true if the database is in archive mode.
+func (d *Database) IsArchive() bool {
+	return d.archive
 }
 
 func (d *Database) logDebug(msg string, keyVals ...interface{}) {
@@ -73,13 +118,15 @@ func (d *Database) Begin() *Batch {
 	tx := new(Batch)
 	tx.store = d.store.Begin()
 	tx.bpt = pmt.NewBPTManager(tx.store)
+	tx.archive = d.archive
 	return tx
 }
 
 // Batch batches database writes.
 type Batch struct {
-	store storage.KeyValueTxn
-	bpt   *pmt.Manager
+	store   storage.KeyValueTxn
+	bpt     *pmt.Manager
+	archive bool
 }
 
 func (b *Batch) getAs(key storage.Key, value encoding.BinaryUnmarshaler) error {
@@ -126,6 +173,14 @@ func (b *Batch) AccountByID(id []byte) *Account {
 	return &Account{b, accountByID(id)}
 }
 
+// ForEachAccount calls fn for every account in the BPT. If fn returns an
+// error, the iteration stops and the error is returned.
+func (b *Batch) ForEachAccount(fn func(*Account) error) error {
+	return b.bpt.Bpt.ForEach(func(key, _ [32]byte) error {
+		return fn(&Account{b, accountBucket{objectBucket(key)}})
+	})
+}
+
 // Transaction returns a Transaction for the given transaction ID.
 func (b *Batch) Transaction(id []byte) *Transaction {
 	return &Transaction{b, transaction(id)}
@@ -0,0 +1,421 @@
+package database
+
+import (
+	"bufio"
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+	"gitlab.com/accumulatenetwork/accumulate/smt/managed"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
+)
+
+// SnapshotVersion is the version of the snapshot format written by
+// SaveSnapshot.
+const SnapshotVersion = 2
+
+// SaveSnapshot writes a snapshot of the current state to w. The snapshot
+// includes every account in the BPT, plus the given ledger. For each account
+// it includes the object metadata, the state, the head of each chain, the
+// directory, recent data entries, pending transactions, and the transactions
+// waiting on signatures from the account. The snapshot also includes the given
+// index values, which are restored as-is. Values that have not been written
+// are skipped.
+//
+// A snapshot is a header followed by one record per account. Each is
+// length-prefixed.
+func (b *Batch) SaveSnapshot(w io.Writer, ledger *url.URL, indexes []*Value) error {
+	ledgerState := protocol.NewInternalLedger()
+	err := b.Account(ledger).GetStateAs(ledgerState)
+	if err != nil {
+		return fmt.Errorf("failed to load ledger: %w", err)
+	}
+
+	header := new(SnapshotHeader)
+	header.Version = SnapshotVersion
+	header.Height = uint64(ledgerState.Index)
+	header.RootHash = b.bpt.Bpt.Root.Hash
+	header.Ledger = ledger
+	err = writeSnapshotValue(w, header)
+	if err != nil {
+		return err
+	}
+
+	// The ledger does not have a BPT entry. The index values are saved with
+	// the ledger.
+	err = b.saveAccount(w, account(ledger), [32]byte{}, indexes)
+	if err != nil {
+		return err
+	}
+
+	return b.bpt.Bpt.ForEach(func(key, hash [32]byte) error {
+		return b.saveAccount(w, accountBucket{objectBucket(key)}, hash, nil)
+	})
+}
+
+func (b *Batch) saveAccount(w io.Writer, key accountBucket, hash [32]byte, indexes []*Value) error {
+	record := &Account{b, key}
+	snap := new(snapshotAccount)
+	snap.Hash = hash
+
+	var err error
+	snap.Object, err = b.store.Get(key.Object())
+	if err != nil {
+		return fmt.Errorf("failed to load object %v: %w", key.Object(), err)
+	}
+
+	meta := new(protocol.ObjectMetadata)
+	err = meta.UnmarshalBinary(snap.Object)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal object %v: %w", key.Object(), err)
+	}
+
+	snap.State, err = b.store.Get(key.State())
+	if err != nil {
+		return fmt.Errorf("failed to load state %v: %w", key.Object(), err)
+	}
+
+	state, err := protocol.UnmarshalAccount(snap.State)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal state %v: %w", key.Object(), err)
+	}
+	snap.Url = state.Header().Url
+
+	for _, chainMeta := range meta.Chains {
+		chain, err := record.ReadChain(chainMeta.Name)
+		if err != nil {
+			return fmt.Errorf("failed to load %s#chain/%s: %w", snap.Url, chainMeta.Name, err)
+		}
+
+		head := chain.Head()
+		data, err := head.Marshal()
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s#chain/%s: %w", snap.Url, chainMeta.Name, err)
+		}
+		snap.Chains = append(snap.Chains, &snapshotChain{Name: chainMeta.Name, Head: data})
+
+		if chainMeta.Type != protocol.ChainTypeData {
+			continue
+		}
+
+		// Include the entries that will be available once the chain is
+		// restored
+		for _, hash := range head.HashList {
+			entry, err := b.store.Get(key.Data(hash))
+			if err != nil {
+				return fmt.Errorf("failed to load data entry %X of %s: %w", hash, snap.Url, err)
+			}
+			snap.DataEntries = append(snap.DataEntries, &snapshotDataEntry{Hash: hash, Entry: entry})
+		}
+	}
+
+	dirMeta := new(protocol.DirectoryIndexMetadata)
+	err = record.Index("Directory", "Metadata").GetAs(dirMeta)
+	switch {
+	case err == nil:
+		for i := uint64(0); i < dirMeta.Count; i++ {
+			entry, err := record.Index("Directory", i).Get()
+			if err != nil {
+				return fmt.Errorf("failed to load directory entry %d of %s: %w", i, snap.Url, err)
+			}
+			snap.Directory = append(snap.Directory, string(entry))
+		}
+	case !errors.Is(err, storage.ErrNotFound):
+		return fmt.Errorf("failed to load directory of %s: %w", snap.Url, err)
+	}
+
+	pending := new(pendingTxnsIndex)
+	err = record.Index("PendingTransactions").GetAs(pending)
+	switch {
+	case err == nil:
+		for _, txid := range pending.Transactions {
+			txn, err := b.saveTransaction(txid)
+			if err != nil {
+				return fmt.Errorf("failed to load pending transaction %X of %s: %w", txid, snap.Url, err)
+			}
+			snap.Pending = append(snap.Pending, txn)
+		}
+	case !errors.Is(err, storage.ErrNotFound):
+		return fmt.Errorf("failed to load pending transactions of %s: %w", snap.Url, err)
+	}
+
+	waiting := new(pendingTxnsIndex)
+	err = record.Index("PendingSignatures").GetAs(waiting)
+	switch {
+	case err == nil:
+		snap.PendingSignatures = waiting.Transactions
+	case !errors.Is(err, storage.ErrNotFound):
+		return fmt.Errorf("failed to load pending signatures of %s: %w", snap.Url, err)
+	}
+
+	for _, v := range indexes {
+		data, err := v.Get()
+		switch {
+		case err == nil:
+			snap.Indexes = append(snap.Indexes, &snapshotIndex{Key: v.key, Value: data})
+		case !errors.Is(err, storage.ErrNotFound):
+			return fmt.Errorf("failed to load index %v: %w", v.key, err)
+		}
+	}
+
+	return writeSnapshotValue(w, snap)
+}
+
+func (b *Batch) saveTransaction(txid [32]byte) (*snapshotTransaction, error) {
+	key := transaction(txid[:])
+	snap := new(snapshotTransaction)
+	snap.Hash = txid
+
+	var err error
+	for _, v := range []struct {
+		key  storage.Key
+		data *[]byte
+	}{
+		{key.State(), &snap.State},
+		{key.Status(), &snap.Status},
+		{key.Signatures(), &snap.Signatures},
+	} {
+		*v.data, err = b.store.Get(v.key)
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return nil, err
+		}
+	}
+
+	return snap, nil
+}
+
+// ReadSnapshotHeader reads the header of a snapshot written by SaveSnapshot.
+func ReadSnapshotHeader(r io.Reader) (*SnapshotHeader, error) {
+	header := new(SnapshotHeader)
+	err := readSnapshotValue(bufio.NewReader(r), header)
+	if err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+// RestoreSnapshot loads a snapshot written by SaveSnapshot into the database,
+// which should be empty. The BPT is rebuilt from the restored accounts.
+// RestoreSnapshot fails and discards its changes if the state of an account
+// does not match its BPT entry or if the root hash of the BPT does not match
+// the snapshot.
+func (d *Database) RestoreSnapshot(r io.Reader) (*SnapshotHeader, error) {
+	rd := bufio.NewReader(r)
+	header := new(SnapshotHeader)
+	err := readSnapshotValue(rd, header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot header: %w", err)
+	}
+
+	if header.Version != SnapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d", header.Version)
+	}
+
+	batch := d.Begin()
+	defer batch.Discard()
+
+	expiring := map[uint64][][32]byte{}
+	keyPageOps := map[uint64][]*url.URL{}
+	for {
+		snap := new(snapshotAccount)
+		err = readSnapshotValue(rd, snap)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read snapshot: %w", err)
+		}
+
+		record, err := batch.restoreAccount(snap)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore %v: %w", snap.Url, err)
+		}
+
+		for _, txn := range snap.Pending {
+			if txn.Status == nil {
+				continue
+			}
+			status := new(protocol.TransactionStatus)
+			err = status.UnmarshalBinary(txn.Status)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal status of %X: %w", txn.Hash, err)
+			}
+			if status.Pending && status.Expiry > 0 {
+				expiring[status.Expiry] = append(expiring[status.Expiry], txn.Hash)
+			}
+		}
+
+		page := new(protocol.KeyPage)
+		if page.UnmarshalBinary(snap.State) == nil {
+			for _, op := range page.PendingOperations {
+				keyPageOps[op.EffectiveAt] = append(keyPageOps[op.EffectiveAt], snap.Url)
+			}
+		}
+
+		// The ledger does not have a BPT entry
+		if header.Ledger.Equal(snap.Url) {
+			continue
+		}
+
+		// Recompute the BPT entry from the restored state and chains. The
+		// root hash only proves that the entries are consistent with the app
+		// hash, not that the state is.
+		hash, err := record.StateHash()
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash %v: %w", snap.Url, err)
+		}
+		if hash != snap.Hash {
+			return nil, fmt.Errorf("state of %v does not match its BPT entry: want %X, got %X", snap.Url, snap.Hash, hash)
+		}
+		record.PutBpt(hash)
+	}
+
+	// The expiry index of the ledger is keyed by height, so it is rebuilt from
+	// the pending transactions instead of being included in the snapshot
+	for height, txids := range expiring {
+		err = batch.Account(header.Ledger).Index("PendingExpiry", height).PutAs(&pendingTxnsIndex{Transactions: txids})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Likewise for the index of time-locked key page operations
+	for height, pages := range keyPageOps {
+		err = batch.Account(header.Ledger).Index("PendingKeyPageOperations", height).PutAs(&keyPageOperationsIndex{Pages: pages})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	batch.UpdateBpt()
+	if batch.bpt.Bpt.Root.Hash != header.RootHash {
+		return nil, fmt.Errorf("root hash does not match the snapshot: want %X, got %X", header.RootHash, batch.RootHash())
+	}
+
+	err = batch.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+func (b *Batch) restoreAccount(snap *snapshotAccount) (*Account, error) {
+	record := b.Account(snap.Url)
+	b.store.Put(record.key.Object(), snap.Object)
+	b.store.Put(record.key.State(), snap.State)
+
+	for _, c := range snap.Chains {
+		head := new(managed.MerkleState)
+		err := head.UnMarshal(c.Head)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal chain %s: %w", c.Name, err)
+		}
+
+		chain, err := record.chain(c.Name, true)
+		if err != nil {
+			return nil, err
+		}
+
+		err = chain.RestoreHead(head)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore chain %s: %w", c.Name, err)
+		}
+	}
+
+	for _, e := range snap.DataEntries {
+		b.store.Put(record.key.Data(e.Hash), e.Entry)
+	}
+
+	if len(snap.Directory) > 0 {
+		err := record.Index("Directory", "Metadata").PutAs(&protocol.DirectoryIndexMetadata{Count: uint64(len(snap.Directory))})
+		if err != nil {
+			return nil, err
+		}
+		for i, entry := range snap.Directory {
+			record.Index("Directory", uint64(i)).Put([]byte(entry))
+		}
+	}
+
+	if len(snap.Pending) > 0 {
+		pending := new(pendingTxnsIndex)
+		for _, txn := range snap.Pending {
+			pending.Transactions = append(pending.Transactions, txn.Hash)
+
+			key := transaction(txn.Hash[:])
+			err := b.putAs(key.Object(), &protocol.ObjectMetadata{Type: protocol.ObjectTypeTransaction})
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range map[storage.Key][]byte{
+				key.State():      txn.State,
+				key.Status():     txn.Status,
+				key.Signatures(): txn.Signatures,
+			} {
+				if v != nil {
+					b.store.Put(k, v)
+				}
+			}
+		}
+
+		err := record.Index("PendingTransactions").PutAs(pending)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(snap.PendingSignatures) > 0 {
+		err := record.Index("PendingSignatures").PutAs(&pendingTxnsIndex{Transactions: snap.PendingSignatures})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, v := range snap.Indexes {
+		b.store.Put(v.Key, v.Value)
+	}
+
+	return record, nil
+}
+
+func writeSnapshotValue(w io.Writer, v encoding.BinaryMarshaler) error {
+	data, err := v.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	var n [binary.MaxVarintLen64]byte
+	_, err = w.Write(n[:binary.PutUvarint(n[:], uint64(len(data)))])
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+func readSnapshotValue(r *bufio.Reader, v encoding.BinaryUnmarshaler) error {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+
+	// The length comes from the snapshot, which may have been crafted by a
+	// peer, so only allocate as much as is actually read
+	if n > math.MaxInt64 {
+		return fmt.Errorf("snapshot value is too large: %d bytes", n)
+	}
+	data, err := io.ReadAll(io.LimitReader(r, int64(n)))
+	if err != nil {
+		return err
+	}
+	if uint64(len(data)) != n {
+		return fmt.Errorf("snapshot value is truncated: want %d bytes, got %d: %w", n, len(data), io.ErrUnexpectedEOF)
+	}
+
+	return v.UnmarshalBinary(data)
+}
@@ -11,117 +11,1081 @@ import (
 	"strings"
 
 	"gitlab.com/accumulatenetwork/accumulate/internal/encoding"
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
 )
 
+type SnapshotHeader struct {
+	fieldsSet []bool
+	Version   uint64   `json:"version,omitempty" form:"version" query:"version" validate:"required"`
+	Height    uint64   `json:"height,omitempty" form:"height" query:"height" validate:"required"`
+	RootHash  [32]byte `json:"rootHash,omitempty" form:"rootHash" query:"rootHash" validate:"required"`
+	Ledger    *url.URL `json:"ledger,omitempty" form:"ledger" query:"ledger" validate:"required"`
+}
+
+type keyPageOperationsIndex struct {
+	fieldsSet []bool
+	Pages     []*url.URL `json:"pages,omitempty" form:"pages" query:"pages" validate:"required"`
+}
+
+type pendingTxnsIndex struct {
+	fieldsSet    []bool
+	Transactions [][32]byte `json:"transactions,omitempty" form:"transactions" query:"transactions" validate:"required"`
+}
+
+type snapshotAccount struct {
+	fieldsSet         []bool
+	Url               *url.URL               `json:"url,omitempty" form:"url" query:"url" validate:"required"`
+	Hash              [32]byte               `json:"hash,omitempty" form:"hash" query:"hash" validate:"required"`
+	Object            []byte                 `json:"object,omitempty" form:"object" query:"object" validate:"required"`
+	State             []byte                 `json:"state,omitempty" form:"state" query:"state" validate:"required"`
+	Chains            []*snapshotChain       `json:"chains,omitempty" form:"chains" query:"chains" validate:"required"`
+	DataEntries       []*snapshotDataEntry   `json:"dataEntries,omitempty" form:"dataEntries" query:"dataEntries" validate:"required"`
+	Directory         []string               `json:"directory,omitempty" form:"directory" query:"directory" validate:"required"`
+	Pending           []*snapshotTransaction `json:"pending,omitempty" form:"pending" query:"pending" validate:"required"`
+	PendingSignatures [][32]byte             `json:"pendingSignatures,omitempty" form:"pendingSignatures" query:"pendingSignatures" validate:"required"`
+	Indexes           []*snapshotIndex       `json:"indexes,omitempty" form:"indexes" query:"indexes" validate:"required"`
+}
+
+type snapshotChain struct {
+	fieldsSet []bool
+	Name      string `json:"name,omitempty" form:"name" query:"name" validate:"required"`
+	Head      []byte `json:"head,omitempty" form:"head" query:"head" validate:"required"`
+}
+
+type snapshotDataEntry struct {
+	fieldsSet []bool
+	Hash      []byte `json:"hash,omitempty" form:"hash" query:"hash" validate:"required"`
+	Entry     []byte `json:"entry,omitempty" form:"entry" query:"entry" validate:"required"`
+}
+
+type snapshotIndex struct {
+	fieldsSet []bool
+	Key       [32]byte `json:"key,omitempty" form:"key" query:"key" validate:"required"`
+	Value     []byte   `json:"value,omitempty" form:"value" query:"value" validate:"required"`
+}
+
+type snapshotTransaction struct {
+	fieldsSet  []bool
+	Hash       [32]byte `json:"hash,omitempty" form:"hash" query:"hash" validate:"required"`
+	State      []byte   `json:"state,omitempty" form:"state" query:"state" validate:"required"`
+	Status     []byte   `json:"status,omitempty" form:"status" query:"status" validate:"required"`
+	Signatures []byte   `json:"signatures,omitempty" form:"signatures" query:"signatures" validate:"required"`
+}
+
+type stateVersionsIndex struct {
+	fieldsSet []bool
+	Blocks    []uint64 `json:"blocks,omitempty" form:"blocks" query:"blocks" validate:"required"`
+}
+
 type txSignatures struct {
 	fieldsSet  []bool
 	Signatures []protocol.Signature `json:"signatures,omitempty" form:"signatures" query:"signatures" validate:"required"`
 }
 
-type txSyntheticTxns struct {
-	fieldsSet []bool
-	Txids     [][32]byte `json:"txids,omitempty" form:"txids" query:"txids" validate:"required"`
+type txSyntheticTxns struct {
+	fieldsSet []bool
+	Txids     [][32]byte `json:"txids,omitempty" form:"txids" query:"txids" validate:"required"`
+}
+
+func (v *SnapshotHeader) Equal(u *SnapshotHeader) bool {
+	if !(v.Version == u.Version) {
+		return false
+	}
+	if !(v.Height == u.Height) {
+		return false
+	}
+	if !(v.RootHash == u.RootHash) {
+		return false
+	}
+	if !((v.Ledger).Equal(u.Ledger)) {
+		return false
+	}
+
+	return true
+}
+
+func (v *keyPageOperationsIndex) Equal(u *keyPageOperationsIndex) bool {
+	if len(v.Pages) != len(u.Pages) {
+		return false
+	}
+	for i := range v.Pages {
+		if !((v.Pages[i]).Equal(u.Pages[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *pendingTxnsIndex) Equal(u *pendingTxnsIndex) bool {
+	if len(v.Transactions) != len(u.Transactions) {
+		return false
+	}
+	for i := range v.Transactions {
+		if !(v.Transactions[i] == u.Transactions[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *snapshotAccount) Equal(u *snapshotAccount) bool {
+	if !((v.Url).Equal(u.Url)) {
+		return false
+	}
+	if !(v.Hash == u.Hash) {
+		return false
+	}
+	if !(bytes.Equal(v.Object, u.Object)) {
+		return false
+	}
+	if !(bytes.Equal(v.State, u.State)) {
+		return false
+	}
+	if len(v.Chains) != len(u.Chains) {
+		return false
+	}
+	for i := range v.Chains {
+		if !((v.Chains[i]).Equal(u.Chains[i])) {
+			return false
+		}
+	}
+	if len(v.DataEntries) != len(u.DataEntries) {
+		return false
+	}
+	for i := range v.DataEntries {
+		if !((v.DataEntries[i]).Equal(u.DataEntries[i])) {
+			return false
+		}
+	}
+	if len(v.Directory) != len(u.Directory) {
+		return false
+	}
+	for i := range v.Directory {
+		if !(v.Directory[i] == u.Directory[i]) {
+			return false
+		}
+	}
+	if len(v.Pending) != len(u.Pending) {
+		return false
+	}
+	for i := range v.Pending {
+		if !((v.Pending[i]).Equal(u.Pending[i])) {
+			return false
+		}
+	}
+	if len(v.PendingSignatures) != len(u.PendingSignatures) {
+		return false
+	}
+	for i := range v.PendingSignatures {
+		if !(v.PendingSignatures[i] == u.PendingSignatures[i]) {
+			return false
+		}
+	}
+	if len(v.Indexes) != len(u.Indexes) {
+		return false
+	}
+	for i := range v.Indexes {
+		if !((v.Indexes[i]).Equal(u.Indexes[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *snapshotChain) Equal(u *snapshotChain) bool {
+	if !(v.Name == u.Name) {
+		return false
+	}
+	if !(bytes.Equal(v.Head, u.Head)) {
+		return false
+	}
+
+	return true
+}
+
+func (v *snapshotDataEntry) Equal(u *snapshotDataEntry) bool {
+	if !(bytes.Equal(v.Hash, u.Hash)) {
+		return false
+	}
+	if !(bytes.Equal(v.Entry, u.Entry)) {
+		return false
+	}
+
+	return true
+}
+
+func (v *snapshotIndex) Equal(u *snapshotIndex) bool {
+	if !(v.Key == u.Key) {
+		return false
+	}
+	if !(bytes.Equal(v.Value, u.Value)) {
+		return false
+	}
+
+	return true
+}
+
+func (v *snapshotTransaction) Equal(u *snapshotTransaction) bool {
+	if !(v.Hash == u.Hash) {
+		return false
+	}
+	if !(bytes.Equal(v.State, u.State)) {
+		return false
+	}
+	if !(bytes.Equal(v.Status, u.Status)) {
+		return false
+	}
+	if !(bytes.Equal(v.Signatures, u.Signatures)) {
+		return false
+	}
+
+	return true
+}
+
+func (v *stateVersionsIndex) Equal(u *stateVersionsIndex) bool {
+	if len(v.Blocks) != len(u.Blocks) {
+		return false
+	}
+	for i := range v.Blocks {
+		if !(v.Blocks[i] == u.Blocks[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *txSignatures) Equal(u *txSignatures) bool {
+	if len(v.Signatures) != len(u.Signatures) {
+		return false
+	}
+	for i := range v.Signatures {
+		if !(v.Signatures[i] == u.Signatures[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *txSyntheticTxns) Equal(u *txSyntheticTxns) bool {
+	if len(v.Txids) != len(u.Txids) {
+		return false
+	}
+	for i := range v.Txids {
+		if !(v.Txids[i] == u.Txids[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+var fieldNames_SnapshotHeader = []string{
+	1: "Version",
+	2: "Height",
+	3: "RootHash",
+	4: "Ledger",
+}
+
+func (v *SnapshotHeader) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Version == 0) {
+		writer.WriteUint(1, v.Version)
+	}
+	if !(v.Height == 0) {
+		writer.WriteUint(2, v.Height)
+	}
+	if !(v.RootHash == ([32]byte{})) {
+		writer.WriteHash(3, &v.RootHash)
+	}
+	if !(v.Ledger == nil) {
+		writer.WriteUrl(4, v.Ledger)
+	}
+
+	_, _, err := writer.Reset(fieldNames_SnapshotHeader)
+	return buffer.Bytes(), err
+}
+
+func (v *SnapshotHeader) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Version is missing")
+	} else if v.Version == 0 {
+		errs = append(errs, "field Version is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Height is missing")
+	} else if v.Height == 0 {
+		errs = append(errs, "field Height is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field RootHash is missing")
+	} else if v.RootHash == ([32]byte{}) {
+		errs = append(errs, "field RootHash is not set")
+	}
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field Ledger is missing")
+	} else if v.Ledger == nil {
+		errs = append(errs, "field Ledger is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_keyPageOperationsIndex = []string{
+	1: "Pages",
+}
+
+func (v *keyPageOperationsIndex) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(len(v.Pages) == 0) {
+		for _, v := range v.Pages {
+			writer.WriteUrl(1, v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_keyPageOperationsIndex)
+	return buffer.Bytes(), err
+}
+
+func (v *keyPageOperationsIndex) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Pages is missing")
+	} else if len(v.Pages) == 0 {
+		errs = append(errs, "field Pages is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_pendingTxnsIndex = []string{
+	1: "Transactions",
+}
+
+func (v *pendingTxnsIndex) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(len(v.Transactions) == 0) {
+		for _, v := range v.Transactions {
+			writer.WriteHash(1, &v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_pendingTxnsIndex)
+	return buffer.Bytes(), err
+}
+
+func (v *pendingTxnsIndex) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Transactions is missing")
+	} else if len(v.Transactions) == 0 {
+		errs = append(errs, "field Transactions is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_snapshotAccount = []string{
+	1:  "Url",
+	2:  "Hash",
+	3:  "Object",
+	4:  "State",
+	5:  "Chains",
+	6:  "DataEntries",
+	7:  "Directory",
+	8:  "Pending",
+	9:  "PendingSignatures",
+	10: "Indexes",
+}
+
+func (v *snapshotAccount) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Url == nil) {
+		writer.WriteUrl(1, v.Url)
+	}
+	if !(v.Hash == ([32]byte{})) {
+		writer.WriteHash(2, &v.Hash)
+	}
+	if !(len(v.Object) == 0) {
+		writer.WriteBytes(3, v.Object)
+	}
+	if !(len(v.State) == 0) {
+		writer.WriteBytes(4, v.State)
+	}
+	if !(len(v.Chains) == 0) {
+		for _, v := range v.Chains {
+			writer.WriteValue(5, v)
+		}
+	}
+	if !(len(v.DataEntries) == 0) {
+		for _, v := range v.DataEntries {
+			writer.WriteValue(6, v)
+		}
+	}
+	if !(len(v.Directory) == 0) {
+		for _, v := range v.Directory {
+			writer.WriteString(7, v)
+		}
+	}
+	if !(len(v.Pending) == 0) {
+		for _, v := range v.Pending {
+			writer.WriteValue(8, v)
+		}
+	}
+	if !(len(v.PendingSignatures) == 0) {
+		for _, v := range v.PendingSignatures {
+			writer.WriteHash(9, &v)
+		}
+	}
+	if !(len(v.Indexes) == 0) {
+		for _, v := range v.Indexes {
+			writer.WriteValue(10, v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_snapshotAccount)
+	return buffer.Bytes(), err
+}
+
+func (v *snapshotAccount) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Url is missing")
+	} else if v.Url == nil {
+		errs = append(errs, "field Url is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Hash is missing")
+	} else if v.Hash == ([32]byte{}) {
+		errs = append(errs, "field Hash is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Object is missing")
+	} else if len(v.Object) == 0 {
+		errs = append(errs, "field Object is not set")
+	}
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field State is missing")
+	} else if len(v.State) == 0 {
+		errs = append(errs, "field State is not set")
+	}
+	if len(v.fieldsSet) > 5 && !v.fieldsSet[5] {
+		errs = append(errs, "field Chains is missing")
+	} else if len(v.Chains) == 0 {
+		errs = append(errs, "field Chains is not set")
+	}
+	if len(v.fieldsSet) > 6 && !v.fieldsSet[6] {
+		errs = append(errs, "field DataEntries is missing")
+	} else if len(v.DataEntries) == 0 {
+		errs = append(errs, "field DataEntries is not set")
+	}
+	if len(v.fieldsSet) > 7 && !v.fieldsSet[7] {
+		errs = append(errs, "field Directory is missing")
+	} else if len(v.Directory) == 0 {
+		errs = append(errs, "field Directory is not set")
+	}
+	if len(v.fieldsSet) > 8 && !v.fieldsSet[8] {
+		errs = append(errs, "field Pending is missing")
+	} else if len(v.Pending) == 0 {
+		errs = append(errs, "field Pending is not set")
+	}
+	if len(v.fieldsSet) > 9 && !v.fieldsSet[9] {
+		errs = append(errs, "field PendingSignatures is missing")
+	} else if len(v.PendingSignatures) == 0 {
+		errs = append(errs, "field PendingSignatures is not set")
+	}
+	if len(v.fieldsSet) > 10 && !v.fieldsSet[10] {
+		errs = append(errs, "field Indexes is missing")
+	} else if len(v.Indexes) == 0 {
+		errs = append(errs, "field Indexes is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_snapshotChain = []string{
+	1: "Name",
+	2: "Head",
+}
+
+func (v *snapshotChain) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(len(v.Name) == 0) {
+		writer.WriteString(1, v.Name)
+	}
+	if !(len(v.Head) == 0) {
+		writer.WriteBytes(2, v.Head)
+	}
+
+	_, _, err := writer.Reset(fieldNames_snapshotChain)
+	return buffer.Bytes(), err
+}
+
+func (v *snapshotChain) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Name is missing")
+	} else if len(v.Name) == 0 {
+		errs = append(errs, "field Name is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Head is missing")
+	} else if len(v.Head) == 0 {
+		errs = append(errs, "field Head is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_snapshotDataEntry = []string{
+	1: "Hash",
+	2: "Entry",
+}
+
+func (v *snapshotDataEntry) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(len(v.Hash) == 0) {
+		writer.WriteBytes(1, v.Hash)
+	}
+	if !(len(v.Entry) == 0) {
+		writer.WriteBytes(2, v.Entry)
+	}
+
+	_, _, err := writer.Reset(fieldNames_snapshotDataEntry)
+	return buffer.Bytes(), err
+}
+
+func (v *snapshotDataEntry) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Hash is missing")
+	} else if len(v.Hash) == 0 {
+		errs = append(errs, "field Hash is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Entry is missing")
+	} else if len(v.Entry) == 0 {
+		errs = append(errs, "field Entry is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_snapshotIndex = []string{
+	1: "Key",
+	2: "Value",
+}
+
+func (v *snapshotIndex) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Key == ([32]byte{})) {
+		writer.WriteHash(1, &v.Key)
+	}
+	if !(len(v.Value) == 0) {
+		writer.WriteBytes(2, v.Value)
+	}
+
+	_, _, err := writer.Reset(fieldNames_snapshotIndex)
+	return buffer.Bytes(), err
+}
+
+func (v *snapshotIndex) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Key is missing")
+	} else if v.Key == ([32]byte{}) {
+		errs = append(errs, "field Key is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Value is missing")
+	} else if len(v.Value) == 0 {
+		errs = append(errs, "field Value is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_snapshotTransaction = []string{
+	1: "Hash",
+	2: "State",
+	3: "Status",
+	4: "Signatures",
+}
+
+func (v *snapshotTransaction) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Hash == ([32]byte{})) {
+		writer.WriteHash(1, &v.Hash)
+	}
+	if !(len(v.State) == 0) {
+		writer.WriteBytes(2, v.State)
+	}
+	if !(len(v.Status) == 0) {
+		writer.WriteBytes(3, v.Status)
+	}
+	if !(len(v.Signatures) == 0) {
+		writer.WriteBytes(4, v.Signatures)
+	}
+
+	_, _, err := writer.Reset(fieldNames_snapshotTransaction)
+	return buffer.Bytes(), err
+}
+
+func (v *snapshotTransaction) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Hash is missing")
+	} else if v.Hash == ([32]byte{}) {
+		errs = append(errs, "field Hash is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field State is missing")
+	} else if len(v.State) == 0 {
+		errs = append(errs, "field State is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Status is missing")
+	} else if len(v.Status) == 0 {
+		errs = append(errs, "field Status is not set")
+	}
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field Signatures is missing")
+	} else if len(v.Signatures) == 0 {
+		errs = append(errs, "field Signatures is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_stateVersionsIndex = []string{
+	1: "Blocks",
+}
+
+func (v *stateVersionsIndex) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(len(v.Blocks) == 0) {
+		for _, v := range v.Blocks {
+			writer.WriteUint(1, v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_stateVersionsIndex)
+	return buffer.Bytes(), err
+}
+
+func (v *stateVersionsIndex) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Blocks is missing")
+	} else if len(v.Blocks) == 0 {
+		errs = append(errs, "field Blocks is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_txSignatures = []string{
+	1: "Signatures",
+}
+
+func (v *txSignatures) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(len(v.Signatures) == 0) {
+		for _, v := range v.Signatures {
+			writer.WriteValue(1, v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_txSignatures)
+	return buffer.Bytes(), err
+}
+
+func (v *txSignatures) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Signatures is missing")
+	} else if len(v.Signatures) == 0 {
+		errs = append(errs, "field Signatures is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_txSyntheticTxns = []string{
+	1: "Txids",
+}
+
+func (v *txSyntheticTxns) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(len(v.Txids) == 0) {
+		for _, v := range v.Txids {
+			writer.WriteHash(1, &v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_txSyntheticTxns)
+	return buffer.Bytes(), err
+}
+
+func (v *txSyntheticTxns) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Txids is missing")
+	} else if len(v.Txids) == 0 {
+		errs = append(errs, "field Txids is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+func (v *SnapshotHeader) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *SnapshotHeader) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUint(1); ok {
+		v.Version = x
+	}
+	if x, ok := reader.ReadUint(2); ok {
+		v.Height = x
+	}
+	if x, ok := reader.ReadHash(3); ok {
+		v.RootHash = *x
+	}
+	if x, ok := reader.ReadUrl(4); ok {
+		v.Ledger = x
+	}
+
+	seen, err := reader.Reset(fieldNames_SnapshotHeader)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *keyPageOperationsIndex) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *keyPageOperationsIndex) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	for {
+		if x, ok := reader.ReadUrl(1); ok {
+			v.Pages = append(v.Pages, x)
+		} else {
+			break
+		}
+	}
+
+	seen, err := reader.Reset(fieldNames_keyPageOperationsIndex)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *pendingTxnsIndex) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *pendingTxnsIndex) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	for {
+		if x, ok := reader.ReadHash(1); ok {
+			v.Transactions = append(v.Transactions, *x)
+		} else {
+			break
+		}
+	}
+
+	seen, err := reader.Reset(fieldNames_pendingTxnsIndex)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *snapshotAccount) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
 }
 
-func (v *txSignatures) Equal(u *txSignatures) bool {
-	if len(v.Signatures) != len(u.Signatures) {
-		return false
+func (v *snapshotAccount) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUrl(1); ok {
+		v.Url = x
 	}
-	for i := range v.Signatures {
-		if !(v.Signatures[i] == u.Signatures[i]) {
-			return false
+	if x, ok := reader.ReadHash(2); ok {
+		v.Hash = *x
+	}
+	if x, ok := reader.ReadBytes(3); ok {
+		v.Object = x
+	}
+	if x, ok := reader.ReadBytes(4); ok {
+		v.State = x
+	}
+	for {
+		if x := new(snapshotChain); reader.ReadValue(5, x.UnmarshalBinary) {
+			v.Chains = append(v.Chains, x)
+		} else {
+			break
+		}
+	}
+	for {
+		if x := new(snapshotDataEntry); reader.ReadValue(6, x.UnmarshalBinary) {
+			v.DataEntries = append(v.DataEntries, x)
+		} else {
+			break
+		}
+	}
+	for {
+		if x, ok := reader.ReadString(7); ok {
+			v.Directory = append(v.Directory, x)
+		} else {
+			break
+		}
+	}
+	for {
+		if x := new(snapshotTransaction); reader.ReadValue(8, x.UnmarshalBinary) {
+			v.Pending = append(v.Pending, x)
+		} else {
+			break
+		}
+	}
+	for {
+		if x, ok := reader.ReadHash(9); ok {
+			v.PendingSignatures = append(v.PendingSignatures, *x)
+		} else {
+			break
+		}
+	}
+	for {
+		if x := new(snapshotIndex); reader.ReadValue(10, x.UnmarshalBinary) {
+			v.Indexes = append(v.Indexes, x)
+		} else {
+			break
 		}
 	}
 
-	return true
+	seen, err := reader.Reset(fieldNames_snapshotAccount)
+	v.fieldsSet = seen
+	return err
 }
 
-func (v *txSyntheticTxns) Equal(u *txSyntheticTxns) bool {
-	if len(v.Txids) != len(u.Txids) {
-		return false
+func (v *snapshotChain) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *snapshotChain) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadString(1); ok {
+		v.Name = x
 	}
-	for i := range v.Txids {
-		if !(v.Txids[i] == u.Txids[i]) {
-			return false
-		}
+	if x, ok := reader.ReadBytes(2); ok {
+		v.Head = x
 	}
 
-	return true
+	seen, err := reader.Reset(fieldNames_snapshotChain)
+	v.fieldsSet = seen
+	return err
 }
 
-var fieldNames_txSignatures = []string{
-	1: "Signatures",
+func (v *snapshotDataEntry) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
 }
 
-func (v *txSignatures) MarshalBinary() ([]byte, error) {
-	buffer := new(bytes.Buffer)
-	writer := encoding.NewWriter(buffer)
+func (v *snapshotDataEntry) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
 
-	if !(len(v.Signatures) == 0) {
-		for _, v := range v.Signatures {
-			writer.WriteValue(1, v)
-		}
+	if x, ok := reader.ReadBytes(1); ok {
+		v.Hash = x
+	}
+	if x, ok := reader.ReadBytes(2); ok {
+		v.Entry = x
 	}
 
-	_, _, err := writer.Reset(fieldNames_txSignatures)
-	return buffer.Bytes(), err
+	seen, err := reader.Reset(fieldNames_snapshotDataEntry)
+	v.fieldsSet = seen
+	return err
 }
 
-func (v *txSignatures) IsValid() error {
-	var errs []string
+func (v *snapshotIndex) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
 
-	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
-		errs = append(errs, "field Signatures is missing")
-	} else if len(v.Signatures) == 0 {
-		errs = append(errs, "field Signatures is not set")
-	}
+func (v *snapshotIndex) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
 
-	switch len(errs) {
-	case 0:
-		return nil
-	case 1:
-		return errors.New(errs[0])
-	default:
-		return errors.New(strings.Join(errs, "; "))
+	if x, ok := reader.ReadHash(1); ok {
+		v.Key = *x
 	}
+	if x, ok := reader.ReadBytes(2); ok {
+		v.Value = x
+	}
+
+	seen, err := reader.Reset(fieldNames_snapshotIndex)
+	v.fieldsSet = seen
+	return err
 }
 
-var fieldNames_txSyntheticTxns = []string{
-	1: "Txids",
+func (v *snapshotTransaction) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
 }
 
-func (v *txSyntheticTxns) MarshalBinary() ([]byte, error) {
-	buffer := new(bytes.Buffer)
-	writer := encoding.NewWriter(buffer)
+func (v *snapshotTransaction) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
 
-	if !(len(v.Txids) == 0) {
-		for _, v := range v.Txids {
-			writer.WriteHash(1, &v)
-		}
+	if x, ok := reader.ReadHash(1); ok {
+		v.Hash = *x
+	}
+	if x, ok := reader.ReadBytes(2); ok {
+		v.State = x
+	}
+	if x, ok := reader.ReadBytes(3); ok {
+		v.Status = x
+	}
+	if x, ok := reader.ReadBytes(4); ok {
+		v.Signatures = x
 	}
 
-	_, _, err := writer.Reset(fieldNames_txSyntheticTxns)
-	return buffer.Bytes(), err
+	seen, err := reader.Reset(fieldNames_snapshotTransaction)
+	v.fieldsSet = seen
+	return err
 }
 
-func (v *txSyntheticTxns) IsValid() error {
-	var errs []string
+func (v *stateVersionsIndex) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
 
-	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
-		errs = append(errs, "field Txids is missing")
-	} else if len(v.Txids) == 0 {
-		errs = append(errs, "field Txids is not set")
-	}
+func (v *stateVersionsIndex) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
 
-	switch len(errs) {
-	case 0:
-		return nil
-	case 1:
-		return errors.New(errs[0])
-	default:
-		return errors.New(strings.Join(errs, "; "))
+	for {
+		if x, ok := reader.ReadUint(1); ok {
+			v.Blocks = append(v.Blocks, x)
+		} else {
+			break
+		}
 	}
+
+	seen, err := reader.Reset(fieldNames_stateVersionsIndex)
+	v.fieldsSet = seen
+	return err
 }
 
 func (v *txSignatures) UnmarshalBinary(data []byte) error {
@@ -169,6 +1133,104 @@ func (v *txSyntheticTxns) UnmarshalBinaryFrom(rd io.Reader) error {
 	return err
 }
 
+func (v *SnapshotHeader) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Version  uint64   `json:"version,omitempty"`
+		Height   uint64   `json:"height,omitempty"`
+		RootHash string   `json:"rootHash,omitempty"`
+		Ledger   *url.URL `json:"ledger,omitempty"`
+	}{}
+	u.Version = v.Version
+	u.Height = v.Height
+	u.RootHash = encoding.ChainToJSON(v.RootHash)
+	u.Ledger = v.Ledger
+	return json.Marshal(&u)
+}
+
+func (v *pendingTxnsIndex) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Transactions []string `json:"transactions,omitempty"`
+	}{}
+	u.Transactions = make([]string, len(v.Transactions))
+	for i, x := range v.Transactions {
+		u.Transactions[i] = encoding.ChainToJSON(x)
+	}
+	return json.Marshal(&u)
+}
+
+func (v *snapshotAccount) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Url               *url.URL               `json:"url,omitempty"`
+		Hash              string                 `json:"hash,omitempty"`
+		Object            *string                `json:"object,omitempty"`
+		State             *string                `json:"state,omitempty"`
+		Chains            []*snapshotChain       `json:"chains,omitempty"`
+		DataEntries       []*snapshotDataEntry   `json:"dataEntries,omitempty"`
+		Directory         []string               `json:"directory,omitempty"`
+		Pending           []*snapshotTransaction `json:"pending,omitempty"`
+		PendingSignatures []string               `json:"pendingSignatures,omitempty"`
+		Indexes           []*snapshotIndex       `json:"indexes,omitempty"`
+	}{}
+	u.Url = v.Url
+	u.Hash = encoding.ChainToJSON(v.Hash)
+	u.Object = encoding.BytesToJSON(v.Object)
+	u.State = encoding.BytesToJSON(v.State)
+	u.Chains = v.Chains
+	u.DataEntries = v.DataEntries
+	u.Directory = v.Directory
+	u.Pending = v.Pending
+	u.PendingSignatures = make([]string, len(v.PendingSignatures))
+	for i, x := range v.PendingSignatures {
+		u.PendingSignatures[i] = encoding.ChainToJSON(x)
+	}
+	u.Indexes = v.Indexes
+	return json.Marshal(&u)
+}
+
+func (v *snapshotChain) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Name string  `json:"name,omitempty"`
+		Head *string `json:"head,omitempty"`
+	}{}
+	u.Name = v.Name
+	u.Head = encoding.BytesToJSON(v.Head)
+	return json.Marshal(&u)
+}
+
+func (v *snapshotDataEntry) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Hash  *string `json:"hash,omitempty"`
+		Entry *string `json:"entry,omitempty"`
+	}{}
+	u.Hash = encoding.BytesToJSON(v.Hash)
+	u.Entry = encoding.BytesToJSON(v.Entry)
+	return json.Marshal(&u)
+}
+
+func (v *snapshotIndex) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Key   string  `json:"key,omitempty"`
+		Value *string `json:"value,omitempty"`
+	}{}
+	u.Key = encoding.ChainToJSON(v.Key)
+	u.Value = encoding.BytesToJSON(v.Value)
+	return json.Marshal(&u)
+}
+
+func (v *snapshotTransaction) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Hash       string  `json:"hash,omitempty"`
+		State      *string `json:"state,omitempty"`
+		Status     *string `json:"status,omitempty"`
+		Signatures *string `json:"signatures,omitempty"`
+	}{}
+	u.Hash = encoding.ChainToJSON(v.Hash)
+	u.State = encoding.BytesToJSON(v.State)
+	u.Status = encoding.BytesToJSON(v.Status)
+	u.Signatures = encoding.BytesToJSON(v.Signatures)
+	return json.Marshal(&u)
+}
+
 func (v *txSignatures) MarshalJSON() ([]byte, error) {
 	u := struct {
 		Signatures []json.RawMessage `json:"signatures,omitempty"`
@@ -195,6 +1257,216 @@ func (v *txSyntheticTxns) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&u)
 }
 
+func (v *SnapshotHeader) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Version  uint64   `json:"version,omitempty"`
+		Height   uint64   `json:"height,omitempty"`
+		RootHash string   `json:"rootHash,omitempty"`
+		Ledger   *url.URL `json:"ledger,omitempty"`
+	}{}
+	u.Version = v.Version
+	u.Height = v.Height
+	u.RootHash = encoding.ChainToJSON(v.RootHash)
+	u.Ledger = v.Ledger
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Version = u.Version
+	v.Height = u.Height
+	if x, err := encoding.ChainFromJSON(u.RootHash); err != nil {
+		return fmt.Errorf("error decoding RootHash: %w", err)
+	} else {
+		v.RootHash = x
+	}
+	v.Ledger = u.Ledger
+	return nil
+}
+
+func (v *pendingTxnsIndex) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Transactions []string `json:"transactions,omitempty"`
+	}{}
+	u.Transactions = make([]string, len(v.Transactions))
+	for i, x := range v.Transactions {
+		u.Transactions[i] = encoding.ChainToJSON(x)
+	}
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Transactions = make([][32]byte, len(u.Transactions))
+	for i, x := range u.Transactions {
+		if x, err := encoding.ChainFromJSON(x); err != nil {
+			return fmt.Errorf("error decoding Transactions: %w", err)
+		} else {
+			v.Transactions[i] = x
+		}
+	}
+	return nil
+}
+
+func (v *snapshotAccount) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Url               *url.URL               `json:"url,omitempty"`
+		Hash              string                 `json:"hash,omitempty"`
+		Object            *string                `json:"object,omitempty"`
+		State             *string                `json:"state,omitempty"`
+		Chains            []*snapshotChain       `json:"chains,omitempty"`
+		DataEntries       []*snapshotDataEntry   `json:"dataEntries,omitempty"`
+		Directory         []string               `json:"directory,omitempty"`
+		Pending           []*snapshotTransaction `json:"pending,omitempty"`
+		PendingSignatures []string               `json:"pendingSignatures,omitempty"`
+		Indexes           []*snapshotIndex       `json:"indexes,omitempty"`
+	}{}
+	u.Url = v.Url
+	u.Hash = encoding.ChainToJSON(v.Hash)
+	u.Object = encoding.BytesToJSON(v.Object)
+	u.State = encoding.BytesToJSON(v.State)
+	u.Chains = v.Chains
+	u.DataEntries = v.DataEntries
+	u.Directory = v.Directory
+	u.Pending = v.Pending
+	u.PendingSignatures = make([]string, len(v.PendingSignatures))
+	for i, x := range v.PendingSignatures {
+		u.PendingSignatures[i] = encoding.ChainToJSON(x)
+	}
+	u.Indexes = v.Indexes
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Url = u.Url
+	if x, err := encoding.ChainFromJSON(u.Hash); err != nil {
+		return fmt.Errorf("error decoding Hash: %w", err)
+	} else {
+		v.Hash = x
+	}
+	if x, err := encoding.BytesFromJSON(u.Object); err != nil {
+		return fmt.Errorf("error decoding Object: %w", err)
+	} else {
+		v.Object = x
+	}
+	if x, err := encoding.BytesFromJSON(u.State); err != nil {
+		return fmt.Errorf("error decoding State: %w", err)
+	} else {
+		v.State = x
+	}
+	v.Chains = u.Chains
+	v.DataEntries = u.DataEntries
+	v.Directory = u.Directory
+	v.Pending = u.Pending
+	v.PendingSignatures = make([][32]byte, len(u.PendingSignatures))
+	for i, x := range u.PendingSignatures {
+		if x, err := encoding.ChainFromJSON(x); err != nil {
+			return fmt.Errorf("error decoding PendingSignatures: %w", err)
+		} else {
+			v.PendingSignatures[i] = x
+		}
+	}
+	v.Indexes = u.Indexes
+	return nil
+}
+
+func (v *snapshotChain) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Name string  `json:"name,omitempty"`
+		Head *string `json:"head,omitempty"`
+	}{}
+	u.Name = v.Name
+	u.Head = encoding.BytesToJSON(v.Head)
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Name = u.Name
+	if x, err := encoding.BytesFromJSON(u.Head); err != nil {
+		return fmt.Errorf("error decoding Head: %w", err)
+	} else {
+		v.Head = x
+	}
+	return nil
+}
+
+func (v *snapshotDataEntry) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Hash  *string `json:"hash,omitempty"`
+		Entry *string `json:"entry,omitempty"`
+	}{}
+	u.Hash = encoding.BytesToJSON(v.Hash)
+	u.Entry = encoding.BytesToJSON(v.Entry)
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	if x, err := encoding.BytesFromJSON(u.Hash); err != nil {
+		return fmt.Errorf("error decoding Hash: %w", err)
+	} else {
+		v.Hash = x
+	}
+	if x, err := encoding.BytesFromJSON(u.Entry); err != nil {
+		return fmt.Errorf("error decoding Entry: %w", err)
+	} else {
+		v.Entry = x
+	}
+	return nil
+}
+
+func (v *snapshotIndex) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Key   string  `json:"key,omitempty"`
+		Value *string `json:"value,omitempty"`
+	}{}
+	u.Key = encoding.ChainToJSON(v.Key)
+	u.Value = encoding.BytesToJSON(v.Value)
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	if x, err := encoding.ChainFromJSON(u.Key); err != nil {
+		return fmt.Errorf("error decoding Key: %w", err)
+	} else {
+		v.Key = x
+	}
+	if x, err := encoding.BytesFromJSON(u.Value); err != nil {
+		return fmt.Errorf("error decoding Value: %w", err)
+	} else {
+		v.Value = x
+	}
+	return nil
+}
+
+func (v *snapshotTransaction) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Hash       string  `json:"hash,omitempty"`
+		State      *string `json:"state,omitempty"`
+		Status     *string `json:"status,omitempty"`
+		Signatures *string `json:"signatures,omitempty"`
+	}{}
+	u.Hash = encoding.ChainToJSON(v.Hash)
+	u.State = encoding.BytesToJSON(v.State)
+	u.Status = encoding.BytesToJSON(v.Status)
+	u.Signatures = encoding.BytesToJSON(v.Signatures)
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	if x, err := encoding.ChainFromJSON(u.Hash); err != nil {
+		return fmt.Errorf("error decoding Hash: %w", err)
+	} else {
+		v.Hash = x
+	}
+	if x, err := encoding.BytesFromJSON(u.State); err != nil {
+		return fmt.Errorf("error decoding State: %w", err)
+	} else {
+		v.State = x
+	}
+	if x, err := encoding.BytesFromJSON(u.Status); err != nil {
+		return fmt.Errorf("error decoding Status: %w", err)
+	} else {
+		v.Status = x
+	}
+	if x, err := encoding.BytesFromJSON(u.Signatures); err != nil {
+		return fmt.Errorf("error decoding Signatures: %w", err)
+	} else {
+		v.Signatures = x
+	}
+	return nil
+}
+
 func (v *txSignatures) UnmarshalJSON(data []byte) error {
 	u := struct {
 		Signatures []json.RawMessage `json:"signatures,omitempty"`
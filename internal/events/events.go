@@ -0,0 +1,123 @@
+// Package events is an in-process publish/subscribe bus for the events the
+// executor emits as it delivers transactions and commits blocks.
+package events
+
+import (
+	"sync"
+	"time"
+
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+)
+
+// Event is an event published on a Bus.
+type Event interface {
+	isEvent()
+}
+
+// DidDeliverTransaction is published for every transaction the executor
+// records during DeliverTx, including transactions that fail, transactions
+// that are waiting for signatures, and pending transactions that expire. It is
+// published once the block has been committed, so the transaction can be
+// queried by the time subscribers see it.
+type DidDeliverTransaction struct {
+	Txid   [32]byte
+	Origin *url.URL
+	Type   protocol.TransactionType
+	Status *protocol.TransactionStatus
+
+	// Accounts are the accounts whose main chain recorded the transaction.
+	Accounts []*url.URL
+
+	// Produced are the synthetic transactions produced by the transaction.
+	Produced []SyntheticTransaction
+}
+
+// SyntheticTransaction identifies a synthetic transaction and the account it
+// was sent to.
+type SyntheticTransaction struct {
+	Txid   [32]byte
+	Origin *url.URL
+}
+
+// DidCommitBlock is published after a block that changed the state of the
+// subnet has been committed.
+type DidCommitBlock struct {
+	Index int64
+	Time  time.Time
+
+	// RootAnchor is the anchor of the subnet's minor root chain.
+	RootAnchor []byte
+
+	// Anchors are the chains that were anchored in the block.
+	Anchors []protocol.AnchorMetadata
+}
+
+func (*DidDeliverTransaction) isEvent() {}
+func (*DidCommitBlock) isEvent()        {}
+
+// Bus delivers published events to subscribers. Subscribers are called
+// synchronously, in the order they subscribed, so they must not block. A nil
+// Bus discards every event.
+type Bus struct {
+	mu     sync.Mutex
+	nextId uint64
+	subs   []subscriber
+}
+
+type subscriber struct {
+	id uint64
+	fn func(Event)
+}
+
+// NewBus returns a new Bus.
+func NewBus() *Bus {
+	return new(Bus)
+}
+
+// Subscribe registers fn to be called for every published event. The returned
+// function removes the subscription. It is safe to call it from fn.
+func (b *Bus) Subscribe(fn func(Event)) (unsubscribe func()) {
+	if b == nil {
+		return func() {}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.nextId++
+	id := b.nextId
+	b.subs = append(b.subs, subscriber{id, fn})
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		for i, s := range b.subs {
+			if s.id != id {
+				continue
+			}
+
+			// Copy, so a concurrent Publish is not affected
+			subs := make([]subscriber, 0, len(b.subs)-1)
+			subs = append(subs, b.subs[:i]...)
+			b.subs = append(subs, b.subs[i+1:]...)
+			return
+		}
+	}
+}
+
+// Publish calls every subscriber with the event.
+func (b *Bus) Publish(e Event) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	subs := b.subs
+	b.mu.Unlock()
+
+	for _, s := range subs {
+		s.fn(e)
+	}
+}
@@ -21,6 +21,10 @@ type InitOpts struct {
 	Validators  []tmtypes.GenesisValidator
 	GenesisTime time.Time
 	Logger      log.Logger
+
+	// Globals are the initial network globals. If Globals is nil, genesis
+	// uses protocol.DefaultNetworkGlobals.
+	Globals *protocol.NetworkGlobals
 }
 
 func mustParseUrl(s string) *url.URL {
@@ -34,6 +38,15 @@ func mustParseUrl(s string) *url.URL {
 func Init(kvdb storage.KeyValueStore, opts InitOpts) ([]byte, error) {
 	db := database.New(kvdb, opts.Logger.With("module", "database"))
 
+	globals := opts.Globals
+	if globals == nil {
+		globals = protocol.DefaultNetworkGlobals()
+	}
+	err := globals.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid network globals: %v", err)
+	}
+
 	exec, err := chain.NewGenesisExecutor(db, opts.Logger, opts.Network)
 	if err != nil {
 		return nil, err
@@ -81,6 +94,10 @@ func Init(kvdb storage.KeyValueStore, opts InitOpts) ([]byte, error) {
 		ledger.Synthetic.Nonce = 1
 		ledger.ActiveOracle = oraclePrice
 		ledger.PendingOracle = ledger.ActiveOracle
+		ledger.ActiveFeeSchedule = protocol.DefaultFeeSchedule()
+		ledger.PendingFeeSchedule = ledger.ActiveFeeSchedule
+		ledger.ActiveGlobals = globals
+		ledger.PendingGlobals = ledger.ActiveGlobals
 		records = append(records, ledger)
 
 		// Create the anchor pool
@@ -122,6 +139,61 @@ func Init(kvdb storage.KeyValueStore, opts InitOpts) ([]byte, error) {
 			urls = append(urls, da.Url)
 			dataRecords = append(dataRecords, DataRecord{da, &wd.Entry})
 
+			// The oracle feeds are set by writing an oracle policy to this
+			// account
+			policy := new(protocol.WriteData)
+			policy.Entry.Data, err = json.Marshal(protocol.DefaultOraclePolicy())
+			if err != nil {
+				return err
+			}
+
+			da = new(protocol.DataAccount)
+			da.Url = uAdi.JoinPath(protocol.OracleFeeds)
+			da.KeyBook = uBook
+
+			records = append(records, da)
+			urls = append(urls, da.Url)
+			dataRecords = append(dataRecords, DataRecord{da, &policy.Entry})
+
+			// The fee schedule is updated by writing to this account
+			fees := new(protocol.WriteData)
+			fees.Entry.Data, err = json.Marshal(protocol.DefaultFeeSchedule())
+			if err != nil {
+				return err
+			}
+
+			da = new(protocol.DataAccount)
+			da.Url = uAdi.JoinPath(protocol.Fees)
+			da.KeyBook = uBook
+
+			records = append(records, da)
+			urls = append(urls, da.Url)
+			dataRecords = append(dataRecords, DataRecord{da, &fees.Entry})
+
+			// The routing table is set by writing to this account. Until it
+			// is, accounts are routed modulo the number of BVNs.
+			da = new(protocol.DataAccount)
+			da.Url = uAdi.JoinPath(protocol.Routing)
+			da.KeyBook = uBook
+
+			records = append(records, da)
+			urls = append(urls, da.Url)
+
+			// The network globals are updated by writing to this account
+			wdGlobals := new(protocol.WriteData)
+			wdGlobals.Entry.Data, err = json.Marshal(globals)
+			if err != nil {
+				return err
+			}
+
+			da = new(protocol.DataAccount)
+			da.Url = uAdi.JoinPath(protocol.Globals)
+			da.KeyBook = uBook
+
+			records = append(records, da)
+			urls = append(urls, da.Url)
+			dataRecords = append(dataRecords, DataRecord{da, &wdGlobals.Entry})
+
 			// TODO Move ACME to DN
 
 		case config.BlockValidator:
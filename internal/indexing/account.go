@@ -18,6 +18,18 @@ func PendingTransactions(batch *database.Batch, account *url.URL) *PendingTransa
 	return &PendingTransactionsIndexer{batch.Account(account).Index("PendingTransactions")}
 }
 
+// PendingSignatures returns an indexer for the pending transactions that are
+// waiting for signatures from a key page.
+func PendingSignatures(batch *database.Batch, keyPage *url.URL) *PendingTransactionsIndexer {
+	return &PendingTransactionsIndexer{batch.Account(keyPage).Index("PendingSignatures")}
+}
+
+// ExpiringTransactions returns an indexer for the pending transactions that
+// expire at the given block height.
+func ExpiringTransactions(batch *database.Batch, ledger *url.URL, height uint64) *PendingTransactionsIndexer {
+	return &PendingTransactionsIndexer{batch.Account(ledger).Index("PendingExpiry", height)}
+}
+
 // Get loads the list of pending transactions.
 func (x *PendingTransactionsIndexer) Get() ([][32]byte, error) {
 	idx := new(PendingTransactionsIndex)
@@ -75,3 +87,53 @@ func (x *PendingTransactionsIndexer) Remove(txid [32]byte) error {
 	}
 	return x.value.PutAs(&PendingTransactionsIndex{Transactions: pending})
 }
+
+// Clear removes every transaction from the list.
+func (x *PendingTransactionsIndexer) Clear() error {
+	return x.value.PutAs(new(PendingTransactionsIndex))
+}
+
+// KeyPageOperationsIndexer indexes the key pages that have time-locked
+// operations that take effect at a given block height.
+type KeyPageOperationsIndexer struct {
+	value *database.Value
+}
+
+// MaturingKeyPageOperations returns an indexer for the key pages with
+// time-locked operations that take effect at the given block height.
+func MaturingKeyPageOperations(batch *database.Batch, ledger *url.URL, height uint64) *KeyPageOperationsIndexer {
+	return &KeyPageOperationsIndexer{batch.Account(ledger).Index("PendingKeyPageOperations", height)}
+}
+
+// Get loads the list of key pages.
+func (x *KeyPageOperationsIndexer) Get() ([]*url.URL, error) {
+	idx := new(KeyPageOperationsIndex)
+	err := x.value.GetAs(idx)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
+
+	return idx.Pages, nil
+}
+
+// Add adds a key page to the list, if it is not already on the list.
+func (x *KeyPageOperationsIndexer) Add(page *url.URL) error {
+	pages, err := x.Get()
+	if err != nil {
+		return err
+	}
+
+	for _, p := range pages {
+		if p.Equal(page) {
+			return nil
+		}
+	}
+
+	pages = append(pages, page)
+	return x.value.PutAs(&KeyPageOperationsIndex{Pages: pages})
+}
+
+// Clear removes every key page from the list.
+func (x *KeyPageOperationsIndexer) Clear() error {
+	return x.value.PutAs(new(KeyPageOperationsIndex))
+}
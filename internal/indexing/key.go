@@ -0,0 +1,72 @@
+package indexing
+
+import (
+	"errors"
+
+	"gitlab.com/accumulatenetwork/accumulate/internal/database"
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
+)
+
+// KeyHashIndexer indexes the key pages of a subnet that contain a key, by the
+// SHA-256 hash of the key.
+type KeyHashIndexer struct {
+	value *database.Value
+}
+
+// KeyHash returns a key hash indexer.
+func KeyHash(batch *database.Batch, ledger *url.URL, keyHash [32]byte) *KeyHashIndexer {
+	return &KeyHashIndexer{batch.Account(ledger).Index("KeyHash", keyHash)}
+}
+
+// Value returns the value the index is stored in.
+func (x *KeyHashIndexer) Value() *database.Value {
+	return x.value
+}
+
+// Get loads the accounts that contain the key.
+func (x *KeyHashIndexer) Get() ([]*url.URL, error) {
+	idx := new(KeyHashIndex)
+	err := x.value.GetAs(idx)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
+
+	return idx.Accounts, nil
+}
+
+// Add adds an account to the index, if it is not already indexed.
+func (x *KeyHashIndexer) Add(account *url.URL) error {
+	accounts, err := x.Get()
+	if err != nil {
+		return err
+	}
+
+	for _, u := range accounts {
+		if u.Equal(account) {
+			return nil
+		}
+	}
+
+	accounts = append(accounts, account)
+	return x.value.PutAs(&KeyHashIndex{Accounts: accounts})
+}
+
+// Remove removes an account from the index, if it is indexed.
+func (x *KeyHashIndexer) Remove(account *url.URL) error {
+	accounts, err := x.Get()
+	if err != nil {
+		return err
+	}
+
+	for i, u := range accounts {
+		if !u.Equal(account) {
+			continue
+		}
+
+		accounts = append(accounts[:i], accounts[i+1:]...)
+		return x.value.PutAs(&KeyHashIndex{Accounts: accounts})
+	}
+
+	return nil
+}
@@ -43,6 +43,31 @@ func (x *BlockStateIndexer) DidProduceSynthTxn(entry *BlockStateSynthTxnEntry) e
 	return x.value.PutAs(state)
 }
 
+// BlockIndexer indexes what happened in each block that changed the state.
+type BlockIndexer struct {
+	account *database.Account
+}
+
+// Block returns a block indexer.
+func Block(batch *database.Batch, ledger *url.URL) *BlockIndexer {
+	return &BlockIndexer{batch.Account(ledger)}
+}
+
+// Put indexes a block.
+func (x *BlockIndexer) Put(block *BlockIndex) error {
+	return x.account.Index("Block", block.Height).PutAs(block)
+}
+
+// Get retrieves the index of a block.
+func (x *BlockIndexer) Get(height uint64) (*BlockIndex, error) {
+	block := new(BlockIndex)
+	err := x.account.Index("Block", height).GetAs(block)
+	if err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
 // DirectoryAnchorIndexer indexes directory anchors.
 type DirectoryAnchorIndexer struct {
 	account *database.Account
@@ -81,3 +106,55 @@ func (x *DirectoryAnchorIndexer) AnchorForLocalBlock(block uint64, major bool) (
 	}
 	return anchor, nil
 }
+
+// BlockRootIndexer indexes the minor root chain entry of the BPT root of each
+// block that changed the state. Each entry links to the previous such block.
+type BlockRootIndexer struct {
+	account *database.Account
+}
+
+// BlockRoot returns a block root indexer.
+func BlockRoot(batch *database.Batch, ledger *url.URL) *BlockRootIndexer {
+	return &BlockRootIndexer{batch.Account(ledger)}
+}
+
+// Add indexes the BPT root entry of a block.
+func (x *BlockRootIndexer) Add(block uint64, entry *BlockRootIndex) error {
+	return x.account.Index("BlockRoot", block).PutAs(entry)
+}
+
+// Get retrieves the BPT root entry of a block.
+func (x *BlockRootIndexer) Get(block uint64) (*BlockRootIndex, error) {
+	entry := new(BlockRootIndex)
+	err := x.account.Index("BlockRoot", block).GetAs(entry)
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// StateReceiptIndexer indexes a receipt from the BPT entry of an account to the
+// BPT root of the last block that updated the account.
+type StateReceiptIndexer struct {
+	value *database.Value
+}
+
+// StateReceipt returns a state receipt indexer.
+func StateReceipt(batch *database.Batch, account *url.URL) *StateReceiptIndexer {
+	return &StateReceiptIndexer{batch.Account(account).Index("StateReceipt")}
+}
+
+// Put records the receipt of the account's BPT entry as of the given block.
+func (x *StateReceiptIndexer) Put(block uint64, receipt *protocol.Receipt) error {
+	return x.value.PutAs(&StateReceiptIndex{Block: block, Receipt: *receipt})
+}
+
+// Get loads the receipt of the account's BPT entry.
+func (x *StateReceiptIndexer) Get() (*StateReceiptIndex, error) {
+	v := new(StateReceiptIndex)
+	err := x.value.GetAs(v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,124 @@
+package indexing
+
+import (
+	"gitlab.com/accumulatenetwork/accumulate/internal/database"
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+)
+
+// MigrationQueueIndexer indexes the accounts of a subnet that are waiting to
+// be migrated to another subnet, in the order they will be migrated.
+type MigrationQueueIndexer struct {
+	index func(key ...interface{}) Value
+}
+
+// MigrationQueue returns a migration queue indexer.
+func MigrationQueue(batch *database.Batch, ledger *url.URL) *MigrationQueueIndexer {
+	return NewMigrationQueue(func(key ...interface{}) Value {
+		return batch.Account(ledger).Index(key...)
+	})
+}
+
+// NewMigrationQueue returns a migration queue indexer that reads and writes
+// the given values.
+func NewMigrationQueue(index func(key ...interface{}) Value) *MigrationQueueIndexer {
+	return &MigrationQueueIndexer{index}
+}
+
+func (x *MigrationQueueIndexer) metadata() (*MigrationQueueMetadata, error) {
+	md := new(MigrationQueueMetadata)
+	err := getAs(x.index("MigrationQueue", "Metadata"), md)
+	if err != nil {
+		return nil, err
+	}
+	return md, nil
+}
+
+// Len returns the number of accounts in the queue.
+func (x *MigrationQueueIndexer) Len() (uint64, error) {
+	md, err := x.metadata()
+	if err != nil {
+		return 0, err
+	}
+	return md.Tail - md.Head, nil
+}
+
+// Push adds accounts to the end of the queue.
+func (x *MigrationQueueIndexer) Push(accounts ...*url.URL) error {
+	md, err := x.metadata()
+	if err != nil {
+		return err
+	}
+
+	for _, u := range accounts {
+		x.index("MigrationQueue", md.Tail).Put([]byte(u.String()))
+		md.Tail++
+	}
+	return putAs(x.index("MigrationQueue", "Metadata"), md)
+}
+
+// Keys returns the keys of the values the queue is stored in. Accounts that
+// have been popped are not included.
+func (x *MigrationQueueIndexer) Keys() ([][]interface{}, error) {
+	md, err := x.metadata()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([][]interface{}, 0, md.Tail-md.Head+1)
+	keys = append(keys, []interface{}{"MigrationQueue", "Metadata"})
+	for i := md.Head; i < md.Tail; i++ {
+		keys = append(keys, []interface{}{"MigrationQueue", i})
+	}
+	return keys, nil
+}
+
+// Peek loads up to n accounts from the front of the queue.
+func (x *MigrationQueueIndexer) Peek(n uint64) ([]*url.URL, error) {
+	md, err := x.metadata()
+	if err != nil {
+		return nil, err
+	}
+
+	if n > md.Tail-md.Head {
+		n = md.Tail - md.Head
+	}
+
+	accounts := make([]*url.URL, 0, n)
+	for i := md.Head; i < md.Head+n; i++ {
+		data, err := x.index("MigrationQueue", i).Get()
+		if err != nil {
+			return nil, err
+		}
+		u, err := url.Parse(string(data))
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, u)
+	}
+	return accounts, nil
+}
+
+// Pop removes up to n accounts from the front of the queue.
+func (x *MigrationQueueIndexer) Pop(n uint64) error {
+	md, err := x.metadata()
+	if err != nil {
+		return err
+	}
+
+	if n > md.Tail-md.Head {
+		n = md.Tail - md.Head
+	}
+	md.Head += n
+	return putAs(x.index("MigrationQueue", "Metadata"), md)
+}
+
+// Clear removes every account from the queue.
+func (x *MigrationQueueIndexer) Clear() error {
+	md, err := x.metadata()
+	if err != nil {
+		return err
+	}
+
+	md.Head = md.Tail
+	return putAs(x.index("MigrationQueue", "Metadata"), md)
+}
@@ -0,0 +1,85 @@
+package indexing
+
+import (
+	"errors"
+
+	"gitlab.com/accumulatenetwork/accumulate/internal/database"
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
+)
+
+// OracleFeedIndexer indexes the most recent submission of an oracle feed.
+type OracleFeedIndexer struct {
+	value *database.Value
+}
+
+// OracleFeed returns an oracle feed indexer.
+func OracleFeed(batch *database.Batch, ledger, feed *url.URL) *OracleFeedIndexer {
+	return &OracleFeedIndexer{batch.Account(ledger).Index("OracleFeed", feed.AccountID32())}
+}
+
+// Get loads the most recent submission of the feed.
+func (x *OracleFeedIndexer) Get() (*protocol.OracleSubmission, error) {
+	sub := new(protocol.OracleSubmission)
+	err := x.value.GetAs(sub)
+	if err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// Put records the most recent submission of the feed.
+func (x *OracleFeedIndexer) Put(sub *protocol.OracleSubmission) error {
+	return x.value.PutAs(sub)
+}
+
+// Value returns the value the submission is stored in.
+func (x *OracleFeedIndexer) Value() *database.Value {
+	return x.value
+}
+
+// OracleHistoryIndexer indexes each change of the active oracle price.
+type OracleHistoryIndexer struct {
+	account *database.Account
+}
+
+// OracleHistory returns an oracle history indexer.
+func OracleHistory(batch *database.Batch, ledger *url.URL) *OracleHistoryIndexer {
+	return &OracleHistoryIndexer{batch.Account(ledger)}
+}
+
+// Count returns the number of entries in the history.
+func (x *OracleHistoryIndexer) Count() (uint64, error) {
+	md := new(OracleHistoryMetadata)
+	err := x.account.Index("OracleHistory", "Metadata").GetAs(md)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return 0, err
+	}
+	return md.Count, nil
+}
+
+// Append adds an entry to the history.
+func (x *OracleHistoryIndexer) Append(entry *OracleHistoryEntry) error {
+	count, err := x.Count()
+	if err != nil {
+		return err
+	}
+
+	err = x.account.Index("OracleHistory", count).PutAs(entry)
+	if err != nil {
+		return err
+	}
+
+	return x.account.Index("OracleHistory", "Metadata").PutAs(&OracleHistoryMetadata{Count: count + 1})
+}
+
+// Get loads an entry of the history.
+func (x *OracleHistoryIndexer) Get(i uint64) (*OracleHistoryEntry, error) {
+	entry := new(OracleHistoryEntry)
+	err := x.account.Index("OracleHistory", i).GetAs(entry)
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
@@ -0,0 +1,196 @@
+package indexing
+
+import (
+	"encoding"
+	"errors"
+
+	"gitlab.com/accumulatenetwork/accumulate/internal/database"
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
+)
+
+// Value is a value that can be read and written. A *database.Value is a Value.
+type Value interface {
+	Get() ([]byte, error)
+	Put([]byte)
+}
+
+// SyntheticOutboxIndexer indexes the synthetic transactions produced by a
+// subnet that have not been acknowledged by their destination. Each entry is
+// stored separately, alongside the list of transactions in the outbox.
+type SyntheticOutboxIndexer struct {
+	index func(key ...interface{}) Value
+}
+
+// SyntheticOutbox returns a synthetic outbox indexer.
+func SyntheticOutbox(batch *database.Batch, ledger *url.URL) *SyntheticOutboxIndexer {
+	return NewSyntheticOutbox(func(key ...interface{}) Value {
+		return batch.Account(ledger).Index(key...)
+	})
+}
+
+// NewSyntheticOutbox returns a synthetic outbox indexer that reads and writes
+// the given values.
+func NewSyntheticOutbox(index func(key ...interface{}) Value) *SyntheticOutboxIndexer {
+	return &SyntheticOutboxIndexer{index}
+}
+
+// Transactions loads the list of transactions in the outbox.
+func (x *SyntheticOutboxIndexer) Transactions() ([][32]byte, error) {
+	idx := new(PendingTransactionsIndex)
+	err := getAs(x.index("SyntheticOutbox"), idx)
+	if err != nil {
+		return nil, err
+	}
+
+	return idx.Transactions, nil
+}
+
+// Entries loads every entry of the outbox.
+func (x *SyntheticOutboxIndexer) Entries() ([]*SyntheticOutboxEntry, error) {
+	txids, err := x.Transactions()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*SyntheticOutboxEntry, 0, len(txids))
+	for _, txid := range txids {
+		entry, err := x.Get(txid)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// Get loads the entry of a transaction. Get returns storage.ErrNotFound if the
+// transaction is not in the outbox.
+func (x *SyntheticOutboxIndexer) Get(txid [32]byte) (*SyntheticOutboxEntry, error) {
+	txids, err := x.Transactions()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range txids {
+		if id != txid {
+			continue
+		}
+
+		entry := new(SyntheticOutboxEntry)
+		data, err := x.index("SyntheticOutbox", txid).Get()
+		if err != nil {
+			return nil, err
+		}
+		err = entry.UnmarshalBinary(data)
+		if err != nil {
+			return nil, err
+		}
+		return entry, nil
+	}
+
+	return nil, storage.ErrNotFound
+}
+
+// Put adds an entry to the outbox, or updates it if the transaction is already
+// in the outbox.
+func (x *SyntheticOutboxIndexer) Put(entry *SyntheticOutboxEntry) error {
+	txids, err := x.Transactions()
+	if err != nil {
+		return err
+	}
+
+	data, err := entry.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	x.index("SyntheticOutbox", entry.Transaction).Put(data)
+
+	for _, id := range txids {
+		if id == entry.Transaction {
+			return nil
+		}
+	}
+
+	txids = append(txids, entry.Transaction)
+	return putAs(x.index("SyntheticOutbox"), &PendingTransactionsIndex{Transactions: txids})
+}
+
+// Keys returns the keys of the values the outbox is stored in.
+func (x *SyntheticOutboxIndexer) Keys() ([][]interface{}, error) {
+	txids, err := x.Transactions()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([][]interface{}, 0, len(txids)+1)
+	keys = append(keys, []interface{}{"SyntheticOutbox"})
+	for _, id := range txids {
+		keys = append(keys, []interface{}{"SyntheticOutbox", id})
+	}
+	return keys, nil
+}
+
+// Remove removes the acknowledged transactions from the outbox and returns the
+// number of transactions that were removed. The entries of the removed
+// transactions are cleared, since the store cannot delete values.
+func (x *SyntheticOutboxIndexer) Remove(txids ...[32]byte) (int, error) {
+	outbox, err := x.Transactions()
+	if err != nil {
+		return 0, err
+	}
+	if len(outbox) == 0 || len(txids) == 0 {
+		return 0, nil
+	}
+
+	acked := make(map[[32]byte]bool, len(txids))
+	for _, id := range txids {
+		acked[id] = true
+	}
+
+	remaining := make([][32]byte, 0, len(outbox))
+	for _, id := range outbox {
+		if !acked[id] {
+			remaining = append(remaining, id)
+			continue
+		}
+
+		x.index("SyntheticOutbox", id).Put([]byte{})
+	}
+
+	removed := len(outbox) - len(remaining)
+	if removed == 0 {
+		return 0, nil
+	}
+	return removed, putAs(x.index("SyntheticOutbox"), &PendingTransactionsIndex{Transactions: remaining})
+}
+
+// Age returns the number of blocks since the transaction was produced.
+func (e *SyntheticOutboxEntry) Age(block uint64) uint64 {
+	if block < e.ProducedBlock {
+		return 0
+	}
+	return block - e.ProducedBlock
+}
+
+func getAs(v Value, u encoding.BinaryUnmarshaler) error {
+	data, err := v.Get()
+	switch {
+	case err == nil:
+		return u.UnmarshalBinary(data)
+	case errors.Is(err, storage.ErrNotFound):
+		return nil
+	default:
+		return err
+	}
+}
+
+func putAs(v Value, u encoding.BinaryMarshaler) error {
+	data, err := u.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	v.Put(data)
+	return nil
+}
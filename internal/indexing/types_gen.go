@@ -8,12 +8,31 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"strings"
+	"time"
 
 	"gitlab.com/accumulatenetwork/accumulate/internal/encoding"
 	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
 )
 
+type BlockIndex struct {
+	fieldsSet         []bool
+	Height            uint64                    `json:"height,omitempty" form:"height" query:"height" validate:"required"`
+	Time              time.Time                 `json:"time,omitempty" form:"time" query:"time" validate:"required"`
+	RootAnchor        [32]byte                  `json:"rootAnchor,omitempty" form:"rootAnchor" query:"rootAnchor" validate:"required"`
+	Updates           []protocol.AnchorMetadata `json:"updates,omitempty" form:"updates" query:"updates" validate:"required"`
+	DeliveredTxns     [][32]byte                `json:"deliveredTxns,omitempty" form:"deliveredTxns" query:"deliveredTxns" validate:"required"`
+	ProducedSynthTxns [][32]byte                `json:"producedSynthTxns,omitempty" form:"producedSynthTxns" query:"producedSynthTxns" validate:"required"`
+}
+
+type BlockRootIndex struct {
+	fieldsSet     []bool
+	BptEntry      uint64 `json:"bptEntry,omitempty" form:"bptEntry" query:"bptEntry" validate:"required"`
+	PreviousBlock uint64 `json:"previousBlock,omitempty" form:"previousBlock" query:"previousBlock" validate:"required"`
+}
+
 type BlockStateIndex struct {
 	fieldsSet         []bool
 	ProducedSynthTxns []*BlockStateSynthTxnEntry `json:"producedSynthTxns,omitempty" form:"producedSynthTxns" query:"producedSynthTxns" validate:"required"`
@@ -25,11 +44,71 @@ type BlockStateSynthTxnEntry struct {
 	ChainEntry  uint64 `json:"chainEntry,omitempty" form:"chainEntry" query:"chainEntry" validate:"required"`
 }
 
+type FaucetLedger struct {
+	fieldsSet     []bool
+	Block         uint64             `json:"block,omitempty" form:"block" query:"block" validate:"required"`
+	BlockRequests uint64             `json:"blockRequests,omitempty" form:"blockRequests" query:"blockRequests" validate:"required"`
+	Day           uint64             `json:"day,omitempty" form:"day" query:"day" validate:"required"`
+	DayTotal      big.Int            `json:"dayTotal,omitempty" form:"dayTotal" query:"dayTotal" validate:"required"`
+	Recipients    []*FaucetRecipient `json:"recipients,omitempty" form:"recipients" query:"recipients" validate:"required"`
+}
+
+type FaucetRecipient struct {
+	fieldsSet   []bool
+	Recipient   *url.URL  `json:"recipient,omitempty" form:"recipient" query:"recipient" validate:"required"`
+	LastDeposit time.Time `json:"lastDeposit,omitempty" form:"lastDeposit" query:"lastDeposit" validate:"required"`
+}
+
+type KeyHashIndex struct {
+	fieldsSet []bool
+	Accounts  []*url.URL `json:"accounts,omitempty" form:"accounts" query:"accounts" validate:"required"`
+}
+
+type KeyPageOperationsIndex struct {
+	fieldsSet []bool
+	Pages     []*url.URL `json:"pages,omitempty" form:"pages" query:"pages" validate:"required"`
+}
+
+type MigrationQueueMetadata struct {
+	fieldsSet []bool
+	Head      uint64 `json:"head,omitempty" form:"head" query:"head" validate:"required"`
+	Tail      uint64 `json:"tail,omitempty" form:"tail" query:"tail" validate:"required"`
+}
+
+type OracleHistoryEntry struct {
+	fieldsSet []bool
+	Block     uint64    `json:"block,omitempty" form:"block" query:"block" validate:"required"`
+	Time      time.Time `json:"time,omitempty" form:"time" query:"time" validate:"required"`
+	Price     uint64    `json:"price,omitempty" form:"price" query:"price" validate:"required"`
+}
+
+type OracleHistoryMetadata struct {
+	fieldsSet []bool
+	Count     uint64 `json:"count,omitempty" form:"count" query:"count" validate:"required"`
+}
+
 type PendingTransactionsIndex struct {
 	fieldsSet    []bool
 	Transactions [][32]byte `json:"transactions,omitempty" form:"transactions" query:"transactions" validate:"required"`
 }
 
+type StateReceiptIndex struct {
+	fieldsSet []bool
+	Block     uint64           `json:"block,omitempty" form:"block" query:"block" validate:"required"`
+	Receipt   protocol.Receipt `json:"receipt,omitempty" form:"receipt" query:"receipt" validate:"required"`
+}
+
+type SyntheticOutboxEntry struct {
+	fieldsSet     []bool
+	Transaction   [32]byte                 `json:"transaction,omitempty" form:"transaction" query:"transaction" validate:"required"`
+	Type          protocol.TransactionType `json:"type,omitempty" form:"type" query:"type" validate:"required"`
+	Destination   *url.URL                 `json:"destination,omitempty" form:"destination" query:"destination" validate:"required"`
+	ProducedBlock uint64                   `json:"producedBlock,omitempty" form:"producedBlock" query:"producedBlock" validate:"required"`
+	ProducedTime  time.Time                `json:"producedTime,omitempty" form:"producedTime" query:"producedTime" validate:"required"`
+	Attempts      uint64                   `json:"attempts,omitempty" form:"attempts" query:"attempts" validate:"required"`
+	NextAttempt   uint64                   `json:"nextAttempt,omitempty" form:"nextAttempt" query:"nextAttempt" validate:"required"`
+}
+
 type TransactionChainEntry struct {
 	fieldsSet   []bool
 	Account     *url.URL `json:"account,omitempty" form:"account" query:"account" validate:"required"`
@@ -46,6 +125,55 @@ type TransactionChainIndex struct {
 	Entries   []*TransactionChainEntry `json:"entries,omitempty" form:"entries" query:"entries" validate:"required"`
 }
 
+func (v *BlockIndex) Equal(u *BlockIndex) bool {
+	if !(v.Height == u.Height) {
+		return false
+	}
+	if !(v.Time == u.Time) {
+		return false
+	}
+	if !(v.RootAnchor == u.RootAnchor) {
+		return false
+	}
+	if len(v.Updates) != len(u.Updates) {
+		return false
+	}
+	for i := range v.Updates {
+		if !((&v.Updates[i]).Equal(&u.Updates[i])) {
+			return false
+		}
+	}
+	if len(v.DeliveredTxns) != len(u.DeliveredTxns) {
+		return false
+	}
+	for i := range v.DeliveredTxns {
+		if !(v.DeliveredTxns[i] == u.DeliveredTxns[i]) {
+			return false
+		}
+	}
+	if len(v.ProducedSynthTxns) != len(u.ProducedSynthTxns) {
+		return false
+	}
+	for i := range v.ProducedSynthTxns {
+		if !(v.ProducedSynthTxns[i] == u.ProducedSynthTxns[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *BlockRootIndex) Equal(u *BlockRootIndex) bool {
+	if !(v.BptEntry == u.BptEntry) {
+		return false
+	}
+	if !(v.PreviousBlock == u.PreviousBlock) {
+		return false
+	}
+
+	return true
+}
+
 func (v *BlockStateIndex) Equal(u *BlockStateIndex) bool {
 	if len(v.ProducedSynthTxns) != len(u.ProducedSynthTxns) {
 		return false
@@ -70,6 +198,101 @@ func (v *BlockStateSynthTxnEntry) Equal(u *BlockStateSynthTxnEntry) bool {
 	return true
 }
 
+func (v *FaucetLedger) Equal(u *FaucetLedger) bool {
+	if !(v.Block == u.Block) {
+		return false
+	}
+	if !(v.BlockRequests == u.BlockRequests) {
+		return false
+	}
+	if !(v.Day == u.Day) {
+		return false
+	}
+	if !((&v.DayTotal).Cmp(&u.DayTotal) == 0) {
+		return false
+	}
+	if len(v.Recipients) != len(u.Recipients) {
+		return false
+	}
+	for i := range v.Recipients {
+		if !((v.Recipients[i]).Equal(u.Recipients[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *FaucetRecipient) Equal(u *FaucetRecipient) bool {
+	if !((v.Recipient).Equal(u.Recipient)) {
+		return false
+	}
+	if !(v.LastDeposit == u.LastDeposit) {
+		return false
+	}
+
+	return true
+}
+
+func (v *KeyHashIndex) Equal(u *KeyHashIndex) bool {
+	if len(v.Accounts) != len(u.Accounts) {
+		return false
+	}
+	for i := range v.Accounts {
+		if !((v.Accounts[i]).Equal(u.Accounts[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *KeyPageOperationsIndex) Equal(u *KeyPageOperationsIndex) bool {
+	if len(v.Pages) != len(u.Pages) {
+		return false
+	}
+	for i := range v.Pages {
+		if !((v.Pages[i]).Equal(u.Pages[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *MigrationQueueMetadata) Equal(u *MigrationQueueMetadata) bool {
+	if !(v.Head == u.Head) {
+		return false
+	}
+	if !(v.Tail == u.Tail) {
+		return false
+	}
+
+	return true
+}
+
+func (v *OracleHistoryEntry) Equal(u *OracleHistoryEntry) bool {
+	if !(v.Block == u.Block) {
+		return false
+	}
+	if !(v.Time == u.Time) {
+		return false
+	}
+	if !(v.Price == u.Price) {
+		return false
+	}
+
+	return true
+}
+
+func (v *OracleHistoryMetadata) Equal(u *OracleHistoryMetadata) bool {
+	if !(v.Count == u.Count) {
+		return false
+	}
+
+	return true
+}
+
 func (v *PendingTransactionsIndex) Equal(u *PendingTransactionsIndex) bool {
 	if len(v.Transactions) != len(u.Transactions) {
 		return false
@@ -83,6 +306,43 @@ func (v *PendingTransactionsIndex) Equal(u *PendingTransactionsIndex) bool {
 	return true
 }
 
+func (v *StateReceiptIndex) Equal(u *StateReceiptIndex) bool {
+	if !(v.Block == u.Block) {
+		return false
+	}
+	if !((&v.Receipt).Equal(&u.Receipt)) {
+		return false
+	}
+
+	return true
+}
+
+func (v *SyntheticOutboxEntry) Equal(u *SyntheticOutboxEntry) bool {
+	if !(v.Transaction == u.Transaction) {
+		return false
+	}
+	if !(v.Type == u.Type) {
+		return false
+	}
+	if !((v.Destination).Equal(u.Destination)) {
+		return false
+	}
+	if !(v.ProducedBlock == u.ProducedBlock) {
+		return false
+	}
+	if !(v.ProducedTime == u.ProducedTime) {
+		return false
+	}
+	if !(v.Attempts == u.Attempts) {
+		return false
+	}
+	if !(v.NextAttempt == u.NextAttempt) {
+		return false
+	}
+
+	return true
+}
+
 func (v *TransactionChainEntry) Equal(u *TransactionChainEntry) bool {
 	if !((v.Account).Equal(u.Account)) {
 		return false
@@ -122,6 +382,136 @@ func (v *TransactionChainIndex) Equal(u *TransactionChainIndex) bool {
 	return true
 }
 
+var fieldNames_BlockIndex = []string{
+	1: "Height",
+	2: "Time",
+	3: "RootAnchor",
+	4: "Updates",
+	5: "DeliveredTxns",
+	6: "ProducedSynthTxns",
+}
+
+func (v *BlockIndex) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Height == 0) {
+		writer.WriteUint(1, v.Height)
+	}
+	if !(v.Time == (time.Time{})) {
+		writer.WriteTime(2, v.Time)
+	}
+	if !(v.RootAnchor == ([32]byte{})) {
+		writer.WriteHash(3, &v.RootAnchor)
+	}
+	if !(len(v.Updates) == 0) {
+		for _, v := range v.Updates {
+			writer.WriteValue(4, &v)
+		}
+	}
+	if !(len(v.DeliveredTxns) == 0) {
+		for _, v := range v.DeliveredTxns {
+			writer.WriteHash(5, &v)
+		}
+	}
+	if !(len(v.ProducedSynthTxns) == 0) {
+		for _, v := range v.ProducedSynthTxns {
+			writer.WriteHash(6, &v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_BlockIndex)
+	return buffer.Bytes(), err
+}
+
+func (v *BlockIndex) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Height is missing")
+	} else if v.Height == 0 {
+		errs = append(errs, "field Height is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Time is missing")
+	} else if v.Time == (time.Time{}) {
+		errs = append(errs, "field Time is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field RootAnchor is missing")
+	} else if v.RootAnchor == ([32]byte{}) {
+		errs = append(errs, "field RootAnchor is not set")
+	}
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field Updates is missing")
+	} else if len(v.Updates) == 0 {
+		errs = append(errs, "field Updates is not set")
+	}
+	if len(v.fieldsSet) > 5 && !v.fieldsSet[5] {
+		errs = append(errs, "field DeliveredTxns is missing")
+	} else if len(v.DeliveredTxns) == 0 {
+		errs = append(errs, "field DeliveredTxns is not set")
+	}
+	if len(v.fieldsSet) > 6 && !v.fieldsSet[6] {
+		errs = append(errs, "field ProducedSynthTxns is missing")
+	} else if len(v.ProducedSynthTxns) == 0 {
+		errs = append(errs, "field ProducedSynthTxns is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_BlockRootIndex = []string{
+	1: "BptEntry",
+	2: "PreviousBlock",
+}
+
+func (v *BlockRootIndex) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.BptEntry == 0) {
+		writer.WriteUint(1, v.BptEntry)
+	}
+	if !(v.PreviousBlock == 0) {
+		writer.WriteUint(2, v.PreviousBlock)
+	}
+
+	_, _, err := writer.Reset(fieldNames_BlockRootIndex)
+	return buffer.Bytes(), err
+}
+
+func (v *BlockRootIndex) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field BptEntry is missing")
+	} else if v.BptEntry == 0 {
+		errs = append(errs, "field BptEntry is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field PreviousBlock is missing")
+	} else if v.PreviousBlock == 0 {
+		errs = append(errs, "field PreviousBlock is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
 var fieldNames_BlockStateIndex = []string{
 	1: "ProducedSynthTxns",
 }
@@ -203,31 +593,487 @@ func (v *BlockStateSynthTxnEntry) IsValid() error {
 	}
 }
 
-var fieldNames_PendingTransactionsIndex = []string{
-	1: "Transactions",
+var fieldNames_FaucetLedger = []string{
+	1: "Block",
+	2: "BlockRequests",
+	3: "Day",
+	4: "DayTotal",
+	5: "Recipients",
 }
 
-func (v *PendingTransactionsIndex) MarshalBinary() ([]byte, error) {
+func (v *FaucetLedger) MarshalBinary() ([]byte, error) {
 	buffer := new(bytes.Buffer)
 	writer := encoding.NewWriter(buffer)
 
-	if !(len(v.Transactions) == 0) {
-		for _, v := range v.Transactions {
-			writer.WriteHash(1, &v)
+	if !(v.Block == 0) {
+		writer.WriteUint(1, v.Block)
+	}
+	if !(v.BlockRequests == 0) {
+		writer.WriteUint(2, v.BlockRequests)
+	}
+	if !(v.Day == 0) {
+		writer.WriteUint(3, v.Day)
+	}
+	if !((v.DayTotal).Cmp(new(big.Int)) == 0) {
+		writer.WriteBigInt(4, &v.DayTotal)
+	}
+	if !(len(v.Recipients) == 0) {
+		for _, v := range v.Recipients {
+			writer.WriteValue(5, v)
 		}
 	}
 
-	_, _, err := writer.Reset(fieldNames_PendingTransactionsIndex)
+	_, _, err := writer.Reset(fieldNames_FaucetLedger)
+	return buffer.Bytes(), err
+}
+
+func (v *FaucetLedger) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Block is missing")
+	} else if v.Block == 0 {
+		errs = append(errs, "field Block is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field BlockRequests is missing")
+	} else if v.BlockRequests == 0 {
+		errs = append(errs, "field BlockRequests is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Day is missing")
+	} else if v.Day == 0 {
+		errs = append(errs, "field Day is not set")
+	}
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field DayTotal is missing")
+	} else if (v.DayTotal).Cmp(new(big.Int)) == 0 {
+		errs = append(errs, "field DayTotal is not set")
+	}
+	if len(v.fieldsSet) > 5 && !v.fieldsSet[5] {
+		errs = append(errs, "field Recipients is missing")
+	} else if len(v.Recipients) == 0 {
+		errs = append(errs, "field Recipients is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_FaucetRecipient = []string{
+	1: "Recipient",
+	2: "LastDeposit",
+}
+
+func (v *FaucetRecipient) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Recipient == nil) {
+		writer.WriteUrl(1, v.Recipient)
+	}
+	if !(v.LastDeposit == (time.Time{})) {
+		writer.WriteTime(2, v.LastDeposit)
+	}
+
+	_, _, err := writer.Reset(fieldNames_FaucetRecipient)
+	return buffer.Bytes(), err
+}
+
+func (v *FaucetRecipient) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Recipient is missing")
+	} else if v.Recipient == nil {
+		errs = append(errs, "field Recipient is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field LastDeposit is missing")
+	} else if v.LastDeposit == (time.Time{}) {
+		errs = append(errs, "field LastDeposit is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_KeyHashIndex = []string{
+	1: "Accounts",
+}
+
+func (v *KeyHashIndex) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(len(v.Accounts) == 0) {
+		for _, v := range v.Accounts {
+			writer.WriteUrl(1, v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_KeyHashIndex)
+	return buffer.Bytes(), err
+}
+
+func (v *KeyHashIndex) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Accounts is missing")
+	} else if len(v.Accounts) == 0 {
+		errs = append(errs, "field Accounts is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_KeyPageOperationsIndex = []string{
+	1: "Pages",
+}
+
+func (v *KeyPageOperationsIndex) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(len(v.Pages) == 0) {
+		for _, v := range v.Pages {
+			writer.WriteUrl(1, v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_KeyPageOperationsIndex)
+	return buffer.Bytes(), err
+}
+
+func (v *KeyPageOperationsIndex) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Pages is missing")
+	} else if len(v.Pages) == 0 {
+		errs = append(errs, "field Pages is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_MigrationQueueMetadata = []string{
+	1: "Head",
+	2: "Tail",
+}
+
+func (v *MigrationQueueMetadata) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Head == 0) {
+		writer.WriteUint(1, v.Head)
+	}
+	if !(v.Tail == 0) {
+		writer.WriteUint(2, v.Tail)
+	}
+
+	_, _, err := writer.Reset(fieldNames_MigrationQueueMetadata)
+	return buffer.Bytes(), err
+}
+
+func (v *MigrationQueueMetadata) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Head is missing")
+	} else if v.Head == 0 {
+		errs = append(errs, "field Head is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Tail is missing")
+	} else if v.Tail == 0 {
+		errs = append(errs, "field Tail is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_OracleHistoryEntry = []string{
+	1: "Block",
+	2: "Time",
+	3: "Price",
+}
+
+func (v *OracleHistoryEntry) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Block == 0) {
+		writer.WriteUint(1, v.Block)
+	}
+	if !(v.Time == (time.Time{})) {
+		writer.WriteTime(2, v.Time)
+	}
+	if !(v.Price == 0) {
+		writer.WriteUint(3, v.Price)
+	}
+
+	_, _, err := writer.Reset(fieldNames_OracleHistoryEntry)
+	return buffer.Bytes(), err
+}
+
+func (v *OracleHistoryEntry) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Block is missing")
+	} else if v.Block == 0 {
+		errs = append(errs, "field Block is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Time is missing")
+	} else if v.Time == (time.Time{}) {
+		errs = append(errs, "field Time is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Price is missing")
+	} else if v.Price == 0 {
+		errs = append(errs, "field Price is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_OracleHistoryMetadata = []string{
+	1: "Count",
+}
+
+func (v *OracleHistoryMetadata) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Count == 0) {
+		writer.WriteUint(1, v.Count)
+	}
+
+	_, _, err := writer.Reset(fieldNames_OracleHistoryMetadata)
+	return buffer.Bytes(), err
+}
+
+func (v *OracleHistoryMetadata) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Count is missing")
+	} else if v.Count == 0 {
+		errs = append(errs, "field Count is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_PendingTransactionsIndex = []string{
+	1: "Transactions",
+}
+
+func (v *PendingTransactionsIndex) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(len(v.Transactions) == 0) {
+		for _, v := range v.Transactions {
+			writer.WriteHash(1, &v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_PendingTransactionsIndex)
+	return buffer.Bytes(), err
+}
+
+func (v *PendingTransactionsIndex) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Transactions is missing")
+	} else if len(v.Transactions) == 0 {
+		errs = append(errs, "field Transactions is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_StateReceiptIndex = []string{
+	1: "Block",
+	2: "Receipt",
+}
+
+func (v *StateReceiptIndex) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Block == 0) {
+		writer.WriteUint(1, v.Block)
+	}
+	if !((v.Receipt).Equal(new(protocol.Receipt))) {
+		writer.WriteValue(2, &v.Receipt)
+	}
+
+	_, _, err := writer.Reset(fieldNames_StateReceiptIndex)
+	return buffer.Bytes(), err
+}
+
+func (v *StateReceiptIndex) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Block is missing")
+	} else if v.Block == 0 {
+		errs = append(errs, "field Block is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Receipt is missing")
+	} else if (v.Receipt).Equal(new(protocol.Receipt)) {
+		errs = append(errs, "field Receipt is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_SyntheticOutboxEntry = []string{
+	1: "Transaction",
+	2: "Type",
+	3: "Destination",
+	4: "ProducedBlock",
+	5: "ProducedTime",
+	6: "Attempts",
+	7: "NextAttempt",
+}
+
+func (v *SyntheticOutboxEntry) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Transaction == ([32]byte{})) {
+		writer.WriteHash(1, &v.Transaction)
+	}
+	if !(v.Type == 0) {
+		writer.WriteEnum(2, v.Type)
+	}
+	if !(v.Destination == nil) {
+		writer.WriteUrl(3, v.Destination)
+	}
+	if !(v.ProducedBlock == 0) {
+		writer.WriteUint(4, v.ProducedBlock)
+	}
+	if !(v.ProducedTime == (time.Time{})) {
+		writer.WriteTime(5, v.ProducedTime)
+	}
+	if !(v.Attempts == 0) {
+		writer.WriteUint(6, v.Attempts)
+	}
+	if !(v.NextAttempt == 0) {
+		writer.WriteUint(7, v.NextAttempt)
+	}
+
+	_, _, err := writer.Reset(fieldNames_SyntheticOutboxEntry)
 	return buffer.Bytes(), err
 }
 
-func (v *PendingTransactionsIndex) IsValid() error {
+func (v *SyntheticOutboxEntry) IsValid() error {
 	var errs []string
 
 	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
-		errs = append(errs, "field Transactions is missing")
-	} else if len(v.Transactions) == 0 {
-		errs = append(errs, "field Transactions is not set")
+		errs = append(errs, "field Transaction is missing")
+	} else if v.Transaction == ([32]byte{}) {
+		errs = append(errs, "field Transaction is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Type is missing")
+	} else if v.Type == 0 {
+		errs = append(errs, "field Type is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Destination is missing")
+	} else if v.Destination == nil {
+		errs = append(errs, "field Destination is not set")
+	}
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field ProducedBlock is missing")
+	} else if v.ProducedBlock == 0 {
+		errs = append(errs, "field ProducedBlock is not set")
+	}
+	if len(v.fieldsSet) > 5 && !v.fieldsSet[5] {
+		errs = append(errs, "field ProducedTime is missing")
+	} else if v.ProducedTime == (time.Time{}) {
+		errs = append(errs, "field ProducedTime is not set")
+	}
+	if len(v.fieldsSet) > 6 && !v.fieldsSet[6] {
+		errs = append(errs, "field Attempts is missing")
+	} else if v.Attempts == 0 {
+		errs = append(errs, "field Attempts is not set")
+	}
+	if len(v.fieldsSet) > 7 && !v.fieldsSet[7] {
+		errs = append(errs, "field NextAttempt is missing")
+	} else if v.NextAttempt == 0 {
+		errs = append(errs, "field NextAttempt is not set")
 	}
 
 	switch len(errs) {
@@ -366,6 +1212,68 @@ func (v *TransactionChainIndex) IsValid() error {
 	}
 }
 
+func (v *BlockIndex) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *BlockIndex) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUint(1); ok {
+		v.Height = x
+	}
+	if x, ok := reader.ReadTime(2); ok {
+		v.Time = x
+	}
+	if x, ok := reader.ReadHash(3); ok {
+		v.RootAnchor = *x
+	}
+	for {
+		if x := new(protocol.AnchorMetadata); reader.ReadValue(4, x.UnmarshalBinary) {
+			v.Updates = append(v.Updates, *x)
+		} else {
+			break
+		}
+	}
+	for {
+		if x, ok := reader.ReadHash(5); ok {
+			v.DeliveredTxns = append(v.DeliveredTxns, *x)
+		} else {
+			break
+		}
+	}
+	for {
+		if x, ok := reader.ReadHash(6); ok {
+			v.ProducedSynthTxns = append(v.ProducedSynthTxns, *x)
+		} else {
+			break
+		}
+	}
+
+	seen, err := reader.Reset(fieldNames_BlockIndex)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *BlockRootIndex) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *BlockRootIndex) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUint(1); ok {
+		v.BptEntry = x
+	}
+	if x, ok := reader.ReadUint(2); ok {
+		v.PreviousBlock = x
+	}
+
+	seen, err := reader.Reset(fieldNames_BlockRootIndex)
+	v.fieldsSet = seen
+	return err
+}
+
 func (v *BlockStateIndex) UnmarshalBinary(data []byte) error {
 	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
 }
@@ -405,6 +1313,154 @@ func (v *BlockStateSynthTxnEntry) UnmarshalBinaryFrom(rd io.Reader) error {
 	return err
 }
 
+func (v *FaucetLedger) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *FaucetLedger) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUint(1); ok {
+		v.Block = x
+	}
+	if x, ok := reader.ReadUint(2); ok {
+		v.BlockRequests = x
+	}
+	if x, ok := reader.ReadUint(3); ok {
+		v.Day = x
+	}
+	if x, ok := reader.ReadBigInt(4); ok {
+		v.DayTotal = *x
+	}
+	for {
+		if x := new(FaucetRecipient); reader.ReadValue(5, x.UnmarshalBinary) {
+			v.Recipients = append(v.Recipients, x)
+		} else {
+			break
+		}
+	}
+
+	seen, err := reader.Reset(fieldNames_FaucetLedger)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *FaucetRecipient) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *FaucetRecipient) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUrl(1); ok {
+		v.Recipient = x
+	}
+	if x, ok := reader.ReadTime(2); ok {
+		v.LastDeposit = x
+	}
+
+	seen, err := reader.Reset(fieldNames_FaucetRecipient)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *KeyHashIndex) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *KeyHashIndex) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	for {
+		if x, ok := reader.ReadUrl(1); ok {
+			v.Accounts = append(v.Accounts, x)
+		} else {
+			break
+		}
+	}
+
+	seen, err := reader.Reset(fieldNames_KeyHashIndex)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *KeyPageOperationsIndex) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *KeyPageOperationsIndex) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	for {
+		if x, ok := reader.ReadUrl(1); ok {
+			v.Pages = append(v.Pages, x)
+		} else {
+			break
+		}
+	}
+
+	seen, err := reader.Reset(fieldNames_KeyPageOperationsIndex)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *MigrationQueueMetadata) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *MigrationQueueMetadata) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUint(1); ok {
+		v.Head = x
+	}
+	if x, ok := reader.ReadUint(2); ok {
+		v.Tail = x
+	}
+
+	seen, err := reader.Reset(fieldNames_MigrationQueueMetadata)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *OracleHistoryEntry) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *OracleHistoryEntry) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUint(1); ok {
+		v.Block = x
+	}
+	if x, ok := reader.ReadTime(2); ok {
+		v.Time = x
+	}
+	if x, ok := reader.ReadUint(3); ok {
+		v.Price = x
+	}
+
+	seen, err := reader.Reset(fieldNames_OracleHistoryEntry)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *OracleHistoryMetadata) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *OracleHistoryMetadata) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUint(1); ok {
+		v.Count = x
+	}
+
+	seen, err := reader.Reset(fieldNames_OracleHistoryMetadata)
+	v.fieldsSet = seen
+	return err
+}
+
 func (v *PendingTransactionsIndex) UnmarshalBinary(data []byte) error {
 	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
 }
@@ -425,6 +1481,59 @@ func (v *PendingTransactionsIndex) UnmarshalBinaryFrom(rd io.Reader) error {
 	return err
 }
 
+func (v *StateReceiptIndex) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *StateReceiptIndex) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUint(1); ok {
+		v.Block = x
+	}
+	if x := new(protocol.Receipt); reader.ReadValue(2, x.UnmarshalBinary) {
+		v.Receipt = *x
+	}
+
+	seen, err := reader.Reset(fieldNames_StateReceiptIndex)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *SyntheticOutboxEntry) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *SyntheticOutboxEntry) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadHash(1); ok {
+		v.Transaction = *x
+	}
+	if x := new(protocol.TransactionType); reader.ReadEnum(2, x) {
+		v.Type = *x
+	}
+	if x, ok := reader.ReadUrl(3); ok {
+		v.Destination = x
+	}
+	if x, ok := reader.ReadUint(4); ok {
+		v.ProducedBlock = x
+	}
+	if x, ok := reader.ReadTime(5); ok {
+		v.ProducedTime = x
+	}
+	if x, ok := reader.ReadUint(6); ok {
+		v.Attempts = x
+	}
+	if x, ok := reader.ReadUint(7); ok {
+		v.NextAttempt = x
+	}
+
+	seen, err := reader.Reset(fieldNames_SyntheticOutboxEntry)
+	v.fieldsSet = seen
+	return err
+}
+
 func (v *TransactionChainEntry) UnmarshalBinary(data []byte) error {
 	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
 }
@@ -479,6 +1588,30 @@ func (v *TransactionChainIndex) UnmarshalBinaryFrom(rd io.Reader) error {
 	return err
 }
 
+func (v *BlockIndex) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Height            uint64                    `json:"height,omitempty"`
+		Time              time.Time                 `json:"time,omitempty"`
+		RootAnchor        string                    `json:"rootAnchor,omitempty"`
+		Updates           []protocol.AnchorMetadata `json:"updates,omitempty"`
+		DeliveredTxns     []string                  `json:"deliveredTxns,omitempty"`
+		ProducedSynthTxns []string                  `json:"producedSynthTxns,omitempty"`
+	}{}
+	u.Height = v.Height
+	u.Time = v.Time
+	u.RootAnchor = encoding.ChainToJSON(v.RootAnchor)
+	u.Updates = v.Updates
+	u.DeliveredTxns = make([]string, len(v.DeliveredTxns))
+	for i, x := range v.DeliveredTxns {
+		u.DeliveredTxns[i] = encoding.ChainToJSON(x)
+	}
+	u.ProducedSynthTxns = make([]string, len(v.ProducedSynthTxns))
+	for i, x := range v.ProducedSynthTxns {
+		u.ProducedSynthTxns[i] = encoding.ChainToJSON(x)
+	}
+	return json.Marshal(&u)
+}
+
 func (v *BlockStateSynthTxnEntry) MarshalJSON() ([]byte, error) {
 	u := struct {
 		Transaction *string `json:"transaction,omitempty"`
@@ -489,6 +1622,22 @@ func (v *BlockStateSynthTxnEntry) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&u)
 }
 
+func (v *FaucetLedger) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Block         uint64             `json:"block,omitempty"`
+		BlockRequests uint64             `json:"blockRequests,omitempty"`
+		Day           uint64             `json:"day,omitempty"`
+		DayTotal      *string            `json:"dayTotal,omitempty"`
+		Recipients    []*FaucetRecipient `json:"recipients,omitempty"`
+	}{}
+	u.Block = v.Block
+	u.BlockRequests = v.BlockRequests
+	u.Day = v.Day
+	u.DayTotal = encoding.BigintToJSON(&v.DayTotal)
+	u.Recipients = v.Recipients
+	return json.Marshal(&u)
+}
+
 func (v *PendingTransactionsIndex) MarshalJSON() ([]byte, error) {
 	u := struct {
 		Transactions []string `json:"transactions,omitempty"`
@@ -500,6 +1649,77 @@ func (v *PendingTransactionsIndex) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&u)
 }
 
+func (v *SyntheticOutboxEntry) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Transaction   string                   `json:"transaction,omitempty"`
+		Type          protocol.TransactionType `json:"type,omitempty"`
+		Destination   *url.URL                 `json:"destination,omitempty"`
+		ProducedBlock uint64                   `json:"producedBlock,omitempty"`
+		ProducedTime  time.Time                `json:"producedTime,omitempty"`
+		Attempts      uint64                   `json:"attempts,omitempty"`
+		NextAttempt   uint64                   `json:"nextAttempt,omitempty"`
+	}{}
+	u.Transaction = encoding.ChainToJSON(v.Transaction)
+	u.Type = v.Type
+	u.Destination = v.Destination
+	u.ProducedBlock = v.ProducedBlock
+	u.ProducedTime = v.ProducedTime
+	u.Attempts = v.Attempts
+	u.NextAttempt = v.NextAttempt
+	return json.Marshal(&u)
+}
+
+func (v *BlockIndex) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Height            uint64                    `json:"height,omitempty"`
+		Time              time.Time                 `json:"time,omitempty"`
+		RootAnchor        string                    `json:"rootAnchor,omitempty"`
+		Updates           []protocol.AnchorMetadata `json:"updates,omitempty"`
+		DeliveredTxns     []string                  `json:"deliveredTxns,omitempty"`
+		ProducedSynthTxns []string                  `json:"producedSynthTxns,omitempty"`
+	}{}
+	u.Height = v.Height
+	u.Time = v.Time
+	u.RootAnchor = encoding.ChainToJSON(v.RootAnchor)
+	u.Updates = v.Updates
+	u.DeliveredTxns = make([]string, len(v.DeliveredTxns))
+	for i, x := range v.DeliveredTxns {
+		u.DeliveredTxns[i] = encoding.ChainToJSON(x)
+	}
+	u.ProducedSynthTxns = make([]string, len(v.ProducedSynthTxns))
+	for i, x := range v.ProducedSynthTxns {
+		u.ProducedSynthTxns[i] = encoding.ChainToJSON(x)
+	}
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Height = u.Height
+	v.Time = u.Time
+	if x, err := encoding.ChainFromJSON(u.RootAnchor); err != nil {
+		return fmt.Errorf("error decoding RootAnchor: %w", err)
+	} else {
+		v.RootAnchor = x
+	}
+	v.Updates = u.Updates
+	v.DeliveredTxns = make([][32]byte, len(u.DeliveredTxns))
+	for i, x := range u.DeliveredTxns {
+		if x, err := encoding.ChainFromJSON(x); err != nil {
+			return fmt.Errorf("error decoding DeliveredTxns: %w", err)
+		} else {
+			v.DeliveredTxns[i] = x
+		}
+	}
+	v.ProducedSynthTxns = make([][32]byte, len(u.ProducedSynthTxns))
+	for i, x := range u.ProducedSynthTxns {
+		if x, err := encoding.ChainFromJSON(x); err != nil {
+			return fmt.Errorf("error decoding ProducedSynthTxns: %w", err)
+		} else {
+			v.ProducedSynthTxns[i] = x
+		}
+	}
+	return nil
+}
+
 func (v *BlockStateSynthTxnEntry) UnmarshalJSON(data []byte) error {
 	u := struct {
 		Transaction *string `json:"transaction,omitempty"`
@@ -519,6 +1739,34 @@ func (v *BlockStateSynthTxnEntry) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (v *FaucetLedger) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Block         uint64             `json:"block,omitempty"`
+		BlockRequests uint64             `json:"blockRequests,omitempty"`
+		Day           uint64             `json:"day,omitempty"`
+		DayTotal      *string            `json:"dayTotal,omitempty"`
+		Recipients    []*FaucetRecipient `json:"recipients,omitempty"`
+	}{}
+	u.Block = v.Block
+	u.BlockRequests = v.BlockRequests
+	u.Day = v.Day
+	u.DayTotal = encoding.BigintToJSON(&v.DayTotal)
+	u.Recipients = v.Recipients
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Block = u.Block
+	v.BlockRequests = u.BlockRequests
+	v.Day = u.Day
+	if x, err := encoding.BigintFromJSON(u.DayTotal); err != nil {
+		return fmt.Errorf("error decoding DayTotal: %w", err)
+	} else {
+		v.DayTotal = *x
+	}
+	v.Recipients = u.Recipients
+	return nil
+}
+
 func (v *PendingTransactionsIndex) UnmarshalJSON(data []byte) error {
 	u := struct {
 		Transactions []string `json:"transactions,omitempty"`
@@ -540,3 +1788,37 @@ func (v *PendingTransactionsIndex) UnmarshalJSON(data []byte) error {
 	}
 	return nil
 }
+
+func (v *SyntheticOutboxEntry) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Transaction   string                   `json:"transaction,omitempty"`
+		Type          protocol.TransactionType `json:"type,omitempty"`
+		Destination   *url.URL                 `json:"destination,omitempty"`
+		ProducedBlock uint64                   `json:"producedBlock,omitempty"`
+		ProducedTime  time.Time                `json:"producedTime,omitempty"`
+		Attempts      uint64                   `json:"attempts,omitempty"`
+		NextAttempt   uint64                   `json:"nextAttempt,omitempty"`
+	}{}
+	u.Transaction = encoding.ChainToJSON(v.Transaction)
+	u.Type = v.Type
+	u.Destination = v.Destination
+	u.ProducedBlock = v.ProducedBlock
+	u.ProducedTime = v.ProducedTime
+	u.Attempts = v.Attempts
+	u.NextAttempt = v.NextAttempt
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	if x, err := encoding.ChainFromJSON(u.Transaction); err != nil {
+		return fmt.Errorf("error decoding Transaction: %w", err)
+	} else {
+		v.Transaction = x
+	}
+	v.Type = u.Type
+	v.Destination = u.Destination
+	v.ProducedBlock = u.ProducedBlock
+	v.ProducedTime = u.ProducedTime
+	v.Attempts = u.Attempts
+	v.NextAttempt = u.NextAttempt
+	return nil
+}
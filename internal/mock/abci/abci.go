@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	abci "gitlab.com/accumulatenetwork/accumulate/internal/abci"
+	database "gitlab.com/accumulatenetwork/accumulate/internal/database"
 	protocol "gitlab.com/accumulatenetwork/accumulate/protocol"
 	query "gitlab.com/accumulatenetwork/accumulate/types/api/query"
 	transactions "gitlab.com/accumulatenetwork/accumulate/types/api/transactions"
@@ -99,11 +100,12 @@ func (mr *MockChainMockRecorder) DeliverTx(arg0 interface{}) *gomock.Call {
 }
 
 // EndBlock mocks base method.
-func (m *MockChain) EndBlock(arg0 abci.EndBlockRequest) abci.EndBlockResponse {
+func (m *MockChain) EndBlock(arg0 abci.EndBlockRequest) (abci.EndBlockResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "EndBlock", arg0)
 	ret0, _ := ret[0].(abci.EndBlockResponse)
-	return ret0
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // EndBlock indicates an expected call of EndBlock.
@@ -141,3 +143,18 @@ func (mr *MockChainMockRecorder) Query(q, height, prove interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockChain)(nil).Query), q, height, prove)
 }
+
+// SnapshotIndexes mocks base method.
+func (m *MockChain) SnapshotIndexes(arg0 *database.Batch) ([]*database.Value, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SnapshotIndexes", arg0)
+	ret0, _ := ret[0].([]*database.Value)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SnapshotIndexes indicates an expected call of SnapshotIndexes.
+func (mr *MockChainMockRecorder) SnapshotIndexes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapshotIndexes", reflect.TypeOf((*MockChain)(nil).SnapshotIndexes), arg0)
+}
@@ -17,6 +17,7 @@ import (
 	cfg "gitlab.com/accumulatenetwork/accumulate/config"
 	"gitlab.com/accumulatenetwork/accumulate/internal/genesis"
 	"gitlab.com/accumulatenetwork/accumulate/networks"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
 	"gitlab.com/accumulatenetwork/accumulate/smt/storage/memory"
 )
 
@@ -30,6 +31,10 @@ type InitOptions struct {
 	RemoteIP   []string
 	ListenIP   []string
 	Logger     log.Logger
+
+	// Globals are the initial network globals, used if GenesisDoc is nil. If
+	// Globals is nil, genesis uses the defaults.
+	Globals *protocol.NetworkGlobals
 }
 
 // Init creates the initial configuration for a set of nodes, using
@@ -113,6 +118,7 @@ func Init(opts InitOptions) (err error) {
 			GenesisTime: genTime,
 			Validators:  genVals,
 			Logger:      opts.Logger,
+			Globals:     opts.Globals,
 		})
 		if err != nil {
 			return err
@@ -18,7 +18,7 @@ import (
 	"github.com/tendermint/tendermint/proxy"
 	coregrpc "github.com/tendermint/tendermint/rpc/grpc"
 	"gitlab.com/accumulatenetwork/accumulate/config"
-	web "gitlab.com/accumulatenetwork/accumulate/internal/web/static"
+	"gitlab.com/accumulatenetwork/accumulate/internal/web"
 )
 
 // AppFactory creates and returns an ABCI application.
@@ -65,7 +65,12 @@ func (n *Node) Start() error {
 			return fmt.Errorf("invalid website listen address: expected scheme http, got %q", u.Scheme)
 		}
 
-		website := http.Server{Addr: u.Host, Handler: http.FileServer(http.FS(web.FS))}
+		handler, err := web.Handler(n.Config.Accumulate.API.ListenAddress)
+		if err != nil {
+			return err
+		}
+
+		website := http.Server{Addr: u.Host, Handler: handler}
 		go func() {
 			<-n.Quit()
 			website.Shutdown(context.Background())
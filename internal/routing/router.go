@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/tendermint/tendermint/rpc/client"
 	core "github.com/tendermint/tendermint/rpc/core/types"
@@ -33,6 +34,13 @@ type ResponseSubmit struct {
 	MempoolError string
 }
 
+// TableRouter is implemented by routers that route accounts with the routing
+// table of the network.
+type TableRouter interface {
+	Router
+	SetRoutingTable(table *protocol.RoutingTable)
+}
+
 // routeModulo routes an account using routingNumber modulo numberOfBvns to
 // select a BVN.
 func routeModulo(network *config.Network, account *url.URL) (string, error) {
@@ -119,17 +127,50 @@ func submitPretend(ctx context.Context, connMgr connections.ConnectionManager, s
 	}
 }
 
+// routeTable routes an account using the routing table. DN and BVN URLs are
+// routed to their subnet.
+func routeTable(network *config.Network, table *protocol.RoutingTable, account *url.URL) (string, error) {
+	if protocol.BelongsToDn(account) {
+		return protocol.Directory, nil
+	}
+
+	if _, ok := protocol.ParseBvnUrl(account); ok {
+		return routeModulo(network, account)
+	}
+
+	return table.Route(account.Routing())
+}
+
 // RouterInstance sends transactions to remote nodes via RPC calls.
 type RouterInstance struct {
 	*config.Network
 	ConnectionManager connections.ConnectionManager
+
+	mu    sync.RWMutex
+	table *protocol.RoutingTable
 }
 
-var _ Router = (*RouterInstance)(nil)
+var _ TableRouter = (*RouterInstance)(nil)
 
-// Route routes the account using modulo routing.
+// SetRoutingTable sets the routing table. If the table is nil, accounts are
+// routed using modulo routing.
+func (r *RouterInstance) SetRoutingTable(table *protocol.RoutingTable) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.table = table
+}
+
+// Route routes the account using the routing table, or using modulo routing if
+// the network does not have a routing table.
 func (r *RouterInstance) Route(account *url.URL) (string, error) {
-	return routeModulo(r.Network, account)
+	r.mu.RLock()
+	table := r.table
+	r.mu.RUnlock()
+
+	if table == nil {
+		return routeModulo(r.Network, account)
+	}
+	return routeTable(r.Network, table, account)
 }
 
 // Query queries the specified subnet. If the subnet matches this
@@ -343,6 +343,7 @@ func (c *FakeTendermint) execute(interval time.Duration) {
 		begin := abci.RequestBeginBlock{}
 		begin.Header.Height = height
 		begin.Header.ProposerAddress = c.address
+		begin.Header.Time = time.Now()
 		c.app.BeginBlock(begin)
 
 		if debugTX {
@@ -46,6 +46,12 @@ func DefaultConfig(net config.NetworkType, node config.NodeType, netId string) *
 }
 
 func CreateTestNet(t *testing.T, numBvns, numValidators, numFollowers int) ([]string, map[string][]*accumulated.Daemon) {
+	return CreateTestNetWithGlobals(t, numBvns, numValidators, numFollowers, nil)
+}
+
+// CreateTestNetWithGlobals creates a test network whose genesis uses the given
+// network globals, or the defaults if globals is nil.
+func CreateTestNetWithGlobals(t *testing.T, numBvns, numValidators, numFollowers int, globals *protocol.NetworkGlobals) ([]string, map[string][]*accumulated.Daemon) {
 	const basePort = 30000
 	dir := t.TempDir()
 
@@ -127,6 +133,7 @@ func CreateTestNet(t *testing.T, numBvns, numValidators, numFollowers int) ([]st
 			RemoteIP: allRemotes[subnetId],
 			ListenIP: allRemotes[subnetId],
 			Logger:   initLogger.With("subnet", subnetId),
+			Globals:  globals,
 		}))
 
 		daemons := make([]*accumulated.Daemon, count)
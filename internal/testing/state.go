@@ -268,6 +268,7 @@ func CreateTokenIssuer(db DB, urlStr, symbol string, precision uint64) error {
 	issuer.KeyBook = u.Identity().JoinPath("book0")
 	issuer.Symbol = symbol
 	issuer.Precision = precision
+	issuer.SupplyVersion = protocol.TokenSupplyVersionCirculating
 
 	return db.Account(u).PutState(issuer)
 }
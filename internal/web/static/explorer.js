@@ -0,0 +1,659 @@
+// The block explorer. Every view is rendered from the v2 JSON-RPC API, which
+// the website forwards to the API server of the node.
+'use strict';
+
+const view = document.getElementById('view');
+const pageSize = 20;
+const maxBlocks = 20;
+
+// #region API
+
+class RpcError extends Error {
+    constructor(error) {
+        super(typeof error.data === 'string' ? error.data : error.message);
+        this.code = error.code;
+    }
+}
+
+let rpcId = 0;
+
+async function rpc(method, params) {
+    const res = await fetch('/v2', {
+        method: 'POST',
+        headers: { 'Content-Type': 'application/json' },
+        body: JSON.stringify({ jsonrpc: '2.0', id: ++rpcId, method, params }),
+    });
+    const body = await res.json();
+    if (body.error) {
+        throw new RpcError(body.error);
+    }
+    return body.result;
+}
+
+let network;
+
+async function describe() {
+    if (!network) {
+        const res = await rpc('describe', {});
+        network = res.subnet;
+    }
+    return network;
+}
+
+function subnetUrl(net, path) {
+    const base = net.Type === 'directory' ? 'acc://dn' : `acc://bvn-${net.LocalSubnetID}`;
+    return path ? `${base}/${path}` : base;
+}
+
+// #endregion
+
+// #region Rendering
+
+function h(tag, attrs, ...children) {
+    const el = document.createElement(tag);
+    for (const [key, value] of Object.entries(attrs || {})) {
+        if (key.startsWith('on')) {
+            el.addEventListener(key.substring(2), value);
+        } else {
+            el.setAttribute(key, value);
+        }
+    }
+    for (const child of children.flat()) {
+        if (child == null) {
+            continue;
+        }
+        el.append(child instanceof Node ? child : String(child));
+    }
+    return el;
+}
+
+function accountLink(url) {
+    return h('a', { href: `#/acc/${encodeURIComponent(url)}`, class: 'mono' }, url);
+}
+
+function txLink(txid) {
+    return h('a', { href: `#/tx/${txid}`, class: 'mono' }, txid);
+}
+
+function section(title, ...children) {
+    return h('section', {}, title ? h('h2', {}, title) : null, ...children);
+}
+
+function errorBox(err) {
+    return h('div', { class: 'error' }, err instanceof Error ? err.message : String(err));
+}
+
+function formatTime(t) {
+    if (!t) {
+        return '';
+    }
+    const d = new Date(t);
+    return isNaN(d) ? String(t) : d.toLocaleString();
+}
+
+function formatAmount(amount, precision) {
+    if (amount == null || precision == null) {
+        return amount;
+    }
+    const s = String(amount).padStart(precision + 1, '0');
+    if (precision === 0) {
+        return s;
+    }
+    return `${s.substring(0, s.length - precision)}.${s.substring(s.length - precision)}`;
+}
+
+// value renders an arbitrary JSON value, linking account URLs.
+function value(v) {
+    if (v == null) {
+        return h('span', { class: 'muted' }, '—');
+    }
+    if (typeof v === 'string') {
+        if (v.startsWith('acc://')) {
+            return accountLink(v);
+        }
+        return h('code', {}, v);
+    }
+    if (typeof v !== 'object') {
+        return h('code', {}, String(v));
+    }
+    if (Array.isArray(v)) {
+        if (v.length === 0) {
+            return h('span', { class: 'muted' }, 'none');
+        }
+        return h('div', {}, v.map((x) => h('div', {}, value(x))));
+    }
+    return fields(v);
+}
+
+function fields(obj) {
+    return h(
+        'table',
+        {},
+        Object.entries(obj).map(([key, v]) => h('tr', {}, h('th', {}, key), h('td', {}, value(v)))),
+    );
+}
+
+function statusBadge(status) {
+    if (!status) {
+        return h('span', { class: 'badge' }, 'unknown');
+    }
+    if (status.code) {
+        return h('span', { class: 'badge failed' }, `failed (${status.code})`);
+    }
+    if (status.pending) {
+        return h('span', { class: 'badge pending' }, 'pending');
+    }
+    if (status.delivered) {
+        return h('span', { class: 'badge ok' }, 'delivered');
+    }
+    return h('span', { class: 'badge' }, 'received');
+}
+
+// paged renders a paginated list. Load is called with the start and count and
+// must return a multi-response.
+function paged(load, render) {
+    const body = h('div', {}, h('span', { class: 'muted' }, 'Loading…'));
+    let start = 0;
+
+    const show = async () => {
+        let res;
+        try {
+            res = await load(start, pageSize);
+        } catch (err) {
+            body.replaceChildren(errorBox(err));
+            return;
+        }
+
+        const items = res.items || [];
+        const total = res.total || 0;
+        if (items.length === 0) {
+            body.replaceChildren(h('span', { class: 'muted' }, 'None'));
+            return;
+        }
+
+        const prev = h('button', { onclick: () => ((start -= pageSize), show()) }, 'Previous');
+        const next = h('button', { onclick: () => ((start += pageSize), show()) }, 'Next');
+        prev.disabled = start === 0;
+        next.disabled = start + pageSize >= total;
+
+        body.replaceChildren(
+            render(items, res),
+            h(
+                'div',
+                { class: 'pager' },
+                prev,
+                h('span', { class: 'muted' }, `${start + 1}–${start + items.length} of ${total}`),
+                next,
+            ),
+        );
+    };
+
+    show();
+    return body;
+}
+
+function txTable(items) {
+    return h(
+        'table',
+        {},
+        h('thead', {}, h('tr', {}, h('th', {}, 'Transaction'), h('th', {}, 'Type'), h('th', {}, 'Status'))),
+        items.map((tx) =>
+            h(
+                'tr',
+                {},
+                h('td', {}, txLink(tx.transactionHash || tx.txid)),
+                h('td', {}, tx.type),
+                h('td', {}, statusBadge(tx.status)),
+            ),
+        ),
+    );
+}
+
+// #endregion
+
+// #region Blocks
+
+let blockSocket;
+
+function closeBlockSocket() {
+    if (blockSocket) {
+        blockSocket.close();
+        blockSocket = null;
+    }
+}
+
+// blockRow renders a block returned by query-block.
+function blockRow(block) {
+    const txs = (block.transactions || []).map((id) => h('div', {}, txLink(id)));
+    const synth = block.syntheticTransactions || [];
+
+    return h(
+        'tr',
+        {},
+        h('td', {}, block.height),
+        h('td', {}, formatTime(block.time)),
+        h('td', {}, (block.updates || []).length),
+        h('td', {}, txs.length > 0 ? txs : h('span', { class: 'muted' }, '—')),
+        h('td', {}, synth.length),
+    );
+}
+
+async function showBlocks() {
+    const rows = h('tbody', {});
+    const status = h('p', { class: 'muted' }, 'Waiting for blocks…');
+    view.replaceChildren(
+        h('h1', {}, 'Recent blocks'),
+        section(
+            null,
+            status,
+            h(
+                'table',
+                {},
+                h(
+                    'thead',
+                    {},
+                    h(
+                        'tr',
+                        {},
+                        h('th', {}, 'Height'),
+                        h('th', {}, 'Time'),
+                        h('th', {}, 'Chains'),
+                        h('th', {}, 'Transactions'),
+                        h('th', {}, 'Synthetic'),
+                    ),
+                ),
+                rows,
+            ),
+        ),
+    );
+
+    const addBlock = (block) => {
+        // Skip blocks that are already shown
+        for (const row of rows.children) {
+            if (row.firstChild.textContent === String(block.height)) {
+                return;
+            }
+        }
+        rows.prepend(blockRow(block));
+        while (rows.children.length > maxBlocks) {
+            rows.lastChild.remove();
+        }
+    };
+
+    // Load the most recent blocks. Blocks that did not change the state are
+    // not indexed, so the range is wider than the number of blocks shown.
+    try {
+        const net = await describe();
+        const res = await rpc('query', { url: subnetUrl(net, 'ledger') });
+        const height = (res.data && res.data.index) || 0;
+        if (height > 0) {
+            const start = Math.max(1, height - 5 * maxBlocks);
+            const blocks = await rpc('query-block-range', { start, count: height - start + 1 });
+            for (const block of blocks.items || []) {
+                addBlock(block);
+            }
+        }
+    } catch (err) {
+        status.replaceChildren(errorBox(err));
+    }
+
+    // Subsequent blocks are received from the WebSocket API
+    closeBlockSocket();
+    const scheme = location.protocol === 'https:' ? 'wss:' : 'ws:';
+    const ws = new WebSocket(`${scheme}//${location.host}/ws`);
+    blockSocket = ws;
+    ws.onopen = () => {
+        ws.send(JSON.stringify({ jsonrpc: '2.0', id: ++rpcId, method: 'subscribe-blocks', params: {} }));
+        status.textContent = 'Live — new blocks are added as they are committed.';
+    };
+    ws.onmessage = (msg) => {
+        const body = JSON.parse(msg.data);
+        if (body.method === 'subscription' && body.params && body.params.height) {
+            rpc('query-block', { height: body.params.height })
+                .then(addBlock)
+                .catch(() => {});
+        }
+    };
+    ws.onclose = () => {
+        if (blockSocket === ws) {
+            status.textContent = 'Disconnected from the node. Reload the page to resume.';
+        }
+    };
+}
+
+// #endregion
+
+// #region Accounts
+
+async function showAccount(url) {
+    view.replaceChildren(h('h1', {}, url), h('p', { class: 'muted' }, 'Loading…'));
+
+    let res;
+    try {
+        res = await rpc('query', { url });
+    } catch (err) {
+        view.replaceChildren(h('h1', {}, url), errorBox(err));
+        return;
+    }
+
+    const data = res.data || {};
+    const parts = [h('h1', {}, data.url || url), h('p', {}, h('span', { class: 'badge' }, res.type))];
+
+    // Balances
+    if (data.balance != null) {
+        const balance = h('td', { class: 'mono' }, data.balance);
+        parts.push(
+            section(
+                'Balance',
+                h(
+                    'table',
+                    {},
+                    h('tr', {}, h('th', {}, 'Balance'), balance),
+                    h('tr', {}, h('th', {}, 'Token'), h('td', {}, value(data.tokenUrl))),
+                    data.creditBalance != null
+                        ? h('tr', {}, h('th', {}, 'Credits'), h('td', { class: 'mono' }, data.creditBalance))
+                        : null,
+                ),
+            ),
+        );
+        if (data.tokenUrl) {
+            rpc('query', { url: data.tokenUrl })
+                .then((token) => {
+                    const t = token.data || {};
+                    balance.textContent = `${formatAmount(data.balance, t.precision || 0)} ${t.symbol || ''}`;
+                })
+                .catch(() => {});
+        }
+    }
+
+    // Key pages
+    if (res.type === 'keyBook') {
+        parts.push(section('Key pages', value(data.pages || [])));
+    }
+    if (res.type === 'keyPage') {
+        const keys = (data.keys || []).map((k) =>
+            h('tr', {}, h('td', {}, h('code', {}, k.publicKey)), h('td', {}, k.nonce || 0), h('td', {}, value(k.owner))),
+        );
+        parts.push(
+            section(
+                'Keys',
+                h(
+                    'table',
+                    {},
+                    h('thead', {}, h('tr', {}, h('th', {}, 'Public key'), h('th', {}, 'Nonce'), h('th', {}, 'Owner'))),
+                    keys,
+                ),
+                h('p', { class: 'muted' }, `Threshold ${data.threshold || 0}, credits ${data.creditBalance || 0}`),
+            ),
+        );
+    }
+
+    parts.push(section('State', fields(data)));
+
+    // Chains
+    const chain = res.mainChain || res.merkleState || {};
+    parts.push(
+        section(
+            'Chains',
+            h(
+                'table',
+                {},
+                h('tr', {}, h('th', {}, 'Chain ID'), h('td', {}, h('code', {}, res.chainId || ''))),
+                h('tr', {}, h('th', {}, 'Main chain height'), h('td', {}, chain.height || chain.count || 0)),
+                h('tr', {}, h('th', {}, 'Main chain roots'), h('td', {}, value(chain.roots || []))),
+            ),
+        ),
+    );
+    parts.push(
+        section(
+            'Transactions',
+            paged((start, count) => rpc('query-tx-history', { url, start, count }), txTable),
+        ),
+    );
+    const pending = section('Pending transactions', h('span', { class: 'muted' }, 'Loading…'));
+    parts.push(pending);
+    rpc('query-pending', { url })
+        .then((res) => {
+            const items = res.items || [];
+            if (items.length === 0) {
+                pending.remove();
+                return;
+            }
+            pending.replaceChildren(
+                h('h2', {}, 'Pending transactions'),
+                h(
+                    'table',
+                    {},
+                    h('thead', {}, h('tr', {}, h('th', {}, 'Transaction'), h('th', {}, 'Key page'), h('th', {}, 'Signatures'))),
+                    items.map((p) =>
+                        h(
+                            'tr',
+                            {},
+                            h('td', {}, txLink(p.txId)),
+                            h('td', {}, value(p.keyPage)),
+                            h('td', {}, `${(p.signed || []).length} of ${p.threshold || 0}`),
+                        ),
+                    ),
+                ),
+            );
+        })
+        .catch(() => pending.remove());
+
+    // Directory entries
+    if (/^acc:\/\/[^/]+\/?$/.test(data.url || url)) {
+        parts.push(
+            section(
+                'Directory',
+                paged(
+                    (start, count) => rpc('query-directory', { url, start, count }),
+                    (items) => h('div', {}, items.map((u) => h('div', {}, accountLink(u)))),
+                ),
+            ),
+        );
+    }
+
+    // Data entries
+    if (res.type === 'dataAccount' || res.type === 'liteDataAccount') {
+        parts.push(
+            section(
+                'Data entries',
+                paged(
+                    (start, count) => rpc('query-data-set', { url, start, count, expand: true }),
+                    (items) =>
+                        h(
+                            'table',
+                            {},
+                            h('thead', {}, h('tr', {}, h('th', {}, 'Entry hash'), h('th', {}, 'External IDs'), h('th', {}, 'Data'))),
+                            items.map((e) =>
+                                h(
+                                    'tr',
+                                    {},
+                                    h('td', {}, h('code', {}, e.entryHash)),
+                                    h('td', {}, value((e.entry && e.entry.extIds) || [])),
+                                    h('td', {}, value(e.entry && e.entry.data)),
+                                ),
+                            ),
+                        ),
+                ),
+            ),
+        );
+    }
+
+    view.replaceChildren(...parts);
+}
+
+// #endregion
+
+// #region Transactions
+
+async function showTransaction(txid) {
+    view.replaceChildren(h('h1', {}, txid), h('p', { class: 'muted' }, 'Loading…'));
+
+    let res;
+    try {
+        res = await rpc('query-tx', { txid });
+    } catch (err) {
+        view.replaceChildren(h('h1', {}, txid), errorBox(err));
+        return;
+    }
+
+    const status = res.status || {};
+    const parts = [
+        h('h1', {}, 'Transaction ', h('span', { class: 'mono' }, res.transactionHash || res.txid || txid)),
+        section(
+            'Summary',
+            h(
+                'table',
+                {},
+                h('tr', {}, h('th', {}, 'Type'), h('td', {}, res.type)),
+                h('tr', {}, h('th', {}, 'Origin'), h('td', {}, value(res.origin || res.sponsor))),
+                h('tr', {}, h('th', {}, 'Status'), h('td', {}, statusBadge(res.status))),
+                status.message ? h('tr', {}, h('th', {}, 'Message'), h('td', {}, status.message)) : null,
+                status.result ? h('tr', {}, h('th', {}, 'Result'), h('td', {}, value(status.result))) : null,
+                res.signatureThreshold
+                    ? h('tr', {}, h('th', {}, 'Signature threshold'), h('td', {}, res.signatureThreshold))
+                    : null,
+                res.invalidated ? h('tr', {}, h('th', {}, 'Invalidated'), h('td', {}, 'yes')) : null,
+            ),
+        ),
+        section('Body', value(res.data)),
+    ];
+
+    const signatures = res.signatures || [];
+    parts.push(
+        section(
+            'Signatures',
+            signatures.length === 0
+                ? h('span', { class: 'muted' }, 'None')
+                : h('div', {}, signatures.map((sig) => fields(sig))),
+        ),
+    );
+
+    const children = res.syntheticTxids || [];
+    const childRows = children.map((id) => {
+        const badge = h('td', {}, h('span', { class: 'muted' }, '…'));
+        rpc('query-tx', { txid: id })
+            .then((child) => badge.replaceChildren(statusBadge(child.status), ' ', child.type || ''))
+            .catch(() => badge.replaceChildren(h('span', { class: 'muted' }, 'not found on this subnet')));
+        return h('tr', {}, h('td', {}, txLink(id)), badge);
+    });
+    parts.push(
+        section(
+            'Synthetic transactions',
+            children.length === 0 ? h('span', { class: 'muted' }, 'None') : h('table', {}, childRows),
+        ),
+    );
+
+    view.replaceChildren(...parts);
+}
+
+// #endregion
+
+// #region Search
+
+const hashPattern = /^(0x)?[0-9a-f]{64}$/i;
+
+function showKeyAccounts(hash, items) {
+    view.replaceChildren(
+        h('h1', {}, 'Key ', h('span', { class: 'mono' }, hash)),
+        section(
+            'Key pages and lite accounts',
+            h(
+                'table',
+                {},
+                h('thead', {}, h('tr', {}, h('th', {}, 'Account'), h('th', {}, 'Type'), h('th', {}, 'Key book'))),
+                items.map((a) =>
+                    h('tr', {}, h('td', {}, accountLink(a.url)), h('td', {}, a.type), h('td', {}, value(a.keyBook))),
+                ),
+            ),
+        ),
+    );
+}
+
+async function search(query) {
+    query = query.trim();
+    view.replaceChildren(h('h1', {}, 'Search'), h('p', { class: 'muted' }, 'Searching…'));
+
+    // A transaction hash, key hash, or public key
+    if (hashPattern.test(query)) {
+        const hash = query.replace(/^0x/i, '').toLowerCase();
+        try {
+            await rpc('query-tx', { txid: hash });
+            location.hash = `#/tx/${hash}`;
+            return;
+        } catch (err) {
+            // Not a transaction, try a key
+        }
+
+        try {
+            for (const params of [{ keyHash: hash }, { key: hash }]) {
+                const res = await rpc('query-key', params);
+                if (res.items && res.items.length > 0) {
+                    showKeyAccounts(hash, res.items);
+                    return;
+                }
+            }
+        } catch (err) {
+            view.replaceChildren(h('h1', {}, 'Search'), errorBox(err));
+            return;
+        }
+
+        view.replaceChildren(
+            h('h1', {}, 'Search'),
+            errorBox(`No transaction, key page, or lite account was found for ${hash}.`),
+        );
+        return;
+    }
+
+    // An account URL
+    let url = query;
+    if (!/^acc:\/\//i.test(url)) {
+        url = `acc://${url}`;
+    }
+    location.hash = `#/acc/${encodeURIComponent(url)}`;
+}
+
+document.getElementById('search').addEventListener('submit', (e) => {
+    e.preventDefault();
+    const q = e.target.elements.q.value;
+    if (q.trim()) {
+        location.hash = `#/search/${encodeURIComponent(q.trim())}`;
+    }
+});
+
+// #endregion
+
+// #region Routing
+
+function route() {
+    const [, kind, ...rest] = location.hash.replace(/^#/, '').split('/');
+    const arg = decodeURIComponent(rest.join('/'));
+
+    if (kind !== '' && kind !== undefined) {
+        closeBlockSocket();
+    }
+
+    switch (kind) {
+        case 'acc':
+            return showAccount(arg);
+        case 'tx':
+            return showTransaction(arg);
+        case 'search':
+            return search(arg);
+        default:
+            return showBlocks();
+    }
+}
+
+window.addEventListener('hashchange', route);
+
+describe()
+    .then((net) => {
+        const name = net.Type === 'directory' ? 'Directory' : net.LocalSubnetID;
+        document.getElementById('network').textContent = `Subnet ${name}`;
+    })
+    .catch(() => {});
+
+route();
+
+// #endregion
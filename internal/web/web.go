@@ -0,0 +1,43 @@
+// Package web serves the website of a node, including the block explorer.
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+
+	"gitlab.com/accumulatenetwork/accumulate/internal/web/static"
+)
+
+// apiPaths are the paths of the API that the website forwards to the API
+// server, so that the explorer can use the API without cross-origin requests.
+var apiPaths = []string{"/v2", "/ws", "/status", "/version"}
+
+// Handler returns a handler that serves the static files of the website and
+// forwards API requests to the API server at the given listen address.
+func Handler(apiListenAddress string) (http.Handler, error) {
+	u, err := url.Parse(apiListenAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid API listen address: %v", err)
+	}
+
+	target := new(url.URL)
+	switch u.Scheme {
+	case "tcp", "http":
+		target.Scheme = "http"
+	case "https":
+		target.Scheme = "https"
+	default:
+		return nil, fmt.Errorf("invalid API listen address: unsupported scheme %q", u.Scheme)
+	}
+	target.Host = u.Host
+
+	mux := http.NewServeMux()
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	for _, path := range apiPaths {
+		mux.Handle(path, proxy)
+	}
+	mux.Handle("/", http.FileServer(http.FS(static.FS)))
+	return mux, nil
+}
@@ -0,0 +1,53 @@
+package web_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/accumulatenetwork/accumulate/internal/web"
+)
+
+func get(t *testing.T, url string) (int, string) {
+	t.Helper()
+	res, err := http.Get(url)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	return res.StatusCode, string(body)
+}
+
+func TestHandler(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "api "+r.URL.Path)
+	}))
+	defer api.Close()
+
+	handler, err := web.Handler(strings.Replace(api.URL, "http://", "tcp://", 1))
+	require.NoError(t, err)
+	site := httptest.NewServer(handler)
+	defer site.Close()
+
+	// API requests are forwarded
+	code, body := get(t, site.URL+"/v2")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "api /v2", body)
+
+	// Everything else is served from the static files
+	code, body = get(t, site.URL+"/explorer.js")
+	require.Equal(t, http.StatusOK, code)
+	require.NotContains(t, body, "api ")
+
+	code, body = get(t, site.URL+"/")
+	require.Equal(t, http.StatusOK, code)
+	require.Contains(t, body, "explorer.js")
+}
+
+func TestHandlerInvalidAddress(t *testing.T) {
+	_, err := web.Handler("udp://127.0.0.1:26660")
+	require.Error(t, err)
+}
@@ -0,0 +1,61 @@
+package protocol
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Credits, fiat amounts, and ACME amounts are converted at the oracle price,
+// which is the price of one ACME in fiat units times AcmeOraclePrecision.
+// Credit balances are in units of 1/CreditPrecision credits, and fiat amounts
+// are in units of 1/CreditsPerFiatUnit dollars, so a credit balance and the
+// fiat amount it is worth have the same value. For example, a balance of 100
+// is one credit, which is worth one cent.
+//
+// Conversions always favor the network: buying credits charges the ACME cost
+// rounded up, and spending ACME buys the credits rounded down.
+
+// CreditsToAcme returns the amount of ACME, in units of 1/AcmePrecision ACME,
+// that is needed to buy the given credits, rounded up.
+func CreditsToAcme(credits, oracle uint64) (*big.Int, error) {
+	if oracle == 0 {
+		return nil, errors.New("the oracle price has not been set")
+	}
+
+	// acme = credits × AcmePrecision × AcmeOraclePrecision / (CreditsPerFiatUnit × oracle)
+	num := new(big.Int).SetUint64(credits)
+	num.Mul(num, big.NewInt(AcmePrecision*AcmeOraclePrecision))
+	den := new(big.Int).SetUint64(oracle)
+	den.Mul(den, big.NewInt(CreditsPerFiatUnit))
+	return divRoundUp(num, den), nil
+}
+
+// AcmeToCredits returns the credits that the given amount of ACME, in units of
+// 1/AcmePrecision ACME, buys, rounded down.
+func AcmeToCredits(acme *big.Int, oracle uint64) (uint64, error) {
+	if oracle == 0 {
+		return 0, errors.New("the oracle price has not been set")
+	}
+	if acme.Sign() < 0 {
+		return 0, errors.New("amount is negative")
+	}
+
+	// credits = acme × CreditsPerFiatUnit × oracle / (AcmePrecision × AcmeOraclePrecision)
+	credits := new(big.Int).SetUint64(oracle)
+	credits.Mul(credits, big.NewInt(CreditsPerFiatUnit))
+	credits.Mul(credits, acme)
+	credits.Div(credits, big.NewInt(AcmePrecision*AcmeOraclePrecision))
+	if !credits.IsUint64() {
+		return 0, fmt.Errorf("%v ACME units buy too many credits", acme)
+	}
+	return credits.Uint64(), nil
+}
+
+func divRoundUp(num, den *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() != 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	return q
+}
@@ -0,0 +1,46 @@
+package protocol
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreditConversion(t *testing.T) {
+	oracle := uint64(0.05 * AcmeOraclePrecision) // $0.05 per ACME
+
+	// One credit is one cent, which costs 0.2 ACME
+	acme, err := CreditsToAcme(1*CreditPrecision, oracle)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(0.2*AcmePrecision), acme)
+
+	credits, err := AcmeToCredits(big.NewInt(0.2*AcmePrecision), oracle)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1*CreditPrecision), credits)
+
+	// Buying credits rounds the cost up and spending ACME rounds the credits
+	// down
+	oracle = 3 * AcmeOraclePrecision // $3 per ACME
+	acme, err = CreditsToAcme(1, oracle)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(3334), acme) // 3333.33...
+
+	credits, err = AcmeToCredits(big.NewInt(3334), oracle)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), credits) // 1.0002
+
+	credits, err = AcmeToCredits(big.NewInt(3333), oracle)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), credits) // 0.9999
+
+	// Invalid conversions
+	_, err = CreditsToAcme(1, 0)
+	require.Error(t, err)
+	_, err = AcmeToCredits(big.NewInt(1), 0)
+	require.Error(t, err)
+	_, err = AcmeToCredits(big.NewInt(-1), oracle)
+	require.Error(t, err)
+	_, err = AcmeToCredits(new(big.Int).Lsh(big.NewInt(1), 128), oracle)
+	require.Error(t, err)
+}
@@ -145,6 +145,15 @@ const ErrorCodeDataEntryHashError ErrorCode = 25
 // ErrorCodeTxnQueryError is returned when txn is not found.
 const ErrorCodeTxnQueryError ErrorCode = 26
 
+// ErrorCodeExpired is returned when a pending transaction expires before it collects enough signatures.
+const ErrorCodeExpired ErrorCode = 27
+
+// ErrorCodeFaucetLimit is returned when the faucet refuses a request because of its rate limits or balance.
+const ErrorCodeFaucetLimit ErrorCode = 28
+
+// ErrorCodeHeightNotSupported is returned when a query that cannot be answered at a height specifies one.
+const ErrorCodeHeightNotSupported ErrorCode = 29
+
 // KeyPageOperationUnknown is used when the key page operation is not known.
 const KeyPageOperationUnknown KeyPageOperation = 0
 
@@ -160,6 +169,18 @@ const KeyPageOperationAdd KeyPageOperation = 3
 // KeyPageOperationSetThreshold sets the signing threshold (the M of "M of N" signatures required).
 const KeyPageOperationSetThreshold KeyPageOperation = 4
 
+// KeyPageOperationCancel cancels a time-locked operation that has not taken effect.
+const KeyPageOperationCancel KeyPageOperation = 5
+
+// KeyPageOperationFreeze blocks all transactions signed by the page.
+const KeyPageOperationFreeze KeyPageOperation = 6
+
+// KeyPageOperationUnfreeze lifts a freeze.
+const KeyPageOperationUnfreeze KeyPageOperation = 7
+
+// KeyPageOperationSetMinDelay sets the minimum delay of operations that can be time-locked.
+const KeyPageOperationSetMinDelay KeyPageOperation = 8
+
 // ObjectTypeUnknown is used when the object type is not known.
 const ObjectTypeUnknown ObjectType = 0
 
@@ -178,6 +199,9 @@ const SignatureTypeLegacyED25519 SignatureType = 1
 // SignatureTypeED25519 represents an ED25519 signature.
 const SignatureTypeED25519 SignatureType = 2
 
+// SignatureTypeSECP256K1 represents a SECP256K1 signature.
+const SignatureTypeSECP256K1 SignatureType = 3
+
 // TransactionMaxUser is the highest number reserved for user transactions.
 const TransactionMaxUser TransactionMax = 47
 
@@ -238,6 +262,9 @@ const TransactionTypeUpdateManager TransactionType = 16
 // TransactionTypeRemoveManager remove manager from existing chain.
 const TransactionTypeRemoveManager TransactionType = 17
 
+// TransactionTypeBatch executes a list of operations atomically, which produces the synthetic transactions of each operation.
+const TransactionTypeBatch TransactionType = 18
+
 // TransactionTypeSignPending is used to sign a pending transaction.
 const TransactionTypeSignPending TransactionType = 48
 
@@ -265,6 +292,12 @@ const TransactionTypeSyntheticMirror TransactionType = 56
 // TransactionTypeSegWitDataEntry is a surrogate transaction segregated witness for a WriteData transaction.
 const TransactionTypeSegWitDataEntry TransactionType = 57
 
+// TransactionTypeSyntheticMigrateAccounts moves accounts to the subnet they are routed to after the routing table changes.
+const TransactionTypeSyntheticMigrateAccounts TransactionType = 58
+
+// TransactionTypeSyntheticReturnAccounts returns migrated accounts that already exist on their new subnet to the subnet they came from.
+const TransactionTypeSyntheticReturnAccounts TransactionType = 59
+
 // TransactionTypeInternalGenesis initializes system chains.
 const TransactionTypeInternalGenesis TransactionType = 96
 
@@ -277,6 +310,9 @@ const TransactionTypeInternalTransactionsSigned TransactionType = 98
 // TransactionTypeInternalTransactionsSent notifies the executor of synthetic transactions that have been sent.
 const TransactionTypeInternalTransactionsSent TransactionType = 99
 
+// TransactionTypeInternalMigrateAccounts migrates accounts that are routed to another subnet after the routing table changes.
+const TransactionTypeInternalMigrateAccounts TransactionType = 100
+
 // ID returns the ID of the Account Type
 func (v AccountType) ID() uint64 { return uint64(v) }
 
@@ -504,7 +540,7 @@ func (v ErrorCode) ID() uint64 { return uint64(v) }
 func (v *ErrorCode) Set(id uint64) bool {
 	u := ErrorCode(id)
 	switch u {
-	case ErrorCodeOK, ErrorCodeEncodingError, ErrorCodeBadNonce, ErrorCodeDidPanic, ErrorCodeUnknownError, ErrorCodeNotFound, ErrorCodeTxnRange, ErrorCodeTxnHistory, ErrorCodeInvalidURL, ErrorCodeDirectoryURL, ErrorCodeChainIdError, ErrorCodeRoutingChainId, ErrorCodeCheckTxError, ErrorCodeDeliverTxError, ErrorCodeTxnStateError, ErrorCodeRecordTxnError, ErrorCodeSyntheticTxnError, ErrorCodeMarshallingError, ErrorCodeUnMarshallingError, ErrorCodeInvalidQueryType, ErrorCodeInvalidTxnType, ErrorCodeValidateTxnError, ErrorCodeInvalidTxnError, ErrorCodeAddTxnError, ErrorCodeDataUrlError, ErrorCodeDataEntryHashError, ErrorCodeTxnQueryError:
+	case ErrorCodeOK, ErrorCodeEncodingError, ErrorCodeBadNonce, ErrorCodeDidPanic, ErrorCodeUnknownError, ErrorCodeNotFound, ErrorCodeTxnRange, ErrorCodeTxnHistory, ErrorCodeInvalidURL, ErrorCodeDirectoryURL, ErrorCodeChainIdError, ErrorCodeRoutingChainId, ErrorCodeCheckTxError, ErrorCodeDeliverTxError, ErrorCodeTxnStateError, ErrorCodeRecordTxnError, ErrorCodeSyntheticTxnError, ErrorCodeMarshallingError, ErrorCodeUnMarshallingError, ErrorCodeInvalidQueryType, ErrorCodeInvalidTxnType, ErrorCodeValidateTxnError, ErrorCodeInvalidTxnError, ErrorCodeAddTxnError, ErrorCodeDataUrlError, ErrorCodeDataEntryHashError, ErrorCodeTxnQueryError, ErrorCodeExpired, ErrorCodeFaucetLimit, ErrorCodeHeightNotSupported:
 		*v = u
 		return true
 	default:
@@ -569,6 +605,12 @@ func (v ErrorCode) String() string {
 		return "dataEntryHashError"
 	case ErrorCodeTxnQueryError:
 		return "txnQueryError"
+	case ErrorCodeExpired:
+		return "expired"
+	case ErrorCodeFaucetLimit:
+		return "faucetLimit"
+	case ErrorCodeHeightNotSupported:
+		return "heightNotSupported"
 	default:
 		return fmt.Sprintf("ErrorCode:%d", v)
 	}
@@ -631,6 +673,12 @@ func ErrorCodeByName(name string) (ErrorCode, bool) {
 		return ErrorCodeDataEntryHashError, true
 	case "txnQueryError":
 		return ErrorCodeTxnQueryError, true
+	case "expired":
+		return ErrorCodeExpired, true
+	case "faucetLimit":
+		return ErrorCodeFaucetLimit, true
+	case "heightNotSupported":
+		return ErrorCodeHeightNotSupported, true
 	default:
 		return 0, false
 	}
@@ -685,7 +733,7 @@ func (v KeyPageOperation) ID() uint64 { return uint64(v) }
 func (v *KeyPageOperation) Set(id uint64) bool {
 	u := KeyPageOperation(id)
 	switch u {
-	case KeyPageOperationUnknown, KeyPageOperationUpdate, KeyPageOperationRemove, KeyPageOperationAdd, KeyPageOperationSetThreshold:
+	case KeyPageOperationUnknown, KeyPageOperationUpdate, KeyPageOperationRemove, KeyPageOperationAdd, KeyPageOperationSetThreshold, KeyPageOperationCancel, KeyPageOperationFreeze, KeyPageOperationUnfreeze, KeyPageOperationSetMinDelay:
 		*v = u
 		return true
 	default:
@@ -706,6 +754,14 @@ func (v KeyPageOperation) String() string {
 		return "add"
 	case KeyPageOperationSetThreshold:
 		return "setThreshold"
+	case KeyPageOperationCancel:
+		return "cancel"
+	case KeyPageOperationFreeze:
+		return "freeze"
+	case KeyPageOperationUnfreeze:
+		return "unfreeze"
+	case KeyPageOperationSetMinDelay:
+		return "setMinDelay"
 	default:
 		return fmt.Sprintf("KeyPageOperation:%d", v)
 	}
@@ -724,6 +780,14 @@ func KeyPageOperationByName(name string) (KeyPageOperation, bool) {
 		return KeyPageOperationAdd, true
 	case "setThreshold":
 		return KeyPageOperationSetThreshold, true
+	case "cancel":
+		return KeyPageOperationCancel, true
+	case "freeze":
+		return KeyPageOperationFreeze, true
+	case "unfreeze":
+		return KeyPageOperationUnfreeze, true
+	case "setMinDelay":
+		return KeyPageOperationSetMinDelay, true
 	default:
 		return 0, false
 	}
@@ -863,7 +927,7 @@ func (v SignatureType) ID() uint64 { return uint64(v) }
 func (v *SignatureType) Set(id uint64) bool {
 	u := SignatureType(id)
 	switch u {
-	case SignatureTypeUnknown, SignatureTypeLegacyED25519, SignatureTypeED25519:
+	case SignatureTypeUnknown, SignatureTypeLegacyED25519, SignatureTypeED25519, SignatureTypeSECP256K1:
 		*v = u
 		return true
 	default:
@@ -880,6 +944,8 @@ func (v SignatureType) String() string {
 		return "legacyED25519"
 	case SignatureTypeED25519:
 		return "eD25519"
+	case SignatureTypeSECP256K1:
+		return "sECP256K1"
 	default:
 		return fmt.Sprintf("SignatureType:%d", v)
 	}
@@ -894,6 +960,10 @@ func SignatureTypeByName(name string) (SignatureType, bool) {
 		return SignatureTypeLegacyED25519, true
 	case "eD25519":
 		return SignatureTypeED25519, true
+	case "sECP256K1":
+		return SignatureTypeSECP256K1, true
+	case "secp256k1":
+		return SignatureTypeSECP256K1, true
 	default:
 		return 0, false
 	}
@@ -1033,7 +1103,7 @@ func (v TransactionType) ID() uint64 { return uint64(v) }
 func (v *TransactionType) Set(id uint64) bool {
 	u := TransactionType(id)
 	switch u {
-	case TransactionTypeUnknown, TransactionTypeCreateIdentity, TransactionTypeCreateTokenAccount, TransactionTypeSendTokens, TransactionTypeCreateDataAccount, TransactionTypeWriteData, TransactionTypeWriteDataTo, TransactionTypeAcmeFaucet, TransactionTypeCreateToken, TransactionTypeIssueTokens, TransactionTypeBurnTokens, TransactionTypeCreateKeyPage, TransactionTypeCreateKeyBook, TransactionTypeAddCredits, TransactionTypeUpdateKeyPage, TransactionTypeUpdateManager, TransactionTypeRemoveManager, TransactionTypeSignPending, TransactionTypeSyntheticCreateChain, TransactionTypeSyntheticWriteData, TransactionTypeSyntheticDepositTokens, TransactionTypeSyntheticAnchor, TransactionTypeSyntheticDepositCredits, TransactionTypeSyntheticBurnTokens, TransactionTypeSyntheticMirror, TransactionTypeSegWitDataEntry, TransactionTypeInternalGenesis, TransactionTypeInternalSendTransactions, TransactionTypeInternalTransactionsSigned, TransactionTypeInternalTransactionsSent:
+	case TransactionTypeUnknown, TransactionTypeCreateIdentity, TransactionTypeCreateTokenAccount, TransactionTypeSendTokens, TransactionTypeCreateDataAccount, TransactionTypeWriteData, TransactionTypeWriteDataTo, TransactionTypeAcmeFaucet, TransactionTypeCreateToken, TransactionTypeIssueTokens, TransactionTypeBurnTokens, TransactionTypeCreateKeyPage, TransactionTypeCreateKeyBook, TransactionTypeAddCredits, TransactionTypeUpdateKeyPage, TransactionTypeUpdateManager, TransactionTypeRemoveManager, TransactionTypeBatch, TransactionTypeSignPending, TransactionTypeSyntheticCreateChain, TransactionTypeSyntheticWriteData, TransactionTypeSyntheticDepositTokens, TransactionTypeSyntheticAnchor, TransactionTypeSyntheticDepositCredits, TransactionTypeSyntheticBurnTokens, TransactionTypeSyntheticMirror, TransactionTypeSegWitDataEntry, TransactionTypeSyntheticMigrateAccounts, TransactionTypeSyntheticReturnAccounts, TransactionTypeInternalGenesis, TransactionTypeInternalSendTransactions, TransactionTypeInternalTransactionsSigned, TransactionTypeInternalTransactionsSent, TransactionTypeInternalMigrateAccounts:
 		*v = u
 		return true
 	default:
@@ -1078,6 +1148,8 @@ func (v TransactionType) String() string {
 		return "updateManager"
 	case TransactionTypeRemoveManager:
 		return "removeManager"
+	case TransactionTypeBatch:
+		return "batch"
 	case TransactionTypeSignPending:
 		return "signPending"
 	case TransactionTypeSyntheticCreateChain:
@@ -1096,6 +1168,10 @@ func (v TransactionType) String() string {
 		return "syntheticMirror"
 	case TransactionTypeSegWitDataEntry:
 		return "segWitDataEntry"
+	case TransactionTypeSyntheticMigrateAccounts:
+		return "syntheticMigrateAccounts"
+	case TransactionTypeSyntheticReturnAccounts:
+		return "syntheticReturnAccounts"
 	case TransactionTypeInternalGenesis:
 		return "internalGenesis"
 	case TransactionTypeInternalSendTransactions:
@@ -1104,6 +1180,8 @@ func (v TransactionType) String() string {
 		return "internalTransactionsSigned"
 	case TransactionTypeInternalTransactionsSent:
 		return "internalTransactionsSent"
+	case TransactionTypeInternalMigrateAccounts:
+		return "internalMigrateAccounts"
 	default:
 		return fmt.Sprintf("TransactionType:%d", v)
 	}
@@ -1146,6 +1224,8 @@ func TransactionTypeByName(name string) (TransactionType, bool) {
 		return TransactionTypeUpdateManager, true
 	case "removeManager":
 		return TransactionTypeRemoveManager, true
+	case "batch":
+		return TransactionTypeBatch, true
 	case "signPending":
 		return TransactionTypeSignPending, true
 	case "syntheticCreateChain":
@@ -1164,6 +1244,10 @@ func TransactionTypeByName(name string) (TransactionType, bool) {
 		return TransactionTypeSyntheticMirror, true
 	case "segWitDataEntry":
 		return TransactionTypeSegWitDataEntry, true
+	case "syntheticMigrateAccounts":
+		return TransactionTypeSyntheticMigrateAccounts, true
+	case "syntheticReturnAccounts":
+		return TransactionTypeSyntheticReturnAccounts, true
 	case "internalGenesis":
 		return TransactionTypeInternalGenesis, true
 	case "internalSendTransactions":
@@ -1172,6 +1256,8 @@ func TransactionTypeByName(name string) (TransactionType, bool) {
 		return TransactionTypeInternalTransactionsSigned, true
 	case "internalTransactionsSent":
 		return TransactionTypeInternalTransactionsSent, true
+	case "internalMigrateAccounts":
+		return TransactionTypeInternalMigrateAccounts, true
 	default:
 		return 0, false
 	}
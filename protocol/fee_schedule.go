@@ -11,7 +11,8 @@ func (n Fee) AsInt() int {
 	return int(n)
 }
 
-// Fee Schedule
+// Default fee schedule. The fees the network charges are set by the fee
+// schedule of the DN, see FeeScheduleUrl.
 const (
 	// FeeFailedMaximum $0.01
 	FeeFailedMaximum Fee = 100
@@ -68,7 +69,75 @@ const (
 	FeeSignPending Fee = 10
 )
 
+// ComputeFee computes the fee of a transaction using the default fee schedule.
 func ComputeFee(tx *Envelope) (Fee, error) {
+	return (*FeeSchedule)(nil).ComputeFee(tx)
+}
+
+// DefaultFeeSchedule returns a fee schedule with the default fee of every
+// transaction type that has a fee.
+func DefaultFeeSchedule() *FeeSchedule {
+	s := new(FeeSchedule)
+	for _, typ := range []TransactionType{
+		TransactionTypeCreateIdentity,
+		TransactionTypeCreateTokenAccount,
+		TransactionTypeSendTokens,
+		TransactionTypeCreateDataAccount,
+		TransactionTypeWriteData,
+		TransactionTypeWriteDataTo,
+		TransactionTypeAcmeFaucet,
+		TransactionTypeCreateToken,
+		TransactionTypeIssueTokens,
+		TransactionTypeBurnTokens,
+		TransactionTypeCreateKeyPage,
+		TransactionTypeCreateKeyBook,
+		TransactionTypeAddCredits,
+		TransactionTypeUpdateKeyPage,
+		TransactionTypeSignPending,
+	} {
+		s.Fees = append(s.Fees, FeeScheduleEntry{Type: typ, Fee: uint64(defaultFee(typ))})
+	}
+	return s
+}
+
+// Validate verifies that every entry of the schedule is for a user
+// transaction type and that no type is listed twice.
+func (s *FeeSchedule) Validate() error {
+	seen := map[TransactionType]bool{}
+	for _, e := range s.Fees {
+		var typ TransactionType
+		if !typ.Set(e.Type.ID()) || typ == TransactionTypeUnknown {
+			return fmt.Errorf("invalid transaction type %d", e.Type.ID())
+		}
+		// SignPending is numbered after the user transactions, but users
+		// submit it and pay for it
+		if !typ.IsUser() && typ != TransactionTypeSignPending {
+			return fmt.Errorf("%v transactions do not pay fees", typ)
+		}
+		if seen[typ] {
+			return fmt.Errorf("%v is listed twice", typ)
+		}
+		seen[typ] = true
+	}
+	return nil
+}
+
+// BaseFee returns the fee of the transaction type. If the schedule does not
+// list the type, or the schedule is nil, the default fee is returned.
+func (s *FeeSchedule) BaseFee(typ TransactionType) Fee {
+	if s != nil {
+		for _, e := range s.Fees {
+			if e.Type == typ {
+				return Fee(e.Fee)
+			}
+		}
+	}
+	return defaultFee(typ)
+}
+
+// ComputeFee computes the fee of a transaction. A nil schedule computes the
+// default fee.
+func (s *FeeSchedule) ComputeFee(tx *Envelope) (Fee, error) {
 	// Do not charge fees for the DN or BVNs
 	if IsDnUrl(tx.Transaction.Origin) {
 		return 0, nil
@@ -77,55 +146,88 @@ func ComputeFee(tx *Envelope) (Fee, error) {
 		return 0, nil
 	}
 
-	txType := tx.Transaction.Type()
-	if txType == TransactionTypeUnknown {
+	return s.computeBodyFee(tx.Transaction.Body)
+}
+
+// computeBodyFee computes the fee of a transaction body. The fee of a batch is
+// the sum of the fees of its operations.
+func (s *FeeSchedule) computeBodyFee(body TransactionPayload) (Fee, error) {
+	if body == nil {
+		return 0, fmt.Errorf("cannot compute fee with no data defined for transaction")
+	}
+
+	txType := body.GetType()
+	switch txType {
+	case TransactionTypeUnknown:
 		return 0, fmt.Errorf("cannot compute fee with no data defined for transaction")
+
+	case TransactionTypeBatch:
+		var fee Fee
+		for _, op := range body.(*Batch).Operations {
+			opFee, err := s.computeBodyFee(op.Body)
+			if err != nil {
+				return 0, err
+			}
+			fee += opFee
+		}
+		return fee, nil
+
+	case TransactionTypeWriteData:
+		// Computed below
+
+	default:
+		return s.BaseFee(txType), nil
+	}
+
+	// TODO Include the header?
+	data, err := body.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	size := len(data)
+	if size > WriteDataMax {
+		return 0, fmt.Errorf("data amount exceeds %v byte entry limit", WriteDataMax)
+	}
+	if size <= 0 {
+		return 0, fmt.Errorf("insufficient data provided for %v needed to compute cost", txType)
 	}
-	switch TransactionType(txType) {
+	return s.BaseFee(TransactionTypeWriteData) * Fee(size/256+1), nil
+}
+
+func defaultFee(typ TransactionType) Fee {
+	switch typ {
 	case TransactionTypeCreateIdentity:
-		return FeeCreateIdentity, nil
+		return FeeCreateIdentity
 	case TransactionTypeCreateTokenAccount:
-		return FeeCreateTokenAccount, nil
+		return FeeCreateTokenAccount
 	case TransactionTypeSendTokens:
-		return FeeSendTokens, nil
+		return FeeSendTokens
 	case TransactionTypeCreateDataAccount:
-		return FeeCreateDataAccount, nil
+		return FeeCreateDataAccount
 	case TransactionTypeWriteData:
-		// TODO Include the header?
-		body, err := tx.Transaction.Body.MarshalBinary()
-		if err != nil {
-			return 0, err
-		}
-		size := len(body)
-		if size > WriteDataMax {
-			return 0, fmt.Errorf("data amount exceeds %v byte entry limit", WriteDataMax)
-		}
-		if size <= 0 {
-			return 0, fmt.Errorf("insufficient data provided for %v needed to compute cost", txType)
-		}
-		return FeeWriteData * Fee(size/256+1), nil
+		return FeeWriteData
 	case TransactionTypeWriteDataTo:
-		return FeeWriteDataTo, nil
+		return FeeWriteDataTo
 	case TransactionTypeAcmeFaucet:
-		return FeeAcmeFaucet, nil
+		return FeeAcmeFaucet
 	case TransactionTypeCreateToken:
-		return FeeCreateToken, nil
+		return FeeCreateToken
 	case TransactionTypeIssueTokens:
-		return FeeIssueTokens, nil
+		return FeeIssueTokens
 	case TransactionTypeBurnTokens:
-		return FeeBurnTokens, nil
+		return FeeBurnTokens
 	case TransactionTypeCreateKeyPage:
-		return FeeCreateKeyPage, nil
+		return FeeCreateKeyPage
 	case TransactionTypeCreateKeyBook:
-		return FeeCreateKeyBook, nil
+		return FeeCreateKeyBook
 	case TransactionTypeAddCredits:
-		return FeeAddCredits, nil
+		return FeeAddCredits
 	case TransactionTypeUpdateKeyPage:
-		return FeeUpdateKeyPage, nil
+		return FeeUpdateKeyPage
 	case TransactionTypeSignPending:
-		return FeeSignPending, nil
+		return FeeSignPending
 	default:
 		//by default assume if type isn't specified, there is no charge for tx
-		return 0, nil
+		return 0
 	}
 }
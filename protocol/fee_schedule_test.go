@@ -0,0 +1,61 @@
+package protocol
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+)
+
+func TestFeeSchedule(t *testing.T) {
+	origin, err := url.Parse("foo/tokens")
+	require.NoError(t, err)
+
+	newEnv := func(body TransactionBody) *Envelope {
+		env := new(Envelope)
+		env.Transaction = new(Transaction)
+		env.Transaction.Origin = origin
+		env.Transaction.Body = body
+		return env
+	}
+
+	// The default schedule matches the constants
+	fee, err := DefaultFeeSchedule().ComputeFee(newEnv(new(SendTokens)))
+	require.NoError(t, err)
+	require.Equal(t, FeeSendTokens, fee)
+
+	// Listed fees override the defaults, others keep the default
+	schedule := &FeeSchedule{Fees: []FeeScheduleEntry{
+		{Type: TransactionTypeSendTokens, Fee: 1000},
+		{Type: TransactionTypeWriteData, Fee: 20},
+	}}
+	fee, err = schedule.ComputeFee(newEnv(new(SendTokens)))
+	require.NoError(t, err)
+	require.Equal(t, Fee(1000), fee)
+
+	fee, err = schedule.ComputeFee(newEnv(new(CreateTokenAccount)))
+	require.NoError(t, err)
+	require.Equal(t, FeeCreateTokenAccount, fee)
+
+	// Data fees scale with the size of the entry
+	wd := new(WriteData)
+	wd.Entry.Data = make([]byte, 300)
+	fee, err = schedule.ComputeFee(newEnv(wd))
+	require.NoError(t, err)
+	require.Equal(t, Fee(40), fee)
+
+	// The schedule survives a round trip through JSON, which is how it is
+	// written to the DN
+	data, err := json.Marshal(schedule)
+	require.NoError(t, err)
+	schedule2 := new(FeeSchedule)
+	require.NoError(t, json.Unmarshal(data, schedule2))
+	require.True(t, schedule.Equal(schedule2))
+
+	// Invalid schedules
+	require.NoError(t, DefaultFeeSchedule().Validate())
+	require.Error(t, (&FeeSchedule{Fees: []FeeScheduleEntry{{Type: TransactionTypeUnknown}}}).Validate())
+	require.Error(t, (&FeeSchedule{Fees: []FeeScheduleEntry{{Type: TransactionTypeSyntheticAnchor}}}).Validate())
+	require.Error(t, (&FeeSchedule{Fees: []FeeScheduleEntry{{Type: TransactionTypeSendTokens}, {Type: TransactionTypeSendTokens}}}).Validate())
+}
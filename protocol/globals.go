@@ -0,0 +1,56 @@
+package protocol
+
+import (
+	"errors"
+	"time"
+)
+
+// DefaultPendingExpiry is the default number of blocks after which a pending
+// transaction expires, about two weeks at one block per second.
+const DefaultPendingExpiry = 14 * 24 * 60 * 60
+
+// DefaultNetworkGlobals returns the network globals that apply until the DN
+// writes its own to the globals account, see GlobalsUrl.
+func DefaultNetworkGlobals() *NetworkGlobals {
+	g := new(NetworkGlobals)
+	g.PendingExpiry = DefaultPendingExpiry
+	g.Faucet = DefaultFaucetPolicy()
+	return g
+}
+
+// DefaultFaucetPolicy returns the faucet policy that applies if the network
+// globals do not specify one.
+func DefaultFaucetPolicy() *FaucetPolicy {
+	p := new(FaucetPolicy)
+	p.Amount = AcmeFaucetAmount
+	p.Cooldown = time.Hour
+	p.DailyLimit = 1000 * AcmeFaucetAmount
+	p.BlockLimit = 100
+	return p
+}
+
+// Validate returns an error if the network globals are not usable.
+func (g *NetworkGlobals) Validate() error {
+	if g.Faucet != nil {
+		return g.Faucet.Validate()
+	}
+	return nil
+}
+
+// Validate returns an error if the faucet policy is not usable. Each request
+// deposits Amount ACME. A recipient must wait Cooldown between requests, the
+// faucet deposits at most DailyLimit ACME per day, and it accepts at most
+// BlockLimit requests per block. A zero limit is not enforced. Days and
+// cooldowns are measured in block time.
+func (p *FaucetPolicy) Validate() error {
+	if p.Amount == 0 {
+		return errors.New("the faucet amount must not be zero")
+	}
+	if p.Cooldown < 0 {
+		return errors.New("the faucet cooldown must not be negative")
+	}
+	if p.DailyLimit != 0 && p.DailyLimit < p.Amount {
+		return errors.New("the faucet daily limit must not be less than the amount")
+	}
+	return nil
+}
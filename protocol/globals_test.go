@@ -0,0 +1,28 @@
+package protocol
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkGlobalsValidate(t *testing.T) {
+	require.NoError(t, DefaultNetworkGlobals().Validate())
+
+	// The faucet policy is optional
+	require.NoError(t, (&NetworkGlobals{PendingExpiry: 10}).Validate())
+
+	require.Error(t, (&NetworkGlobals{Faucet: &FaucetPolicy{}}).Validate())
+	require.Error(t, (&NetworkGlobals{Faucet: &FaucetPolicy{Amount: 10, Cooldown: -time.Second}}).Validate())
+	require.Error(t, (&NetworkGlobals{Faucet: &FaucetPolicy{Amount: 10, DailyLimit: 5}}).Validate())
+	require.NoError(t, (&NetworkGlobals{Faucet: &FaucetPolicy{Amount: 10, DailyLimit: 10}}).Validate())
+
+	// The globals survive the JSON round trip of the globals account
+	data, err := json.Marshal(DefaultNetworkGlobals())
+	require.NoError(t, err)
+	globals := new(NetworkGlobals)
+	require.NoError(t, json.Unmarshal(data, globals))
+	require.True(t, DefaultNetworkGlobals().Equal(globals))
+}
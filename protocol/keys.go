@@ -6,8 +6,19 @@ import (
 )
 
 func (ms *KeyPage) FindKey(pubKey []byte) *KeySpec {
+	return ms.FindKeyOfType(SignatureTypeUnknown, pubKey)
+}
+
+// FindKeyOfType finds the key that matches the public key and accepts
+// signatures of the given type. If the type is unknown, only the public key is
+// matched.
+func (ms *KeyPage) FindKeyOfType(typ SignatureType, pubKey []byte) *KeySpec {
 	// Check each key
 	for _, candidate := range ms.Keys {
+		if typ != SignatureTypeUnknown && !candidate.Accepts(typ) {
+			continue
+		}
+
 		// Try with each supported hash algorithm
 		for _, ha := range []HashAlgorithm{Unhashed, SHA256, SHA256D} {
 			if bytes.Equal(ha.MustApply(pubKey), candidate.PublicKey) {
@@ -19,6 +30,28 @@ func (ms *KeyPage) FindKey(pubKey []byte) *KeySpec {
 	return nil
 }
 
+// Accepts returns true if the key accepts signatures of the given type. Keys
+// that do not specify a type are ED25519 keys.
+func (k *KeySpec) Accepts(typ SignatureType) bool {
+	switch k.KeyType {
+	case SignatureTypeUnknown, SignatureTypeLegacyED25519, SignatureTypeED25519:
+		return typ == SignatureTypeLegacyED25519 || typ == SignatureTypeED25519
+	default:
+		return typ == k.KeyType
+	}
+}
+
+// ValidateKeyType returns an error if keys of the given type cannot be added to
+// a key page. A key without a type is an ED25519 key.
+func ValidateKeyType(typ SignatureType) error {
+	switch typ {
+	case SignatureTypeUnknown, SignatureTypeLegacyED25519, SignatureTypeED25519, SignatureTypeSECP256K1:
+		return nil
+	default:
+		return fmt.Errorf("unsupported key type %v", typ)
+	}
+}
+
 // GetMofN
 // return the signature requirements of the Key Page.  Each Key Page requires
 // m of n signatures, where m <= n, and n is the number of keys on the key page.
@@ -39,3 +72,51 @@ func (ms *KeyPage) SetThreshold(m uint64) error {
 	}
 	return nil
 }
+
+// RemovePendingOperation removes the time-locked operation scheduled by the
+// given transaction. It returns nil if there is no such operation.
+func (ms *KeyPage) RemovePendingOperation(txid [32]byte) *PendingKeyPageOperation {
+	for i, op := range ms.PendingOperations {
+		if op.Txid == txid {
+			ms.PendingOperations = append(ms.PendingOperations[:i], ms.PendingOperations[i+1:]...)
+			return op
+		}
+	}
+	return nil
+}
+
+// AllowsSingleSignature returns true if the operation can be executed with
+// the signature of any one key of a page, regardless of the page's threshold.
+// This allows the holder of any key to react to a compromise of the others. A
+// key cannot cancel an operation that targets it on its own, and operations
+// signed by the threshold override a freeze by a single signature.
+func (op KeyPageOperation) AllowsSingleSignature() bool {
+	switch op {
+	case KeyPageOperationCancel, KeyPageOperationFreeze:
+		return true
+	default:
+		return false
+	}
+}
+
+// AllowedWhileFrozen returns true if the operation can be executed by a frozen
+// key page.
+func (op KeyPageOperation) AllowedWhileFrozen() bool {
+	switch op {
+	case KeyPageOperationCancel, KeyPageOperationFreeze, KeyPageOperationUnfreeze:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanBeDelayed returns true if the operation can be time-locked. These
+// operations must be delayed by at least the page's MinDelay.
+func (op KeyPageOperation) CanBeDelayed() bool {
+	switch op {
+	case KeyPageOperationUpdate, KeyPageOperationRemove, KeyPageOperationAdd, KeyPageOperationSetThreshold, KeyPageOperationUnfreeze, KeyPageOperationSetMinDelay:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,117 @@
+package protocol
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+)
+
+// DefaultOraclePolicy returns an oracle policy with a single feed, the price
+// oracle account, and no freshness window or outlier rejection.
+func DefaultOraclePolicy() *OraclePolicy {
+	policy := new(OraclePolicy)
+	policy.Feeds = []*url.URL{PriceOracle()}
+	return policy
+}
+
+// Validate verifies that the policy has at least one feed, that every feed is
+// an account of the DN, and that no feed is listed twice.
+func (p *OraclePolicy) Validate() error {
+	if len(p.Feeds) == 0 {
+		return errors.New("oracle policy has no feeds")
+	}
+
+	seen := map[string]bool{}
+	for _, feed := range p.Feeds {
+		if !BelongsToDn(feed) {
+			return fmt.Errorf("oracle feed %v does not belong to the DN", feed)
+		}
+
+		s := strings.ToLower(feed.String())
+		if seen[s] {
+			return fmt.Errorf("oracle feed %v is listed twice", feed)
+		}
+		seen[s] = true
+	}
+
+	return nil
+}
+
+// IsFeed returns true if the account is one of the feeds of the policy.
+func (p *OraclePolicy) IsFeed(account *url.URL) bool {
+	for _, feed := range p.Feeds {
+		if feed.Equal(account) {
+			return true
+		}
+	}
+	return false
+}
+
+// Aggregate computes the oracle price from the most recent submission of each
+// feed. Submissions from accounts that are not feeds of the policy are
+// ignored, as are submissions older than Window seconds, if Window is set. If
+// MaxDeviation is set, submissions that deviate from the median by more than
+// MaxDeviation basis points are rejected, and the price is the median of the
+// remainder. Aggregate returns the price and the feeds that contributed to it.
+func (p *OraclePolicy) Aggregate(now time.Time, submissions []*OracleSubmission) (uint64, []*url.URL, error) {
+	var fresh []*OracleSubmission
+	for _, sub := range submissions {
+		if sub.Price == 0 || !p.IsFeed(sub.Feed) {
+			continue
+		}
+		if p.Window != 0 && now.Sub(sub.Time) > time.Duration(p.Window)*time.Second {
+			continue
+		}
+		fresh = append(fresh, sub)
+	}
+	if len(fresh) == 0 {
+		return 0, nil, errors.New("no oracle feed has a recent submission")
+	}
+
+	median := medianOraclePrice(fresh)
+	if p.MaxDeviation != 0 {
+		var accepted []*OracleSubmission
+		for _, sub := range fresh {
+			var diff uint64
+			if sub.Price > median {
+				diff = sub.Price - median
+			} else {
+				diff = median - sub.Price
+			}
+			if diff*10000 <= median*p.MaxDeviation {
+				accepted = append(accepted, sub)
+			}
+		}
+		if len(accepted) == 0 {
+			return 0, nil, errors.New("every oracle submission deviates too far from the median")
+		}
+		fresh = accepted
+		median = medianOraclePrice(fresh)
+	}
+
+	sources := make([]*url.URL, len(fresh))
+	for i, sub := range fresh {
+		sources[i] = sub.Feed
+	}
+	return median, sources, nil
+}
+
+// medianOraclePrice returns the median price of the submissions, or the mean
+// of the middle two if there is an even number of submissions.
+func medianOraclePrice(submissions []*OracleSubmission) uint64 {
+	prices := make([]uint64, len(submissions))
+	for i, sub := range submissions {
+		prices[i] = sub.Price
+	}
+	sort.Slice(prices, func(i, j int) bool { return prices[i] < prices[j] })
+
+	mid := len(prices) / 2
+	if len(prices)%2 == 1 {
+		return prices[mid]
+	}
+	return prices[mid-1]/2 + prices[mid]/2 + (prices[mid-1]%2+prices[mid]%2)/2
+}
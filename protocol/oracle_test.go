@@ -0,0 +1,65 @@
+package protocol
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/accumulatenetwork/accumulate/internal/url"
+)
+
+func TestOraclePolicy(t *testing.T) {
+	feed := func(name string) *url.URL { return DnUrl().JoinPath(name) }
+	now := time.Unix(1e9, 0)
+	ago := func(seconds int) time.Time { return now.Add(-time.Duration(seconds) * time.Second) }
+
+	policy := &OraclePolicy{
+		Feeds:        []*url.URL{feed("feed1"), feed("feed2"), feed("feed3"), feed("feed4")},
+		Window:       60,
+		MaxDeviation: 1000, // 10%
+	}
+	require.NoError(t, policy.Validate())
+
+	// The median of the fresh submissions of authorized feeds
+	price, sources, err := policy.Aggregate(now, []*OracleSubmission{
+		{Feed: feed("feed1"), Price: 100, Time: ago(10)},
+		{Feed: feed("feed2"), Price: 104, Time: ago(20)},
+		{Feed: feed("feed3"), Price: 102, Time: ago(30)},
+		{Feed: feed("feed4"), Price: 1, Time: ago(120)},   // Stale
+		{Feed: feed("other"), Price: 1000, Time: ago(10)}, // Not a feed
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(102), price)
+	require.Equal(t, []*url.URL{feed("feed1"), feed("feed2"), feed("feed3")}, sources)
+
+	// Outliers are rejected, and the median of an even number of submissions
+	// is the mean of the middle two
+	price, sources, err = policy.Aggregate(now, []*OracleSubmission{
+		{Feed: feed("feed1"), Price: 100, Time: ago(10)},
+		{Feed: feed("feed2"), Price: 104, Time: ago(10)},
+		{Feed: feed("feed3"), Price: 102, Time: ago(10)},
+		{Feed: feed("feed4"), Price: 500, Time: ago(10)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(102), price)
+	require.Equal(t, []*url.URL{feed("feed1"), feed("feed2"), feed("feed3")}, sources)
+
+	price, _, err = policy.Aggregate(now, []*OracleSubmission{
+		{Feed: feed("feed1"), Price: 100, Time: ago(10)},
+		{Feed: feed("feed2"), Price: 105, Time: ago(10)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(102), price)
+
+	// Nothing fresh
+	_, _, err = policy.Aggregate(now, []*OracleSubmission{
+		{Feed: feed("feed1"), Price: 100, Time: ago(61)},
+	})
+	require.Error(t, err)
+
+	// Invalid policies
+	require.Error(t, new(OraclePolicy).Validate())
+	require.Error(t, (&OraclePolicy{Feeds: []*url.URL{{Authority: "foo"}}}).Validate())
+	require.Error(t, (&OraclePolicy{Feeds: []*url.URL{feed("feed1"), feed("FEED1")}}).Validate())
+	require.NoError(t, DefaultOraclePolicy().Validate())
+}
@@ -34,6 +34,19 @@ const (
 	// Oracle is the path to a node's anchor chain account.
 	Oracle = "oracle"
 
+	// OracleFeeds is the path to the DN's oracle policy account, which lists
+	// the authorized oracle feeds.
+	OracleFeeds = "oracle-feeds"
+
+	// Fees is the path to the DN's fee schedule account.
+	Fees = "fees"
+
+	// Routing is the path to the DN's routing table account.
+	Routing = "routing"
+
+	// Globals is the path to the DN's network globals account.
+	Globals = "globals"
+
 	// MainChain is the main transaction chain of a record.
 	MainChain = "main"
 
@@ -65,6 +78,34 @@ func PriceOracle() *url.URL {
 
 var PriceOracleAuthority = PriceOracle().String()
 
+// OracleFeedsUrl returns acc://dn/oracle-feeds
+func OracleFeedsUrl() *url.URL {
+	return DnUrl().JoinPath(OracleFeeds)
+}
+
+var OracleFeedsAuthority = OracleFeedsUrl().String()
+
+// FeeScheduleUrl returns acc://dn/fees
+func FeeScheduleUrl() *url.URL {
+	return DnUrl().JoinPath(Fees)
+}
+
+var FeeScheduleAuthority = FeeScheduleUrl().String()
+
+// RoutingTableUrl returns acc://dn/routing
+func RoutingTableUrl() *url.URL {
+	return DnUrl().JoinPath(Routing)
+}
+
+var RoutingTableAuthority = RoutingTableUrl().String()
+
+// GlobalsUrl returns acc://dn/globals
+func GlobalsUrl() *url.URL {
+	return DnUrl().JoinPath(Globals)
+}
+
+var GlobalsAuthority = GlobalsUrl().String()
+
 // AcmePrecision is the precision of ACME token amounts.
 const AcmePrecision = 1e8
 
@@ -167,12 +208,23 @@ func LiteTokenAddress(pubKey []byte, tokenUrlStr string) (*url.URL, error) {
 
 	liteUrl := new(url.URL)
 	keyHash := sha256.Sum256(pubKey)
+	liteUrl.Authority = liteAuthority(keyHash[:])
+	liteUrl.Path = fmt.Sprintf("/%s%s", tokenUrl.Authority, tokenUrl.Path)
+	return liteUrl, nil
+}
+
+// LiteIdentityForKeyHash returns the URL of the lite identity of the key with
+// the given public key hash. The lite token accounts of the key are
+// sub-accounts of the lite identity.
+func LiteIdentityForKeyHash(keyHash []byte) *url.URL {
+	return &url.URL{Authority: liteAuthority(keyHash)}
+}
+
+func liteAuthority(keyHash []byte) string {
 	keyStr := fmt.Sprintf("%x", keyHash[:20])
 	checkSum := sha256.Sum256([]byte(keyStr))
 	checkStr := fmt.Sprintf("%x", checkSum[28:])
-	liteUrl.Authority = keyStr + checkStr
-	liteUrl.Path = fmt.Sprintf("/%s%s", tokenUrl.Authority, tokenUrl.Path)
-	return liteUrl, nil
+	return keyStr + checkStr
 }
 
 // ParseLiteTokenAddress extracts the key hash and token URL from an lite token
@@ -0,0 +1,114 @@
+package protocol
+
+import (
+	"fmt"
+	"sort"
+)
+
+// DefaultRoutingTable returns a routing table that divides the routing numbers
+// evenly between the given BVNs. If the number of BVNs is not a power of two,
+// the first BVNs receive an extra prefix.
+func DefaultRoutingTable(bvnNames []string) *RoutingTable {
+	var length uint64
+	for 1<<length < len(bvnNames) {
+		length++
+	}
+
+	table := new(RoutingTable)
+	for i := 0; i < 1<<length; i++ {
+		route := Route{Length: length, Value: uint64(i)}
+		route.Subnet = bvnNames[i%len(bvnNames)]
+		table.Routes = append(table.Routes, route)
+	}
+	return table
+}
+
+// Matches returns true if the first Length bits of the routing number are
+// equal to Value.
+func (r *Route) Matches(routingNumber uint64) bool {
+	if r.Length == 0 {
+		return true
+	}
+	return routingNumber>>(64-r.Length) == r.Value
+}
+
+// Route returns the subnet of the longest route that matches the routing
+// number.
+func (t *RoutingTable) Route(routingNumber uint64) (string, error) {
+	var found *Route
+	for i, r := range t.Routes {
+		if !r.Matches(routingNumber) {
+			continue
+		}
+		if found == nil || r.Length > found.Length {
+			found = &t.Routes[i]
+		}
+	}
+	if found == nil {
+		return "", fmt.Errorf("no route for routing number %016x", routingNumber)
+	}
+	return found.Subnet, nil
+}
+
+// Validate verifies that every route is valid, that no two routes have the
+// same prefix, and that every routing number has a route.
+func (t *RoutingTable) Validate() error {
+	routes := make([]Route, len(t.Routes))
+	copy(routes, t.Routes)
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Length != routes[j].Length {
+			return routes[i].Length < routes[j].Length
+		}
+		return routes[i].Value < routes[j].Value
+	})
+
+	for i, r := range routes {
+		if r.Length > 64 {
+			return fmt.Errorf("route %d/%d: prefix is longer than 64 bits", r.Value, r.Length)
+		}
+		if r.Length < 64 && r.Value>>r.Length != 0 {
+			return fmt.Errorf("route %d/%d: value is longer than the prefix", r.Value, r.Length)
+		}
+		if r.Subnet == "" {
+			return fmt.Errorf("route %d/%d: missing subnet", r.Value, r.Length)
+		}
+		if i > 0 && routes[i-1].Length == r.Length && routes[i-1].Value == r.Value {
+			return fmt.Errorf("route %d/%d: duplicate prefix", r.Value, r.Length)
+		}
+	}
+
+	if !covers(routes, 0, 0) {
+		return fmt.Errorf("some routing numbers do not have a route")
+	}
+	return nil
+}
+
+// covers returns true if every routing number that starts with the prefix has
+// a route. The routes must be sorted by length.
+func covers(routes []Route, value, length uint64) bool {
+	deeper := false
+	for _, r := range routes {
+		if r.Length < length {
+			// A shorter route covers the prefix if the prefix starts with it
+			if r.Length == 0 || value>>(length-r.Length) == r.Value {
+				return true
+			}
+			continue
+		}
+
+		if r.Length == length && r.Value == value {
+			return true
+		}
+
+		// A longer route starts with the prefix
+		if length == 0 || r.Value>>(r.Length-length) == value {
+			deeper = true
+		}
+	}
+
+	// The prefix is only covered if both halves are
+	if !deeper || length == 64 {
+		return false
+	}
+	return covers(routes, value<<1, length+1) && covers(routes, value<<1|1, length+1)
+}
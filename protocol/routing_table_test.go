@@ -0,0 +1,55 @@
+package protocol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutingTable(t *testing.T) {
+	// The default table has a route for every routing number
+	table := DefaultRoutingTable([]string{"BVN0", "BVN1", "BVN2"})
+	require.NoError(t, table.Validate())
+	require.Len(t, table.Routes, 4)
+
+	subnet, err := table.Route(0x0123 << 48)
+	require.NoError(t, err)
+	require.Equal(t, "BVN0", subnet)
+	subnet, err = table.Route(0xC123 << 48)
+	require.NoError(t, err)
+	require.Equal(t, "BVN0", subnet)
+	subnet, err = table.Route(0x8123 << 48)
+	require.NoError(t, err)
+	require.Equal(t, "BVN2", subnet)
+
+	// The longest matching route wins, so a subnet can take over part of
+	// another subnet's prefix
+	table.Routes = append(table.Routes, Route{Length: 4, Value: 0xC, Subnet: "BVN3"})
+	require.NoError(t, table.Validate())
+	subnet, err = table.Route(0xC123 << 48)
+	require.NoError(t, err)
+	require.Equal(t, "BVN3", subnet)
+	subnet, err = table.Route(0xD123 << 48)
+	require.NoError(t, err)
+	require.Equal(t, "BVN0", subnet)
+
+	// A single BVN gets everything
+	require.NoError(t, DefaultRoutingTable([]string{"BVN0"}).Validate())
+
+	// Invalid tables
+	gap := &RoutingTable{Routes: []Route{
+		{Length: 1, Value: 0, Subnet: "BVN0"},
+		{Length: 2, Value: 2, Subnet: "BVN1"},
+	}}
+	require.Error(t, gap.Validate())
+
+	dup := &RoutingTable{Routes: []Route{
+		{Length: 1, Value: 0, Subnet: "BVN0"},
+		{Length: 1, Value: 0, Subnet: "BVN1"},
+		{Length: 1, Value: 1, Subnet: "BVN1"},
+	}}
+	require.Error(t, dup.Validate())
+
+	long := &RoutingTable{Routes: []Route{{Length: 1, Value: 2, Subnet: "BVN0"}}}
+	require.Error(t, long.Validate())
+}
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"crypto/ed25519"
 	"errors"
+	"math/big"
 
+	"github.com/btcsuite/btcd/btcec"
 	"gitlab.com/accumulatenetwork/accumulate/smt/common"
 )
 
@@ -73,3 +75,60 @@ func (e *ED25519Signature) Sign(nonce uint64, privateKey []byte, hash []byte) er
 func (e *ED25519Signature) Verify(hash []byte) bool {
 	return len(e.PublicKey) == 32 && len(e.Signature) == 64 && ed25519.Verify(e.PublicKey, hash, e.Signature)
 }
+
+// GetPublicKey returns PublicKey.
+func (e *SECP256K1Signature) GetPublicKey() []byte {
+	return e.PublicKey
+}
+
+// GetSignature returns Signature.
+func (e *SECP256K1Signature) GetSignature() []byte {
+	return e.Signature
+}
+
+// Sign
+// Signs the hash with the private key and records the compressed public key
+// and the DER encoded signature. The private key may be 32 bytes, or 32 bytes
+// followed by the compressed public key, as the CLI wallet stores it. The nonce
+// is part of the transaction hash and is not used.
+func (e *SECP256K1Signature) Sign(nonce uint64, privateKey []byte, hash []byte) error {
+	if len(privateKey) != 32 && len(privateKey) != 32+btcec.PubKeyBytesLenCompressed {
+		return errors.New("invalid private key")
+	}
+
+	priv, pub := btcec.PrivKeyFromBytes(btcec.S256(), privateKey[:32])
+	if len(privateKey) > 32 && !bytes.Equal(privateKey[32:], pub.SerializeCompressed()) {
+		return errors.New("privateKey cannot sign this struct")
+	}
+
+	sig, err := priv.Sign(hash)
+	if err != nil {
+		return err
+	}
+
+	e.PublicKey = pub.SerializeCompressed()
+	e.Signature = sig.Serialize()
+	return nil
+}
+
+// secp256k1HalfOrder is half the order of the SECP256K1 curve.
+var secp256k1HalfOrder = new(big.Int).Rsh(btcec.S256().N, 1)
+
+// Verify
+// Returns true if the signature is a valid DER encoded signature of the hash.
+// The public key may be compressed or uncompressed. Signatures with a high S
+// value are rejected, since (R, N-S) is also valid and would allow anyone to
+// change the signature, and thus the envelope hash, without the private key.
+func (e *SECP256K1Signature) Verify(hash []byte) bool {
+	pub, err := btcec.ParsePubKey(e.PublicKey, btcec.S256())
+	if err != nil {
+		return false
+	}
+
+	sig, err := btcec.ParseDERSignature(e.Signature, btcec.S256())
+	if err != nil || sig.S.Cmp(secp256k1HalfOrder) > 0 {
+		return false
+	}
+
+	return sig.Verify(hash, pub)
+}
@@ -2,8 +2,11 @@ package protocol
 
 import (
 	"crypto/sha256"
+	"math/big"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/ed25519"
 )
 
@@ -37,3 +40,86 @@ func TestED25519Sig(t *testing.T) {
 		t.Error("verify signature marshaled message failed")
 	}
 }
+
+func TestSECP256K1Sig(t *testing.T) {
+	seed := sha256.Sum256([]byte{17, 26, 35, 44, 53, 62})
+	priv, pub := btcec.PrivKeyFromBytes(btcec.S256(), seed[:])
+	mh := sha256.Sum256([]byte("this is a message of some import"))
+
+	sig := new(SECP256K1Signature)
+	require.NoError(t, sig.Sign(1, priv.Serialize(), mh[:]))
+	require.Equal(t, pub.SerializeCompressed(), sig.PublicKey)
+	require.True(t, sig.Verify(mh[:]))
+
+	// The wallet form, with the public key appended, works as well
+	walletKey := append(priv.Serialize(), pub.SerializeCompressed()...)
+	require.NoError(t, sig.Sign(1, walletKey, mh[:]))
+	require.True(t, sig.Verify(mh[:]))
+
+	// Uncompressed public keys are accepted
+	sig.PublicKey = pub.SerializeUncompressed()
+	require.True(t, sig.Verify(mh[:]))
+
+	// Round trip through the signature union
+	data, err := sig.MarshalBinary()
+	require.NoError(t, err)
+	sig2, err := UnmarshalSignature(data)
+	require.NoError(t, err)
+	require.IsType(t, new(SECP256K1Signature), sig2)
+	require.True(t, sig2.Verify(mh[:]))
+
+	// A different message does not verify
+	other := sha256.Sum256([]byte("some other message"))
+	require.False(t, sig.Verify(other[:]))
+
+	// The malleated signature (R, N-S) is rejected
+	parsed, err := btcec.ParseDERSignature(sig.Signature, btcec.S256())
+	require.NoError(t, err)
+	highS := new(big.Int).Sub(btcec.S256().N, parsed.S)
+	sig.Signature = derSignature(parsed.R, highS)
+	high, err := btcec.ParseDERSignature(sig.Signature, btcec.S256())
+	require.NoError(t, err)
+	require.True(t, high.Verify(mh[:], pub), "the malleated signature is valid ECDSA")
+	require.False(t, sig.Verify(mh[:]))
+}
+
+// derSignature encodes the signature without normalizing S, which
+// btcec.Signature.Serialize does.
+func derSignature(r, s *big.Int) []byte {
+	integer := func(v *big.Int) []byte {
+		b := v.Bytes()
+		if b[0]&0x80 != 0 {
+			b = append([]byte{0}, b...)
+		}
+		return append([]byte{0x02, byte(len(b))}, b...)
+	}
+	body := append(integer(r), integer(s)...)
+	return append([]byte{0x30, byte(len(body))}, body...)
+}
+
+func TestValidateKeyType(t *testing.T) {
+	for _, typ := range []SignatureType{SignatureTypeUnknown, SignatureTypeLegacyED25519, SignatureTypeED25519, SignatureTypeSECP256K1} {
+		require.NoError(t, ValidateKeyType(typ), typ.String())
+	}
+	require.Error(t, ValidateKeyType(SignatureType(100)))
+}
+
+func TestFindKeyOfType(t *testing.T) {
+	edKey := ed25519.NewKeyFromSeed(make([]byte, 32))
+	seed := sha256.Sum256([]byte{1})
+	_, secpKey := btcec.PrivKeyFromBytes(btcec.S256(), seed[:])
+
+	page := new(KeyPage)
+	page.Keys = []*KeySpec{
+		{PublicKey: edKey[32:]},
+		{PublicKey: secpKey.SerializeCompressed(), KeyType: SignatureTypeSECP256K1},
+	}
+
+	require.NotNil(t, page.FindKeyOfType(SignatureTypeLegacyED25519, edKey[32:]))
+	require.NotNil(t, page.FindKeyOfType(SignatureTypeED25519, edKey[32:]))
+	require.Nil(t, page.FindKeyOfType(SignatureTypeSECP256K1, edKey[32:]))
+
+	require.NotNil(t, page.FindKeyOfType(SignatureTypeSECP256K1, secpKey.SerializeCompressed()))
+	require.Nil(t, page.FindKeyOfType(SignatureTypeED25519, secpKey.SerializeCompressed()))
+	require.NotNil(t, page.FindKey(secpKey.SerializeCompressed()))
+}
@@ -0,0 +1,8 @@
+package protocol
+
+// TracksDelivery returns true if the delivery of synthetic transactions of the
+// given type is tracked by the outbox of the producing subnet. Anchors are sent
+// every block and are not tracked.
+func TracksDelivery(typ TransactionType) bool {
+	return typ.IsSynthetic() && typ != TransactionTypeSyntheticAnchor
+}
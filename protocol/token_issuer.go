@@ -0,0 +1,101 @@
+package protocol
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Token supply versions. The meaning of TokenIssuer.Supply changed when the
+// circulating supply started being tracked, so issuers record which meaning
+// applies to them. Existing issuers are not migrated, since the amount they
+// have issued is not known.
+const (
+	// TokenSupplyVersionRemaining issuers were created before the circulating
+	// supply was tracked. Supply is the amount that can still be issued:
+	// issuing tokens decreases it and burning tokens increases it. ACME is a
+	// TokenSupplyVersionRemaining issuer, since ACME is minted by the faucet
+	// and at genesis instead of by IssueTokens.
+	TokenSupplyVersionRemaining uint64 = 0
+
+	// TokenSupplyVersionCirculating issuers are created by CreateToken.
+	// Supply is the maximum supply and Issued is the circulating supply.
+	TokenSupplyVersionCirculating uint64 = 1
+)
+
+// TracksCirculatingSupply returns true if Issued is the circulating supply.
+func (t *TokenIssuer) TracksCirculatingSupply() bool {
+	return t.SupplyVersion >= TokenSupplyVersionCirculating
+}
+
+// RemainingSupply returns the amount that can still be issued, or nil if the
+// supply is not limited.
+func (t *TokenIssuer) RemainingSupply() *big.Int {
+	if !t.HasSupplyLimit {
+		return nil
+	}
+
+	if !t.TracksCirculatingSupply() {
+		return new(big.Int).Set(&t.Supply)
+	}
+
+	remaining := new(big.Int).Sub(&t.Supply, &t.Issued)
+	if remaining.Sign() < 0 {
+		remaining.SetInt64(0)
+	}
+	return remaining
+}
+
+// CanIssue returns true if the amount is positive and issuing it would not
+// exceed the supply limit.
+func (t *TokenIssuer) CanIssue(amount *big.Int) bool {
+	if amount == nil || amount.Sign() <= 0 {
+		return false
+	}
+
+	remaining := t.RemainingSupply()
+	return remaining == nil || remaining.Cmp(amount) >= 0
+}
+
+// Issue adds the amount to the circulating supply. It returns false if the
+// amount cannot be issued.
+func (t *TokenIssuer) Issue(amount *big.Int) bool {
+	if !t.CanIssue(amount) {
+		return false
+	}
+
+	if t.TracksCirculatingSupply() {
+		t.Issued.Add(&t.Issued, amount)
+	} else {
+		t.Supply.Sub(&t.Supply, amount)
+	}
+	return true
+}
+
+// CanBurn returns an error if the amount is negative or, if the issuer tracks
+// the circulating supply, if it exceeds the circulating supply.
+func (t *TokenIssuer) CanBurn(amount *big.Int) error {
+	if amount == nil || amount.Sign() < 0 {
+		return fmt.Errorf("invalid amount %v", amount)
+	}
+
+	if t.TracksCirculatingSupply() && amount.Cmp(&t.Issued) > 0 {
+		return fmt.Errorf("cannot burn %s, the circulating supply is %s", amount.String(), t.Issued.String())
+	}
+	return nil
+}
+
+// Burn removes the amount from the circulating supply, returning it to the
+// issuable pool. It returns an error if the amount cannot be burned.
+func (t *TokenIssuer) Burn(amount *big.Int) error {
+	err := t.CanBurn(amount)
+	if err != nil {
+		return err
+	}
+
+	if t.TracksCirculatingSupply() {
+		t.Issued.Sub(&t.Issued, amount)
+	} else {
+		t.Supply.Add(&t.Supply, amount)
+	}
+	return nil
+}
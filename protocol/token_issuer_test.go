@@ -0,0 +1,48 @@
+package protocol
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenIssuerSupply(t *testing.T) {
+	t.Run("Circulating", func(t *testing.T) {
+		issuer := new(TokenIssuer)
+		issuer.SupplyVersion = TokenSupplyVersionCirculating
+		issuer.HasSupplyLimit = true
+		issuer.Supply.SetInt64(100)
+
+		require.True(t, issuer.Issue(big.NewInt(60)))
+		require.False(t, issuer.Issue(big.NewInt(50)))
+		require.Equal(t, int64(60), issuer.Issued.Int64())
+		require.Equal(t, int64(40), issuer.RemainingSupply().Int64())
+
+		require.NoError(t, issuer.Burn(big.NewInt(20)))
+		require.Equal(t, int64(40), issuer.Issued.Int64())
+		require.Equal(t, int64(60), issuer.RemainingSupply().Int64())
+
+		// Burning more than the circulating supply fails and changes nothing
+		require.Error(t, issuer.Burn(big.NewInt(41)))
+		require.Equal(t, int64(40), issuer.Issued.Int64())
+		require.Error(t, issuer.Burn(big.NewInt(-1)))
+	})
+
+	t.Run("Remaining", func(t *testing.T) {
+		// An issuer created before the circulating supply was tracked keeps
+		// treating Supply as the remaining supply
+		issuer := new(TokenIssuer)
+		issuer.HasSupplyLimit = true
+		issuer.Supply.SetInt64(100)
+
+		require.True(t, issuer.Issue(big.NewInt(60)))
+		require.Equal(t, int64(40), issuer.Supply.Int64())
+		require.Equal(t, int64(40), issuer.RemainingSupply().Int64())
+		require.Zero(t, issuer.Issued.Sign())
+
+		// Tokens it did not issue can be burned
+		require.NoError(t, issuer.Burn(big.NewInt(80)))
+		require.Equal(t, int64(120), issuer.RemainingSupply().Int64())
+	})
+}
@@ -11,6 +11,12 @@ func NewTransactionResult(typ TransactionType) (TransactionResult, error) {
 	case TransactionTypeWriteData:
 		return new(WriteDataResult), nil
 
+	case TransactionTypeBatch:
+		return new(BatchResult), nil
+
+	case TransactionTypeAddCredits:
+		return new(AddCreditsResult), nil
+
 	case TransactionTypeUnknown:
 		return new(EmptyResult), nil
 	}
@@ -39,9 +39,17 @@ type AcmeOracle struct {
 }
 
 type AddCredits struct {
+	fieldsSet  []bool
+	Recipient  *url.URL `json:"recipient,omitempty" form:"recipient" query:"recipient" validate:"required"`
+	Amount     uint64   `json:"amount,omitempty" form:"amount" query:"amount"`
+	AcmeAmount big.Int  `json:"acmeAmount,omitempty" form:"acmeAmount" query:"acmeAmount"`
+}
+
+type AddCreditsResult struct {
 	fieldsSet []bool
-	Recipient *url.URL `json:"recipient,omitempty" form:"recipient" query:"recipient" validate:"required"`
-	Amount    uint64   `json:"amount,omitempty" form:"amount" query:"amount" validate:"required"`
+	Amount    big.Int `json:"amount,omitempty" form:"amount" query:"amount" validate:"required"`
+	Credits   uint64  `json:"credits,omitempty" form:"credits" query:"credits" validate:"required"`
+	Oracle    uint64  `json:"oracle,omitempty" form:"oracle" query:"oracle" validate:"required"`
 }
 
 type Anchor struct {
@@ -65,6 +73,24 @@ type AnchoredRecord struct {
 	Anchor    [32]byte `json:"anchor,omitempty" form:"anchor" query:"anchor" validate:"required"`
 }
 
+type Batch struct {
+	fieldsSet  []bool
+	Operations []*BatchOperation `json:"operations,omitempty" form:"operations" query:"operations" validate:"required"`
+}
+
+type BatchOperation struct {
+	fieldsSet     []bool
+	Origin        *url.URL           `json:"origin,omitempty" form:"origin" query:"origin"`
+	KeyPageIndex  uint64             `json:"keyPageIndex,omitempty" form:"keyPageIndex" query:"keyPageIndex"`
+	KeyPageHeight uint64             `json:"keyPageHeight,omitempty" form:"keyPageHeight" query:"keyPageHeight"`
+	Body          TransactionPayload `json:"body,omitempty" form:"body" query:"body" validate:"required"`
+}
+
+type BatchResult struct {
+	fieldsSet []bool
+	Results   []TransactionResult `json:"results,omitempty" form:"results" query:"results" validate:"required"`
+}
+
 type BurnTokens struct {
 	fieldsSet []bool
 	Amount    big.Int `json:"amount,omitempty" form:"amount" query:"amount" validate:"required"`
@@ -92,11 +118,12 @@ type CreateDataAccount struct {
 
 type CreateIdentity struct {
 	fieldsSet   []bool
-	Url         *url.URL `json:"url,omitempty" form:"url" query:"url" validate:"required"`
-	PublicKey   []byte   `json:"publicKey,omitempty" form:"publicKey" query:"publicKey" validate:"required"`
-	KeyBookName string   `json:"keyBookName,omitempty" form:"keyBookName" query:"keyBookName"`
-	KeyPageName string   `json:"keyPageName,omitempty" form:"keyPageName" query:"keyPageName"`
-	Manager     *url.URL `json:"manager,omitempty" form:"manager" query:"manager"`
+	Url         *url.URL      `json:"url,omitempty" form:"url" query:"url" validate:"required"`
+	PublicKey   []byte        `json:"publicKey,omitempty" form:"publicKey" query:"publicKey" validate:"required"`
+	KeyBookName string        `json:"keyBookName,omitempty" form:"keyBookName" query:"keyBookName"`
+	KeyPageName string        `json:"keyPageName,omitempty" form:"keyPageName" query:"keyPageName"`
+	Manager     *url.URL      `json:"manager,omitempty" form:"manager" query:"manager"`
+	KeyType     SignatureType `json:"keyType,omitempty" form:"keyType" query:"keyType"`
 }
 
 type CreateKeyBook struct {
@@ -176,6 +203,25 @@ type Envelope struct {
 	hash        []byte
 }
 
+type FaucetPolicy struct {
+	fieldsSet  []bool
+	Amount     uint64        `json:"amount,omitempty" form:"amount" query:"amount" validate:"required"`
+	Cooldown   time.Duration `json:"cooldown,omitempty" form:"cooldown" query:"cooldown" validate:"required"`
+	DailyLimit uint64        `json:"dailyLimit,omitempty" form:"dailyLimit" query:"dailyLimit" validate:"required"`
+	BlockLimit uint64        `json:"blockLimit,omitempty" form:"blockLimit" query:"blockLimit" validate:"required"`
+}
+
+type FeeSchedule struct {
+	fieldsSet []bool
+	Fees      []FeeScheduleEntry `json:"fees,omitempty" form:"fees" query:"fees" validate:"required"`
+}
+
+type FeeScheduleEntry struct {
+	fieldsSet []bool
+	Type      TransactionType `json:"type,omitempty" form:"type" query:"type" validate:"required"`
+	Fee       uint64          `json:"fee,omitempty" form:"fee" query:"fee" validate:"required"`
+}
+
 type InternalGenesis struct {
 	fieldsSet []bool
 }
@@ -183,12 +229,25 @@ type InternalGenesis struct {
 type InternalLedger struct {
 	fieldsSet []bool
 	AccountHeader
-	Index         int64            `json:"index,omitempty" form:"index" query:"index" validate:"required"`
-	Timestamp     time.Time        `json:"timestamp,omitempty" form:"timestamp" query:"timestamp" validate:"required"`
-	Synthetic     SyntheticLedger  `json:"synthetic,omitempty" form:"synthetic" query:"synthetic" validate:"required"`
-	PendingOracle uint64           `json:"pendingOracle,omitempty" form:"pendingOracle" query:"pendingOracle" validate:"required"`
-	ActiveOracle  uint64           `json:"activeOracle,omitempty" form:"activeOracle" query:"activeOracle" validate:"required"`
-	Updates       []AnchorMetadata `json:"updates,omitempty" form:"updates" query:"updates" validate:"required"`
+	Index               int64            `json:"index,omitempty" form:"index" query:"index" validate:"required"`
+	Timestamp           time.Time        `json:"timestamp,omitempty" form:"timestamp" query:"timestamp" validate:"required"`
+	Synthetic           SyntheticLedger  `json:"synthetic,omitempty" form:"synthetic" query:"synthetic" validate:"required"`
+	PendingOracle       uint64           `json:"pendingOracle,omitempty" form:"pendingOracle" query:"pendingOracle" validate:"required"`
+	ActiveOracle        uint64           `json:"activeOracle,omitempty" form:"activeOracle" query:"activeOracle" validate:"required"`
+	OracleSources       []*url.URL       `json:"oracleSources,omitempty" form:"oracleSources" query:"oracleSources" validate:"required"`
+	Updates             []AnchorMetadata `json:"updates,omitempty" form:"updates" query:"updates" validate:"required"`
+	PendingFeeSchedule  *FeeSchedule     `json:"pendingFeeSchedule,omitempty" form:"pendingFeeSchedule" query:"pendingFeeSchedule"`
+	ActiveFeeSchedule   *FeeSchedule     `json:"activeFeeSchedule,omitempty" form:"activeFeeSchedule" query:"activeFeeSchedule"`
+	PendingRoutingTable *RoutingTable    `json:"pendingRoutingTable,omitempty" form:"pendingRoutingTable" query:"pendingRoutingTable"`
+	ActiveRoutingTable  *RoutingTable    `json:"activeRoutingTable,omitempty" form:"activeRoutingTable" query:"activeRoutingTable"`
+	RoutingMigration    bool             `json:"routingMigration,omitempty" form:"routingMigration" query:"routingMigration" validate:"required"`
+	PendingGlobals      *NetworkGlobals  `json:"pendingGlobals,omitempty" form:"pendingGlobals" query:"pendingGlobals"`
+	ActiveGlobals       *NetworkGlobals  `json:"activeGlobals,omitempty" form:"activeGlobals" query:"activeGlobals"`
+}
+
+type InternalMigrateAccounts struct {
+	fieldsSet []bool
+	Accounts  []*url.URL `json:"accounts,omitempty" form:"accounts" query:"accounts" validate:"required"`
 }
 
 type InternalSendTransactions struct {
@@ -221,21 +280,27 @@ type KeyBook struct {
 type KeyPage struct {
 	fieldsSet []bool
 	AccountHeader
-	CreditBalance big.Int    `json:"creditBalance,omitempty" form:"creditBalance" query:"creditBalance" validate:"required"`
-	Threshold     uint64     `json:"threshold,omitempty" form:"threshold" query:"threshold" validate:"required"`
-	Keys          []*KeySpec `json:"keys,omitempty" form:"keys" query:"keys" validate:"required"`
+	CreditBalance           big.Int                    `json:"creditBalance,omitempty" form:"creditBalance" query:"creditBalance" validate:"required"`
+	Threshold               uint64                     `json:"threshold,omitempty" form:"threshold" query:"threshold" validate:"required"`
+	Keys                    []*KeySpec                 `json:"keys,omitempty" form:"keys" query:"keys" validate:"required"`
+	Frozen                  bool                       `json:"frozen,omitempty" form:"frozen" query:"frozen"`
+	FrozenBySingleSignature bool                       `json:"frozenBySingleSignature,omitempty" form:"frozenBySingleSignature" query:"frozenBySingleSignature"`
+	MinDelay                uint64                     `json:"minDelay,omitempty" form:"minDelay" query:"minDelay"`
+	PendingOperations       []*PendingKeyPageOperation `json:"pendingOperations,omitempty" form:"pendingOperations" query:"pendingOperations"`
 }
 
 type KeySpec struct {
 	fieldsSet []bool
-	PublicKey []byte   `json:"publicKey,omitempty" form:"publicKey" query:"publicKey" validate:"required"`
-	Nonce     uint64   `json:"nonce,omitempty" form:"nonce" query:"nonce" validate:"required"`
-	Owner     *url.URL `json:"owner,omitempty" form:"owner" query:"owner" validate:"required"`
+	PublicKey []byte        `json:"publicKey,omitempty" form:"publicKey" query:"publicKey" validate:"required"`
+	Nonce     uint64        `json:"nonce,omitempty" form:"nonce" query:"nonce" validate:"required"`
+	Owner     *url.URL      `json:"owner,omitempty" form:"owner" query:"owner" validate:"required"`
+	KeyType   SignatureType `json:"keyType,omitempty" form:"keyType" query:"keyType"`
 }
 
 type KeySpecParams struct {
 	fieldsSet []bool
-	PublicKey []byte `json:"publicKey,omitempty" form:"publicKey" query:"publicKey" validate:"required"`
+	PublicKey []byte        `json:"publicKey,omitempty" form:"publicKey" query:"publicKey" validate:"required"`
+	KeyType   SignatureType `json:"keyType,omitempty" form:"keyType" query:"keyType"`
 }
 
 type LegacyED25519Signature struct {
@@ -275,6 +340,12 @@ type MetricsResponse struct {
 	Value interface{} `json:"value,omitempty" form:"value" query:"value" validate:"required"`
 }
 
+type NetworkGlobals struct {
+	fieldsSet     []bool
+	PendingExpiry uint64        `json:"pendingExpiry,omitempty" form:"pendingExpiry" query:"pendingExpiry" validate:"required"`
+	Faucet        *FaucetPolicy `json:"faucet,omitempty" form:"faucet" query:"faucet" validate:"required"`
+}
+
 type Object struct {
 	fieldsSet []bool
 	Entry     []byte   `json:"entry,omitempty" form:"entry" query:"entry" validate:"required"`
@@ -288,6 +359,27 @@ type ObjectMetadata struct {
 	Chains    []ChainMetadata `json:"chains,omitempty" form:"chains" query:"chains" validate:"required"`
 }
 
+type OraclePolicy struct {
+	fieldsSet    []bool
+	Feeds        []*url.URL `json:"feeds,omitempty" form:"feeds" query:"feeds" validate:"required"`
+	Window       uint64     `json:"window,omitempty" form:"window" query:"window" validate:"required"`
+	MaxDeviation uint64     `json:"maxDeviation,omitempty" form:"maxDeviation" query:"maxDeviation" validate:"required"`
+}
+
+type OracleSubmission struct {
+	fieldsSet []bool
+	Feed      *url.URL  `json:"feed,omitempty" form:"feed" query:"feed" validate:"required"`
+	Price     uint64    `json:"price,omitempty" form:"price" query:"price" validate:"required"`
+	Time      time.Time `json:"time,omitempty" form:"time" query:"time" validate:"required"`
+}
+
+type PendingKeyPageOperation struct {
+	fieldsSet   []bool
+	Txid        [32]byte       `json:"txid,omitempty" form:"txid" query:"txid" validate:"required"`
+	EffectiveAt uint64         `json:"effectiveAt,omitempty" form:"effectiveAt" query:"effectiveAt" validate:"required"`
+	Operation   *UpdateKeyPage `json:"operation,omitempty" form:"operation" query:"operation" validate:"required"`
+}
+
 type PendingTransactionState struct {
 	fieldsSet []bool
 	AccountHeader
@@ -338,6 +430,24 @@ type ResponseDataEntrySet struct {
 	Total       uint64              `json:"total,omitempty" form:"total" query:"total" validate:"required"`
 }
 
+type Route struct {
+	fieldsSet []bool
+	Length    uint64 `json:"length,omitempty" form:"length" query:"length" validate:"required"`
+	Value     uint64 `json:"value,omitempty" form:"value" query:"value" validate:"required"`
+	Subnet    string `json:"subnet,omitempty" form:"subnet" query:"subnet" validate:"required"`
+}
+
+type RoutingTable struct {
+	fieldsSet []bool
+	Routes    []Route `json:"routes,omitempty" form:"routes" query:"routes" validate:"required"`
+}
+
+type SECP256K1Signature struct {
+	fieldsSet []bool
+	PublicKey []byte `json:"publicKey,omitempty" form:"publicKey" query:"publicKey" validate:"required"`
+	Signature []byte `json:"signature,omitempty" form:"signature" query:"signature" validate:"required"`
+}
+
 type SegWitDataEntry struct {
 	fieldsSet []bool
 	Cause     [32]byte `json:"cause,omitempty" form:"cause" query:"cause" validate:"required"`
@@ -363,16 +473,21 @@ type SignPending struct {
 }
 
 type SyntheticAnchor struct {
-	fieldsSet       []bool
-	Source          *url.URL `json:"source,omitempty" form:"source" query:"source" validate:"required"`
-	Major           bool     `json:"major,omitempty" form:"major" query:"major" validate:"required"`
-	RootAnchor      [32]byte `json:"rootAnchor,omitempty" form:"rootAnchor" query:"rootAnchor" validate:"required"`
-	RootIndex       uint64   `json:"rootIndex,omitempty" form:"rootIndex" query:"rootIndex" validate:"required"`
-	Block           uint64   `json:"block,omitempty" form:"block" query:"block" validate:"required"`
-	SourceIndex     uint64   `json:"sourceIndex,omitempty" form:"sourceIndex" query:"sourceIndex" validate:"required"`
-	SourceBlock     uint64   `json:"sourceBlock,omitempty" form:"sourceBlock" query:"sourceBlock" validate:"required"`
-	AcmeOraclePrice uint64   `json:"acmeOraclePrice,omitempty" form:"acmeOraclePrice" query:"acmeOraclePrice" validate:"required"`
-	Receipt         Receipt  `json:"receipt,omitempty" form:"receipt" query:"receipt" validate:"required"`
+	fieldsSet        []bool
+	Source           *url.URL        `json:"source,omitempty" form:"source" query:"source" validate:"required"`
+	Major            bool            `json:"major,omitempty" form:"major" query:"major" validate:"required"`
+	RootAnchor       [32]byte        `json:"rootAnchor,omitempty" form:"rootAnchor" query:"rootAnchor" validate:"required"`
+	RootIndex        uint64          `json:"rootIndex,omitempty" form:"rootIndex" query:"rootIndex" validate:"required"`
+	Block            uint64          `json:"block,omitempty" form:"block" query:"block" validate:"required"`
+	SourceIndex      uint64          `json:"sourceIndex,omitempty" form:"sourceIndex" query:"sourceIndex" validate:"required"`
+	SourceBlock      uint64          `json:"sourceBlock,omitempty" form:"sourceBlock" query:"sourceBlock" validate:"required"`
+	AcmeOraclePrice  uint64          `json:"acmeOraclePrice,omitempty" form:"acmeOraclePrice" query:"acmeOraclePrice" validate:"required"`
+	Receipt          Receipt         `json:"receipt,omitempty" form:"receipt" query:"receipt" validate:"required"`
+	FeeSchedule      *FeeSchedule    `json:"feeSchedule,omitempty" form:"feeSchedule" query:"feeSchedule"`
+	RoutingTable     *RoutingTable   `json:"routingTable,omitempty" form:"routingTable" query:"routingTable"`
+	Globals          *NetworkGlobals `json:"globals,omitempty" form:"globals" query:"globals"`
+	Acknowledgements [][32]byte      `json:"acknowledgements,omitempty" form:"acknowledgements" query:"acknowledgements"`
+	Unacknowledged   [][32]byte      `json:"unacknowledged,omitempty" form:"unacknowledged" query:"unacknowledged"`
 }
 
 type SyntheticBurnTokens struct {
@@ -401,11 +516,20 @@ type SyntheticDepositTokens struct {
 }
 
 type SyntheticLedger struct {
+	fieldsSet        []bool
+	Nonce            uint64     `json:"nonce,omitempty" form:"nonce" query:"nonce" validate:"required"`
+	Produced         [][32]byte `json:"produced,omitempty" form:"produced" query:"produced" validate:"required"`
+	Unsigned         [][32]byte `json:"unsigned,omitempty" form:"unsigned" query:"unsigned" validate:"required"`
+	Unsent           [][32]byte `json:"unsent,omitempty" form:"unsent" query:"unsent" validate:"required"`
+	Acknowledgements [][32]byte `json:"acknowledgements,omitempty" form:"acknowledgements" query:"acknowledgements" validate:"required"`
+	Unacknowledged   [][32]byte `json:"unacknowledged,omitempty" form:"unacknowledged" query:"unacknowledged" validate:"required"`
+}
+
+type SyntheticMigrateAccounts struct {
 	fieldsSet []bool
-	Nonce     uint64     `json:"nonce,omitempty" form:"nonce" query:"nonce" validate:"required"`
-	Produced  [][32]byte `json:"produced,omitempty" form:"produced" query:"produced" validate:"required"`
-	Unsigned  [][32]byte `json:"unsigned,omitempty" form:"unsigned" query:"unsigned" validate:"required"`
-	Unsent    [][32]byte `json:"unsent,omitempty" form:"unsent" query:"unsent" validate:"required"`
+	Cause     [32]byte         `json:"cause,omitempty" form:"cause" query:"cause" validate:"required"`
+	Source    *url.URL         `json:"source,omitempty" form:"source" query:"source" validate:"required"`
+	Accounts  []AnchoredRecord `json:"accounts,omitempty" form:"accounts" query:"accounts" validate:"required"`
 }
 
 type SyntheticMirror struct {
@@ -413,6 +537,12 @@ type SyntheticMirror struct {
 	Objects   []AnchoredRecord `json:"objects,omitempty" form:"objects" query:"objects" validate:"required"`
 }
 
+type SyntheticReturnAccounts struct {
+	fieldsSet []bool
+	Cause     [32]byte   `json:"cause,omitempty" form:"cause" query:"cause" validate:"required"`
+	Accounts  []*url.URL `json:"accounts,omitempty" form:"accounts" query:"accounts" validate:"required"`
+}
+
 type SyntheticWriteData struct {
 	fieldsSet []bool
 	Cause     [32]byte  `json:"cause,omitempty" form:"cause" query:"cause" validate:"required"`
@@ -435,6 +565,8 @@ type TokenIssuer struct {
 	Properties     *url.URL `json:"properties,omitempty" form:"properties" query:"properties" validate:"required"`
 	Supply         big.Int  `json:"supply,omitempty" form:"supply" query:"supply"`
 	HasSupplyLimit bool     `json:"hasSupplyLimit,omitempty" form:"hasSupplyLimit" query:"hasSupplyLimit"`
+	Issued         big.Int  `json:"issued,omitempty" form:"issued" query:"issued"`
+	SupplyVersion  uint64   `json:"supplyVersion,omitempty" form:"supplyVersion" query:"supplyVersion"`
 }
 
 type TokenRecipient struct {
@@ -456,6 +588,7 @@ type TransactionHeader struct {
 	KeyPageHeight uint64   `json:"keyPageHeight,omitempty" form:"keyPageHeight" query:"keyPageHeight" validate:"required"`
 	KeyPageIndex  uint64   `json:"keyPageIndex,omitempty" form:"keyPageIndex" query:"keyPageIndex" validate:"required"`
 	Nonce         uint64   `json:"nonce,omitempty" form:"nonce" query:"nonce" validate:"required"`
+	Expiry        uint64   `json:"expiry,omitempty" form:"expiry" query:"expiry"`
 }
 
 type TransactionSignature struct {
@@ -478,6 +611,7 @@ type TransactionStatus struct {
 	Code      uint64            `json:"code,omitempty" form:"code" query:"code" validate:"required"`
 	Message   string            `json:"message,omitempty" form:"message" query:"message" validate:"required"`
 	Result    TransactionResult `json:"result,omitempty" form:"result" query:"result"`
+	Expiry    uint64            `json:"expiry,omitempty" form:"expiry" query:"expiry"`
 }
 
 type TxState struct {
@@ -488,12 +622,16 @@ type TxState struct {
 }
 
 type UpdateKeyPage struct {
-	fieldsSet []bool
-	Operation KeyPageOperation `json:"operation,omitempty" form:"operation" query:"operation" validate:"required"`
-	Key       []byte           `json:"key,omitempty" form:"key" query:"key"`
-	NewKey    []byte           `json:"newKey,omitempty" form:"newKey" query:"newKey"`
-	Owner     *url.URL         `json:"owner,omitempty" form:"owner" query:"owner"`
-	Threshold uint64           `json:"threshold,omitempty" form:"threshold" query:"threshold"`
+	fieldsSet   []bool
+	Operation   KeyPageOperation `json:"operation,omitempty" form:"operation" query:"operation" validate:"required"`
+	Key         []byte           `json:"key,omitempty" form:"key" query:"key"`
+	NewKey      []byte           `json:"newKey,omitempty" form:"newKey" query:"newKey"`
+	Owner       *url.URL         `json:"owner,omitempty" form:"owner" query:"owner"`
+	Threshold   uint64           `json:"threshold,omitempty" form:"threshold" query:"threshold"`
+	NewKeyType  SignatureType    `json:"newKeyType,omitempty" form:"newKeyType" query:"newKeyType"`
+	Delay       uint64           `json:"delay,omitempty" form:"delay" query:"delay"`
+	MinDelay    uint64           `json:"minDelay,omitempty" form:"minDelay" query:"minDelay"`
+	PendingTxid [32]byte         `json:"pendingTxid,omitempty" form:"pendingTxid" query:"pendingTxid"`
 }
 
 type UpdateManager struct {
@@ -586,10 +724,22 @@ func (*AddCredits) Type() TransactionType { return TransactionTypeAddCredits }
 
 func (*AddCredits) GetType() TransactionType { return TransactionTypeAddCredits }
 
+func (*AddCreditsResult) Type() TransactionType { return TransactionTypeAddCredits }
+
+func (*AddCreditsResult) GetType() TransactionType { return TransactionTypeAddCredits }
+
 func (*Anchor) Type() AccountType { return AccountTypeAnchor }
 
 func (*Anchor) GetType() AccountType { return AccountTypeAnchor }
 
+func (*Batch) Type() TransactionType { return TransactionTypeBatch }
+
+func (*Batch) GetType() TransactionType { return TransactionTypeBatch }
+
+func (*BatchResult) Type() TransactionType { return TransactionTypeBatch }
+
+func (*BatchResult) GetType() TransactionType { return TransactionTypeBatch }
+
 func (*BurnTokens) Type() TransactionType { return TransactionTypeBurnTokens }
 
 func (*BurnTokens) GetType() TransactionType { return TransactionTypeBurnTokens }
@@ -636,6 +786,12 @@ func (*InternalLedger) Type() AccountType { return AccountTypeInternalLedger }
 
 func (*InternalLedger) GetType() AccountType { return AccountTypeInternalLedger }
 
+func (*InternalMigrateAccounts) Type() TransactionType { return TransactionTypeInternalMigrateAccounts }
+
+func (*InternalMigrateAccounts) GetType() TransactionType {
+	return TransactionTypeInternalMigrateAccounts
+}
+
 func (*InternalSendTransactions) Type() TransactionType {
 	return TransactionTypeInternalSendTransactions
 }
@@ -694,6 +850,8 @@ func (*RemoveManager) Type() TransactionType { return TransactionTypeRemoveManag
 
 func (*RemoveManager) GetType() TransactionType { return TransactionTypeRemoveManager }
 
+func (*SECP256K1Signature) Type() SignatureType { return SignatureTypeSECP256K1 }
+
 func (*SegWitDataEntry) Type() TransactionType { return TransactionTypeSegWitDataEntry }
 
 func (*SegWitDataEntry) GetType() TransactionType { return TransactionTypeSegWitDataEntry }
@@ -730,10 +888,24 @@ func (*SyntheticDepositTokens) GetType() TransactionType {
 	return TransactionTypeSyntheticDepositTokens
 }
 
+func (*SyntheticMigrateAccounts) Type() TransactionType {
+	return TransactionTypeSyntheticMigrateAccounts
+}
+
+func (*SyntheticMigrateAccounts) GetType() TransactionType {
+	return TransactionTypeSyntheticMigrateAccounts
+}
+
 func (*SyntheticMirror) Type() TransactionType { return TransactionTypeSyntheticMirror }
 
 func (*SyntheticMirror) GetType() TransactionType { return TransactionTypeSyntheticMirror }
 
+func (*SyntheticReturnAccounts) Type() TransactionType { return TransactionTypeSyntheticReturnAccounts }
+
+func (*SyntheticReturnAccounts) GetType() TransactionType {
+	return TransactionTypeSyntheticReturnAccounts
+}
+
 func (*SyntheticWriteData) Type() TransactionType { return TransactionTypeSyntheticWriteData }
 
 func (*SyntheticWriteData) GetType() TransactionType { return TransactionTypeSyntheticWriteData }
@@ -815,6 +987,23 @@ func (v *AddCredits) Equal(u *AddCredits) bool {
 	if !(v.Amount == u.Amount) {
 		return false
 	}
+	if !((&v.AcmeAmount).Cmp(&u.AcmeAmount) == 0) {
+		return false
+	}
+
+	return true
+}
+
+func (v *AddCreditsResult) Equal(u *AddCreditsResult) bool {
+	if !((&v.Amount).Cmp(&u.Amount) == 0) {
+		return false
+	}
+	if !(v.Credits == u.Credits) {
+		return false
+	}
+	if !(v.Oracle == u.Oracle) {
+		return false
+	}
 
 	return true
 }
@@ -924,6 +1113,9 @@ func (v *CreateIdentity) Equal(u *CreateIdentity) bool {
 	if !((v.Manager).Equal(u.Manager)) {
 		return false
 	}
+	if !(v.KeyType == u.KeyType) {
+		return false
+	}
 
 	return true
 }
@@ -1109,6 +1301,47 @@ func (v *Envelope) Equal(u *Envelope) bool {
 	return true
 }
 
+func (v *FaucetPolicy) Equal(u *FaucetPolicy) bool {
+	if !(v.Amount == u.Amount) {
+		return false
+	}
+	if !(v.Cooldown == u.Cooldown) {
+		return false
+	}
+	if !(v.DailyLimit == u.DailyLimit) {
+		return false
+	}
+	if !(v.BlockLimit == u.BlockLimit) {
+		return false
+	}
+
+	return true
+}
+
+func (v *FeeSchedule) Equal(u *FeeSchedule) bool {
+	if len(v.Fees) != len(u.Fees) {
+		return false
+	}
+	for i := range v.Fees {
+		if !((&v.Fees[i]).Equal(&u.Fees[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *FeeScheduleEntry) Equal(u *FeeScheduleEntry) bool {
+	if !(v.Type == u.Type) {
+		return false
+	}
+	if !(v.Fee == u.Fee) {
+		return false
+	}
+
+	return true
+}
+
 func (v *InternalGenesis) Equal(u *InternalGenesis) bool {
 
 	return true
@@ -1133,6 +1366,14 @@ func (v *InternalLedger) Equal(u *InternalLedger) bool {
 	if !(v.ActiveOracle == u.ActiveOracle) {
 		return false
 	}
+	if len(v.OracleSources) != len(u.OracleSources) {
+		return false
+	}
+	for i := range v.OracleSources {
+		if !((v.OracleSources[i]).Equal(u.OracleSources[i])) {
+			return false
+		}
+	}
 	if len(v.Updates) != len(u.Updates) {
 		return false
 	}
@@ -1141,6 +1382,40 @@ func (v *InternalLedger) Equal(u *InternalLedger) bool {
 			return false
 		}
 	}
+	if !((v.PendingFeeSchedule).Equal(u.PendingFeeSchedule)) {
+		return false
+	}
+	if !((v.ActiveFeeSchedule).Equal(u.ActiveFeeSchedule)) {
+		return false
+	}
+	if !((v.PendingRoutingTable).Equal(u.PendingRoutingTable)) {
+		return false
+	}
+	if !((v.ActiveRoutingTable).Equal(u.ActiveRoutingTable)) {
+		return false
+	}
+	if !(v.RoutingMigration == u.RoutingMigration) {
+		return false
+	}
+	if !((v.PendingGlobals).Equal(u.PendingGlobals)) {
+		return false
+	}
+	if !((v.ActiveGlobals).Equal(u.ActiveGlobals)) {
+		return false
+	}
+
+	return true
+}
+
+func (v *InternalMigrateAccounts) Equal(u *InternalMigrateAccounts) bool {
+	if len(v.Accounts) != len(u.Accounts) {
+		return false
+	}
+	for i := range v.Accounts {
+		if !((v.Accounts[i]).Equal(u.Accounts[i])) {
+			return false
+		}
+	}
 
 	return true
 }
@@ -1216,6 +1491,23 @@ func (v *KeyPage) Equal(u *KeyPage) bool {
 			return false
 		}
 	}
+	if !(v.Frozen == u.Frozen) {
+		return false
+	}
+	if !(v.FrozenBySingleSignature == u.FrozenBySingleSignature) {
+		return false
+	}
+	if !(v.MinDelay == u.MinDelay) {
+		return false
+	}
+	if len(v.PendingOperations) != len(u.PendingOperations) {
+		return false
+	}
+	for i := range v.PendingOperations {
+		if !((v.PendingOperations[i]).Equal(u.PendingOperations[i])) {
+			return false
+		}
+	}
 
 	return true
 }
@@ -1230,6 +1522,9 @@ func (v *KeySpec) Equal(u *KeySpec) bool {
 	if !((v.Owner).Equal(u.Owner)) {
 		return false
 	}
+	if !(v.KeyType == u.KeyType) {
+		return false
+	}
 
 	return true
 }
@@ -1238,6 +1533,9 @@ func (v *KeySpecParams) Equal(u *KeySpecParams) bool {
 	if !(bytes.Equal(v.PublicKey, u.PublicKey)) {
 		return false
 	}
+	if !(v.KeyType == u.KeyType) {
+		return false
+	}
 
 	return true
 }
@@ -1306,6 +1604,17 @@ func (v *MetricsRequest) Equal(u *MetricsRequest) bool {
 	return true
 }
 
+func (v *NetworkGlobals) Equal(u *NetworkGlobals) bool {
+	if !(v.PendingExpiry == u.PendingExpiry) {
+		return false
+	}
+	if !((v.Faucet).Equal(u.Faucet)) {
+		return false
+	}
+
+	return true
+}
+
 func (v *Object) Equal(u *Object) bool {
 	if !(bytes.Equal(v.Entry, u.Entry)) {
 		return false
@@ -1341,6 +1650,53 @@ func (v *ObjectMetadata) Equal(u *ObjectMetadata) bool {
 	return true
 }
 
+func (v *OraclePolicy) Equal(u *OraclePolicy) bool {
+	if len(v.Feeds) != len(u.Feeds) {
+		return false
+	}
+	for i := range v.Feeds {
+		if !((v.Feeds[i]).Equal(u.Feeds[i])) {
+			return false
+		}
+	}
+	if !(v.Window == u.Window) {
+		return false
+	}
+	if !(v.MaxDeviation == u.MaxDeviation) {
+		return false
+	}
+
+	return true
+}
+
+func (v *OracleSubmission) Equal(u *OracleSubmission) bool {
+	if !((v.Feed).Equal(u.Feed)) {
+		return false
+	}
+	if !(v.Price == u.Price) {
+		return false
+	}
+	if !(v.Time == u.Time) {
+		return false
+	}
+
+	return true
+}
+
+func (v *PendingKeyPageOperation) Equal(u *PendingKeyPageOperation) bool {
+	if !(v.Txid == u.Txid) {
+		return false
+	}
+	if !(v.EffectiveAt == u.EffectiveAt) {
+		return false
+	}
+	if !((v.Operation).Equal(u.Operation)) {
+		return false
+	}
+
+	return true
+}
+
 func (v *PendingTransactionState) Equal(u *PendingTransactionState) bool {
 	if !v.AccountHeader.Equal(&u.AccountHeader) {
 		return false
@@ -1450,6 +1806,44 @@ func (v *ResponseDataEntrySet) Equal(u *ResponseDataEntrySet) bool {
 	return true
 }
 
+func (v *Route) Equal(u *Route) bool {
+	if !(v.Length == u.Length) {
+		return false
+	}
+	if !(v.Value == u.Value) {
+		return false
+	}
+	if !(v.Subnet == u.Subnet) {
+		return false
+	}
+
+	return true
+}
+
+func (v *RoutingTable) Equal(u *RoutingTable) bool {
+	if len(v.Routes) != len(u.Routes) {
+		return false
+	}
+	for i := range v.Routes {
+		if !((&v.Routes[i]).Equal(&u.Routes[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *SECP256K1Signature) Equal(u *SECP256K1Signature) bool {
+	if !(bytes.Equal(v.PublicKey, u.PublicKey)) {
+		return false
+	}
+	if !(bytes.Equal(v.Signature, u.Signature)) {
+		return false
+	}
+
+	return true
+}
+
 func (v *SegWitDataEntry) Equal(u *SegWitDataEntry) bool {
 	if !(v.Cause == u.Cause) {
 		return false
@@ -1516,6 +1910,31 @@ func (v *SyntheticAnchor) Equal(u *SyntheticAnchor) bool {
 	if !((&v.Receipt).Equal(&u.Receipt)) {
 		return false
 	}
+	if !((v.FeeSchedule).Equal(u.FeeSchedule)) {
+		return false
+	}
+	if !((v.RoutingTable).Equal(u.RoutingTable)) {
+		return false
+	}
+	if !((v.Globals).Equal(u.Globals)) {
+		return false
+	}
+	if len(v.Acknowledgements) != len(u.Acknowledgements) {
+		return false
+	}
+	for i := range v.Acknowledgements {
+		if !(v.Acknowledgements[i] == u.Acknowledgements[i]) {
+			return false
+		}
+	}
+	if len(v.Unacknowledged) != len(u.Unacknowledged) {
+		return false
+	}
+	for i := range v.Unacknowledged {
+		if !(v.Unacknowledged[i] == u.Unacknowledged[i]) {
+			return false
+		}
+	}
 
 	return true
 }
@@ -1600,12 +2019,47 @@ func (v *SyntheticLedger) Equal(u *SyntheticLedger) bool {
 			return false
 		}
 	}
-
-	return true
-}
-
-func (v *SyntheticMirror) Equal(u *SyntheticMirror) bool {
-	if len(v.Objects) != len(u.Objects) {
+	if len(v.Acknowledgements) != len(u.Acknowledgements) {
+		return false
+	}
+	for i := range v.Acknowledgements {
+		if !(v.Acknowledgements[i] == u.Acknowledgements[i]) {
+			return false
+		}
+	}
+	if len(v.Unacknowledged) != len(u.Unacknowledged) {
+		return false
+	}
+	for i := range v.Unacknowledged {
+		if !(v.Unacknowledged[i] == u.Unacknowledged[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *SyntheticMigrateAccounts) Equal(u *SyntheticMigrateAccounts) bool {
+	if !(v.Cause == u.Cause) {
+		return false
+	}
+	if !((v.Source).Equal(u.Source)) {
+		return false
+	}
+	if len(v.Accounts) != len(u.Accounts) {
+		return false
+	}
+	for i := range v.Accounts {
+		if !((&v.Accounts[i]).Equal(&u.Accounts[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *SyntheticMirror) Equal(u *SyntheticMirror) bool {
+	if len(v.Objects) != len(u.Objects) {
 		return false
 	}
 	for i := range v.Objects {
@@ -1617,6 +2071,22 @@ func (v *SyntheticMirror) Equal(u *SyntheticMirror) bool {
 	return true
 }
 
+func (v *SyntheticReturnAccounts) Equal(u *SyntheticReturnAccounts) bool {
+	if !(v.Cause == u.Cause) {
+		return false
+	}
+	if len(v.Accounts) != len(u.Accounts) {
+		return false
+	}
+	for i := range v.Accounts {
+		if !((v.Accounts[i]).Equal(u.Accounts[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (v *SyntheticWriteData) Equal(u *SyntheticWriteData) bool {
 	if !(v.Cause == u.Cause) {
 		return false
@@ -1664,6 +2134,12 @@ func (v *TokenIssuer) Equal(u *TokenIssuer) bool {
 	if !(v.HasSupplyLimit == u.HasSupplyLimit) {
 		return false
 	}
+	if !((&v.Issued).Cmp(&u.Issued) == 0) {
+		return false
+	}
+	if !(v.SupplyVersion == u.SupplyVersion) {
+		return false
+	}
 
 	return true
 }
@@ -1703,6 +2179,9 @@ func (v *TransactionHeader) Equal(u *TransactionHeader) bool {
 	if !(v.Nonce == u.Nonce) {
 		return false
 	}
+	if !(v.Expiry == u.Expiry) {
+		return false
+	}
 
 	return true
 }
@@ -1748,6 +2227,9 @@ func (v *TransactionStatus) Equal(u *TransactionStatus) bool {
 	if !(v.Result == u.Result) {
 		return false
 	}
+	if !(v.Expiry == u.Expiry) {
+		return false
+	}
 
 	return true
 }
@@ -1779,6 +2261,18 @@ func (v *UpdateKeyPage) Equal(u *UpdateKeyPage) bool {
 	if !(v.Threshold == u.Threshold) {
 		return false
 	}
+	if !(v.NewKeyType == u.NewKeyType) {
+		return false
+	}
+	if !(v.Delay == u.Delay) {
+		return false
+	}
+	if !(v.MinDelay == u.MinDelay) {
+		return false
+	}
+	if !(v.PendingTxid == u.PendingTxid) {
+		return false
+	}
 
 	return true
 }
@@ -1986,6 +2480,7 @@ var fieldNames_AddCredits = []string{
 	1: "Type",
 	2: "Recipient",
 	3: "Amount",
+	4: "AcmeAmount",
 }
 
 func (v *AddCredits) MarshalBinary() ([]byte, error) {
@@ -1999,6 +2494,9 @@ func (v *AddCredits) MarshalBinary() ([]byte, error) {
 	if !(v.Amount == 0) {
 		writer.WriteUint(3, v.Amount)
 	}
+	if !((v.AcmeAmount).Cmp(new(big.Int)) == 0) {
+		writer.WriteBigInt(4, &v.AcmeAmount)
+	}
 
 	_, _, err := writer.Reset(fieldNames_AddCredits)
 	return buffer.Bytes(), err
@@ -2012,11 +2510,61 @@ func (v *AddCredits) IsValid() error {
 	} else if v.Recipient == nil {
 		errs = append(errs, "field Recipient is not set")
 	}
-	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_AddCreditsResult = []string{
+	1: "Type",
+	2: "Amount",
+	3: "Credits",
+	4: "Oracle",
+}
+
+func (v *AddCreditsResult) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	writer.WriteUint(1, TransactionTypeAddCredits.ID())
+	if !((v.Amount).Cmp(new(big.Int)) == 0) {
+		writer.WriteBigInt(2, &v.Amount)
+	}
+	if !(v.Credits == 0) {
+		writer.WriteUint(3, v.Credits)
+	}
+	if !(v.Oracle == 0) {
+		writer.WriteUint(4, v.Oracle)
+	}
+
+	_, _, err := writer.Reset(fieldNames_AddCreditsResult)
+	return buffer.Bytes(), err
+}
+
+func (v *AddCreditsResult) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
 		errs = append(errs, "field Amount is missing")
-	} else if v.Amount == 0 {
+	} else if (v.Amount).Cmp(new(big.Int)) == 0 {
 		errs = append(errs, "field Amount is not set")
 	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Credits is missing")
+	} else if v.Credits == 0 {
+		errs = append(errs, "field Credits is not set")
+	}
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field Oracle is missing")
+	} else if v.Oracle == 0 {
+		errs = append(errs, "field Oracle is not set")
+	}
 
 	switch len(errs) {
 	case 0:
@@ -2181,6 +2729,131 @@ func (v *AnchoredRecord) IsValid() error {
 	}
 }
 
+var fieldNames_Batch = []string{
+	1: "Type",
+	2: "Operations",
+}
+
+func (v *Batch) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	writer.WriteUint(1, TransactionTypeBatch.ID())
+	if !(len(v.Operations) == 0) {
+		for _, v := range v.Operations {
+			writer.WriteValue(2, v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_Batch)
+	return buffer.Bytes(), err
+}
+
+func (v *Batch) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Operations is missing")
+	} else if len(v.Operations) == 0 {
+		errs = append(errs, "field Operations is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_BatchOperation = []string{
+	1: "Origin",
+	2: "KeyPageIndex",
+	3: "KeyPageHeight",
+	4: "Body",
+}
+
+func (v *BatchOperation) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Origin == nil) {
+		writer.WriteUrl(1, v.Origin)
+	}
+	if !(v.KeyPageIndex == 0) {
+		writer.WriteUint(2, v.KeyPageIndex)
+	}
+	if !(v.KeyPageHeight == 0) {
+		writer.WriteUint(3, v.KeyPageHeight)
+	}
+	if !(v.Body == (nil)) {
+		writer.WriteValue(4, v.Body)
+	}
+
+	_, _, err := writer.Reset(fieldNames_BatchOperation)
+	return buffer.Bytes(), err
+}
+
+func (v *BatchOperation) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field Body is missing")
+	} else if v.Body == (nil) {
+		errs = append(errs, "field Body is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_BatchResult = []string{
+	1: "Type",
+	2: "Results",
+}
+
+func (v *BatchResult) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	writer.WriteUint(1, TransactionTypeBatch.ID())
+	if !(len(v.Results) == 0) {
+		for _, v := range v.Results {
+			writer.WriteValue(2, v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_BatchResult)
+	return buffer.Bytes(), err
+}
+
+func (v *BatchResult) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Results is missing")
+	} else if len(v.Results) == 0 {
+		errs = append(errs, "field Results is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
 var fieldNames_BurnTokens = []string{
 	1: "Type",
 	2: "Amount",
@@ -2362,6 +3035,7 @@ var fieldNames_CreateIdentity = []string{
 	4: "KeyBookName",
 	5: "KeyPageName",
 	6: "Manager",
+	7: "KeyType",
 }
 
 func (v *CreateIdentity) MarshalBinary() ([]byte, error) {
@@ -2384,6 +3058,9 @@ func (v *CreateIdentity) MarshalBinary() ([]byte, error) {
 	if !(v.Manager == nil) {
 		writer.WriteUrl(6, v.Manager)
 	}
+	if !(v.KeyType == 0) {
+		writer.WriteEnum(7, v.KeyType)
+	}
 
 	_, _, err := writer.Reset(fieldNames_CreateIdentity)
 	return buffer.Bytes(), err
@@ -2920,23 +3597,58 @@ func (v *Envelope) IsValid() error {
 	}
 }
 
-var fieldNames_InternalGenesis = []string{
-	1: "Type",
+var fieldNames_FaucetPolicy = []string{
+	1: "Amount",
+	2: "Cooldown",
+	3: "DailyLimit",
+	4: "BlockLimit",
 }
 
-func (v *InternalGenesis) MarshalBinary() ([]byte, error) {
+func (v *FaucetPolicy) MarshalBinary() ([]byte, error) {
 	buffer := new(bytes.Buffer)
 	writer := encoding.NewWriter(buffer)
 
-	writer.WriteUint(1, TransactionTypeInternalGenesis.ID())
+	if !(v.Amount == 0) {
+		writer.WriteUint(1, v.Amount)
+	}
+	if !(v.Cooldown == 0) {
+		writer.WriteDuration(2, v.Cooldown)
+	}
+	if !(v.DailyLimit == 0) {
+		writer.WriteUint(3, v.DailyLimit)
+	}
+	if !(v.BlockLimit == 0) {
+		writer.WriteUint(4, v.BlockLimit)
+	}
 
-	_, _, err := writer.Reset(fieldNames_InternalGenesis)
+	_, _, err := writer.Reset(fieldNames_FaucetPolicy)
 	return buffer.Bytes(), err
 }
 
-func (v *InternalGenesis) IsValid() error {
+func (v *FaucetPolicy) IsValid() error {
 	var errs []string
 
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Amount is missing")
+	} else if v.Amount == 0 {
+		errs = append(errs, "field Amount is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Cooldown is missing")
+	} else if v.Cooldown == 0 {
+		errs = append(errs, "field Cooldown is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field DailyLimit is missing")
+	} else if v.DailyLimit == 0 {
+		errs = append(errs, "field DailyLimit is not set")
+	}
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field BlockLimit is missing")
+	} else if v.BlockLimit == 0 {
+		errs = append(errs, "field BlockLimit is not set")
+	}
+
 	switch len(errs) {
 	case 0:
 		return nil
@@ -2947,65 +3659,207 @@ func (v *InternalGenesis) IsValid() error {
 	}
 }
 
-var fieldNames_InternalLedger = []string{
-	1: "Type",
-	2: "AccountHeader",
-	3: "Index",
-	4: "Timestamp",
-	5: "Synthetic",
-	6: "PendingOracle",
-	7: "ActiveOracle",
-	8: "Updates",
+var fieldNames_FeeSchedule = []string{
+	1: "Fees",
 }
 
-func (v *InternalLedger) MarshalBinary() ([]byte, error) {
+func (v *FeeSchedule) MarshalBinary() ([]byte, error) {
 	buffer := new(bytes.Buffer)
 	writer := encoding.NewWriter(buffer)
 
-	writer.WriteUint(1, AccountTypeInternalLedger.ID())
-	writer.WriteValue(2, &v.AccountHeader)
-	if !(v.Index == 0) {
-		writer.WriteInt(3, v.Index)
-	}
-	if !(v.Timestamp == (time.Time{})) {
-		writer.WriteTime(4, v.Timestamp)
-	}
-	if !((v.Synthetic).Equal(new(SyntheticLedger))) {
-		writer.WriteValue(5, &v.Synthetic)
-	}
-	if !(v.PendingOracle == 0) {
-		writer.WriteUint(6, v.PendingOracle)
-	}
-	if !(v.ActiveOracle == 0) {
-		writer.WriteUint(7, v.ActiveOracle)
-	}
-	if !(len(v.Updates) == 0) {
-		for _, v := range v.Updates {
-			writer.WriteValue(8, &v)
+	if !(len(v.Fees) == 0) {
+		for _, v := range v.Fees {
+			writer.WriteValue(1, &v)
 		}
 	}
 
-	_, _, err := writer.Reset(fieldNames_InternalLedger)
+	_, _, err := writer.Reset(fieldNames_FeeSchedule)
 	return buffer.Bytes(), err
 }
 
-func (v *InternalLedger) IsValid() error {
+func (v *FeeSchedule) IsValid() error {
 	var errs []string
 
-	if err := v.AccountHeader.IsValid(); err != nil {
-		errs = append(errs, err.Error())
-	}
-	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
-		errs = append(errs, "field Index is missing")
-	} else if v.Index == 0 {
-		errs = append(errs, "field Index is not set")
-	}
-	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
-		errs = append(errs, "field Timestamp is missing")
-	} else if v.Timestamp == (time.Time{}) {
-		errs = append(errs, "field Timestamp is not set")
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Fees is missing")
+	} else if len(v.Fees) == 0 {
+		errs = append(errs, "field Fees is not set")
 	}
-	if len(v.fieldsSet) > 5 && !v.fieldsSet[5] {
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_FeeScheduleEntry = []string{
+	1: "Type",
+	2: "Fee",
+}
+
+func (v *FeeScheduleEntry) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Type == 0) {
+		writer.WriteEnum(1, v.Type)
+	}
+	if !(v.Fee == 0) {
+		writer.WriteUint(2, v.Fee)
+	}
+
+	_, _, err := writer.Reset(fieldNames_FeeScheduleEntry)
+	return buffer.Bytes(), err
+}
+
+func (v *FeeScheduleEntry) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Type is missing")
+	} else if v.Type == 0 {
+		errs = append(errs, "field Type is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Fee is missing")
+	} else if v.Fee == 0 {
+		errs = append(errs, "field Fee is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_InternalGenesis = []string{
+	1: "Type",
+}
+
+func (v *InternalGenesis) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	writer.WriteUint(1, TransactionTypeInternalGenesis.ID())
+
+	_, _, err := writer.Reset(fieldNames_InternalGenesis)
+	return buffer.Bytes(), err
+}
+
+func (v *InternalGenesis) IsValid() error {
+	var errs []string
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_InternalLedger = []string{
+	1:  "Type",
+	2:  "AccountHeader",
+	3:  "Index",
+	4:  "Timestamp",
+	5:  "Synthetic",
+	6:  "PendingOracle",
+	7:  "ActiveOracle",
+	8:  "OracleSources",
+	9:  "Updates",
+	10: "PendingFeeSchedule",
+	11: "ActiveFeeSchedule",
+	12: "PendingRoutingTable",
+	13: "ActiveRoutingTable",
+	14: "RoutingMigration",
+	15: "PendingGlobals",
+	16: "ActiveGlobals",
+}
+
+func (v *InternalLedger) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	writer.WriteUint(1, AccountTypeInternalLedger.ID())
+	writer.WriteValue(2, &v.AccountHeader)
+	if !(v.Index == 0) {
+		writer.WriteInt(3, v.Index)
+	}
+	if !(v.Timestamp == (time.Time{})) {
+		writer.WriteTime(4, v.Timestamp)
+	}
+	if !((v.Synthetic).Equal(new(SyntheticLedger))) {
+		writer.WriteValue(5, &v.Synthetic)
+	}
+	if !(v.PendingOracle == 0) {
+		writer.WriteUint(6, v.PendingOracle)
+	}
+	if !(v.ActiveOracle == 0) {
+		writer.WriteUint(7, v.ActiveOracle)
+	}
+	if !(len(v.OracleSources) == 0) {
+		for _, v := range v.OracleSources {
+			writer.WriteUrl(8, v)
+		}
+	}
+	if !(len(v.Updates) == 0) {
+		for _, v := range v.Updates {
+			writer.WriteValue(9, &v)
+		}
+	}
+	if !(v.PendingFeeSchedule == nil) {
+		writer.WriteValue(10, v.PendingFeeSchedule)
+	}
+	if !(v.ActiveFeeSchedule == nil) {
+		writer.WriteValue(11, v.ActiveFeeSchedule)
+	}
+	if !(v.PendingRoutingTable == nil) {
+		writer.WriteValue(12, v.PendingRoutingTable)
+	}
+	if !(v.ActiveRoutingTable == nil) {
+		writer.WriteValue(13, v.ActiveRoutingTable)
+	}
+	if !(!v.RoutingMigration) {
+		writer.WriteBool(14, v.RoutingMigration)
+	}
+	if !(v.PendingGlobals == nil) {
+		writer.WriteValue(15, v.PendingGlobals)
+	}
+	if !(v.ActiveGlobals == nil) {
+		writer.WriteValue(16, v.ActiveGlobals)
+	}
+
+	_, _, err := writer.Reset(fieldNames_InternalLedger)
+	return buffer.Bytes(), err
+}
+
+func (v *InternalLedger) IsValid() error {
+	var errs []string
+
+	if err := v.AccountHeader.IsValid(); err != nil {
+		errs = append(errs, err.Error())
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Index is missing")
+	} else if v.Index == 0 {
+		errs = append(errs, "field Index is not set")
+	}
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field Timestamp is missing")
+	} else if v.Timestamp == (time.Time{}) {
+		errs = append(errs, "field Timestamp is not set")
+	}
+	if len(v.fieldsSet) > 5 && !v.fieldsSet[5] {
 		errs = append(errs, "field Synthetic is missing")
 	} else if (v.Synthetic).Equal(new(SyntheticLedger)) {
 		errs = append(errs, "field Synthetic is not set")
@@ -3021,10 +3875,59 @@ func (v *InternalLedger) IsValid() error {
 		errs = append(errs, "field ActiveOracle is not set")
 	}
 	if len(v.fieldsSet) > 8 && !v.fieldsSet[8] {
+		errs = append(errs, "field OracleSources is missing")
+	} else if len(v.OracleSources) == 0 {
+		errs = append(errs, "field OracleSources is not set")
+	}
+	if len(v.fieldsSet) > 9 && !v.fieldsSet[9] {
 		errs = append(errs, "field Updates is missing")
 	} else if len(v.Updates) == 0 {
 		errs = append(errs, "field Updates is not set")
 	}
+	if len(v.fieldsSet) > 14 && !v.fieldsSet[14] {
+		errs = append(errs, "field RoutingMigration is missing")
+	} else if !v.RoutingMigration {
+		errs = append(errs, "field RoutingMigration is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_InternalMigrateAccounts = []string{
+	1: "Type",
+	2: "Accounts",
+}
+
+func (v *InternalMigrateAccounts) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	writer.WriteUint(1, TransactionTypeInternalMigrateAccounts.ID())
+	if !(len(v.Accounts) == 0) {
+		for _, v := range v.Accounts {
+			writer.WriteUrl(2, v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_InternalMigrateAccounts)
+	return buffer.Bytes(), err
+}
+
+func (v *InternalMigrateAccounts) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Accounts is missing")
+	} else if len(v.Accounts) == 0 {
+		errs = append(errs, "field Accounts is not set")
+	}
 
 	switch len(errs) {
 	case 0:
@@ -3249,6 +4152,10 @@ var fieldNames_KeyPage = []string{
 	3: "CreditBalance",
 	4: "Threshold",
 	5: "Keys",
+	6: "Frozen",
+	7: "FrozenBySingleSignature",
+	8: "MinDelay",
+	9: "PendingOperations",
 }
 
 func (v *KeyPage) MarshalBinary() ([]byte, error) {
@@ -3268,6 +4175,20 @@ func (v *KeyPage) MarshalBinary() ([]byte, error) {
 			writer.WriteValue(5, v)
 		}
 	}
+	if !(!v.Frozen) {
+		writer.WriteBool(6, v.Frozen)
+	}
+	if !(!v.FrozenBySingleSignature) {
+		writer.WriteBool(7, v.FrozenBySingleSignature)
+	}
+	if !(v.MinDelay == 0) {
+		writer.WriteUint(8, v.MinDelay)
+	}
+	if !(len(v.PendingOperations) == 0) {
+		for _, v := range v.PendingOperations {
+			writer.WriteValue(9, v)
+		}
+	}
 
 	_, _, err := writer.Reset(fieldNames_KeyPage)
 	return buffer.Bytes(), err
@@ -3309,6 +4230,7 @@ var fieldNames_KeySpec = []string{
 	1: "PublicKey",
 	2: "Nonce",
 	3: "Owner",
+	4: "KeyType",
 }
 
 func (v *KeySpec) MarshalBinary() ([]byte, error) {
@@ -3324,6 +4246,9 @@ func (v *KeySpec) MarshalBinary() ([]byte, error) {
 	if !(v.Owner == nil) {
 		writer.WriteUrl(3, v.Owner)
 	}
+	if !(v.KeyType == 0) {
+		writer.WriteEnum(4, v.KeyType)
+	}
 
 	_, _, err := writer.Reset(fieldNames_KeySpec)
 	return buffer.Bytes(), err
@@ -3360,6 +4285,7 @@ func (v *KeySpec) IsValid() error {
 
 var fieldNames_KeySpecParams = []string{
 	1: "PublicKey",
+	2: "KeyType",
 }
 
 func (v *KeySpecParams) MarshalBinary() ([]byte, error) {
@@ -3369,6 +4295,9 @@ func (v *KeySpecParams) MarshalBinary() ([]byte, error) {
 	if !(len(v.PublicKey) == 0) {
 		writer.WriteBytes(1, v.PublicKey)
 	}
+	if !(v.KeyType == 0) {
+		writer.WriteEnum(2, v.KeyType)
+	}
 
 	_, _, err := writer.Reset(fieldNames_KeySpecParams)
 	return buffer.Bytes(), err
@@ -3636,6 +4565,50 @@ func (v *MetricsRequest) IsValid() error {
 	}
 }
 
+var fieldNames_NetworkGlobals = []string{
+	1: "PendingExpiry",
+	2: "Faucet",
+}
+
+func (v *NetworkGlobals) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.PendingExpiry == 0) {
+		writer.WriteUint(1, v.PendingExpiry)
+	}
+	if !(v.Faucet == nil) {
+		writer.WriteValue(2, v.Faucet)
+	}
+
+	_, _, err := writer.Reset(fieldNames_NetworkGlobals)
+	return buffer.Bytes(), err
+}
+
+func (v *NetworkGlobals) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field PendingExpiry is missing")
+	} else if v.PendingExpiry == 0 {
+		errs = append(errs, "field PendingExpiry is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Faucet is missing")
+	} else if v.Faucet == nil {
+		errs = append(errs, "field Faucet is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
 var fieldNames_Object = []string{
 	1: "Entry",
 	2: "Height",
@@ -3737,6 +4710,167 @@ func (v *ObjectMetadata) IsValid() error {
 	}
 }
 
+var fieldNames_OraclePolicy = []string{
+	1: "Feeds",
+	2: "Window",
+	3: "MaxDeviation",
+}
+
+func (v *OraclePolicy) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(len(v.Feeds) == 0) {
+		for _, v := range v.Feeds {
+			writer.WriteUrl(1, v)
+		}
+	}
+	if !(v.Window == 0) {
+		writer.WriteUint(2, v.Window)
+	}
+	if !(v.MaxDeviation == 0) {
+		writer.WriteUint(3, v.MaxDeviation)
+	}
+
+	_, _, err := writer.Reset(fieldNames_OraclePolicy)
+	return buffer.Bytes(), err
+}
+
+func (v *OraclePolicy) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Feeds is missing")
+	} else if len(v.Feeds) == 0 {
+		errs = append(errs, "field Feeds is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Window is missing")
+	} else if v.Window == 0 {
+		errs = append(errs, "field Window is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field MaxDeviation is missing")
+	} else if v.MaxDeviation == 0 {
+		errs = append(errs, "field MaxDeviation is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_OracleSubmission = []string{
+	1: "Feed",
+	2: "Price",
+	3: "Time",
+}
+
+func (v *OracleSubmission) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Feed == nil) {
+		writer.WriteUrl(1, v.Feed)
+	}
+	if !(v.Price == 0) {
+		writer.WriteUint(2, v.Price)
+	}
+	if !(v.Time == (time.Time{})) {
+		writer.WriteTime(3, v.Time)
+	}
+
+	_, _, err := writer.Reset(fieldNames_OracleSubmission)
+	return buffer.Bytes(), err
+}
+
+func (v *OracleSubmission) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Feed is missing")
+	} else if v.Feed == nil {
+		errs = append(errs, "field Feed is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Price is missing")
+	} else if v.Price == 0 {
+		errs = append(errs, "field Price is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Time is missing")
+	} else if v.Time == (time.Time{}) {
+		errs = append(errs, "field Time is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_PendingKeyPageOperation = []string{
+	1: "Txid",
+	2: "EffectiveAt",
+	3: "Operation",
+}
+
+func (v *PendingKeyPageOperation) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Txid == ([32]byte{})) {
+		writer.WriteHash(1, &v.Txid)
+	}
+	if !(v.EffectiveAt == 0) {
+		writer.WriteUint(2, v.EffectiveAt)
+	}
+	if !(v.Operation == nil) {
+		writer.WriteValue(3, v.Operation)
+	}
+
+	_, _, err := writer.Reset(fieldNames_PendingKeyPageOperation)
+	return buffer.Bytes(), err
+}
+
+func (v *PendingKeyPageOperation) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Txid is missing")
+	} else if v.Txid == ([32]byte{}) {
+		errs = append(errs, "field Txid is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field EffectiveAt is missing")
+	} else if v.EffectiveAt == 0 {
+		errs = append(errs, "field EffectiveAt is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Operation is missing")
+	} else if v.Operation == nil {
+		errs = append(errs, "field Operation is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
 var fieldNames_PendingTransactionState = []string{
 	1: "Type",
 	2: "AccountHeader",
@@ -4102,6 +5236,142 @@ func (v *ResponseDataEntrySet) IsValid() error {
 	}
 }
 
+var fieldNames_Route = []string{
+	1: "Length",
+	2: "Value",
+	3: "Subnet",
+}
+
+func (v *Route) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Length == 0) {
+		writer.WriteUint(1, v.Length)
+	}
+	if !(v.Value == 0) {
+		writer.WriteUint(2, v.Value)
+	}
+	if !(len(v.Subnet) == 0) {
+		writer.WriteString(3, v.Subnet)
+	}
+
+	_, _, err := writer.Reset(fieldNames_Route)
+	return buffer.Bytes(), err
+}
+
+func (v *Route) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Length is missing")
+	} else if v.Length == 0 {
+		errs = append(errs, "field Length is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Value is missing")
+	} else if v.Value == 0 {
+		errs = append(errs, "field Value is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Subnet is missing")
+	} else if len(v.Subnet) == 0 {
+		errs = append(errs, "field Subnet is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_RoutingTable = []string{
+	1: "Routes",
+}
+
+func (v *RoutingTable) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(len(v.Routes) == 0) {
+		for _, v := range v.Routes {
+			writer.WriteValue(1, &v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_RoutingTable)
+	return buffer.Bytes(), err
+}
+
+func (v *RoutingTable) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Routes is missing")
+	} else if len(v.Routes) == 0 {
+		errs = append(errs, "field Routes is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_SECP256K1Signature = []string{
+	1: "Type",
+	2: "PublicKey",
+	3: "Signature",
+}
+
+func (v *SECP256K1Signature) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	writer.WriteUint(1, SignatureTypeSECP256K1.ID())
+	if !(len(v.PublicKey) == 0) {
+		writer.WriteBytes(2, v.PublicKey)
+	}
+	if !(len(v.Signature) == 0) {
+		writer.WriteBytes(3, v.Signature)
+	}
+
+	_, _, err := writer.Reset(fieldNames_SECP256K1Signature)
+	return buffer.Bytes(), err
+}
+
+func (v *SECP256K1Signature) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field PublicKey is missing")
+	} else if len(v.PublicKey) == 0 {
+		errs = append(errs, "field PublicKey is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Signature is missing")
+	} else if len(v.Signature) == 0 {
+		errs = append(errs, "field Signature is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
 var fieldNames_SegWitDataEntry = []string{
 	1: "Type",
 	2: "Cause",
@@ -4286,6 +5556,11 @@ var fieldNames_SyntheticAnchor = []string{
 	8:  "SourceBlock",
 	9:  "AcmeOraclePrice",
 	10: "Receipt",
+	11: "FeeSchedule",
+	12: "RoutingTable",
+	13: "Globals",
+	14: "Acknowledgements",
+	15: "Unacknowledged",
 }
 
 func (v *SyntheticAnchor) MarshalBinary() ([]byte, error) {
@@ -4320,6 +5595,25 @@ func (v *SyntheticAnchor) MarshalBinary() ([]byte, error) {
 	if !((v.Receipt).Equal(new(Receipt))) {
 		writer.WriteValue(10, &v.Receipt)
 	}
+	if !(v.FeeSchedule == nil) {
+		writer.WriteValue(11, v.FeeSchedule)
+	}
+	if !(v.RoutingTable == nil) {
+		writer.WriteValue(12, v.RoutingTable)
+	}
+	if !(v.Globals == nil) {
+		writer.WriteValue(13, v.Globals)
+	}
+	if !(len(v.Acknowledgements) == 0) {
+		for _, v := range v.Acknowledgements {
+			writer.WriteHash(14, &v)
+		}
+	}
+	if !(len(v.Unacknowledged) == 0) {
+		for _, v := range v.Unacknowledged {
+			writer.WriteHash(15, &v)
+		}
+	}
 
 	_, _, err := writer.Reset(fieldNames_SyntheticAnchor)
 	return buffer.Bytes(), err
@@ -4584,6 +5878,8 @@ var fieldNames_SyntheticLedger = []string{
 	2: "Produced",
 	3: "Unsigned",
 	4: "Unsent",
+	5: "Acknowledgements",
+	6: "Unacknowledged",
 }
 
 func (v *SyntheticLedger) MarshalBinary() ([]byte, error) {
@@ -4598,43 +5894,120 @@ func (v *SyntheticLedger) MarshalBinary() ([]byte, error) {
 			writer.WriteHash(2, &v)
 		}
 	}
-	if !(len(v.Unsigned) == 0) {
-		for _, v := range v.Unsigned {
-			writer.WriteHash(3, &v)
-		}
+	if !(len(v.Unsigned) == 0) {
+		for _, v := range v.Unsigned {
+			writer.WriteHash(3, &v)
+		}
+	}
+	if !(len(v.Unsent) == 0) {
+		for _, v := range v.Unsent {
+			writer.WriteHash(4, &v)
+		}
+	}
+	if !(len(v.Acknowledgements) == 0) {
+		for _, v := range v.Acknowledgements {
+			writer.WriteHash(5, &v)
+		}
+	}
+	if !(len(v.Unacknowledged) == 0) {
+		for _, v := range v.Unacknowledged {
+			writer.WriteHash(6, &v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_SyntheticLedger)
+	return buffer.Bytes(), err
+}
+
+func (v *SyntheticLedger) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Nonce is missing")
+	} else if v.Nonce == 0 {
+		errs = append(errs, "field Nonce is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Produced is missing")
+	} else if len(v.Produced) == 0 {
+		errs = append(errs, "field Produced is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Unsigned is missing")
+	} else if len(v.Unsigned) == 0 {
+		errs = append(errs, "field Unsigned is not set")
+	}
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field Unsent is missing")
+	} else if len(v.Unsent) == 0 {
+		errs = append(errs, "field Unsent is not set")
+	}
+	if len(v.fieldsSet) > 5 && !v.fieldsSet[5] {
+		errs = append(errs, "field Acknowledgements is missing")
+	} else if len(v.Acknowledgements) == 0 {
+		errs = append(errs, "field Acknowledgements is not set")
+	}
+	if len(v.fieldsSet) > 6 && !v.fieldsSet[6] {
+		errs = append(errs, "field Unacknowledged is missing")
+	} else if len(v.Unacknowledged) == 0 {
+		errs = append(errs, "field Unacknowledged is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_SyntheticMigrateAccounts = []string{
+	1: "Type",
+	2: "Cause",
+	3: "Source",
+	4: "Accounts",
+}
+
+func (v *SyntheticMigrateAccounts) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	writer.WriteUint(1, TransactionTypeSyntheticMigrateAccounts.ID())
+	if !(v.Cause == ([32]byte{})) {
+		writer.WriteHash(2, &v.Cause)
+	}
+	if !(v.Source == nil) {
+		writer.WriteUrl(3, v.Source)
 	}
-	if !(len(v.Unsent) == 0) {
-		for _, v := range v.Unsent {
-			writer.WriteHash(4, &v)
+	if !(len(v.Accounts) == 0) {
+		for _, v := range v.Accounts {
+			writer.WriteValue(4, &v)
 		}
 	}
 
-	_, _, err := writer.Reset(fieldNames_SyntheticLedger)
+	_, _, err := writer.Reset(fieldNames_SyntheticMigrateAccounts)
 	return buffer.Bytes(), err
 }
 
-func (v *SyntheticLedger) IsValid() error {
+func (v *SyntheticMigrateAccounts) IsValid() error {
 	var errs []string
 
-	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
-		errs = append(errs, "field Nonce is missing")
-	} else if v.Nonce == 0 {
-		errs = append(errs, "field Nonce is not set")
-	}
 	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
-		errs = append(errs, "field Produced is missing")
-	} else if len(v.Produced) == 0 {
-		errs = append(errs, "field Produced is not set")
+		errs = append(errs, "field Cause is missing")
+	} else if v.Cause == ([32]byte{}) {
+		errs = append(errs, "field Cause is not set")
 	}
 	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
-		errs = append(errs, "field Unsigned is missing")
-	} else if len(v.Unsigned) == 0 {
-		errs = append(errs, "field Unsigned is not set")
+		errs = append(errs, "field Source is missing")
+	} else if v.Source == nil {
+		errs = append(errs, "field Source is not set")
 	}
 	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
-		errs = append(errs, "field Unsent is missing")
-	} else if len(v.Unsent) == 0 {
-		errs = append(errs, "field Unsent is not set")
+		errs = append(errs, "field Accounts is missing")
+	} else if len(v.Accounts) == 0 {
+		errs = append(errs, "field Accounts is not set")
 	}
 
 	switch len(errs) {
@@ -4686,6 +6059,54 @@ func (v *SyntheticMirror) IsValid() error {
 	}
 }
 
+var fieldNames_SyntheticReturnAccounts = []string{
+	1: "Type",
+	2: "Cause",
+	3: "Accounts",
+}
+
+func (v *SyntheticReturnAccounts) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	writer.WriteUint(1, TransactionTypeSyntheticReturnAccounts.ID())
+	if !(v.Cause == ([32]byte{})) {
+		writer.WriteHash(2, &v.Cause)
+	}
+	if !(len(v.Accounts) == 0) {
+		for _, v := range v.Accounts {
+			writer.WriteUrl(3, v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_SyntheticReturnAccounts)
+	return buffer.Bytes(), err
+}
+
+func (v *SyntheticReturnAccounts) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Cause is missing")
+	} else if v.Cause == ([32]byte{}) {
+		errs = append(errs, "field Cause is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Accounts is missing")
+	} else if len(v.Accounts) == 0 {
+		errs = append(errs, "field Accounts is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
 var fieldNames_SyntheticWriteData = []string{
 	1: "Type",
 	2: "Cause",
@@ -4795,6 +6216,8 @@ var fieldNames_TokenIssuer = []string{
 	5: "Properties",
 	6: "Supply",
 	7: "HasSupplyLimit",
+	8: "Issued",
+	9: "SupplyVersion",
 }
 
 func (v *TokenIssuer) MarshalBinary() ([]byte, error) {
@@ -4818,6 +6241,12 @@ func (v *TokenIssuer) MarshalBinary() ([]byte, error) {
 	if !(!v.HasSupplyLimit) {
 		writer.WriteBool(7, v.HasSupplyLimit)
 	}
+	if !((v.Issued).Cmp(new(big.Int)) == 0) {
+		writer.WriteBigInt(8, &v.Issued)
+	}
+	if !(v.SupplyVersion == 0) {
+		writer.WriteUint(9, v.SupplyVersion)
+	}
 
 	_, _, err := writer.Reset(fieldNames_TokenIssuer)
 	return buffer.Bytes(), err
@@ -4944,6 +6373,7 @@ var fieldNames_TransactionHeader = []string{
 	2: "KeyPageHeight",
 	3: "KeyPageIndex",
 	4: "Nonce",
+	5: "Expiry",
 }
 
 func (v *TransactionHeader) MarshalBinary() ([]byte, error) {
@@ -4962,6 +6392,9 @@ func (v *TransactionHeader) MarshalBinary() ([]byte, error) {
 	if !(v.Nonce == 0) {
 		writer.WriteUint(4, v.Nonce)
 	}
+	if !(v.Expiry == 0) {
+		writer.WriteUint(5, v.Expiry)
+	}
 
 	_, _, err := writer.Reset(fieldNames_TransactionHeader)
 	return buffer.Bytes(), err
@@ -5090,6 +6523,7 @@ var fieldNames_TransactionStatus = []string{
 	4: "Code",
 	5: "Message",
 	6: "Result",
+	7: "Expiry",
 }
 
 func (v *TransactionStatus) MarshalBinary() ([]byte, error) {
@@ -5114,6 +6548,9 @@ func (v *TransactionStatus) MarshalBinary() ([]byte, error) {
 	if !(v.Result == (nil)) {
 		writer.WriteValue(6, v.Result)
 	}
+	if !(v.Expiry == 0) {
+		writer.WriteUint(7, v.Expiry)
+	}
 
 	_, _, err := writer.Reset(fieldNames_TransactionStatus)
 	return buffer.Bytes(), err
@@ -5203,12 +6640,16 @@ func (v *TxState) IsValid() error {
 }
 
 var fieldNames_UpdateKeyPage = []string{
-	1: "Type",
-	2: "Operation",
-	3: "Key",
-	4: "NewKey",
-	5: "Owner",
-	6: "Threshold",
+	1:  "Type",
+	2:  "Operation",
+	3:  "Key",
+	4:  "NewKey",
+	5:  "Owner",
+	6:  "Threshold",
+	7:  "NewKeyType",
+	8:  "Delay",
+	9:  "MinDelay",
+	10: "PendingTxid",
 }
 
 func (v *UpdateKeyPage) MarshalBinary() ([]byte, error) {
@@ -5231,6 +6672,18 @@ func (v *UpdateKeyPage) MarshalBinary() ([]byte, error) {
 	if !(v.Threshold == 0) {
 		writer.WriteUint(6, v.Threshold)
 	}
+	if !(v.NewKeyType == 0) {
+		writer.WriteEnum(7, v.NewKeyType)
+	}
+	if !(v.Delay == 0) {
+		writer.WriteUint(8, v.Delay)
+	}
+	if !(v.MinDelay == 0) {
+		writer.WriteUint(9, v.MinDelay)
+	}
+	if !(v.PendingTxid == ([32]byte{})) {
+		writer.WriteHash(10, &v.PendingTxid)
+	}
 
 	_, _, err := writer.Reset(fieldNames_UpdateKeyPage)
 	return buffer.Bytes(), err
@@ -5532,12 +6985,44 @@ func (v *AddCredits) UnmarshalBinaryFrom(rd io.Reader) error {
 	if x, ok := reader.ReadUint(3); ok {
 		v.Amount = x
 	}
+	if x, ok := reader.ReadBigInt(4); ok {
+		v.AcmeAmount = *x
+	}
 
 	seen, err := reader.Reset(fieldNames_AddCredits)
 	v.fieldsSet = seen
 	return err
 }
 
+func (v *AddCreditsResult) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *AddCreditsResult) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	var typ TransactionType
+	if !reader.ReadEnum(1, &typ) {
+		return fmt.Errorf("field Type: missing")
+	} else if typ != TransactionTypeAddCredits {
+		return fmt.Errorf("field Type: want %v, got %v", TransactionTypeAddCredits, typ)
+	}
+
+	if x, ok := reader.ReadBigInt(2); ok {
+		v.Amount = *x
+	}
+	if x, ok := reader.ReadUint(3); ok {
+		v.Credits = x
+	}
+	if x, ok := reader.ReadUint(4); ok {
+		v.Oracle = x
+	}
+
+	seen, err := reader.Reset(fieldNames_AddCreditsResult)
+	v.fieldsSet = seen
+	return err
+}
+
 func (v *Anchor) UnmarshalBinary(data []byte) error {
 	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
 }
@@ -5608,6 +7093,94 @@ func (v *AnchoredRecord) UnmarshalBinaryFrom(rd io.Reader) error {
 	return err
 }
 
+func (v *Batch) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *Batch) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	var typ TransactionType
+	if !reader.ReadEnum(1, &typ) {
+		return fmt.Errorf("field Type: missing")
+	} else if typ != TransactionTypeBatch {
+		return fmt.Errorf("field Type: want %v, got %v", TransactionTypeBatch, typ)
+	}
+
+	for {
+		if x := new(BatchOperation); reader.ReadValue(2, x.UnmarshalBinary) {
+			v.Operations = append(v.Operations, x)
+		} else {
+			break
+		}
+	}
+
+	seen, err := reader.Reset(fieldNames_Batch)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *BatchOperation) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *BatchOperation) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUrl(1); ok {
+		v.Origin = x
+	}
+	if x, ok := reader.ReadUint(2); ok {
+		v.KeyPageIndex = x
+	}
+	if x, ok := reader.ReadUint(3); ok {
+		v.KeyPageHeight = x
+	}
+	reader.ReadValue(4, func(b []byte) error {
+		x, err := UnmarshalTransaction(b)
+		if err == nil {
+			v.Body = x
+		}
+		return err
+	})
+
+	seen, err := reader.Reset(fieldNames_BatchOperation)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *BatchResult) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *BatchResult) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	var typ TransactionType
+	if !reader.ReadEnum(1, &typ) {
+		return fmt.Errorf("field Type: missing")
+	} else if typ != TransactionTypeBatch {
+		return fmt.Errorf("field Type: want %v, got %v", TransactionTypeBatch, typ)
+	}
+
+	for {
+		ok := reader.ReadValue(2, func(b []byte) error {
+			x, err := UnmarshalTransactionResult(b)
+			if err == nil {
+				v.Results = append(v.Results, x)
+			}
+			return err
+		})
+		if !ok {
+			break
+		}
+	}
+
+	seen, err := reader.Reset(fieldNames_BatchResult)
+	v.fieldsSet = seen
+	return err
+}
+
 func (v *BurnTokens) UnmarshalBinary(data []byte) error {
 	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
 }
@@ -5730,6 +7303,9 @@ func (v *CreateIdentity) UnmarshalBinaryFrom(rd io.Reader) error {
 	if x, ok := reader.ReadUrl(6); ok {
 		v.Manager = x
 	}
+	if x := new(SignatureType); reader.ReadEnum(7, x) {
+		v.KeyType = *x
+	}
 
 	seen, err := reader.Reset(fieldNames_CreateIdentity)
 	v.fieldsSet = seen
@@ -6051,6 +7627,70 @@ func (v *Envelope) UnmarshalBinaryFrom(rd io.Reader) error {
 	return err
 }
 
+func (v *FaucetPolicy) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *FaucetPolicy) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUint(1); ok {
+		v.Amount = x
+	}
+	if x, ok := reader.ReadDuration(2); ok {
+		v.Cooldown = x
+	}
+	if x, ok := reader.ReadUint(3); ok {
+		v.DailyLimit = x
+	}
+	if x, ok := reader.ReadUint(4); ok {
+		v.BlockLimit = x
+	}
+
+	seen, err := reader.Reset(fieldNames_FaucetPolicy)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *FeeSchedule) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *FeeSchedule) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	for {
+		if x := new(FeeScheduleEntry); reader.ReadValue(1, x.UnmarshalBinary) {
+			v.Fees = append(v.Fees, *x)
+		} else {
+			break
+		}
+	}
+
+	seen, err := reader.Reset(fieldNames_FeeSchedule)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *FeeScheduleEntry) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *FeeScheduleEntry) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x := new(TransactionType); reader.ReadEnum(1, x) {
+		v.Type = *x
+	}
+	if x, ok := reader.ReadUint(2); ok {
+		v.Fee = x
+	}
+
+	seen, err := reader.Reset(fieldNames_FeeScheduleEntry)
+	v.fieldsSet = seen
+	return err
+}
+
 func (v *InternalGenesis) UnmarshalBinary(data []byte) error {
 	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
 }
@@ -6102,14 +7742,69 @@ func (v *InternalLedger) UnmarshalBinaryFrom(rd io.Reader) error {
 		v.ActiveOracle = x
 	}
 	for {
-		if x := new(AnchorMetadata); reader.ReadValue(8, x.UnmarshalBinary) {
+		if x, ok := reader.ReadUrl(8); ok {
+			v.OracleSources = append(v.OracleSources, x)
+		} else {
+			break
+		}
+	}
+	for {
+		if x := new(AnchorMetadata); reader.ReadValue(9, x.UnmarshalBinary) {
 			v.Updates = append(v.Updates, *x)
 		} else {
 			break
 		}
 	}
+	if x := new(FeeSchedule); reader.ReadValue(10, x.UnmarshalBinary) {
+		v.PendingFeeSchedule = x
+	}
+	if x := new(FeeSchedule); reader.ReadValue(11, x.UnmarshalBinary) {
+		v.ActiveFeeSchedule = x
+	}
+	if x := new(RoutingTable); reader.ReadValue(12, x.UnmarshalBinary) {
+		v.PendingRoutingTable = x
+	}
+	if x := new(RoutingTable); reader.ReadValue(13, x.UnmarshalBinary) {
+		v.ActiveRoutingTable = x
+	}
+	if x, ok := reader.ReadBool(14); ok {
+		v.RoutingMigration = x
+	}
+	if x := new(NetworkGlobals); reader.ReadValue(15, x.UnmarshalBinary) {
+		v.PendingGlobals = x
+	}
+	if x := new(NetworkGlobals); reader.ReadValue(16, x.UnmarshalBinary) {
+		v.ActiveGlobals = x
+	}
+
+	seen, err := reader.Reset(fieldNames_InternalLedger)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *InternalMigrateAccounts) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *InternalMigrateAccounts) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	var typ TransactionType
+	if !reader.ReadEnum(1, &typ) {
+		return fmt.Errorf("field Type: missing")
+	} else if typ != TransactionTypeInternalMigrateAccounts {
+		return fmt.Errorf("field Type: want %v, got %v", TransactionTypeInternalMigrateAccounts, typ)
+	}
+
+	for {
+		if x, ok := reader.ReadUrl(2); ok {
+			v.Accounts = append(v.Accounts, x)
+		} else {
+			break
+		}
+	}
 
-	seen, err := reader.Reset(fieldNames_InternalLedger)
+	seen, err := reader.Reset(fieldNames_InternalMigrateAccounts)
 	v.fieldsSet = seen
 	return err
 }
@@ -6279,6 +7974,22 @@ func (v *KeyPage) UnmarshalBinaryFrom(rd io.Reader) error {
 			break
 		}
 	}
+	if x, ok := reader.ReadBool(6); ok {
+		v.Frozen = x
+	}
+	if x, ok := reader.ReadBool(7); ok {
+		v.FrozenBySingleSignature = x
+	}
+	if x, ok := reader.ReadUint(8); ok {
+		v.MinDelay = x
+	}
+	for {
+		if x := new(PendingKeyPageOperation); reader.ReadValue(9, x.UnmarshalBinary) {
+			v.PendingOperations = append(v.PendingOperations, x)
+		} else {
+			break
+		}
+	}
 
 	seen, err := reader.Reset(fieldNames_KeyPage)
 	v.fieldsSet = seen
@@ -6301,6 +8012,9 @@ func (v *KeySpec) UnmarshalBinaryFrom(rd io.Reader) error {
 	if x, ok := reader.ReadUrl(3); ok {
 		v.Owner = x
 	}
+	if x := new(SignatureType); reader.ReadEnum(4, x) {
+		v.KeyType = *x
+	}
 
 	seen, err := reader.Reset(fieldNames_KeySpec)
 	v.fieldsSet = seen
@@ -6317,6 +8031,9 @@ func (v *KeySpecParams) UnmarshalBinaryFrom(rd io.Reader) error {
 	if x, ok := reader.ReadBytes(1); ok {
 		v.PublicKey = x
 	}
+	if x := new(SignatureType); reader.ReadEnum(2, x) {
+		v.KeyType = *x
+	}
 
 	seen, err := reader.Reset(fieldNames_KeySpecParams)
 	v.fieldsSet = seen
@@ -6451,6 +8168,25 @@ func (v *MetricsRequest) UnmarshalBinaryFrom(rd io.Reader) error {
 	return err
 }
 
+func (v *NetworkGlobals) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *NetworkGlobals) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUint(1); ok {
+		v.PendingExpiry = x
+	}
+	if x := new(FaucetPolicy); reader.ReadValue(2, x.UnmarshalBinary) {
+		v.Faucet = x
+	}
+
+	seen, err := reader.Reset(fieldNames_NetworkGlobals)
+	v.fieldsSet = seen
+	return err
+}
+
 func (v *Object) UnmarshalBinary(data []byte) error {
 	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
 }
@@ -6500,6 +8236,76 @@ func (v *ObjectMetadata) UnmarshalBinaryFrom(rd io.Reader) error {
 	return err
 }
 
+func (v *OraclePolicy) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *OraclePolicy) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	for {
+		if x, ok := reader.ReadUrl(1); ok {
+			v.Feeds = append(v.Feeds, x)
+		} else {
+			break
+		}
+	}
+	if x, ok := reader.ReadUint(2); ok {
+		v.Window = x
+	}
+	if x, ok := reader.ReadUint(3); ok {
+		v.MaxDeviation = x
+	}
+
+	seen, err := reader.Reset(fieldNames_OraclePolicy)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *OracleSubmission) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *OracleSubmission) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUrl(1); ok {
+		v.Feed = x
+	}
+	if x, ok := reader.ReadUint(2); ok {
+		v.Price = x
+	}
+	if x, ok := reader.ReadTime(3); ok {
+		v.Time = x
+	}
+
+	seen, err := reader.Reset(fieldNames_OracleSubmission)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *PendingKeyPageOperation) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *PendingKeyPageOperation) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadHash(1); ok {
+		v.Txid = *x
+	}
+	if x, ok := reader.ReadUint(2); ok {
+		v.EffectiveAt = x
+	}
+	if x := new(UpdateKeyPage); reader.ReadValue(3, x.UnmarshalBinary) {
+		v.Operation = x
+	}
+
+	seen, err := reader.Reset(fieldNames_PendingKeyPageOperation)
+	v.fieldsSet = seen
+	return err
+}
+
 func (v *PendingTransactionState) UnmarshalBinary(data []byte) error {
 	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
 }
@@ -6687,6 +8493,74 @@ func (v *ResponseDataEntrySet) UnmarshalBinaryFrom(rd io.Reader) error {
 	return err
 }
 
+func (v *Route) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *Route) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUint(1); ok {
+		v.Length = x
+	}
+	if x, ok := reader.ReadUint(2); ok {
+		v.Value = x
+	}
+	if x, ok := reader.ReadString(3); ok {
+		v.Subnet = x
+	}
+
+	seen, err := reader.Reset(fieldNames_Route)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *RoutingTable) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *RoutingTable) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	for {
+		if x := new(Route); reader.ReadValue(1, x.UnmarshalBinary) {
+			v.Routes = append(v.Routes, *x)
+		} else {
+			break
+		}
+	}
+
+	seen, err := reader.Reset(fieldNames_RoutingTable)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *SECP256K1Signature) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *SECP256K1Signature) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	var typ SignatureType
+	if !reader.ReadEnum(1, &typ) {
+		return fmt.Errorf("field Type: missing")
+	} else if typ != SignatureTypeSECP256K1 {
+		return fmt.Errorf("field Type: want %v, got %v", SignatureTypeSECP256K1, typ)
+	}
+
+	if x, ok := reader.ReadBytes(2); ok {
+		v.PublicKey = x
+	}
+	if x, ok := reader.ReadBytes(3); ok {
+		v.Signature = x
+	}
+
+	seen, err := reader.Reset(fieldNames_SECP256K1Signature)
+	v.fieldsSet = seen
+	return err
+}
+
 func (v *SegWitDataEntry) UnmarshalBinary(data []byte) error {
 	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
 }
@@ -6832,6 +8706,29 @@ func (v *SyntheticAnchor) UnmarshalBinaryFrom(rd io.Reader) error {
 	if x := new(Receipt); reader.ReadValue(10, x.UnmarshalBinary) {
 		v.Receipt = *x
 	}
+	if x := new(FeeSchedule); reader.ReadValue(11, x.UnmarshalBinary) {
+		v.FeeSchedule = x
+	}
+	if x := new(RoutingTable); reader.ReadValue(12, x.UnmarshalBinary) {
+		v.RoutingTable = x
+	}
+	if x := new(NetworkGlobals); reader.ReadValue(13, x.UnmarshalBinary) {
+		v.Globals = x
+	}
+	for {
+		if x, ok := reader.ReadHash(14); ok {
+			v.Acknowledgements = append(v.Acknowledgements, *x)
+		} else {
+			break
+		}
+	}
+	for {
+		if x, ok := reader.ReadHash(15); ok {
+			v.Unacknowledged = append(v.Unacknowledged, *x)
+		} else {
+			break
+		}
+	}
 
 	seen, err := reader.Reset(fieldNames_SyntheticAnchor)
 	v.fieldsSet = seen
@@ -6980,12 +8877,59 @@ func (v *SyntheticLedger) UnmarshalBinaryFrom(rd io.Reader) error {
 			break
 		}
 	}
+	for {
+		if x, ok := reader.ReadHash(5); ok {
+			v.Acknowledgements = append(v.Acknowledgements, *x)
+		} else {
+			break
+		}
+	}
+	for {
+		if x, ok := reader.ReadHash(6); ok {
+			v.Unacknowledged = append(v.Unacknowledged, *x)
+		} else {
+			break
+		}
+	}
 
 	seen, err := reader.Reset(fieldNames_SyntheticLedger)
 	v.fieldsSet = seen
 	return err
 }
 
+func (v *SyntheticMigrateAccounts) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *SyntheticMigrateAccounts) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	var typ TransactionType
+	if !reader.ReadEnum(1, &typ) {
+		return fmt.Errorf("field Type: missing")
+	} else if typ != TransactionTypeSyntheticMigrateAccounts {
+		return fmt.Errorf("field Type: want %v, got %v", TransactionTypeSyntheticMigrateAccounts, typ)
+	}
+
+	if x, ok := reader.ReadHash(2); ok {
+		v.Cause = *x
+	}
+	if x, ok := reader.ReadUrl(3); ok {
+		v.Source = x
+	}
+	for {
+		if x := new(AnchoredRecord); reader.ReadValue(4, x.UnmarshalBinary) {
+			v.Accounts = append(v.Accounts, *x)
+		} else {
+			break
+		}
+	}
+
+	seen, err := reader.Reset(fieldNames_SyntheticMigrateAccounts)
+	v.fieldsSet = seen
+	return err
+}
+
 func (v *SyntheticMirror) UnmarshalBinary(data []byte) error {
 	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
 }
@@ -7013,6 +8957,36 @@ func (v *SyntheticMirror) UnmarshalBinaryFrom(rd io.Reader) error {
 	return err
 }
 
+func (v *SyntheticReturnAccounts) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *SyntheticReturnAccounts) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	var typ TransactionType
+	if !reader.ReadEnum(1, &typ) {
+		return fmt.Errorf("field Type: missing")
+	} else if typ != TransactionTypeSyntheticReturnAccounts {
+		return fmt.Errorf("field Type: want %v, got %v", TransactionTypeSyntheticReturnAccounts, typ)
+	}
+
+	if x, ok := reader.ReadHash(2); ok {
+		v.Cause = *x
+	}
+	for {
+		if x, ok := reader.ReadUrl(3); ok {
+			v.Accounts = append(v.Accounts, x)
+		} else {
+			break
+		}
+	}
+
+	seen, err := reader.Reset(fieldNames_SyntheticReturnAccounts)
+	v.fieldsSet = seen
+	return err
+}
+
 func (v *SyntheticWriteData) UnmarshalBinary(data []byte) error {
 	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
 }
@@ -7101,6 +9075,12 @@ func (v *TokenIssuer) UnmarshalBinaryFrom(rd io.Reader) error {
 	if x, ok := reader.ReadBool(7); ok {
 		v.HasSupplyLimit = x
 	}
+	if x, ok := reader.ReadBigInt(8); ok {
+		v.Issued = *x
+	}
+	if x, ok := reader.ReadUint(9); ok {
+		v.SupplyVersion = x
+	}
 
 	seen, err := reader.Reset(fieldNames_TokenIssuer)
 	v.fieldsSet = seen
@@ -7167,6 +9147,9 @@ func (v *TransactionHeader) UnmarshalBinaryFrom(rd io.Reader) error {
 	if x, ok := reader.ReadUint(4); ok {
 		v.Nonce = x
 	}
+	if x, ok := reader.ReadUint(5); ok {
+		v.Expiry = x
+	}
 
 	seen, err := reader.Reset(fieldNames_TransactionHeader)
 	v.fieldsSet = seen
@@ -7248,6 +9231,9 @@ func (v *TransactionStatus) UnmarshalBinaryFrom(rd io.Reader) error {
 		}
 		return err
 	})
+	if x, ok := reader.ReadUint(7); ok {
+		v.Expiry = x
+	}
 
 	seen, err := reader.Reset(fieldNames_TransactionStatus)
 	v.fieldsSet = seen
@@ -7306,6 +9292,18 @@ func (v *UpdateKeyPage) UnmarshalBinaryFrom(rd io.Reader) error {
 	if x, ok := reader.ReadUint(6); ok {
 		v.Threshold = x
 	}
+	if x := new(SignatureType); reader.ReadEnum(7, x) {
+		v.NewKeyType = *x
+	}
+	if x, ok := reader.ReadUint(8); ok {
+		v.Delay = x
+	}
+	if x, ok := reader.ReadUint(9); ok {
+		v.MinDelay = x
+	}
+	if x, ok := reader.ReadHash(10); ok {
+		v.PendingTxid = *x
+	}
 
 	seen, err := reader.Reset(fieldNames_UpdateKeyPage)
 	v.fieldsSet = seen
@@ -7439,13 +9437,29 @@ func (v *AcmeFaucet) MarshalJSON() ([]byte, error) {
 
 func (v *AddCredits) MarshalJSON() ([]byte, error) {
 	u := struct {
-		Type      TransactionType `json:"type"`
-		Recipient *url.URL        `json:"recipient,omitempty"`
-		Amount    uint64          `json:"amount,omitempty"`
+		Type       TransactionType `json:"type"`
+		Recipient  *url.URL        `json:"recipient,omitempty"`
+		Amount     uint64          `json:"amount,omitempty"`
+		AcmeAmount *string         `json:"acmeAmount,omitempty"`
 	}{}
 	u.Type = v.Type()
 	u.Recipient = v.Recipient
 	u.Amount = v.Amount
+	u.AcmeAmount = encoding.BigintToJSON(&v.AcmeAmount)
+	return json.Marshal(&u)
+}
+
+func (v *AddCreditsResult) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Type    TransactionType `json:"type"`
+		Amount  *string         `json:"amount,omitempty"`
+		Credits uint64          `json:"credits,omitempty"`
+		Oracle  uint64          `json:"oracle,omitempty"`
+	}{}
+	u.Type = v.Type()
+	u.Amount = encoding.BigintToJSON(&v.Amount)
+	u.Credits = v.Credits
+	u.Oracle = v.Oracle
 	return json.Marshal(&u)
 }
 
@@ -7483,13 +9497,58 @@ func (v *AnchorMetadata) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&u)
 }
 
-func (v *AnchoredRecord) MarshalJSON() ([]byte, error) {
+func (v *AnchoredRecord) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Record *string `json:"record,omitempty"`
+		Anchor string  `json:"anchor,omitempty"`
+	}{}
+	u.Record = encoding.BytesToJSON(v.Record)
+	u.Anchor = encoding.ChainToJSON(v.Anchor)
+	return json.Marshal(&u)
+}
+
+func (v *Batch) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Type       TransactionType   `json:"type"`
+		Operations []*BatchOperation `json:"operations,omitempty"`
+	}{}
+	u.Type = v.Type()
+	u.Operations = v.Operations
+	return json.Marshal(&u)
+}
+
+func (v *BatchOperation) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Origin        *url.URL        `json:"origin,omitempty"`
+		KeyPageIndex  uint64          `json:"keyPageIndex,omitempty"`
+		KeyPageHeight uint64          `json:"keyPageHeight,omitempty"`
+		Body          json.RawMessage `json:"body,omitempty"`
+	}{}
+	u.Origin = v.Origin
+	u.KeyPageIndex = v.KeyPageIndex
+	u.KeyPageHeight = v.KeyPageHeight
+	if x, err := json.Marshal(v.Body); err != nil {
+		return nil, fmt.Errorf("error encoding Body: %w", err)
+	} else {
+		u.Body = x
+	}
+	return json.Marshal(&u)
+}
+
+func (v *BatchResult) MarshalJSON() ([]byte, error) {
 	u := struct {
-		Record *string `json:"record,omitempty"`
-		Anchor string  `json:"anchor,omitempty"`
+		Type    TransactionType   `json:"type"`
+		Results []json.RawMessage `json:"results,omitempty"`
 	}{}
-	u.Record = encoding.BytesToJSON(v.Record)
-	u.Anchor = encoding.ChainToJSON(v.Anchor)
+	u.Type = v.Type()
+	u.Results = make([]json.RawMessage, len(v.Results))
+	for i, x := range v.Results {
+		if y, err := json.Marshal(x); err != nil {
+			return nil, fmt.Errorf("error encoding Results: %w", err)
+		} else {
+			u.Results[i] = y
+		}
+	}
 	return json.Marshal(&u)
 }
 
@@ -7537,6 +9596,7 @@ func (v *CreateIdentity) MarshalJSON() ([]byte, error) {
 		KeyBookName string          `json:"keyBookName,omitempty"`
 		KeyPageName string          `json:"keyPageName,omitempty"`
 		Manager     *url.URL        `json:"manager,omitempty"`
+		KeyType     SignatureType   `json:"keyType,omitempty"`
 	}{}
 	u.Type = v.Type()
 	u.Url = v.Url
@@ -7544,6 +9604,7 @@ func (v *CreateIdentity) MarshalJSON() ([]byte, error) {
 	u.KeyBookName = v.KeyBookName
 	u.KeyPageName = v.KeyPageName
 	u.Manager = v.Manager
+	u.KeyType = v.KeyType
 	return json.Marshal(&u)
 }
 
@@ -7685,6 +9746,20 @@ func (v *Envelope) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&u)
 }
 
+func (v *FaucetPolicy) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Amount     uint64      `json:"amount,omitempty"`
+		Cooldown   interface{} `json:"cooldown,omitempty"`
+		DailyLimit uint64      `json:"dailyLimit,omitempty"`
+		BlockLimit uint64      `json:"blockLimit,omitempty"`
+	}{}
+	u.Amount = v.Amount
+	u.Cooldown = encoding.DurationToJSON(v.Cooldown)
+	u.DailyLimit = v.DailyLimit
+	u.BlockLimit = v.BlockLimit
+	return json.Marshal(&u)
+}
+
 func (v *InternalGenesis) MarshalJSON() ([]byte, error) {
 	u := struct {
 		Type TransactionType `json:"type"`
@@ -7695,16 +9770,24 @@ func (v *InternalGenesis) MarshalJSON() ([]byte, error) {
 
 func (v *InternalLedger) MarshalJSON() ([]byte, error) {
 	u := struct {
-		Type           AccountType      `json:"type"`
-		Url            *url.URL         `json:"url,omitempty"`
-		KeyBook        *url.URL         `json:"keyBook,omitempty"`
-		ManagerKeyBook *url.URL         `json:"managerKeyBook,omitempty"`
-		Index          int64            `json:"index,omitempty"`
-		Timestamp      time.Time        `json:"timestamp,omitempty"`
-		Synthetic      SyntheticLedger  `json:"synthetic,omitempty"`
-		PendingOracle  uint64           `json:"pendingOracle,omitempty"`
-		ActiveOracle   uint64           `json:"activeOracle,omitempty"`
-		Updates        []AnchorMetadata `json:"updates,omitempty"`
+		Type                AccountType      `json:"type"`
+		Url                 *url.URL         `json:"url,omitempty"`
+		KeyBook             *url.URL         `json:"keyBook,omitempty"`
+		ManagerKeyBook      *url.URL         `json:"managerKeyBook,omitempty"`
+		Index               int64            `json:"index,omitempty"`
+		Timestamp           time.Time        `json:"timestamp,omitempty"`
+		Synthetic           SyntheticLedger  `json:"synthetic,omitempty"`
+		PendingOracle       uint64           `json:"pendingOracle,omitempty"`
+		ActiveOracle        uint64           `json:"activeOracle,omitempty"`
+		OracleSources       []*url.URL       `json:"oracleSources,omitempty"`
+		Updates             []AnchorMetadata `json:"updates,omitempty"`
+		PendingFeeSchedule  *FeeSchedule     `json:"pendingFeeSchedule,omitempty"`
+		ActiveFeeSchedule   *FeeSchedule     `json:"activeFeeSchedule,omitempty"`
+		PendingRoutingTable *RoutingTable    `json:"pendingRoutingTable,omitempty"`
+		ActiveRoutingTable  *RoutingTable    `json:"activeRoutingTable,omitempty"`
+		RoutingMigration    bool             `json:"routingMigration,omitempty"`
+		PendingGlobals      *NetworkGlobals  `json:"pendingGlobals,omitempty"`
+		ActiveGlobals       *NetworkGlobals  `json:"activeGlobals,omitempty"`
 	}{}
 	u.Type = v.Type()
 	u.Url = v.AccountHeader.Url
@@ -7715,7 +9798,25 @@ func (v *InternalLedger) MarshalJSON() ([]byte, error) {
 	u.Synthetic = v.Synthetic
 	u.PendingOracle = v.PendingOracle
 	u.ActiveOracle = v.ActiveOracle
+	u.OracleSources = v.OracleSources
 	u.Updates = v.Updates
+	u.PendingFeeSchedule = v.PendingFeeSchedule
+	u.ActiveFeeSchedule = v.ActiveFeeSchedule
+	u.PendingRoutingTable = v.PendingRoutingTable
+	u.ActiveRoutingTable = v.ActiveRoutingTable
+	u.RoutingMigration = v.RoutingMigration
+	u.PendingGlobals = v.PendingGlobals
+	u.ActiveGlobals = v.ActiveGlobals
+	return json.Marshal(&u)
+}
+
+func (v *InternalMigrateAccounts) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Type     TransactionType `json:"type"`
+		Accounts []*url.URL      `json:"accounts,omitempty"`
+	}{}
+	u.Type = v.Type()
+	u.Accounts = v.Accounts
 	return json.Marshal(&u)
 }
 
@@ -7782,13 +9883,17 @@ func (v *KeyBook) MarshalJSON() ([]byte, error) {
 
 func (v *KeyPage) MarshalJSON() ([]byte, error) {
 	u := struct {
-		Type           AccountType `json:"type"`
-		Url            *url.URL    `json:"url,omitempty"`
-		KeyBook        *url.URL    `json:"keyBook,omitempty"`
-		ManagerKeyBook *url.URL    `json:"managerKeyBook,omitempty"`
-		CreditBalance  *string     `json:"creditBalance,omitempty"`
-		Threshold      uint64      `json:"threshold,omitempty"`
-		Keys           []*KeySpec  `json:"keys,omitempty"`
+		Type                    AccountType                `json:"type"`
+		Url                     *url.URL                   `json:"url,omitempty"`
+		KeyBook                 *url.URL                   `json:"keyBook,omitempty"`
+		ManagerKeyBook          *url.URL                   `json:"managerKeyBook,omitempty"`
+		CreditBalance           *string                    `json:"creditBalance,omitempty"`
+		Threshold               uint64                     `json:"threshold,omitempty"`
+		Keys                    []*KeySpec                 `json:"keys,omitempty"`
+		Frozen                  bool                       `json:"frozen,omitempty"`
+		FrozenBySingleSignature bool                       `json:"frozenBySingleSignature,omitempty"`
+		MinDelay                uint64                     `json:"minDelay,omitempty"`
+		PendingOperations       []*PendingKeyPageOperation `json:"pendingOperations,omitempty"`
 	}{}
 	u.Type = v.Type()
 	u.Url = v.AccountHeader.Url
@@ -7797,26 +9902,34 @@ func (v *KeyPage) MarshalJSON() ([]byte, error) {
 	u.CreditBalance = encoding.BigintToJSON(&v.CreditBalance)
 	u.Threshold = v.Threshold
 	u.Keys = v.Keys
+	u.Frozen = v.Frozen
+	u.FrozenBySingleSignature = v.FrozenBySingleSignature
+	u.MinDelay = v.MinDelay
+	u.PendingOperations = v.PendingOperations
 	return json.Marshal(&u)
 }
 
 func (v *KeySpec) MarshalJSON() ([]byte, error) {
 	u := struct {
-		PublicKey *string  `json:"publicKey,omitempty"`
-		Nonce     uint64   `json:"nonce,omitempty"`
-		Owner     *url.URL `json:"owner,omitempty"`
+		PublicKey *string       `json:"publicKey,omitempty"`
+		Nonce     uint64        `json:"nonce,omitempty"`
+		Owner     *url.URL      `json:"owner,omitempty"`
+		KeyType   SignatureType `json:"keyType,omitempty"`
 	}{}
 	u.PublicKey = encoding.BytesToJSON(v.PublicKey)
 	u.Nonce = v.Nonce
 	u.Owner = v.Owner
+	u.KeyType = v.KeyType
 	return json.Marshal(&u)
 }
 
 func (v *KeySpecParams) MarshalJSON() ([]byte, error) {
 	u := struct {
-		PublicKey *string `json:"publicKey,omitempty"`
+		PublicKey *string       `json:"publicKey,omitempty"`
+		KeyType   SignatureType `json:"keyType,omitempty"`
 	}{}
 	u.PublicKey = encoding.BytesToJSON(v.PublicKey)
+	u.KeyType = v.KeyType
 	return json.Marshal(&u)
 }
 
@@ -7919,6 +10032,18 @@ func (v *Object) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&u)
 }
 
+func (v *PendingKeyPageOperation) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Txid        string         `json:"txid,omitempty"`
+		EffectiveAt uint64         `json:"effectiveAt,omitempty"`
+		Operation   *UpdateKeyPage `json:"operation,omitempty"`
+	}{}
+	u.Txid = encoding.ChainToJSON(v.Txid)
+	u.EffectiveAt = v.EffectiveAt
+	u.Operation = v.Operation
+	return json.Marshal(&u)
+}
+
 func (v *PendingTransactionState) MarshalJSON() ([]byte, error) {
 	u := struct {
 		Type             AccountType       `json:"type"`
@@ -7994,6 +10119,18 @@ func (v *ResponseDataEntry) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&u)
 }
 
+func (v *SECP256K1Signature) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Type      SignatureType `json:"type"`
+		PublicKey *string       `json:"publicKey,omitempty"`
+		Signature *string       `json:"signature,omitempty"`
+	}{}
+	u.Type = v.Type()
+	u.PublicKey = encoding.BytesToJSON(v.PublicKey)
+	u.Signature = encoding.BytesToJSON(v.Signature)
+	return json.Marshal(&u)
+}
+
 func (v *SegWitDataEntry) MarshalJSON() ([]byte, error) {
 	u := struct {
 		Type      TransactionType `json:"type"`
@@ -8046,16 +10183,21 @@ func (v *SignPending) MarshalJSON() ([]byte, error) {
 
 func (v *SyntheticAnchor) MarshalJSON() ([]byte, error) {
 	u := struct {
-		Type            TransactionType `json:"type"`
-		Source          *url.URL        `json:"source,omitempty"`
-		Major           bool            `json:"major,omitempty"`
-		RootAnchor      string          `json:"rootAnchor,omitempty"`
-		RootIndex       uint64          `json:"rootIndex,omitempty"`
-		Block           uint64          `json:"block,omitempty"`
-		SourceIndex     uint64          `json:"sourceIndex,omitempty"`
-		SourceBlock     uint64          `json:"sourceBlock,omitempty"`
-		AcmeOraclePrice uint64          `json:"acmeOraclePrice,omitempty"`
-		Receipt         Receipt         `json:"receipt,omitempty"`
+		Type             TransactionType `json:"type"`
+		Source           *url.URL        `json:"source,omitempty"`
+		Major            bool            `json:"major,omitempty"`
+		RootAnchor       string          `json:"rootAnchor,omitempty"`
+		RootIndex        uint64          `json:"rootIndex,omitempty"`
+		Block            uint64          `json:"block,omitempty"`
+		SourceIndex      uint64          `json:"sourceIndex,omitempty"`
+		SourceBlock      uint64          `json:"sourceBlock,omitempty"`
+		AcmeOraclePrice  uint64          `json:"acmeOraclePrice,omitempty"`
+		Receipt          Receipt         `json:"receipt,omitempty"`
+		FeeSchedule      *FeeSchedule    `json:"feeSchedule,omitempty"`
+		RoutingTable     *RoutingTable   `json:"routingTable,omitempty"`
+		Globals          *NetworkGlobals `json:"globals,omitempty"`
+		Acknowledgements []string        `json:"acknowledgements,omitempty"`
+		Unacknowledged   []string        `json:"unacknowledged,omitempty"`
 	}{}
 	u.Type = v.Type()
 	u.Source = v.Source
@@ -8067,6 +10209,17 @@ func (v *SyntheticAnchor) MarshalJSON() ([]byte, error) {
 	u.SourceBlock = v.SourceBlock
 	u.AcmeOraclePrice = v.AcmeOraclePrice
 	u.Receipt = v.Receipt
+	u.FeeSchedule = v.FeeSchedule
+	u.RoutingTable = v.RoutingTable
+	u.Globals = v.Globals
+	u.Acknowledgements = make([]string, len(v.Acknowledgements))
+	for i, x := range v.Acknowledgements {
+		u.Acknowledgements[i] = encoding.ChainToJSON(x)
+	}
+	u.Unacknowledged = make([]string, len(v.Unacknowledged))
+	for i, x := range v.Unacknowledged {
+		u.Unacknowledged[i] = encoding.ChainToJSON(x)
+	}
 	return json.Marshal(&u)
 }
 
@@ -8122,10 +10275,12 @@ func (v *SyntheticDepositTokens) MarshalJSON() ([]byte, error) {
 
 func (v *SyntheticLedger) MarshalJSON() ([]byte, error) {
 	u := struct {
-		Nonce    uint64   `json:"nonce,omitempty"`
-		Produced []string `json:"produced,omitempty"`
-		Unsigned []string `json:"unsigned,omitempty"`
-		Unsent   []string `json:"unsent,omitempty"`
+		Nonce            uint64   `json:"nonce,omitempty"`
+		Produced         []string `json:"produced,omitempty"`
+		Unsigned         []string `json:"unsigned,omitempty"`
+		Unsent           []string `json:"unsent,omitempty"`
+		Acknowledgements []string `json:"acknowledgements,omitempty"`
+		Unacknowledged   []string `json:"unacknowledged,omitempty"`
 	}{}
 	u.Nonce = v.Nonce
 	u.Produced = make([]string, len(v.Produced))
@@ -8140,6 +10295,28 @@ func (v *SyntheticLedger) MarshalJSON() ([]byte, error) {
 	for i, x := range v.Unsent {
 		u.Unsent[i] = encoding.ChainToJSON(x)
 	}
+	u.Acknowledgements = make([]string, len(v.Acknowledgements))
+	for i, x := range v.Acknowledgements {
+		u.Acknowledgements[i] = encoding.ChainToJSON(x)
+	}
+	u.Unacknowledged = make([]string, len(v.Unacknowledged))
+	for i, x := range v.Unacknowledged {
+		u.Unacknowledged[i] = encoding.ChainToJSON(x)
+	}
+	return json.Marshal(&u)
+}
+
+func (v *SyntheticMigrateAccounts) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Type     TransactionType  `json:"type"`
+		Cause    string           `json:"cause,omitempty"`
+		Source   *url.URL         `json:"source,omitempty"`
+		Accounts []AnchoredRecord `json:"accounts,omitempty"`
+	}{}
+	u.Type = v.Type()
+	u.Cause = encoding.ChainToJSON(v.Cause)
+	u.Source = v.Source
+	u.Accounts = v.Accounts
 	return json.Marshal(&u)
 }
 
@@ -8153,6 +10330,18 @@ func (v *SyntheticMirror) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&u)
 }
 
+func (v *SyntheticReturnAccounts) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Type     TransactionType `json:"type"`
+		Cause    string          `json:"cause,omitempty"`
+		Accounts []*url.URL      `json:"accounts,omitempty"`
+	}{}
+	u.Type = v.Type()
+	u.Cause = encoding.ChainToJSON(v.Cause)
+	u.Accounts = v.Accounts
+	return json.Marshal(&u)
+}
+
 func (v *SyntheticWriteData) MarshalJSON() ([]byte, error) {
 	u := struct {
 		Type  TransactionType `json:"type"`
@@ -8196,6 +10385,8 @@ func (v *TokenIssuer) MarshalJSON() ([]byte, error) {
 		Properties     *url.URL    `json:"properties,omitempty"`
 		Supply         *string     `json:"supply,omitempty"`
 		HasSupplyLimit bool        `json:"hasSupplyLimit,omitempty"`
+		Issued         *string     `json:"issued,omitempty"`
+		SupplyVersion  uint64      `json:"supplyVersion,omitempty"`
 	}{}
 	u.Type = v.Type()
 	u.Url = v.AccountHeader.Url
@@ -8206,6 +10397,8 @@ func (v *TokenIssuer) MarshalJSON() ([]byte, error) {
 	u.Properties = v.Properties
 	u.Supply = encoding.BigintToJSON(&v.Supply)
 	u.HasSupplyLimit = v.HasSupplyLimit
+	u.Issued = encoding.BigintToJSON(&v.Issued)
+	u.SupplyVersion = v.SupplyVersion
 	return json.Marshal(&u)
 }
 
@@ -8225,12 +10418,14 @@ func (v *Transaction) MarshalJSON() ([]byte, error) {
 		KeyPageHeight uint64          `json:"keyPageHeight,omitempty"`
 		KeyPageIndex  uint64          `json:"keyPageIndex,omitempty"`
 		Nonce         uint64          `json:"nonce,omitempty"`
+		Expiry        uint64          `json:"expiry,omitempty"`
 		Body          json.RawMessage `json:"body,omitempty"`
 	}{}
 	u.Origin = v.TransactionHeader.Origin
 	u.KeyPageHeight = v.TransactionHeader.KeyPageHeight
 	u.KeyPageIndex = v.TransactionHeader.KeyPageIndex
 	u.Nonce = v.TransactionHeader.Nonce
+	u.Expiry = v.TransactionHeader.Expiry
 	if x, err := json.Marshal(v.Body); err != nil {
 		return nil, fmt.Errorf("error encoding Body: %w", err)
 	} else {
@@ -8283,6 +10478,7 @@ func (v *TransactionStatus) MarshalJSON() ([]byte, error) {
 		Code      uint64          `json:"code,omitempty"`
 		Message   string          `json:"message,omitempty"`
 		Result    json.RawMessage `json:"result,omitempty"`
+		Expiry    uint64          `json:"expiry,omitempty"`
 	}{}
 	u.Remote = v.Remote
 	u.Delivered = v.Delivered
@@ -8294,6 +10490,7 @@ func (v *TransactionStatus) MarshalJSON() ([]byte, error) {
 	} else {
 		u.Result = x
 	}
+	u.Expiry = v.Expiry
 	return json.Marshal(&u)
 }
 
@@ -8313,12 +10510,16 @@ func (v *TxState) MarshalJSON() ([]byte, error) {
 
 func (v *UpdateKeyPage) MarshalJSON() ([]byte, error) {
 	u := struct {
-		Type      TransactionType  `json:"type"`
-		Operation KeyPageOperation `json:"operation,omitempty"`
-		Key       *string          `json:"key,omitempty"`
-		NewKey    *string          `json:"newKey,omitempty"`
-		Owner     *url.URL         `json:"owner,omitempty"`
-		Threshold uint64           `json:"threshold,omitempty"`
+		Type        TransactionType  `json:"type"`
+		Operation   KeyPageOperation `json:"operation,omitempty"`
+		Key         *string          `json:"key,omitempty"`
+		NewKey      *string          `json:"newKey,omitempty"`
+		Owner       *url.URL         `json:"owner,omitempty"`
+		Threshold   uint64           `json:"threshold,omitempty"`
+		NewKeyType  SignatureType    `json:"newKeyType,omitempty"`
+		Delay       uint64           `json:"delay,omitempty"`
+		MinDelay    uint64           `json:"minDelay,omitempty"`
+		PendingTxid string           `json:"pendingTxid,omitempty"`
 	}{}
 	u.Type = v.Type()
 	u.Operation = v.Operation
@@ -8326,6 +10527,10 @@ func (v *UpdateKeyPage) MarshalJSON() ([]byte, error) {
 	u.NewKey = encoding.BytesToJSON(v.NewKey)
 	u.Owner = v.Owner
 	u.Threshold = v.Threshold
+	u.NewKeyType = v.NewKeyType
+	u.Delay = v.Delay
+	u.MinDelay = v.MinDelay
+	u.PendingTxid = encoding.ChainToJSON(v.PendingTxid)
 	return json.Marshal(&u)
 }
 
@@ -8411,18 +10616,49 @@ func (v *AcmeFaucet) UnmarshalJSON(data []byte) error {
 
 func (v *AddCredits) UnmarshalJSON(data []byte) error {
 	u := struct {
-		Type      TransactionType `json:"type"`
-		Recipient *url.URL        `json:"recipient,omitempty"`
-		Amount    uint64          `json:"amount,omitempty"`
+		Type       TransactionType `json:"type"`
+		Recipient  *url.URL        `json:"recipient,omitempty"`
+		Amount     uint64          `json:"amount,omitempty"`
+		AcmeAmount *string         `json:"acmeAmount,omitempty"`
 	}{}
 	u.Type = v.Type()
 	u.Recipient = v.Recipient
 	u.Amount = v.Amount
+	u.AcmeAmount = encoding.BigintToJSON(&v.AcmeAmount)
 	if err := json.Unmarshal(data, &u); err != nil {
 		return err
 	}
 	v.Recipient = u.Recipient
 	v.Amount = u.Amount
+	if x, err := encoding.BigintFromJSON(u.AcmeAmount); err != nil {
+		return fmt.Errorf("error decoding AcmeAmount: %w", err)
+	} else {
+		v.AcmeAmount = *x
+	}
+	return nil
+}
+
+func (v *AddCreditsResult) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Type    TransactionType `json:"type"`
+		Amount  *string         `json:"amount,omitempty"`
+		Credits uint64          `json:"credits,omitempty"`
+		Oracle  uint64          `json:"oracle,omitempty"`
+	}{}
+	u.Type = v.Type()
+	u.Amount = encoding.BigintToJSON(&v.Amount)
+	u.Credits = v.Credits
+	u.Oracle = v.Oracle
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	if x, err := encoding.BigintFromJSON(u.Amount); err != nil {
+		return fmt.Errorf("error decoding Amount: %w", err)
+	} else {
+		v.Amount = *x
+	}
+	v.Credits = u.Credits
+	v.Oracle = u.Oracle
 	return nil
 }
 
@@ -8503,6 +10739,78 @@ func (v *AnchoredRecord) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (v *Batch) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Type       TransactionType   `json:"type"`
+		Operations []*BatchOperation `json:"operations,omitempty"`
+	}{}
+	u.Type = v.Type()
+	u.Operations = v.Operations
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Operations = u.Operations
+	return nil
+}
+
+func (v *BatchOperation) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Origin        *url.URL        `json:"origin,omitempty"`
+		KeyPageIndex  uint64          `json:"keyPageIndex,omitempty"`
+		KeyPageHeight uint64          `json:"keyPageHeight,omitempty"`
+		Body          json.RawMessage `json:"body,omitempty"`
+	}{}
+	u.Origin = v.Origin
+	u.KeyPageIndex = v.KeyPageIndex
+	u.KeyPageHeight = v.KeyPageHeight
+	if x, err := json.Marshal(v.Body); err != nil {
+		return fmt.Errorf("error encoding Body: %w", err)
+	} else {
+		u.Body = x
+	}
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Origin = u.Origin
+	v.KeyPageIndex = u.KeyPageIndex
+	v.KeyPageHeight = u.KeyPageHeight
+	if x, err := UnmarshalTransactionJSON(u.Body); err != nil {
+		return fmt.Errorf("error decoding Body: %w", err)
+	} else {
+		v.Body = x
+	}
+
+	return nil
+}
+
+func (v *BatchResult) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Type    TransactionType   `json:"type"`
+		Results []json.RawMessage `json:"results,omitempty"`
+	}{}
+	u.Type = v.Type()
+	u.Results = make([]json.RawMessage, len(v.Results))
+	for i, x := range v.Results {
+		if y, err := json.Marshal(x); err != nil {
+			return fmt.Errorf("error encoding Results: %w", err)
+		} else {
+			u.Results[i] = y
+		}
+	}
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Results = make([]TransactionResult, len(u.Results))
+	for i, x := range u.Results {
+		if y, err := UnmarshalTransactionResultJSON(x); err != nil {
+			return fmt.Errorf("error decoding Results: %w", err)
+		} else {
+			v.Results[i] = y
+		}
+	}
+	return nil
+}
+
 func (v *BurnTokens) UnmarshalJSON(data []byte) error {
 	u := struct {
 		Type   TransactionType `json:"type"`
@@ -8571,6 +10879,7 @@ func (v *CreateIdentity) UnmarshalJSON(data []byte) error {
 		KeyBookName string          `json:"keyBookName,omitempty"`
 		KeyPageName string          `json:"keyPageName,omitempty"`
 		Manager     *url.URL        `json:"manager,omitempty"`
+		KeyType     SignatureType   `json:"keyType,omitempty"`
 	}{}
 	u.Type = v.Type()
 	u.Url = v.Url
@@ -8578,6 +10887,7 @@ func (v *CreateIdentity) UnmarshalJSON(data []byte) error {
 	u.KeyBookName = v.KeyBookName
 	u.KeyPageName = v.KeyPageName
 	u.Manager = v.Manager
+	u.KeyType = v.KeyType
 	if err := json.Unmarshal(data, &u); err != nil {
 		return err
 	}
@@ -8590,6 +10900,7 @@ func (v *CreateIdentity) UnmarshalJSON(data []byte) error {
 	v.KeyBookName = u.KeyBookName
 	v.KeyPageName = u.KeyPageName
 	v.Manager = u.Manager
+	v.KeyType = u.KeyType
 	return nil
 }
 
@@ -8822,6 +11133,31 @@ func (v *Envelope) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (v *FaucetPolicy) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Amount     uint64      `json:"amount,omitempty"`
+		Cooldown   interface{} `json:"cooldown,omitempty"`
+		DailyLimit uint64      `json:"dailyLimit,omitempty"`
+		BlockLimit uint64      `json:"blockLimit,omitempty"`
+	}{}
+	u.Amount = v.Amount
+	u.Cooldown = encoding.DurationToJSON(v.Cooldown)
+	u.DailyLimit = v.DailyLimit
+	u.BlockLimit = v.BlockLimit
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Amount = u.Amount
+	if x, err := encoding.DurationFromJSON(u.Cooldown); err != nil {
+		return fmt.Errorf("error decoding Cooldown: %w", err)
+	} else {
+		v.Cooldown = x
+	}
+	v.DailyLimit = u.DailyLimit
+	v.BlockLimit = u.BlockLimit
+	return nil
+}
+
 func (v *InternalGenesis) UnmarshalJSON(data []byte) error {
 	u := struct {
 		Type TransactionType `json:"type"`
@@ -8835,16 +11171,24 @@ func (v *InternalGenesis) UnmarshalJSON(data []byte) error {
 
 func (v *InternalLedger) UnmarshalJSON(data []byte) error {
 	u := struct {
-		Type           AccountType      `json:"type"`
-		Url            *url.URL         `json:"url,omitempty"`
-		KeyBook        *url.URL         `json:"keyBook,omitempty"`
-		ManagerKeyBook *url.URL         `json:"managerKeyBook,omitempty"`
-		Index          int64            `json:"index,omitempty"`
-		Timestamp      time.Time        `json:"timestamp,omitempty"`
-		Synthetic      SyntheticLedger  `json:"synthetic,omitempty"`
-		PendingOracle  uint64           `json:"pendingOracle,omitempty"`
-		ActiveOracle   uint64           `json:"activeOracle,omitempty"`
-		Updates        []AnchorMetadata `json:"updates,omitempty"`
+		Type                AccountType      `json:"type"`
+		Url                 *url.URL         `json:"url,omitempty"`
+		KeyBook             *url.URL         `json:"keyBook,omitempty"`
+		ManagerKeyBook      *url.URL         `json:"managerKeyBook,omitempty"`
+		Index               int64            `json:"index,omitempty"`
+		Timestamp           time.Time        `json:"timestamp,omitempty"`
+		Synthetic           SyntheticLedger  `json:"synthetic,omitempty"`
+		PendingOracle       uint64           `json:"pendingOracle,omitempty"`
+		ActiveOracle        uint64           `json:"activeOracle,omitempty"`
+		OracleSources       []*url.URL       `json:"oracleSources,omitempty"`
+		Updates             []AnchorMetadata `json:"updates,omitempty"`
+		PendingFeeSchedule  *FeeSchedule     `json:"pendingFeeSchedule,omitempty"`
+		ActiveFeeSchedule   *FeeSchedule     `json:"activeFeeSchedule,omitempty"`
+		PendingRoutingTable *RoutingTable    `json:"pendingRoutingTable,omitempty"`
+		ActiveRoutingTable  *RoutingTable    `json:"activeRoutingTable,omitempty"`
+		RoutingMigration    bool             `json:"routingMigration,omitempty"`
+		PendingGlobals      *NetworkGlobals  `json:"pendingGlobals,omitempty"`
+		ActiveGlobals       *NetworkGlobals  `json:"activeGlobals,omitempty"`
 	}{}
 	u.Type = v.Type()
 	u.Url = v.AccountHeader.Url
@@ -8855,7 +11199,15 @@ func (v *InternalLedger) UnmarshalJSON(data []byte) error {
 	u.Synthetic = v.Synthetic
 	u.PendingOracle = v.PendingOracle
 	u.ActiveOracle = v.ActiveOracle
+	u.OracleSources = v.OracleSources
 	u.Updates = v.Updates
+	u.PendingFeeSchedule = v.PendingFeeSchedule
+	u.ActiveFeeSchedule = v.ActiveFeeSchedule
+	u.PendingRoutingTable = v.PendingRoutingTable
+	u.ActiveRoutingTable = v.ActiveRoutingTable
+	u.RoutingMigration = v.RoutingMigration
+	u.PendingGlobals = v.PendingGlobals
+	u.ActiveGlobals = v.ActiveGlobals
 	if err := json.Unmarshal(data, &u); err != nil {
 		return err
 	}
@@ -8867,7 +11219,29 @@ func (v *InternalLedger) UnmarshalJSON(data []byte) error {
 	v.Synthetic = u.Synthetic
 	v.PendingOracle = u.PendingOracle
 	v.ActiveOracle = u.ActiveOracle
+	v.OracleSources = u.OracleSources
 	v.Updates = u.Updates
+	v.PendingFeeSchedule = u.PendingFeeSchedule
+	v.ActiveFeeSchedule = u.ActiveFeeSchedule
+	v.PendingRoutingTable = u.PendingRoutingTable
+	v.ActiveRoutingTable = u.ActiveRoutingTable
+	v.RoutingMigration = u.RoutingMigration
+	v.PendingGlobals = u.PendingGlobals
+	v.ActiveGlobals = u.ActiveGlobals
+	return nil
+}
+
+func (v *InternalMigrateAccounts) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Type     TransactionType `json:"type"`
+		Accounts []*url.URL      `json:"accounts,omitempty"`
+	}{}
+	u.Type = v.Type()
+	u.Accounts = v.Accounts
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Accounts = u.Accounts
 	return nil
 }
 
@@ -8969,13 +11343,17 @@ func (v *KeyBook) UnmarshalJSON(data []byte) error {
 
 func (v *KeyPage) UnmarshalJSON(data []byte) error {
 	u := struct {
-		Type           AccountType `json:"type"`
-		Url            *url.URL    `json:"url,omitempty"`
-		KeyBook        *url.URL    `json:"keyBook,omitempty"`
-		ManagerKeyBook *url.URL    `json:"managerKeyBook,omitempty"`
-		CreditBalance  *string     `json:"creditBalance,omitempty"`
-		Threshold      uint64      `json:"threshold,omitempty"`
-		Keys           []*KeySpec  `json:"keys,omitempty"`
+		Type                    AccountType                `json:"type"`
+		Url                     *url.URL                   `json:"url,omitempty"`
+		KeyBook                 *url.URL                   `json:"keyBook,omitempty"`
+		ManagerKeyBook          *url.URL                   `json:"managerKeyBook,omitempty"`
+		CreditBalance           *string                    `json:"creditBalance,omitempty"`
+		Threshold               uint64                     `json:"threshold,omitempty"`
+		Keys                    []*KeySpec                 `json:"keys,omitempty"`
+		Frozen                  bool                       `json:"frozen,omitempty"`
+		FrozenBySingleSignature bool                       `json:"frozenBySingleSignature,omitempty"`
+		MinDelay                uint64                     `json:"minDelay,omitempty"`
+		PendingOperations       []*PendingKeyPageOperation `json:"pendingOperations,omitempty"`
 	}{}
 	u.Type = v.Type()
 	u.Url = v.AccountHeader.Url
@@ -8984,6 +11362,10 @@ func (v *KeyPage) UnmarshalJSON(data []byte) error {
 	u.CreditBalance = encoding.BigintToJSON(&v.CreditBalance)
 	u.Threshold = v.Threshold
 	u.Keys = v.Keys
+	u.Frozen = v.Frozen
+	u.FrozenBySingleSignature = v.FrozenBySingleSignature
+	u.MinDelay = v.MinDelay
+	u.PendingOperations = v.PendingOperations
 	if err := json.Unmarshal(data, &u); err != nil {
 		return err
 	}
@@ -8997,18 +11379,24 @@ func (v *KeyPage) UnmarshalJSON(data []byte) error {
 	}
 	v.Threshold = u.Threshold
 	v.Keys = u.Keys
+	v.Frozen = u.Frozen
+	v.FrozenBySingleSignature = u.FrozenBySingleSignature
+	v.MinDelay = u.MinDelay
+	v.PendingOperations = u.PendingOperations
 	return nil
 }
 
 func (v *KeySpec) UnmarshalJSON(data []byte) error {
 	u := struct {
-		PublicKey *string  `json:"publicKey,omitempty"`
-		Nonce     uint64   `json:"nonce,omitempty"`
-		Owner     *url.URL `json:"owner,omitempty"`
+		PublicKey *string       `json:"publicKey,omitempty"`
+		Nonce     uint64        `json:"nonce,omitempty"`
+		Owner     *url.URL      `json:"owner,omitempty"`
+		KeyType   SignatureType `json:"keyType,omitempty"`
 	}{}
 	u.PublicKey = encoding.BytesToJSON(v.PublicKey)
 	u.Nonce = v.Nonce
 	u.Owner = v.Owner
+	u.KeyType = v.KeyType
 	if err := json.Unmarshal(data, &u); err != nil {
 		return err
 	}
@@ -9019,14 +11407,17 @@ func (v *KeySpec) UnmarshalJSON(data []byte) error {
 	}
 	v.Nonce = u.Nonce
 	v.Owner = u.Owner
+	v.KeyType = u.KeyType
 	return nil
 }
 
 func (v *KeySpecParams) UnmarshalJSON(data []byte) error {
 	u := struct {
-		PublicKey *string `json:"publicKey,omitempty"`
+		PublicKey *string       `json:"publicKey,omitempty"`
+		KeyType   SignatureType `json:"keyType,omitempty"`
 	}{}
 	u.PublicKey = encoding.BytesToJSON(v.PublicKey)
+	u.KeyType = v.KeyType
 	if err := json.Unmarshal(data, &u); err != nil {
 		return err
 	}
@@ -9035,6 +11426,7 @@ func (v *KeySpecParams) UnmarshalJSON(data []byte) error {
 	} else {
 		v.PublicKey = x
 	}
+	v.KeyType = u.KeyType
 	return nil
 }
 
@@ -9220,6 +11612,28 @@ func (v *Object) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (v *PendingKeyPageOperation) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Txid        string         `json:"txid,omitempty"`
+		EffectiveAt uint64         `json:"effectiveAt,omitempty"`
+		Operation   *UpdateKeyPage `json:"operation,omitempty"`
+	}{}
+	u.Txid = encoding.ChainToJSON(v.Txid)
+	u.EffectiveAt = v.EffectiveAt
+	u.Operation = v.Operation
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	if x, err := encoding.ChainFromJSON(u.Txid); err != nil {
+		return fmt.Errorf("error decoding Txid: %w", err)
+	} else {
+		v.Txid = x
+	}
+	v.EffectiveAt = u.EffectiveAt
+	v.Operation = u.Operation
+	return nil
+}
+
 func (v *PendingTransactionState) UnmarshalJSON(data []byte) error {
 	u := struct {
 		Type             AccountType       `json:"type"`
@@ -9350,6 +11764,31 @@ func (v *ResponseDataEntry) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (v *SECP256K1Signature) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Type      SignatureType `json:"type"`
+		PublicKey *string       `json:"publicKey,omitempty"`
+		Signature *string       `json:"signature,omitempty"`
+	}{}
+	u.Type = v.Type()
+	u.PublicKey = encoding.BytesToJSON(v.PublicKey)
+	u.Signature = encoding.BytesToJSON(v.Signature)
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	if x, err := encoding.BytesFromJSON(u.PublicKey); err != nil {
+		return fmt.Errorf("error decoding PublicKey: %w", err)
+	} else {
+		v.PublicKey = x
+	}
+	if x, err := encoding.BytesFromJSON(u.Signature); err != nil {
+		return fmt.Errorf("error decoding Signature: %w", err)
+	} else {
+		v.Signature = x
+	}
+	return nil
+}
+
 func (v *SegWitDataEntry) UnmarshalJSON(data []byte) error {
 	u := struct {
 		Type      TransactionType `json:"type"`
@@ -9439,16 +11878,21 @@ func (v *SignPending) UnmarshalJSON(data []byte) error {
 
 func (v *SyntheticAnchor) UnmarshalJSON(data []byte) error {
 	u := struct {
-		Type            TransactionType `json:"type"`
-		Source          *url.URL        `json:"source,omitempty"`
-		Major           bool            `json:"major,omitempty"`
-		RootAnchor      string          `json:"rootAnchor,omitempty"`
-		RootIndex       uint64          `json:"rootIndex,omitempty"`
-		Block           uint64          `json:"block,omitempty"`
-		SourceIndex     uint64          `json:"sourceIndex,omitempty"`
-		SourceBlock     uint64          `json:"sourceBlock,omitempty"`
-		AcmeOraclePrice uint64          `json:"acmeOraclePrice,omitempty"`
-		Receipt         Receipt         `json:"receipt,omitempty"`
+		Type             TransactionType `json:"type"`
+		Source           *url.URL        `json:"source,omitempty"`
+		Major            bool            `json:"major,omitempty"`
+		RootAnchor       string          `json:"rootAnchor,omitempty"`
+		RootIndex        uint64          `json:"rootIndex,omitempty"`
+		Block            uint64          `json:"block,omitempty"`
+		SourceIndex      uint64          `json:"sourceIndex,omitempty"`
+		SourceBlock      uint64          `json:"sourceBlock,omitempty"`
+		AcmeOraclePrice  uint64          `json:"acmeOraclePrice,omitempty"`
+		Receipt          Receipt         `json:"receipt,omitempty"`
+		FeeSchedule      *FeeSchedule    `json:"feeSchedule,omitempty"`
+		RoutingTable     *RoutingTable   `json:"routingTable,omitempty"`
+		Globals          *NetworkGlobals `json:"globals,omitempty"`
+		Acknowledgements []string        `json:"acknowledgements,omitempty"`
+		Unacknowledged   []string        `json:"unacknowledged,omitempty"`
 	}{}
 	u.Type = v.Type()
 	u.Source = v.Source
@@ -9460,6 +11904,17 @@ func (v *SyntheticAnchor) UnmarshalJSON(data []byte) error {
 	u.SourceBlock = v.SourceBlock
 	u.AcmeOraclePrice = v.AcmeOraclePrice
 	u.Receipt = v.Receipt
+	u.FeeSchedule = v.FeeSchedule
+	u.RoutingTable = v.RoutingTable
+	u.Globals = v.Globals
+	u.Acknowledgements = make([]string, len(v.Acknowledgements))
+	for i, x := range v.Acknowledgements {
+		u.Acknowledgements[i] = encoding.ChainToJSON(x)
+	}
+	u.Unacknowledged = make([]string, len(v.Unacknowledged))
+	for i, x := range v.Unacknowledged {
+		u.Unacknowledged[i] = encoding.ChainToJSON(x)
+	}
 	if err := json.Unmarshal(data, &u); err != nil {
 		return err
 	}
@@ -9476,6 +11931,25 @@ func (v *SyntheticAnchor) UnmarshalJSON(data []byte) error {
 	v.SourceBlock = u.SourceBlock
 	v.AcmeOraclePrice = u.AcmeOraclePrice
 	v.Receipt = u.Receipt
+	v.FeeSchedule = u.FeeSchedule
+	v.RoutingTable = u.RoutingTable
+	v.Globals = u.Globals
+	v.Acknowledgements = make([][32]byte, len(u.Acknowledgements))
+	for i, x := range u.Acknowledgements {
+		if x, err := encoding.ChainFromJSON(x); err != nil {
+			return fmt.Errorf("error decoding Acknowledgements: %w", err)
+		} else {
+			v.Acknowledgements[i] = x
+		}
+	}
+	v.Unacknowledged = make([][32]byte, len(u.Unacknowledged))
+	for i, x := range u.Unacknowledged {
+		if x, err := encoding.ChainFromJSON(x); err != nil {
+			return fmt.Errorf("error decoding Unacknowledged: %w", err)
+		} else {
+			v.Unacknowledged[i] = x
+		}
+	}
 	return nil
 }
 
@@ -9576,10 +12050,12 @@ func (v *SyntheticDepositTokens) UnmarshalJSON(data []byte) error {
 
 func (v *SyntheticLedger) UnmarshalJSON(data []byte) error {
 	u := struct {
-		Nonce    uint64   `json:"nonce,omitempty"`
-		Produced []string `json:"produced,omitempty"`
-		Unsigned []string `json:"unsigned,omitempty"`
-		Unsent   []string `json:"unsent,omitempty"`
+		Nonce            uint64   `json:"nonce,omitempty"`
+		Produced         []string `json:"produced,omitempty"`
+		Unsigned         []string `json:"unsigned,omitempty"`
+		Unsent           []string `json:"unsent,omitempty"`
+		Acknowledgements []string `json:"acknowledgements,omitempty"`
+		Unacknowledged   []string `json:"unacknowledged,omitempty"`
 	}{}
 	u.Nonce = v.Nonce
 	u.Produced = make([]string, len(v.Produced))
@@ -9594,6 +12070,14 @@ func (v *SyntheticLedger) UnmarshalJSON(data []byte) error {
 	for i, x := range v.Unsent {
 		u.Unsent[i] = encoding.ChainToJSON(x)
 	}
+	u.Acknowledgements = make([]string, len(v.Acknowledgements))
+	for i, x := range v.Acknowledgements {
+		u.Acknowledgements[i] = encoding.ChainToJSON(x)
+	}
+	u.Unacknowledged = make([]string, len(v.Unacknowledged))
+	for i, x := range v.Unacknowledged {
+		u.Unacknowledged[i] = encoding.ChainToJSON(x)
+	}
 	if err := json.Unmarshal(data, &u); err != nil {
 		return err
 	}
@@ -9622,6 +12106,46 @@ func (v *SyntheticLedger) UnmarshalJSON(data []byte) error {
 			v.Unsent[i] = x
 		}
 	}
+	v.Acknowledgements = make([][32]byte, len(u.Acknowledgements))
+	for i, x := range u.Acknowledgements {
+		if x, err := encoding.ChainFromJSON(x); err != nil {
+			return fmt.Errorf("error decoding Acknowledgements: %w", err)
+		} else {
+			v.Acknowledgements[i] = x
+		}
+	}
+	v.Unacknowledged = make([][32]byte, len(u.Unacknowledged))
+	for i, x := range u.Unacknowledged {
+		if x, err := encoding.ChainFromJSON(x); err != nil {
+			return fmt.Errorf("error decoding Unacknowledged: %w", err)
+		} else {
+			v.Unacknowledged[i] = x
+		}
+	}
+	return nil
+}
+
+func (v *SyntheticMigrateAccounts) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Type     TransactionType  `json:"type"`
+		Cause    string           `json:"cause,omitempty"`
+		Source   *url.URL         `json:"source,omitempty"`
+		Accounts []AnchoredRecord `json:"accounts,omitempty"`
+	}{}
+	u.Type = v.Type()
+	u.Cause = encoding.ChainToJSON(v.Cause)
+	u.Source = v.Source
+	u.Accounts = v.Accounts
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	if x, err := encoding.ChainFromJSON(u.Cause); err != nil {
+		return fmt.Errorf("error decoding Cause: %w", err)
+	} else {
+		v.Cause = x
+	}
+	v.Source = u.Source
+	v.Accounts = u.Accounts
 	return nil
 }
 
@@ -9639,6 +12163,27 @@ func (v *SyntheticMirror) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (v *SyntheticReturnAccounts) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Type     TransactionType `json:"type"`
+		Cause    string          `json:"cause,omitempty"`
+		Accounts []*url.URL      `json:"accounts,omitempty"`
+	}{}
+	u.Type = v.Type()
+	u.Cause = encoding.ChainToJSON(v.Cause)
+	u.Accounts = v.Accounts
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	if x, err := encoding.ChainFromJSON(u.Cause); err != nil {
+		return fmt.Errorf("error decoding Cause: %w", err)
+	} else {
+		v.Cause = x
+	}
+	v.Accounts = u.Accounts
+	return nil
+}
+
 func (v *SyntheticWriteData) UnmarshalJSON(data []byte) error {
 	u := struct {
 		Type  TransactionType `json:"type"`
@@ -9704,6 +12249,8 @@ func (v *TokenIssuer) UnmarshalJSON(data []byte) error {
 		Properties     *url.URL    `json:"properties,omitempty"`
 		Supply         *string     `json:"supply,omitempty"`
 		HasSupplyLimit bool        `json:"hasSupplyLimit,omitempty"`
+		Issued         *string     `json:"issued,omitempty"`
+		SupplyVersion  uint64      `json:"supplyVersion,omitempty"`
 	}{}
 	u.Type = v.Type()
 	u.Url = v.AccountHeader.Url
@@ -9714,6 +12261,8 @@ func (v *TokenIssuer) UnmarshalJSON(data []byte) error {
 	u.Properties = v.Properties
 	u.Supply = encoding.BigintToJSON(&v.Supply)
 	u.HasSupplyLimit = v.HasSupplyLimit
+	u.Issued = encoding.BigintToJSON(&v.Issued)
+	u.SupplyVersion = v.SupplyVersion
 	if err := json.Unmarshal(data, &u); err != nil {
 		return err
 	}
@@ -9729,6 +12278,12 @@ func (v *TokenIssuer) UnmarshalJSON(data []byte) error {
 		v.Supply = *x
 	}
 	v.HasSupplyLimit = u.HasSupplyLimit
+	if x, err := encoding.BigintFromJSON(u.Issued); err != nil {
+		return fmt.Errorf("error decoding Issued: %w", err)
+	} else {
+		v.Issued = *x
+	}
+	v.SupplyVersion = u.SupplyVersion
 	return nil
 }
 
@@ -9757,12 +12312,14 @@ func (v *Transaction) UnmarshalJSON(data []byte) error {
 		KeyPageHeight uint64          `json:"keyPageHeight,omitempty"`
 		KeyPageIndex  uint64          `json:"keyPageIndex,omitempty"`
 		Nonce         uint64          `json:"nonce,omitempty"`
+		Expiry        uint64          `json:"expiry,omitempty"`
 		Body          json.RawMessage `json:"body,omitempty"`
 	}{}
 	u.Origin = v.TransactionHeader.Origin
 	u.KeyPageHeight = v.TransactionHeader.KeyPageHeight
 	u.KeyPageIndex = v.TransactionHeader.KeyPageIndex
 	u.Nonce = v.TransactionHeader.Nonce
+	u.Expiry = v.TransactionHeader.Expiry
 	if x, err := json.Marshal(v.Body); err != nil {
 		return fmt.Errorf("error encoding Body: %w", err)
 	} else {
@@ -9775,6 +12332,7 @@ func (v *Transaction) UnmarshalJSON(data []byte) error {
 	v.TransactionHeader.KeyPageHeight = u.KeyPageHeight
 	v.TransactionHeader.KeyPageIndex = u.KeyPageIndex
 	v.TransactionHeader.Nonce = u.Nonce
+	v.TransactionHeader.Expiry = u.Expiry
 	if x, err := UnmarshalTransactionJSON(u.Body); err != nil {
 		return fmt.Errorf("error decoding Body: %w", err)
 	} else {
@@ -9855,6 +12413,7 @@ func (v *TransactionStatus) UnmarshalJSON(data []byte) error {
 		Code      uint64          `json:"code,omitempty"`
 		Message   string          `json:"message,omitempty"`
 		Result    json.RawMessage `json:"result,omitempty"`
+		Expiry    uint64          `json:"expiry,omitempty"`
 	}{}
 	u.Remote = v.Remote
 	u.Delivered = v.Delivered
@@ -9866,6 +12425,7 @@ func (v *TransactionStatus) UnmarshalJSON(data []byte) error {
 	} else {
 		u.Result = x
 	}
+	u.Expiry = v.Expiry
 	if err := json.Unmarshal(data, &u); err != nil {
 		return err
 	}
@@ -9880,6 +12440,7 @@ func (v *TransactionStatus) UnmarshalJSON(data []byte) error {
 		v.Result = x
 	}
 
+	v.Expiry = u.Expiry
 	return nil
 }
 
@@ -9909,12 +12470,16 @@ func (v *TxState) UnmarshalJSON(data []byte) error {
 
 func (v *UpdateKeyPage) UnmarshalJSON(data []byte) error {
 	u := struct {
-		Type      TransactionType  `json:"type"`
-		Operation KeyPageOperation `json:"operation,omitempty"`
-		Key       *string          `json:"key,omitempty"`
-		NewKey    *string          `json:"newKey,omitempty"`
-		Owner     *url.URL         `json:"owner,omitempty"`
-		Threshold uint64           `json:"threshold,omitempty"`
+		Type        TransactionType  `json:"type"`
+		Operation   KeyPageOperation `json:"operation,omitempty"`
+		Key         *string          `json:"key,omitempty"`
+		NewKey      *string          `json:"newKey,omitempty"`
+		Owner       *url.URL         `json:"owner,omitempty"`
+		Threshold   uint64           `json:"threshold,omitempty"`
+		NewKeyType  SignatureType    `json:"newKeyType,omitempty"`
+		Delay       uint64           `json:"delay,omitempty"`
+		MinDelay    uint64           `json:"minDelay,omitempty"`
+		PendingTxid string           `json:"pendingTxid,omitempty"`
 	}{}
 	u.Type = v.Type()
 	u.Operation = v.Operation
@@ -9922,6 +12487,10 @@ func (v *UpdateKeyPage) UnmarshalJSON(data []byte) error {
 	u.NewKey = encoding.BytesToJSON(v.NewKey)
 	u.Owner = v.Owner
 	u.Threshold = v.Threshold
+	u.NewKeyType = v.NewKeyType
+	u.Delay = v.Delay
+	u.MinDelay = v.MinDelay
+	u.PendingTxid = encoding.ChainToJSON(v.PendingTxid)
 	if err := json.Unmarshal(data, &u); err != nil {
 		return err
 	}
@@ -9938,6 +12507,14 @@ func (v *UpdateKeyPage) UnmarshalJSON(data []byte) error {
 	}
 	v.Owner = u.Owner
 	v.Threshold = u.Threshold
+	v.NewKeyType = u.NewKeyType
+	v.Delay = u.Delay
+	v.MinDelay = u.MinDelay
+	if x, err := encoding.ChainFromJSON(u.PendingTxid); err != nil {
+		return fmt.Errorf("error decoding PendingTxid: %w", err)
+	} else {
+		v.PendingTxid = x
+	}
 	return nil
 }
 
@@ -135,6 +135,8 @@ func NewTransactionBody(typ TransactionType) (TransactionBody, error) {
 		return new(AcmeFaucet), nil
 	case TransactionTypeAddCredits:
 		return new(AddCredits), nil
+	case TransactionTypeBatch:
+		return new(Batch), nil
 	case TransactionTypeBurnTokens:
 		return new(BurnTokens), nil
 	case TransactionTypeCreateDataAccount:
@@ -151,6 +153,8 @@ func NewTransactionBody(typ TransactionType) (TransactionBody, error) {
 		return new(CreateTokenAccount), nil
 	case TransactionTypeInternalGenesis:
 		return new(InternalGenesis), nil
+	case TransactionTypeInternalMigrateAccounts:
+		return new(InternalMigrateAccounts), nil
 	case TransactionTypeInternalSendTransactions:
 		return new(InternalSendTransactions), nil
 	case TransactionTypeInternalTransactionsSent:
@@ -177,8 +181,12 @@ func NewTransactionBody(typ TransactionType) (TransactionBody, error) {
 		return new(SyntheticDepositCredits), nil
 	case TransactionTypeSyntheticDepositTokens:
 		return new(SyntheticDepositTokens), nil
+	case TransactionTypeSyntheticMigrateAccounts:
+		return new(SyntheticMigrateAccounts), nil
 	case TransactionTypeSyntheticMirror:
 		return new(SyntheticMirror), nil
+	case TransactionTypeSyntheticReturnAccounts:
+		return new(SyntheticReturnAccounts), nil
 	case TransactionTypeSyntheticWriteData:
 		return new(SyntheticWriteData), nil
 	case TransactionTypeUpdateKeyPage:
@@ -284,6 +292,8 @@ func NewSignature(typ SignatureType) (Signature, error) {
 		return new(ED25519Signature), nil
 	case SignatureTypeLegacyED25519:
 		return new(LegacyED25519Signature), nil
+	case SignatureTypeSECP256K1:
+		return new(SECP256K1Signature), nil
 	default:
 		return nil, fmt.Errorf("unknown signature type %v", typ)
 	}
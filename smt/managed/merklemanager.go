@@ -89,6 +89,57 @@ func (m *MerkleManager) WriteChainHead(key storage.Key) error {
 	return nil
 }
 
+// RestoreHead
+// Replaces the head of the chain with the given state, such as a state taken
+// from a snapshot.  The elements recorded in the state's HashList are written
+// along with the state of the preceding mark point, so the chain can be
+// extended and recent elements can be retrieved.  Elements and states prior
+// to the HashList are not available after a restore.
+func (m *MerkleManager) RestoreHead(ms *MerkleState) error {
+	ms = ms.Copy()
+	ms.InitSha256()
+	m.MS = ms
+
+	// The HashList holds the elements added since the previous mark point
+	first := ms.Count - int64(len(ms.HashList))
+	if first < 0 {
+		return fmt.Errorf("invalid chain state: %d hashes but a count of %d", len(ms.HashList), ms.Count)
+	}
+	for i, h := range ms.HashList {
+		m.Manager.Put(m.key.Append("Element", first+int64(i)), h.Copy())
+		m.Manager.Put(m.key.Append("ElementIndex", h), common.Int64Bytes(first+int64(i)))
+	}
+
+	switch {
+	case ms.Count&m.MarkMask == 0 && ms.Count > 0:
+		// The head is itself a mark point
+		data, err := ms.Marshal()
+		if err != nil {
+			return err
+		}
+		m.Manager.Put(m.key.Append("States", ms.Count-1), data)
+
+	case first > 0 && first&m.MarkMask == 0:
+		// Adding fewer than MarkFreq elements to a mark point only touches the
+		// pending roots below MarkPower, so the state of the mark point is the
+		// head with those roots removed
+		mark := new(MerkleState)
+		mark.InitSha256()
+		mark.Count = first
+		mark.Pending = append(mark.Pending, ms.Pending...)
+		for i := int64(0); i < m.MarkPower && i < int64(len(mark.Pending)); i++ {
+			mark.Pending[i] = nil
+		}
+		data, err := mark.Marshal()
+		if err != nil {
+			return err
+		}
+		m.Manager.Put(m.key.Append("States", first-1), data)
+	}
+
+	return m.WriteChainHead(m.key)
+}
+
 // GetChainState
 // Reads the highest state of the chain stored to the database.  Returns nilTestCli
 // if no state has been recorded for a chain
@@ -0,0 +1,37 @@
+package pmt
+
+// ForEach
+// Calls fn for every key/hash pair in the BPT.  Byte blocks that have not
+// been loaded are loaded from the database as they are reached.  If fn
+// returns an error, the walk stops and the error is returned.
+func (b *BPT) ForEach(fn func(key, hash [32]byte) error) error {
+	return b.walkNode(b.Root, fn)
+}
+
+// walkNode
+// A recursive routine that visits the values under the given node, Left
+// before Right.
+func (b *BPT) walkNode(node *BptNode, fn func(key, hash [32]byte) error) error {
+	if node.Left != nil && node.Left.T() == TNotLoaded || //  If either side is not loaded,
+		node.Right != nil && node.Right.T() == TNotLoaded { // load the byte block
+		n := b.manager.LoadNode(node)
+		node.Left = n.Left
+		node.Right = n.Right
+	}
+
+	for _, e := range []Entry{node.Left, node.Right} {
+		switch e := e.(type) {
+		case nil:
+			// Nothing to do
+		case *Value:
+			if err := fn(e.Key, e.Hash); err != nil {
+				return err
+			}
+		case *BptNode:
+			if err := b.walkNode(e, fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -179,6 +179,12 @@ func (d *DB) Put(key storage.Key, value []byte) error {
 // Write all the transactions in a given batch of pending transactions.  The
 // batch is emptied, so it can be reused.
 func (d *DB) EndBatch(TXCache map[storage.Key][]byte) error {
+	if l, err := d.lock(false); err != nil {
+		return err
+	} else {
+		defer l.Unlock()
+	}
+
 	txn := d.badgerDB.NewTransaction(true)
 	defer txn.Discard()
 
@@ -210,6 +216,42 @@ func (d *DB) EndBatch(TXCache map[storage.Key][]byte) error {
 	return nil
 }
 
+// ForEach
+// Iterate over every key/value pair in the database. Keys that are not the
+// length of a storage key are reported as an error.
+func (d *DB) ForEach(fn func(storage.Key, []byte) error) error {
+	if l, err := d.lock(false); err != nil {
+		return err
+	} else {
+		defer l.Unlock()
+	}
+
+	return d.badgerDB.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			var key storage.Key
+			if len(item.Key()) != len(key) {
+				return fmt.Errorf("invalid key %X: want %d bytes, got %d", item.Key(), len(key), len(item.Key()))
+			}
+			copy(key[:], item.Key())
+
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+
+			err = fn(key, value)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (db *DB) Begin() storage.KeyValueTxn {
 	return batch.New(db, db.logger)
 }
@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/dgraph-io/badger/v3"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage/storagetest"
 )
 
 func TestDatabase(t *testing.T) {
@@ -102,3 +104,10 @@ func TestDatabase2(t *testing.T) {
 		}
 	}
 }
+
+func TestConformance(t *testing.T) {
+	storagetest.TestKeyValueStore(t, func(path string) (storage.KeyValueStore, error) {
+		db := new(DB)
+		return db, db.InitDB(path, nil)
+	}, true)
+}
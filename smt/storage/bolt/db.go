@@ -0,0 +1,158 @@
+package bolt
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage/batch"
+	"go.etcd.io/bbolt"
+)
+
+// bucket is the bucket that holds every key-value pair.
+var bucket = []byte("accumulate")
+
+// DB is a key-value store backed by bbolt, a B+tree in a single
+// memory-mapped file. Unlike Badger, bbolt does not have a value log, so it
+// does not need garbage collection and cannot be left with a truncated value
+// log if the node is terminated abruptly.
+type DB struct {
+	boltDB *bbolt.DB
+	logger storage.Logger
+}
+
+var _ storage.KeyValueStore = (*DB)(nil)
+
+// Close
+// Close the underlying database
+func (d *DB) Close() error {
+	if d.boltDB == nil {
+		return storage.ErrNotOpen
+	}
+	return d.boltDB.Close()
+}
+
+// InitDB
+// Open the database file with the given name, creating it and any missing
+// directories if necessary.
+func (d *DB) InitDB(file string, logger storage.Logger) error {
+	err := os.MkdirAll(filepath.Dir(file), 0700)
+	if err != nil {
+		return errors.New("failed to create home directory")
+	}
+
+	// Fail instead of waiting forever if another process has the file open
+	db, err := bbolt.Open(file, 0600, &bbolt.Options{Timeout: time.Second})
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", file, err)
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucket)
+		return err
+	})
+	if err != nil {
+		_ = db.Close()
+		return err
+	}
+
+	d.boltDB = db
+	d.logger = logger
+	return nil
+}
+
+func (d *DB) view(fn func(*bbolt.Bucket) error) error {
+	if d.boltDB == nil {
+		return storage.ErrNotOpen
+	}
+	err := d.boltDB.View(func(tx *bbolt.Tx) error {
+		return fn(tx.Bucket(bucket))
+	})
+	if errors.Is(err, bbolt.ErrDatabaseNotOpen) {
+		return storage.ErrNotOpen
+	}
+	return err
+}
+
+func (d *DB) update(fn func(*bbolt.Bucket) error) error {
+	if d.boltDB == nil {
+		return storage.ErrNotOpen
+	}
+	err := d.boltDB.Update(func(tx *bbolt.Tx) error {
+		return fn(tx.Bucket(bucket))
+	})
+	if errors.Is(err, bbolt.ErrDatabaseNotOpen) {
+		return storage.ErrNotOpen
+	}
+	return err
+}
+
+// Get
+// Return the value of the given key, or ErrNotFound if the key is not found
+func (d *DB) Get(key storage.Key) (value []byte, err error) {
+	err = d.view(func(b *bbolt.Bucket) error {
+		// Seek instead of Get, so an empty value is not mistaken for a
+		// missing key
+		k, v := b.Cursor().Seek(key[:])
+		if !bytes.Equal(k, key[:]) {
+			return storage.ErrNotFound
+		}
+
+		// The value is only valid for the life of the transaction
+		value = append([]byte{}, v...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// Put
+// Put a key/value in the database
+func (d *DB) Put(key storage.Key, value []byte) error {
+	return d.update(func(b *bbolt.Bucket) error {
+		return b.Put(key[:], value)
+	})
+}
+
+// EndBatch
+// Write all the key/value pairs of a batch in a single transaction
+func (d *DB) EndBatch(values map[storage.Key][]byte) error {
+	return d.update(func(b *bbolt.Bucket) error {
+		for k, v := range values {
+			// bbolt holds on to the key until the transaction is committed, so
+			// each key must be a copy of the range variable. See the comment
+			// in badger.DB.EndBatch.
+			k := k
+			err := b.Put(k[:], v)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ForEach
+// Iterate over every key/value pair in the database, in key order
+func (d *DB) ForEach(fn func(storage.Key, []byte) error) error {
+	return d.view(func(b *bbolt.Bucket) error {
+		return b.ForEach(func(k, v []byte) error {
+			var key storage.Key
+			if len(k) != len(key) {
+				return fmt.Errorf("invalid key %X: want %d bytes, got %d", k, len(key), len(k))
+			}
+			copy(key[:], k)
+			return fn(key, append([]byte{}, v...))
+		})
+	})
+}
+
+func (d *DB) Begin() storage.KeyValueTxn {
+	return batch.New(d, d.logger)
+}
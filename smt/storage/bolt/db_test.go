@@ -0,0 +1,15 @@
+package bolt
+
+import (
+	"testing"
+
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage/storagetest"
+)
+
+func TestConformance(t *testing.T) {
+	storagetest.TestKeyValueStore(t, func(path string) (storage.KeyValueStore, error) {
+		db := new(DB)
+		return db, db.InitDB(path, nil)
+	}, true)
+}
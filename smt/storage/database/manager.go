@@ -8,6 +8,7 @@ import (
 
 	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
 	"gitlab.com/accumulatenetwork/accumulate/smt/storage/badger"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage/bolt"
 	"gitlab.com/accumulatenetwork/accumulate/smt/storage/memory"
 )
 
@@ -77,6 +78,11 @@ func (m *Manager) Init(databaseTag, filename string, logger storage.Logger) erro
 		if err := m.DB.InitDB(filename, logger); err != nil { // Initialize it with the given filename
 			return err
 		}
+	case "bolt": //                                      bbolt database indicated
+		m.DB = new(bolt.DB)                                   // Create a bolt struct
+		if err := m.DB.InitDB(filename, logger); err != nil { // Initialize it with the given filename
+			return err
+		}
 	case "memory": //                                    memory database indicated
 		m.DB = new(memory.DB)             //                     Allocate the structure
 		_ = m.DB.InitDB(filename, logger) //                     filename is ignored, but must allocate the underlying map
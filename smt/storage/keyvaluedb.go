@@ -26,6 +26,11 @@ type KeyValueStore interface {
 	Put(key Key, value []byte) error             // Put the value in the database, throws an error if fails
 	EndBatch(map[Key][]byte) error               // End and commit a batch of transactions
 	Begin() KeyValueTxn
+
+	// ForEach calls fn for every key-value pair in the database, in no
+	// particular order, and stops if fn returns an error. fn must not modify
+	// the database.
+	ForEach(fn func(key Key, value []byte) error) error
 }
 
 // Logger defines a generic logging interface compatible with Tendermint (stolen from Tendermint).
@@ -34,3 +39,24 @@ type Logger interface {
 	Info(msg string, keyVals ...interface{})
 	Error(msg string, keyVals ...interface{})
 }
+
+// Copy copies every key-value pair of src into dst, committing a batch every
+// batchSize pairs.
+func Copy(dst, src KeyValueStore, batchSize int) error {
+	values := make(map[Key][]byte, batchSize)
+	err := src.ForEach(func(key Key, value []byte) error {
+		values[key] = value
+		if len(values) < batchSize {
+			return nil
+		}
+
+		err := dst.EndBatch(values)
+		values = make(map[Key][]byte, batchSize)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return dst.EndBatch(values)
+}
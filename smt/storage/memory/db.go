@@ -43,10 +43,10 @@ func (m *DB) Ready() bool {
 // the cache is applied to a key value store does not matter.
 func (m *DB) EndBatch(txCache map[storage.Key][]byte) error {
 	m.mutex.Lock()
+	defer m.mutex.Unlock()
 	if !m.Ready() {
 		return storage.ErrNotOpen
 	}
-	defer m.mutex.Unlock()
 	for k, v := range txCache {
 		m.entries[k] = v
 	}
@@ -164,6 +164,29 @@ func (m *DB) Put(key storage.Key, value []byte) error {
 	return nil
 }
 
+// ForEach
+// Calls fn for every key-value pair of a snapshot of the database
+func (m *DB) ForEach(fn func(storage.Key, []byte) error) error {
+	m.mutex.Lock()
+	if !m.Ready() {
+		m.mutex.Unlock()
+		return storage.ErrNotOpen
+	}
+	entries := make(map[storage.Key][]byte, len(m.entries))
+	for k, v := range m.entries {
+		entries[k] = v
+	}
+	m.mutex.Unlock()
+
+	for k, v := range entries {
+		err := fn(k, append([]byte{}, v...))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (db *DB) Begin() storage.KeyValueTxn {
 	return batch.New(db, db.logger)
 }
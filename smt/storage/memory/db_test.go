@@ -4,6 +4,9 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"testing"
+
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage/storagetest"
 )
 
 func GetKey(key []byte) (dbKey [32]byte) {
@@ -34,3 +37,10 @@ func TestDatabase(t *testing.T) {
 		}
 	}
 }
+
+func TestConformance(t *testing.T) {
+	storagetest.TestKeyValueStore(t, func(path string) (storage.KeyValueStore, error) {
+		db := new(DB)
+		return db, db.InitDB(path, nil)
+	}, false)
+}
@@ -0,0 +1,234 @@
+// Package storagetest implements the conformance tests that every
+// storage.KeyValueStore implementation must pass.
+package storagetest
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/accumulatenetwork/accumulate/smt/storage"
+)
+
+// Open opens a store at the given path, creating it if it does not exist.
+type Open func(path string) (storage.KeyValueStore, error)
+
+// TestKeyValueStore runs the conformance tests against stores returned by
+// open. Each test opens a store at a new path. If persistent is true, the
+// store must retain its contents when it is closed and opened again at the
+// same path.
+func TestKeyValueStore(t *testing.T, open Open, persistent bool) {
+	tests := map[string]func(*testing.T, Open){
+		"GetPut":     testGetPut,
+		"EmptyValue": testEmptyValue,
+		"Copies":     testCopies,
+		"EndBatch":   testEndBatch,
+		"Txn":        testTxn,
+		"ForEach":    testForEach,
+		"Copy":       testCopy,
+		"Closed":     testClosed,
+	}
+	if persistent {
+		tests["Reopen"] = testReopen
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) { test(t, open) })
+	}
+}
+
+func key(i int) storage.Key {
+	return sha256.Sum256([]byte(fmt.Sprintf("key %d", i)))
+}
+
+func value(i int) []byte {
+	return []byte(fmt.Sprintf("value %d", i))
+}
+
+func openNew(t *testing.T, open Open) (storage.KeyValueStore, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "db")
+	db, err := open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	return db, path
+}
+
+func requireValue(t *testing.T, db interface {
+	Get(storage.Key) ([]byte, error)
+}, k storage.Key, v []byte) {
+	t.Helper()
+	got, err := db.Get(k)
+	require.NoError(t, err)
+	require.Equal(t, string(v), string(got))
+}
+
+func requireNotFound(t *testing.T, db interface {
+	Get(storage.Key) ([]byte, error)
+}, k storage.Key) {
+	t.Helper()
+	_, err := db.Get(k)
+	require.Truef(t, errors.Is(err, storage.ErrNotFound), "want ErrNotFound, got %v", err)
+}
+
+func testGetPut(t *testing.T, open Open) {
+	db, _ := openNew(t, open)
+
+	requireNotFound(t, db, key(1))
+
+	require.NoError(t, db.Put(key(1), value(1)))
+	requireValue(t, db, key(1), value(1))
+	requireNotFound(t, db, key(2))
+
+	// Overwrite
+	require.NoError(t, db.Put(key(1), value(2)))
+	requireValue(t, db, key(1), value(2))
+}
+
+func testEmptyValue(t *testing.T, open Open) {
+	db, _ := openNew(t, open)
+
+	// An empty value is not the same as a missing key
+	require.NoError(t, db.Put(key(1), nil))
+	requireValue(t, db, key(1), nil)
+	require.NoError(t, db.EndBatch(map[storage.Key][]byte{key(2): {}}))
+	requireValue(t, db, key(2), nil)
+}
+
+func testCopies(t *testing.T, open Open) {
+	db, _ := openNew(t, open)
+
+	// Modifying the value passed to Put must not modify the store
+	v := value(1)
+	require.NoError(t, db.Put(key(1), v))
+	v[0] = 'X'
+	requireValue(t, db, key(1), value(1))
+
+	// Modifying the value returned by Get must not modify the store
+	v, err := db.Get(key(1))
+	require.NoError(t, err)
+	v[0] = 'X'
+	requireValue(t, db, key(1), value(1))
+}
+
+func testEndBatch(t *testing.T, open Open) {
+	db, _ := openNew(t, open)
+
+	// Enough entries that a store that keeps a reference to the range variable
+	// instead of the key will fail
+	const count = 1000
+	values := map[storage.Key][]byte{}
+	for i := 0; i < count; i++ {
+		values[key(i)] = value(i)
+	}
+	require.NoError(t, db.EndBatch(values))
+
+	for i := 0; i < count; i++ {
+		requireValue(t, db, key(i), value(i))
+	}
+	require.NoError(t, db.EndBatch(nil))
+}
+
+func testTxn(t *testing.T, open Open) {
+	db, _ := openNew(t, open)
+	require.NoError(t, db.Put(key(1), value(1)))
+
+	// Writes are visible to the transaction but not the store until the
+	// transaction is committed
+	txn := db.Begin()
+	requireValue(t, txn, key(1), value(1))
+	txn.Put(key(2), value(2))
+	txn.PutAll(map[storage.Key][]byte{key(3): value(3)})
+	requireValue(t, txn, key(2), value(2))
+	requireValue(t, txn, key(3), value(3))
+	requireNotFound(t, db, key(2))
+	require.NoError(t, txn.Commit())
+	requireValue(t, db, key(2), value(2))
+	requireValue(t, db, key(3), value(3))
+
+	// Discarded writes are lost
+	txn = db.Begin()
+	txn.Put(key(4), value(4))
+	txn.Discard()
+	requireNotFound(t, db, key(4))
+}
+
+func testForEach(t *testing.T, open Open) {
+	db, _ := openNew(t, open)
+
+	// An empty store
+	require.NoError(t, db.ForEach(func(storage.Key, []byte) error {
+		return errors.New("empty store has an entry")
+	}))
+
+	const count = 100
+	for i := 0; i < count; i++ {
+		require.NoError(t, db.Put(key(i), value(i)))
+	}
+
+	// Every entry is visited exactly once
+	seen := map[storage.Key]string{}
+	require.NoError(t, db.ForEach(func(k storage.Key, v []byte) error {
+		_, ok := seen[k]
+		require.Falsef(t, ok, "%v was visited twice", k)
+		seen[k] = string(v)
+		return nil
+	}))
+	require.Len(t, seen, count)
+	for i := 0; i < count; i++ {
+		require.Equal(t, string(value(i)), seen[key(i)])
+	}
+
+	// Iteration stops at the first error
+	var visited int
+	errStop := errors.New("stop")
+	err := db.ForEach(func(storage.Key, []byte) error {
+		visited++
+		return errStop
+	})
+	require.ErrorIs(t, err, errStop)
+	require.Equal(t, 1, visited)
+}
+
+func testCopy(t *testing.T, open Open) {
+	src, _ := openNew(t, open)
+	dst, _ := openNew(t, open)
+
+	const count = 100
+	for i := 0; i < count; i++ {
+		require.NoError(t, src.Put(key(i), value(i)))
+	}
+
+	require.NoError(t, storage.Copy(dst, src, 7))
+	for i := 0; i < count; i++ {
+		requireValue(t, dst, key(i), value(i))
+	}
+}
+
+func testClosed(t *testing.T, open Open) {
+	db, _ := openNew(t, open)
+	require.NoError(t, db.Put(key(1), value(1)))
+	require.NoError(t, db.Close())
+
+	_, err := db.Get(key(1))
+	require.ErrorIs(t, err, storage.ErrNotOpen)
+	require.ErrorIs(t, db.Put(key(1), value(1)), storage.ErrNotOpen)
+	require.ErrorIs(t, db.EndBatch(map[storage.Key][]byte{key(1): value(1)}), storage.ErrNotOpen)
+	require.ErrorIs(t, db.ForEach(func(storage.Key, []byte) error { return nil }), storage.ErrNotOpen)
+}
+
+func testReopen(t *testing.T, open Open) {
+	db, path := openNew(t, open)
+	require.NoError(t, db.Put(key(1), value(1)))
+	require.NoError(t, db.EndBatch(map[storage.Key][]byte{key(2): value(2)}))
+	require.NoError(t, db.Close())
+
+	db, err := open(path)
+	require.NoError(t, err)
+	defer db.Close()
+	requireValue(t, db, key(1), value(1))
+	requireValue(t, db, key(2), value(2))
+}
@@ -4,4 +4,14 @@ import "gitlab.com/accumulatenetwork/accumulate/types"
 
 //go:generate go run ../../../tools/cmd/gen-types --package query types.yml
 
-func (*RequestKeyPageIndex) Type() types.QueryType { return types.QueryTypeKeyPageIndex }
+func (*RequestKeyPageIndex) Type() types.QueryType      { return types.QueryTypeKeyPageIndex }
+func (*RequestPendingSignatures) Type() types.QueryType { return types.QueryTypePendingSignatures }
+func (*RequestSignatureStatus) Type() types.QueryType   { return types.QueryTypeSignatureStatus }
+func (*RequestProof) Type() types.QueryType             { return types.QueryTypeProof }
+func (*RequestSyntheticOutbox) Type() types.QueryType   { return types.QueryTypeSyntheticOutbox }
+func (*RequestBlock) Type() types.QueryType             { return types.QueryTypeBlock }
+func (*RequestBlockRange) Type() types.QueryType        { return types.QueryTypeBlockRange }
+func (*RequestKey) Type() types.QueryType               { return types.QueryTypeKey }
+func (*RequestOracle) Type() types.QueryType            { return types.QueryTypeOracle }
+func (*RequestSimulate) Type() types.QueryType          { return types.QueryTypeSimulate }
+func (*RequestAnchorProof) Type() types.QueryType       { return types.QueryTypeAnchorProof }
@@ -9,12 +9,27 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"gitlab.com/accumulatenetwork/accumulate/internal/encoding"
 	"gitlab.com/accumulatenetwork/accumulate/internal/url"
 	"gitlab.com/accumulatenetwork/accumulate/protocol"
 )
 
+type AccountChange struct {
+	fieldsSet []bool
+	Url       *url.URL `json:"url,omitempty" form:"url" query:"url" validate:"required"`
+	Before    []byte   `json:"before,omitempty" form:"before" query:"before"`
+	After     []byte   `json:"after,omitempty" form:"after" query:"after" validate:"required"`
+}
+
+type KeyAccount struct {
+	fieldsSet []bool
+	Url       *url.URL             `json:"url,omitempty" form:"url" query:"url" validate:"required"`
+	Type      protocol.AccountType `json:"type,omitempty" form:"type" query:"type" validate:"required"`
+	KeyBook   *url.URL             `json:"keyBook,omitempty" form:"keyBook" query:"keyBook"`
+}
+
 type MultiResponse struct {
 	fieldsSet []bool
 	Type      string   `json:"type,omitempty" form:"type" query:"type" validate:"required"`
@@ -24,12 +39,100 @@ type MultiResponse struct {
 	Total     uint64   `json:"total" form:"total" query:"total" validate:"required"`
 }
 
+type OraclePrice struct {
+	fieldsSet []bool
+	Block     uint64    `json:"block,omitempty" form:"block" query:"block" validate:"required"`
+	Time      time.Time `json:"time,omitempty" form:"time" query:"time" validate:"required"`
+	Price     uint64    `json:"price,omitempty" form:"price" query:"price" validate:"required"`
+}
+
+type RequestAnchorProof struct {
+	fieldsSet []bool
+	Anchor    []byte `json:"anchor,omitempty" form:"anchor" query:"anchor" validate:"required"`
+}
+
+type RequestBlock struct {
+	fieldsSet []bool
+	Height    uint64 `json:"height,omitempty" form:"height" query:"height" validate:"required"`
+}
+
+type RequestBlockRange struct {
+	fieldsSet []bool
+	Start     uint64 `json:"start,omitempty" form:"start" query:"start" validate:"required"`
+	Count     uint64 `json:"count,omitempty" form:"count" query:"count" validate:"required"`
+}
+
+type RequestKey struct {
+	fieldsSet []bool
+	KeyHash   [32]byte `json:"keyHash,omitempty" form:"keyHash" query:"keyHash" validate:"required"`
+}
+
 type RequestKeyPageIndex struct {
 	fieldsSet []bool
 	Url       *url.URL `json:"url,omitempty" form:"url" query:"url" validate:"required"`
 	Key       []byte   `json:"key,omitempty" form:"key" query:"key" validate:"required"`
 }
 
+type RequestOracle struct {
+	fieldsSet []bool
+	Start     uint64 `json:"start,omitempty" form:"start" query:"start" validate:"required"`
+	Count     uint64 `json:"count,omitempty" form:"count" query:"count" validate:"required"`
+}
+
+type RequestPendingSignatures struct {
+	fieldsSet []bool
+	Url       *url.URL `json:"url,omitempty" form:"url" query:"url" validate:"required"`
+}
+
+type RequestProof struct {
+	fieldsSet []bool
+	Url       *url.URL `json:"url,omitempty" form:"url" query:"url"`
+	TxId      []byte   `json:"txId,omitempty" form:"txId" query:"txId"`
+}
+
+type RequestSignatureStatus struct {
+	fieldsSet []bool
+	TxId      [32]byte `json:"txId,omitempty" form:"txId" query:"txId" validate:"required"`
+}
+
+type RequestSimulate struct {
+	fieldsSet []bool
+	Envelope  []byte `json:"envelope,omitempty" form:"envelope" query:"envelope" validate:"required"`
+}
+
+type RequestSyntheticOutbox struct {
+	fieldsSet []bool
+	MinAge    uint64 `json:"minAge,omitempty" form:"minAge" query:"minAge"`
+}
+
+type ResponseAnchorProof struct {
+	fieldsSet    []bool
+	Anchor       []byte           `json:"anchor,omitempty" form:"anchor" query:"anchor" validate:"required"`
+	Account      *url.URL         `json:"account,omitempty" form:"account" query:"account" validate:"required"`
+	Chain        string           `json:"chain,omitempty" form:"chain" query:"chain" validate:"required"`
+	Block        uint64           `json:"block,omitempty" form:"block" query:"block" validate:"required"`
+	ChainReceipt protocol.Receipt `json:"chainReceipt,omitempty" form:"chainReceipt" query:"chainReceipt" validate:"required"`
+	State        []byte           `json:"state,omitempty" form:"state" query:"state" validate:"required"`
+	ChainAnchors [][]byte         `json:"chainAnchors,omitempty" form:"chainAnchors" query:"chainAnchors" validate:"required"`
+	StateReceipt protocol.Receipt `json:"stateReceipt,omitempty" form:"stateReceipt" query:"stateReceipt" validate:"required"`
+}
+
+type ResponseBlock struct {
+	fieldsSet             []bool
+	Height                uint64                    `json:"height,omitempty" form:"height" query:"height" validate:"required"`
+	Time                  time.Time                 `json:"time,omitempty" form:"time" query:"time" validate:"required"`
+	RootAnchor            [32]byte                  `json:"rootAnchor,omitempty" form:"rootAnchor" query:"rootAnchor" validate:"required"`
+	Updates               []protocol.AnchorMetadata `json:"updates,omitempty" form:"updates" query:"updates" validate:"required"`
+	Transactions          [][32]byte                `json:"transactions,omitempty" form:"transactions" query:"transactions" validate:"required"`
+	SyntheticTransactions [][32]byte                `json:"syntheticTransactions,omitempty" form:"syntheticTransactions" query:"syntheticTransactions" validate:"required"`
+}
+
+type ResponseBlockRange struct {
+	fieldsSet []bool
+	Blocks    []*ResponseBlock `json:"blocks,omitempty" form:"blocks" query:"blocks" validate:"required"`
+	Total     uint64           `json:"total,omitempty" form:"total" query:"total" validate:"required"`
+}
+
 type ResponseByTxId struct {
 	fieldsSet          []bool
 	TxId               [32]byte     `json:"txId,omitempty" form:"txId" query:"txId" validate:"required"`
@@ -58,6 +161,11 @@ type ResponseChainRange struct {
 	Entries   [][]byte `json:"entries,omitempty" form:"entries" query:"entries" validate:"required"`
 }
 
+type ResponseKey struct {
+	fieldsSet []bool
+	Accounts  []*KeyAccount `json:"accounts,omitempty" form:"accounts" query:"accounts" validate:"required"`
+}
+
 type ResponseKeyPageIndex struct {
 	fieldsSet []bool
 	KeyBook   *url.URL `json:"keyBook,omitempty" form:"keyBook" query:"keyBook" validate:"required"`
@@ -65,11 +173,69 @@ type ResponseKeyPageIndex struct {
 	Index     uint64   `json:"index" form:"index" query:"index" validate:"required"`
 }
 
+type ResponseOracle struct {
+	fieldsSet []bool
+	Active    uint64                       `json:"active,omitempty" form:"active" query:"active" validate:"required"`
+	Pending   uint64                       `json:"pending,omitempty" form:"pending" query:"pending" validate:"required"`
+	Sources   []*url.URL                   `json:"sources,omitempty" form:"sources" query:"sources" validate:"required"`
+	Feeds     []*protocol.OracleSubmission `json:"feeds,omitempty" form:"feeds" query:"feeds" validate:"required"`
+	History   []*OraclePrice               `json:"history,omitempty" form:"history" query:"history" validate:"required"`
+	Total     uint64                       `json:"total,omitempty" form:"total" query:"total" validate:"required"`
+}
+
 type ResponsePending struct {
 	fieldsSet    []bool
 	Transactions [][32]byte `json:"transactions,omitempty" form:"transactions" query:"transactions" validate:"required"`
 }
 
+type ResponsePendingSignatures struct {
+	fieldsSet    []bool
+	Url          *url.URL                   `json:"url,omitempty" form:"url" query:"url" validate:"required"`
+	Transactions []*ResponseSignatureStatus `json:"transactions,omitempty" form:"transactions" query:"transactions" validate:"required"`
+}
+
+type ResponseProof struct {
+	fieldsSet       []bool
+	Account         *url.URL            `json:"account,omitempty" form:"account" query:"account" validate:"required"`
+	Chain           string              `json:"chain,omitempty" form:"chain" query:"chain" validate:"required"`
+	Start           []byte              `json:"start,omitempty" form:"start" query:"start" validate:"required"`
+	LocalBlock      uint64              `json:"localBlock,omitempty" form:"localBlock" query:"localBlock" validate:"required"`
+	DirectoryBlock  uint64              `json:"directoryBlock,omitempty" form:"directoryBlock" query:"directoryBlock" validate:"required"`
+	DirectoryAnchor []byte              `json:"directoryAnchor,omitempty" form:"directoryAnchor" query:"directoryAnchor" validate:"required"`
+	Segments        []*protocol.Receipt `json:"segments,omitempty" form:"segments" query:"segments" validate:"required"`
+	Receipt         protocol.Receipt    `json:"receipt,omitempty" form:"receipt" query:"receipt" validate:"required"`
+	State           []byte              `json:"state,omitempty" form:"state" query:"state"`
+	ChainAnchors    [][]byte            `json:"chainAnchors,omitempty" form:"chainAnchors" query:"chainAnchors" validate:"required"`
+	Transaction     []byte              `json:"transaction,omitempty" form:"transaction" query:"transaction"`
+}
+
+type ResponseSignatureStatus struct {
+	fieldsSet   []bool
+	TxId        [32]byte `json:"txId,omitempty" form:"txId" query:"txId" validate:"required"`
+	Origin      *url.URL `json:"origin,omitempty" form:"origin" query:"origin" validate:"required"`
+	KeyPage     *url.URL `json:"keyPage,omitempty" form:"keyPage" query:"keyPage" validate:"required"`
+	Threshold   uint64   `json:"threshold,omitempty" form:"threshold" query:"threshold" validate:"required"`
+	Signed      [][]byte `json:"signed,omitempty" form:"signed" query:"signed" validate:"required"`
+	Unsigned    [][]byte `json:"unsigned,omitempty" form:"unsigned" query:"unsigned" validate:"required"`
+	Invalidated bool     `json:"invalidated,omitempty" form:"invalidated" query:"invalidated" validate:"required"`
+}
+
+type ResponseSimulate struct {
+	fieldsSet []bool
+	Fee       uint64           `json:"fee,omitempty" form:"fee" query:"fee" validate:"required"`
+	Pending   bool             `json:"pending,omitempty" form:"pending" query:"pending" validate:"required"`
+	Result    []byte           `json:"result,omitempty" form:"result" query:"result" validate:"required"`
+	Changes   []*AccountChange `json:"changes,omitempty" form:"changes" query:"changes" validate:"required"`
+	Synthetic [][]byte         `json:"synthetic,omitempty" form:"synthetic" query:"synthetic" validate:"required"`
+}
+
+type ResponseSyntheticOutbox struct {
+	fieldsSet    []bool
+	Block        uint64                       `json:"block,omitempty" form:"block" query:"block" validate:"required"`
+	Time         time.Time                    `json:"time,omitempty" form:"time" query:"time" validate:"required"`
+	Transactions []*StuckSyntheticTransaction `json:"transactions,omitempty" form:"transactions" query:"transactions" validate:"required"`
+}
+
 type ResponseTxHistory struct {
 	fieldsSet    []bool
 	Start        int64            `json:"start" form:"start" query:"start" validate:"required"`
@@ -78,6 +244,19 @@ type ResponseTxHistory struct {
 	Transactions []ResponseByTxId `json:"transactions,omitempty" form:"transactions" query:"transactions" validate:"required"`
 }
 
+type StuckSyntheticTransaction struct {
+	fieldsSet     []bool
+	Transaction   [32]byte                 `json:"transaction,omitempty" form:"transaction" query:"transaction" validate:"required"`
+	Source        *url.URL                 `json:"source,omitempty" form:"source" query:"source" validate:"required"`
+	Type          protocol.TransactionType `json:"type,omitempty" form:"type" query:"type" validate:"required"`
+	Destination   *url.URL                 `json:"destination,omitempty" form:"destination" query:"destination" validate:"required"`
+	ProducedBlock uint64                   `json:"producedBlock,omitempty" form:"producedBlock" query:"producedBlock" validate:"required"`
+	ProducedTime  time.Time                `json:"producedTime,omitempty" form:"producedTime" query:"producedTime" validate:"required"`
+	Age           uint64                   `json:"age,omitempty" form:"age" query:"age" validate:"required"`
+	Attempts      uint64                   `json:"attempts,omitempty" form:"attempts" query:"attempts" validate:"required"`
+	NextAttempt   uint64                   `json:"nextAttempt,omitempty" form:"nextAttempt" query:"nextAttempt" validate:"required"`
+}
+
 type TxReceipt struct {
 	fieldsSet      []bool
 	Account        *url.URL         `json:"account,omitempty" form:"account" query:"account" validate:"required"`
@@ -86,6 +265,83 @@ type TxReceipt struct {
 	Receipt        protocol.Receipt `json:"receipt,omitempty" form:"receipt" query:"receipt" validate:"required"`
 }
 
+func (v *AccountChange) Equal(u *AccountChange) bool {
+	if !((v.Url).Equal(u.Url)) {
+		return false
+	}
+	if !(bytes.Equal(v.Before, u.Before)) {
+		return false
+	}
+	if !(bytes.Equal(v.After, u.After)) {
+		return false
+	}
+
+	return true
+}
+
+func (v *KeyAccount) Equal(u *KeyAccount) bool {
+	if !((v.Url).Equal(u.Url)) {
+		return false
+	}
+	if !(v.Type == u.Type) {
+		return false
+	}
+	if !((v.KeyBook).Equal(u.KeyBook)) {
+		return false
+	}
+
+	return true
+}
+
+func (v *OraclePrice) Equal(u *OraclePrice) bool {
+	if !(v.Block == u.Block) {
+		return false
+	}
+	if !(v.Time == u.Time) {
+		return false
+	}
+	if !(v.Price == u.Price) {
+		return false
+	}
+
+	return true
+}
+
+func (v *RequestAnchorProof) Equal(u *RequestAnchorProof) bool {
+	if !(bytes.Equal(v.Anchor, u.Anchor)) {
+		return false
+	}
+
+	return true
+}
+
+func (v *RequestBlock) Equal(u *RequestBlock) bool {
+	if !(v.Height == u.Height) {
+		return false
+	}
+
+	return true
+}
+
+func (v *RequestBlockRange) Equal(u *RequestBlockRange) bool {
+	if !(v.Start == u.Start) {
+		return false
+	}
+	if !(v.Count == u.Count) {
+		return false
+	}
+
+	return true
+}
+
+func (v *RequestKey) Equal(u *RequestKey) bool {
+	if !(v.KeyHash == u.KeyHash) {
+		return false
+	}
+
+	return true
+}
+
 func (v *RequestKeyPageIndex) Equal(u *RequestKeyPageIndex) bool {
 	if !((v.Url).Equal(u.Url)) {
 		return false
@@ -97,6 +353,148 @@ func (v *RequestKeyPageIndex) Equal(u *RequestKeyPageIndex) bool {
 	return true
 }
 
+func (v *RequestOracle) Equal(u *RequestOracle) bool {
+	if !(v.Start == u.Start) {
+		return false
+	}
+	if !(v.Count == u.Count) {
+		return false
+	}
+
+	return true
+}
+
+func (v *RequestPendingSignatures) Equal(u *RequestPendingSignatures) bool {
+	if !((v.Url).Equal(u.Url)) {
+		return false
+	}
+
+	return true
+}
+
+func (v *RequestProof) Equal(u *RequestProof) bool {
+	if !((v.Url).Equal(u.Url)) {
+		return false
+	}
+	if !(bytes.Equal(v.TxId, u.TxId)) {
+		return false
+	}
+
+	return true
+}
+
+func (v *RequestSignatureStatus) Equal(u *RequestSignatureStatus) bool {
+	if !(v.TxId == u.TxId) {
+		return false
+	}
+
+	return true
+}
+
+func (v *RequestSimulate) Equal(u *RequestSimulate) bool {
+	if !(bytes.Equal(v.Envelope, u.Envelope)) {
+		return false
+	}
+
+	return true
+}
+
+func (v *RequestSyntheticOutbox) Equal(u *RequestSyntheticOutbox) bool {
+	if !(v.MinAge == u.MinAge) {
+		return false
+	}
+
+	return true
+}
+
+func (v *ResponseAnchorProof) Equal(u *ResponseAnchorProof) bool {
+	if !(bytes.Equal(v.Anchor, u.Anchor)) {
+		return false
+	}
+	if !((v.Account).Equal(u.Account)) {
+		return false
+	}
+	if !(v.Chain == u.Chain) {
+		return false
+	}
+	if !(v.Block == u.Block) {
+		return false
+	}
+	if !((&v.ChainReceipt).Equal(&u.ChainReceipt)) {
+		return false
+	}
+	if !(bytes.Equal(v.State, u.State)) {
+		return false
+	}
+	if len(v.ChainAnchors) != len(u.ChainAnchors) {
+		return false
+	}
+	for i := range v.ChainAnchors {
+		if !(bytes.Equal(v.ChainAnchors[i], u.ChainAnchors[i])) {
+			return false
+		}
+	}
+	if !((&v.StateReceipt).Equal(&u.StateReceipt)) {
+		return false
+	}
+
+	return true
+}
+
+func (v *ResponseBlock) Equal(u *ResponseBlock) bool {
+	if !(v.Height == u.Height) {
+		return false
+	}
+	if !(v.Time == u.Time) {
+		return false
+	}
+	if !(v.RootAnchor == u.RootAnchor) {
+		return false
+	}
+	if len(v.Updates) != len(u.Updates) {
+		return false
+	}
+	for i := range v.Updates {
+		if !((&v.Updates[i]).Equal(&u.Updates[i])) {
+			return false
+		}
+	}
+	if len(v.Transactions) != len(u.Transactions) {
+		return false
+	}
+	for i := range v.Transactions {
+		if !(v.Transactions[i] == u.Transactions[i]) {
+			return false
+		}
+	}
+	if len(v.SyntheticTransactions) != len(u.SyntheticTransactions) {
+		return false
+	}
+	for i := range v.SyntheticTransactions {
+		if !(v.SyntheticTransactions[i] == u.SyntheticTransactions[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *ResponseBlockRange) Equal(u *ResponseBlockRange) bool {
+	if len(v.Blocks) != len(u.Blocks) {
+		return false
+	}
+	for i := range v.Blocks {
+		if !((v.Blocks[i]).Equal(u.Blocks[i])) {
+			return false
+		}
+	}
+	if !(v.Total == u.Total) {
+		return false
+	}
+
+	return true
+}
+
 func (v *ResponseByTxId) Equal(u *ResponseByTxId) bool {
 	if !(v.TxId == u.TxId) {
 		return false
@@ -180,6 +578,19 @@ func (v *ResponseChainRange) Equal(u *ResponseChainRange) bool {
 	return true
 }
 
+func (v *ResponseKey) Equal(u *ResponseKey) bool {
+	if len(v.Accounts) != len(u.Accounts) {
+		return false
+	}
+	for i := range v.Accounts {
+		if !((v.Accounts[i]).Equal(u.Accounts[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (v *ResponseKeyPageIndex) Equal(u *ResponseKeyPageIndex) bool {
 	if !((v.KeyBook).Equal(u.KeyBook)) {
 		return false
@@ -194,14 +605,201 @@ func (v *ResponseKeyPageIndex) Equal(u *ResponseKeyPageIndex) bool {
 	return true
 }
 
-func (v *ResponsePending) Equal(u *ResponsePending) bool {
-	if len(v.Transactions) != len(u.Transactions) {
+func (v *ResponseOracle) Equal(u *ResponseOracle) bool {
+	if !(v.Active == u.Active) {
 		return false
 	}
-	for i := range v.Transactions {
-		if !(v.Transactions[i] == u.Transactions[i]) {
-			return false
-		}
+	if !(v.Pending == u.Pending) {
+		return false
+	}
+	if len(v.Sources) != len(u.Sources) {
+		return false
+	}
+	for i := range v.Sources {
+		if !((v.Sources[i]).Equal(u.Sources[i])) {
+			return false
+		}
+	}
+	if len(v.Feeds) != len(u.Feeds) {
+		return false
+	}
+	for i := range v.Feeds {
+		if !((v.Feeds[i]).Equal(u.Feeds[i])) {
+			return false
+		}
+	}
+	if len(v.History) != len(u.History) {
+		return false
+	}
+	for i := range v.History {
+		if !((v.History[i]).Equal(u.History[i])) {
+			return false
+		}
+	}
+	if !(v.Total == u.Total) {
+		return false
+	}
+
+	return true
+}
+
+func (v *ResponsePending) Equal(u *ResponsePending) bool {
+	if len(v.Transactions) != len(u.Transactions) {
+		return false
+	}
+	for i := range v.Transactions {
+		if !(v.Transactions[i] == u.Transactions[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *ResponsePendingSignatures) Equal(u *ResponsePendingSignatures) bool {
+	if !((v.Url).Equal(u.Url)) {
+		return false
+	}
+	if len(v.Transactions) != len(u.Transactions) {
+		return false
+	}
+	for i := range v.Transactions {
+		if !((v.Transactions[i]).Equal(u.Transactions[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *ResponseProof) Equal(u *ResponseProof) bool {
+	if !((v.Account).Equal(u.Account)) {
+		return false
+	}
+	if !(v.Chain == u.Chain) {
+		return false
+	}
+	if !(bytes.Equal(v.Start, u.Start)) {
+		return false
+	}
+	if !(v.LocalBlock == u.LocalBlock) {
+		return false
+	}
+	if !(v.DirectoryBlock == u.DirectoryBlock) {
+		return false
+	}
+	if !(bytes.Equal(v.DirectoryAnchor, u.DirectoryAnchor)) {
+		return false
+	}
+	if len(v.Segments) != len(u.Segments) {
+		return false
+	}
+	for i := range v.Segments {
+		if !((v.Segments[i]).Equal(u.Segments[i])) {
+			return false
+		}
+	}
+	if !((&v.Receipt).Equal(&u.Receipt)) {
+		return false
+	}
+	if !(bytes.Equal(v.State, u.State)) {
+		return false
+	}
+	if len(v.ChainAnchors) != len(u.ChainAnchors) {
+		return false
+	}
+	for i := range v.ChainAnchors {
+		if !(bytes.Equal(v.ChainAnchors[i], u.ChainAnchors[i])) {
+			return false
+		}
+	}
+	if !(bytes.Equal(v.Transaction, u.Transaction)) {
+		return false
+	}
+
+	return true
+}
+
+func (v *ResponseSignatureStatus) Equal(u *ResponseSignatureStatus) bool {
+	if !(v.TxId == u.TxId) {
+		return false
+	}
+	if !((v.Origin).Equal(u.Origin)) {
+		return false
+	}
+	if !((v.KeyPage).Equal(u.KeyPage)) {
+		return false
+	}
+	if !(v.Threshold == u.Threshold) {
+		return false
+	}
+	if len(v.Signed) != len(u.Signed) {
+		return false
+	}
+	for i := range v.Signed {
+		if !(bytes.Equal(v.Signed[i], u.Signed[i])) {
+			return false
+		}
+	}
+	if len(v.Unsigned) != len(u.Unsigned) {
+		return false
+	}
+	for i := range v.Unsigned {
+		if !(bytes.Equal(v.Unsigned[i], u.Unsigned[i])) {
+			return false
+		}
+	}
+	if !(v.Invalidated == u.Invalidated) {
+		return false
+	}
+
+	return true
+}
+
+func (v *ResponseSimulate) Equal(u *ResponseSimulate) bool {
+	if !(v.Fee == u.Fee) {
+		return false
+	}
+	if !(v.Pending == u.Pending) {
+		return false
+	}
+	if !(bytes.Equal(v.Result, u.Result)) {
+		return false
+	}
+	if len(v.Changes) != len(u.Changes) {
+		return false
+	}
+	for i := range v.Changes {
+		if !((v.Changes[i]).Equal(u.Changes[i])) {
+			return false
+		}
+	}
+	if len(v.Synthetic) != len(u.Synthetic) {
+		return false
+	}
+	for i := range v.Synthetic {
+		if !(bytes.Equal(v.Synthetic[i], u.Synthetic[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *ResponseSyntheticOutbox) Equal(u *ResponseSyntheticOutbox) bool {
+	if !(v.Block == u.Block) {
+		return false
+	}
+	if !(v.Time == u.Time) {
+		return false
+	}
+	if len(v.Transactions) != len(u.Transactions) {
+		return false
+	}
+	for i := range v.Transactions {
+		if !((v.Transactions[i]).Equal(u.Transactions[i])) {
+			return false
+		}
 	}
 
 	return true
@@ -229,6 +827,38 @@ func (v *ResponseTxHistory) Equal(u *ResponseTxHistory) bool {
 	return true
 }
 
+func (v *StuckSyntheticTransaction) Equal(u *StuckSyntheticTransaction) bool {
+	if !(v.Transaction == u.Transaction) {
+		return false
+	}
+	if !((v.Source).Equal(u.Source)) {
+		return false
+	}
+	if !(v.Type == u.Type) {
+		return false
+	}
+	if !((v.Destination).Equal(u.Destination)) {
+		return false
+	}
+	if !(v.ProducedBlock == u.ProducedBlock) {
+		return false
+	}
+	if !(v.ProducedTime == u.ProducedTime) {
+		return false
+	}
+	if !(v.Age == u.Age) {
+		return false
+	}
+	if !(v.Attempts == u.Attempts) {
+		return false
+	}
+	if !(v.NextAttempt == u.NextAttempt) {
+		return false
+	}
+
+	return true
+}
+
 func (v *TxReceipt) Equal(u *TxReceipt) bool {
 	if !((v.Account).Equal(u.Account)) {
 		return false
@@ -246,6 +876,102 @@ func (v *TxReceipt) Equal(u *TxReceipt) bool {
 	return true
 }
 
+var fieldNames_AccountChange = []string{
+	1: "Url",
+	2: "Before",
+	3: "After",
+}
+
+func (v *AccountChange) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Url == nil) {
+		writer.WriteUrl(1, v.Url)
+	}
+	if !(len(v.Before) == 0) {
+		writer.WriteBytes(2, v.Before)
+	}
+	if !(len(v.After) == 0) {
+		writer.WriteBytes(3, v.After)
+	}
+
+	_, _, err := writer.Reset(fieldNames_AccountChange)
+	return buffer.Bytes(), err
+}
+
+func (v *AccountChange) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Url is missing")
+	} else if v.Url == nil {
+		errs = append(errs, "field Url is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field After is missing")
+	} else if len(v.After) == 0 {
+		errs = append(errs, "field After is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_KeyAccount = []string{
+	1: "Url",
+	2: "Type",
+	3: "KeyBook",
+}
+
+func (v *KeyAccount) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Url == nil) {
+		writer.WriteUrl(1, v.Url)
+	}
+	if !(v.Type == 0) {
+		writer.WriteEnum(2, v.Type)
+	}
+	if !(v.KeyBook == nil) {
+		writer.WriteUrl(3, v.KeyBook)
+	}
+
+	_, _, err := writer.Reset(fieldNames_KeyAccount)
+	return buffer.Bytes(), err
+}
+
+func (v *KeyAccount) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Url is missing")
+	} else if v.Url == nil {
+		errs = append(errs, "field Url is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Type is missing")
+	} else if v.Type == 0 {
+		errs = append(errs, "field Type is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
 var fieldNames_MultiResponse = []string{
 	1: "Type",
 	2: "Items",
@@ -313,38 +1039,47 @@ func (v *MultiResponse) IsValid() error {
 	}
 }
 
-var fieldNames_RequestKeyPageIndex = []string{
-	1: "Url",
-	2: "Key",
+var fieldNames_OraclePrice = []string{
+	1: "Block",
+	2: "Time",
+	3: "Price",
 }
 
-func (v *RequestKeyPageIndex) MarshalBinary() ([]byte, error) {
+func (v *OraclePrice) MarshalBinary() ([]byte, error) {
 	buffer := new(bytes.Buffer)
 	writer := encoding.NewWriter(buffer)
 
-	if !(v.Url == nil) {
-		writer.WriteUrl(1, v.Url)
+	if !(v.Block == 0) {
+		writer.WriteUint(1, v.Block)
 	}
-	if !(len(v.Key) == 0) {
-		writer.WriteBytes(2, v.Key)
+	if !(v.Time == (time.Time{})) {
+		writer.WriteTime(2, v.Time)
+	}
+	if !(v.Price == 0) {
+		writer.WriteUint(3, v.Price)
 	}
 
-	_, _, err := writer.Reset(fieldNames_RequestKeyPageIndex)
+	_, _, err := writer.Reset(fieldNames_OraclePrice)
 	return buffer.Bytes(), err
 }
 
-func (v *RequestKeyPageIndex) IsValid() error {
+func (v *OraclePrice) IsValid() error {
 	var errs []string
 
 	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
-		errs = append(errs, "field Url is missing")
-	} else if v.Url == nil {
-		errs = append(errs, "field Url is not set")
+		errs = append(errs, "field Block is missing")
+	} else if v.Block == 0 {
+		errs = append(errs, "field Block is not set")
 	}
 	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
-		errs = append(errs, "field Key is missing")
-	} else if len(v.Key) == 0 {
-		errs = append(errs, "field Key is not set")
+		errs = append(errs, "field Time is missing")
+	} else if v.Time == (time.Time{}) {
+		errs = append(errs, "field Time is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Price is missing")
+	} else if v.Price == 0 {
+		errs = append(errs, "field Price is not set")
 	}
 
 	switch len(errs) {
@@ -357,103 +1092,29 @@ func (v *RequestKeyPageIndex) IsValid() error {
 	}
 }
 
-var fieldNames_ResponseByTxId = []string{
-	1: "TxId",
-	2: "TxState",
-	3: "TxPendingState",
-	4: "TxSynthTxIds",
-	5: "Height",
-	6: "ChainState",
-	7: "Receipts",
-	8: "SignatureThreshold",
-	9: "Invalidated",
+var fieldNames_RequestAnchorProof = []string{
+	1: "Anchor",
 }
 
-func (v *ResponseByTxId) MarshalBinary() ([]byte, error) {
+func (v *RequestAnchorProof) MarshalBinary() ([]byte, error) {
 	buffer := new(bytes.Buffer)
 	writer := encoding.NewWriter(buffer)
 
-	if !(v.TxId == ([32]byte{})) {
-		writer.WriteHash(1, &v.TxId)
-	}
-	if !(len(v.TxState) == 0) {
-		writer.WriteBytes(2, v.TxState)
-	}
-	if !(len(v.TxPendingState) == 0) {
-		writer.WriteBytes(3, v.TxPendingState)
-	}
-	if !(len(v.TxSynthTxIds) == 0) {
-		writer.WriteBytes(4, v.TxSynthTxIds)
-	}
-	writer.WriteInt(5, v.Height)
-	if !(len(v.ChainState) == 0) {
-		for _, v := range v.ChainState {
-			writer.WriteBytes(6, v)
-		}
-	}
-	if !(len(v.Receipts) == 0) {
-		for _, v := range v.Receipts {
-			writer.WriteValue(7, v)
-		}
-	}
-	if !(v.SignatureThreshold == 0) {
-		writer.WriteUint(8, v.SignatureThreshold)
-	}
-	if !(!v.Invalidated) {
-		writer.WriteBool(9, v.Invalidated)
+	if !(len(v.Anchor) == 0) {
+		writer.WriteBytes(1, v.Anchor)
 	}
 
-	_, _, err := writer.Reset(fieldNames_ResponseByTxId)
+	_, _, err := writer.Reset(fieldNames_RequestAnchorProof)
 	return buffer.Bytes(), err
 }
 
-func (v *ResponseByTxId) IsValid() error {
+func (v *RequestAnchorProof) IsValid() error {
 	var errs []string
 
 	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
-		errs = append(errs, "field TxId is missing")
-	} else if v.TxId == ([32]byte{}) {
-		errs = append(errs, "field TxId is not set")
-	}
-	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
-		errs = append(errs, "field TxState is missing")
-	} else if len(v.TxState) == 0 {
-		errs = append(errs, "field TxState is not set")
-	}
-	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
-		errs = append(errs, "field TxPendingState is missing")
-	} else if len(v.TxPendingState) == 0 {
-		errs = append(errs, "field TxPendingState is not set")
-	}
-	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
-		errs = append(errs, "field TxSynthTxIds is missing")
-	} else if len(v.TxSynthTxIds) == 0 {
-		errs = append(errs, "field TxSynthTxIds is not set")
-	}
-	if len(v.fieldsSet) > 5 && !v.fieldsSet[5] {
-		errs = append(errs, "field Height is missing")
-	} else if v.Height == 0 {
-		errs = append(errs, "field Height is not set")
-	}
-	if len(v.fieldsSet) > 6 && !v.fieldsSet[6] {
-		errs = append(errs, "field ChainState is missing")
-	} else if len(v.ChainState) == 0 {
-		errs = append(errs, "field ChainState is not set")
-	}
-	if len(v.fieldsSet) > 7 && !v.fieldsSet[7] {
-		errs = append(errs, "field Receipts is missing")
-	} else if len(v.Receipts) == 0 {
-		errs = append(errs, "field Receipts is not set")
-	}
-	if len(v.fieldsSet) > 8 && !v.fieldsSet[8] {
-		errs = append(errs, "field SignatureThreshold is missing")
-	} else if v.SignatureThreshold == 0 {
-		errs = append(errs, "field SignatureThreshold is not set")
-	}
-	if len(v.fieldsSet) > 9 && !v.fieldsSet[9] {
-		errs = append(errs, "field Invalidated is missing")
-	} else if !v.Invalidated {
-		errs = append(errs, "field Invalidated is not set")
+		errs = append(errs, "field Anchor is missing")
+	} else if len(v.Anchor) == 0 {
+		errs = append(errs, "field Anchor is not set")
 	}
 
 	switch len(errs) {
@@ -466,31 +1127,23 @@ func (v *ResponseByTxId) IsValid() error {
 	}
 }
 
-var fieldNames_ResponseChainEntry = []string{
+var fieldNames_RequestBlock = []string{
 	1: "Height",
-	2: "Entry",
-	3: "State",
 }
 
-func (v *ResponseChainEntry) MarshalBinary() ([]byte, error) {
+func (v *RequestBlock) MarshalBinary() ([]byte, error) {
 	buffer := new(bytes.Buffer)
 	writer := encoding.NewWriter(buffer)
 
-	writer.WriteInt(1, v.Height)
-	if !(len(v.Entry) == 0) {
-		writer.WriteBytes(2, v.Entry)
-	}
-	if !(len(v.State) == 0) {
-		for _, v := range v.State {
-			writer.WriteBytes(3, v)
-		}
+	if !(v.Height == 0) {
+		writer.WriteUint(1, v.Height)
 	}
 
-	_, _, err := writer.Reset(fieldNames_ResponseChainEntry)
+	_, _, err := writer.Reset(fieldNames_RequestBlock)
 	return buffer.Bytes(), err
 }
 
-func (v *ResponseChainEntry) IsValid() error {
+func (v *RequestBlock) IsValid() error {
 	var errs []string
 
 	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
@@ -498,16 +1151,6 @@ func (v *ResponseChainEntry) IsValid() error {
 	} else if v.Height == 0 {
 		errs = append(errs, "field Height is not set")
 	}
-	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
-		errs = append(errs, "field Entry is missing")
-	} else if len(v.Entry) == 0 {
-		errs = append(errs, "field Entry is not set")
-	}
-	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
-		errs = append(errs, "field State is missing")
-	} else if len(v.State) == 0 {
-		errs = append(errs, "field State is not set")
-	}
 
 	switch len(errs) {
 	case 0:
@@ -519,31 +1162,27 @@ func (v *ResponseChainEntry) IsValid() error {
 	}
 }
 
-var fieldNames_ResponseChainRange = []string{
+var fieldNames_RequestBlockRange = []string{
 	1: "Start",
-	2: "End",
-	3: "Total",
-	4: "Entries",
+	2: "Count",
 }
 
-func (v *ResponseChainRange) MarshalBinary() ([]byte, error) {
+func (v *RequestBlockRange) MarshalBinary() ([]byte, error) {
 	buffer := new(bytes.Buffer)
 	writer := encoding.NewWriter(buffer)
 
-	writer.WriteInt(1, v.Start)
-	writer.WriteInt(2, v.End)
-	writer.WriteInt(3, v.Total)
-	if !(len(v.Entries) == 0) {
-		for _, v := range v.Entries {
-			writer.WriteBytes(4, v)
-		}
+	if !(v.Start == 0) {
+		writer.WriteUint(1, v.Start)
+	}
+	if !(v.Count == 0) {
+		writer.WriteUint(2, v.Count)
 	}
 
-	_, _, err := writer.Reset(fieldNames_ResponseChainRange)
+	_, _, err := writer.Reset(fieldNames_RequestBlockRange)
 	return buffer.Bytes(), err
 }
 
-func (v *ResponseChainRange) IsValid() error {
+func (v *RequestBlockRange) IsValid() error {
 	var errs []string
 
 	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
@@ -552,19 +1191,9 @@ func (v *ResponseChainRange) IsValid() error {
 		errs = append(errs, "field Start is not set")
 	}
 	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
-		errs = append(errs, "field End is missing")
-	} else if v.End == 0 {
-		errs = append(errs, "field End is not set")
-	}
-	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
-		errs = append(errs, "field Total is missing")
-	} else if v.Total == 0 {
-		errs = append(errs, "field Total is not set")
-	}
-	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
-		errs = append(errs, "field Entries is missing")
-	} else if len(v.Entries) == 0 {
-		errs = append(errs, "field Entries is not set")
+		errs = append(errs, "field Count is missing")
+	} else if v.Count == 0 {
+		errs = append(errs, "field Count is not set")
 	}
 
 	switch len(errs) {
@@ -577,45 +1206,29 @@ func (v *ResponseChainRange) IsValid() error {
 	}
 }
 
-var fieldNames_ResponseKeyPageIndex = []string{
-	1: "KeyBook",
-	2: "KeyPage",
-	3: "Index",
+var fieldNames_RequestKey = []string{
+	1: "KeyHash",
 }
 
-func (v *ResponseKeyPageIndex) MarshalBinary() ([]byte, error) {
+func (v *RequestKey) MarshalBinary() ([]byte, error) {
 	buffer := new(bytes.Buffer)
 	writer := encoding.NewWriter(buffer)
 
-	if !(v.KeyBook == nil) {
-		writer.WriteUrl(1, v.KeyBook)
+	if !(v.KeyHash == ([32]byte{})) {
+		writer.WriteHash(1, &v.KeyHash)
 	}
-	if !(v.KeyPage == nil) {
-		writer.WriteUrl(2, v.KeyPage)
-	}
-	writer.WriteUint(3, v.Index)
 
-	_, _, err := writer.Reset(fieldNames_ResponseKeyPageIndex)
+	_, _, err := writer.Reset(fieldNames_RequestKey)
 	return buffer.Bytes(), err
 }
 
-func (v *ResponseKeyPageIndex) IsValid() error {
+func (v *RequestKey) IsValid() error {
 	var errs []string
 
 	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
-		errs = append(errs, "field KeyBook is missing")
-	} else if v.KeyBook == nil {
-		errs = append(errs, "field KeyBook is not set")
-	}
-	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
-		errs = append(errs, "field KeyPage is missing")
-	} else if v.KeyPage == nil {
-		errs = append(errs, "field KeyPage is not set")
-	}
-	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
-		errs = append(errs, "field Index is missing")
-	} else if v.Index == 0 {
-		errs = append(errs, "field Index is not set")
+		errs = append(errs, "field KeyHash is missing")
+	} else if v.KeyHash == ([32]byte{}) {
+		errs = append(errs, "field KeyHash is not set")
 	}
 
 	switch len(errs) {
@@ -628,31 +1241,38 @@ func (v *ResponseKeyPageIndex) IsValid() error {
 	}
 }
 
-var fieldNames_ResponsePending = []string{
-	1: "Transactions",
+var fieldNames_RequestKeyPageIndex = []string{
+	1: "Url",
+	2: "Key",
 }
 
-func (v *ResponsePending) MarshalBinary() ([]byte, error) {
+func (v *RequestKeyPageIndex) MarshalBinary() ([]byte, error) {
 	buffer := new(bytes.Buffer)
 	writer := encoding.NewWriter(buffer)
 
-	if !(len(v.Transactions) == 0) {
-		for _, v := range v.Transactions {
-			writer.WriteHash(1, &v)
-		}
+	if !(v.Url == nil) {
+		writer.WriteUrl(1, v.Url)
+	}
+	if !(len(v.Key) == 0) {
+		writer.WriteBytes(2, v.Key)
 	}
 
-	_, _, err := writer.Reset(fieldNames_ResponsePending)
+	_, _, err := writer.Reset(fieldNames_RequestKeyPageIndex)
 	return buffer.Bytes(), err
 }
 
-func (v *ResponsePending) IsValid() error {
+func (v *RequestKeyPageIndex) IsValid() error {
 	var errs []string
 
 	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
-		errs = append(errs, "field Transactions is missing")
-	} else if len(v.Transactions) == 0 {
-		errs = append(errs, "field Transactions is not set")
+		errs = append(errs, "field Url is missing")
+	} else if v.Url == nil {
+		errs = append(errs, "field Url is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Key is missing")
+	} else if len(v.Key) == 0 {
+		errs = append(errs, "field Key is not set")
 	}
 
 	switch len(errs) {
@@ -665,31 +1285,27 @@ func (v *ResponsePending) IsValid() error {
 	}
 }
 
-var fieldNames_ResponseTxHistory = []string{
+var fieldNames_RequestOracle = []string{
 	1: "Start",
-	2: "End",
-	3: "Total",
-	4: "Transactions",
+	2: "Count",
 }
 
-func (v *ResponseTxHistory) MarshalBinary() ([]byte, error) {
+func (v *RequestOracle) MarshalBinary() ([]byte, error) {
 	buffer := new(bytes.Buffer)
 	writer := encoding.NewWriter(buffer)
 
-	writer.WriteInt(1, v.Start)
-	writer.WriteInt(2, v.End)
-	writer.WriteInt(3, v.Total)
-	if !(len(v.Transactions) == 0) {
-		for _, v := range v.Transactions {
-			writer.WriteValue(4, &v)
-		}
+	if !(v.Start == 0) {
+		writer.WriteUint(1, v.Start)
+	}
+	if !(v.Count == 0) {
+		writer.WriteUint(2, v.Count)
 	}
 
-	_, _, err := writer.Reset(fieldNames_ResponseTxHistory)
+	_, _, err := writer.Reset(fieldNames_RequestOracle)
 	return buffer.Bytes(), err
 }
 
-func (v *ResponseTxHistory) IsValid() error {
+func (v *RequestOracle) IsValid() error {
 	var errs []string
 
 	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
@@ -698,19 +1314,9 @@ func (v *ResponseTxHistory) IsValid() error {
 		errs = append(errs, "field Start is not set")
 	}
 	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
-		errs = append(errs, "field End is missing")
-	} else if v.End == 0 {
-		errs = append(errs, "field End is not set")
-	}
-	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
-		errs = append(errs, "field Total is missing")
-	} else if v.Total == 0 {
-		errs = append(errs, "field Total is not set")
-	}
-	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
-		errs = append(errs, "field Transactions is missing")
-	} else if len(v.Transactions) == 0 {
-		errs = append(errs, "field Transactions is not set")
+		errs = append(errs, "field Count is missing")
+	} else if v.Count == 0 {
+		errs = append(errs, "field Count is not set")
 	}
 
 	switch len(errs) {
@@ -723,115 +1329,1840 @@ func (v *ResponseTxHistory) IsValid() error {
 	}
 }
 
-var fieldNames_TxReceipt = []string{
-	1: "Account",
-	2: "Chain",
-	3: "DirectoryBlock",
-	4: "Receipt",
+var fieldNames_RequestPendingSignatures = []string{
+	1: "Url",
 }
 
-func (v *TxReceipt) MarshalBinary() ([]byte, error) {
+func (v *RequestPendingSignatures) MarshalBinary() ([]byte, error) {
 	buffer := new(bytes.Buffer)
 	writer := encoding.NewWriter(buffer)
 
-	if !(v.Account == nil) {
-		writer.WriteUrl(1, v.Account)
-	}
-	if !(len(v.Chain) == 0) {
-		writer.WriteString(2, v.Chain)
-	}
-	if !(v.DirectoryBlock == 0) {
-		writer.WriteUint(3, v.DirectoryBlock)
-	}
-	if !((v.Receipt).Equal(new(protocol.Receipt))) {
-		writer.WriteValue(4, &v.Receipt)
+	if !(v.Url == nil) {
+		writer.WriteUrl(1, v.Url)
 	}
 
-	_, _, err := writer.Reset(fieldNames_TxReceipt)
+	_, _, err := writer.Reset(fieldNames_RequestPendingSignatures)
 	return buffer.Bytes(), err
 }
 
-func (v *TxReceipt) IsValid() error {
+func (v *RequestPendingSignatures) IsValid() error {
 	var errs []string
 
 	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
-		errs = append(errs, "field Account is missing")
-	} else if v.Account == nil {
-		errs = append(errs, "field Account is not set")
-	}
-	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Url is missing")
+	} else if v.Url == nil {
+		errs = append(errs, "field Url is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_RequestProof = []string{
+	1: "Url",
+	2: "TxId",
+}
+
+func (v *RequestProof) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Url == nil) {
+		writer.WriteUrl(1, v.Url)
+	}
+	if !(len(v.TxId) == 0) {
+		writer.WriteBytes(2, v.TxId)
+	}
+
+	_, _, err := writer.Reset(fieldNames_RequestProof)
+	return buffer.Bytes(), err
+}
+
+func (v *RequestProof) IsValid() error {
+	var errs []string
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_RequestSignatureStatus = []string{
+	1: "TxId",
+}
+
+func (v *RequestSignatureStatus) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.TxId == ([32]byte{})) {
+		writer.WriteHash(1, &v.TxId)
+	}
+
+	_, _, err := writer.Reset(fieldNames_RequestSignatureStatus)
+	return buffer.Bytes(), err
+}
+
+func (v *RequestSignatureStatus) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field TxId is missing")
+	} else if v.TxId == ([32]byte{}) {
+		errs = append(errs, "field TxId is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_RequestSimulate = []string{
+	1: "Envelope",
+}
+
+func (v *RequestSimulate) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(len(v.Envelope) == 0) {
+		writer.WriteBytes(1, v.Envelope)
+	}
+
+	_, _, err := writer.Reset(fieldNames_RequestSimulate)
+	return buffer.Bytes(), err
+}
+
+func (v *RequestSimulate) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Envelope is missing")
+	} else if len(v.Envelope) == 0 {
+		errs = append(errs, "field Envelope is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_RequestSyntheticOutbox = []string{
+	1: "MinAge",
+}
+
+func (v *RequestSyntheticOutbox) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.MinAge == 0) {
+		writer.WriteUint(1, v.MinAge)
+	}
+
+	_, _, err := writer.Reset(fieldNames_RequestSyntheticOutbox)
+	return buffer.Bytes(), err
+}
+
+func (v *RequestSyntheticOutbox) IsValid() error {
+	var errs []string
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_ResponseAnchorProof = []string{
+	1: "Anchor",
+	2: "Account",
+	3: "Chain",
+	4: "Block",
+	5: "ChainReceipt",
+	6: "State",
+	7: "ChainAnchors",
+	8: "StateReceipt",
+}
+
+func (v *ResponseAnchorProof) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(len(v.Anchor) == 0) {
+		writer.WriteBytes(1, v.Anchor)
+	}
+	if !(v.Account == nil) {
+		writer.WriteUrl(2, v.Account)
+	}
+	if !(len(v.Chain) == 0) {
+		writer.WriteString(3, v.Chain)
+	}
+	if !(v.Block == 0) {
+		writer.WriteUint(4, v.Block)
+	}
+	if !((v.ChainReceipt).Equal(new(protocol.Receipt))) {
+		writer.WriteValue(5, &v.ChainReceipt)
+	}
+	if !(len(v.State) == 0) {
+		writer.WriteBytes(6, v.State)
+	}
+	if !(len(v.ChainAnchors) == 0) {
+		for _, v := range v.ChainAnchors {
+			writer.WriteBytes(7, v)
+		}
+	}
+	if !((v.StateReceipt).Equal(new(protocol.Receipt))) {
+		writer.WriteValue(8, &v.StateReceipt)
+	}
+
+	_, _, err := writer.Reset(fieldNames_ResponseAnchorProof)
+	return buffer.Bytes(), err
+}
+
+func (v *ResponseAnchorProof) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Anchor is missing")
+	} else if len(v.Anchor) == 0 {
+		errs = append(errs, "field Anchor is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Account is missing")
+	} else if v.Account == nil {
+		errs = append(errs, "field Account is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
 		errs = append(errs, "field Chain is missing")
 	} else if len(v.Chain) == 0 {
 		errs = append(errs, "field Chain is not set")
 	}
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field Block is missing")
+	} else if v.Block == 0 {
+		errs = append(errs, "field Block is not set")
+	}
+	if len(v.fieldsSet) > 5 && !v.fieldsSet[5] {
+		errs = append(errs, "field ChainReceipt is missing")
+	} else if (v.ChainReceipt).Equal(new(protocol.Receipt)) {
+		errs = append(errs, "field ChainReceipt is not set")
+	}
+	if len(v.fieldsSet) > 6 && !v.fieldsSet[6] {
+		errs = append(errs, "field State is missing")
+	} else if len(v.State) == 0 {
+		errs = append(errs, "field State is not set")
+	}
+	if len(v.fieldsSet) > 7 && !v.fieldsSet[7] {
+		errs = append(errs, "field ChainAnchors is missing")
+	} else if len(v.ChainAnchors) == 0 {
+		errs = append(errs, "field ChainAnchors is not set")
+	}
+	if len(v.fieldsSet) > 8 && !v.fieldsSet[8] {
+		errs = append(errs, "field StateReceipt is missing")
+	} else if (v.StateReceipt).Equal(new(protocol.Receipt)) {
+		errs = append(errs, "field StateReceipt is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_ResponseBlock = []string{
+	1: "Height",
+	2: "Time",
+	3: "RootAnchor",
+	4: "Updates",
+	5: "Transactions",
+	6: "SyntheticTransactions",
+}
+
+func (v *ResponseBlock) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Height == 0) {
+		writer.WriteUint(1, v.Height)
+	}
+	if !(v.Time == (time.Time{})) {
+		writer.WriteTime(2, v.Time)
+	}
+	if !(v.RootAnchor == ([32]byte{})) {
+		writer.WriteHash(3, &v.RootAnchor)
+	}
+	if !(len(v.Updates) == 0) {
+		for _, v := range v.Updates {
+			writer.WriteValue(4, &v)
+		}
+	}
+	if !(len(v.Transactions) == 0) {
+		for _, v := range v.Transactions {
+			writer.WriteHash(5, &v)
+		}
+	}
+	if !(len(v.SyntheticTransactions) == 0) {
+		for _, v := range v.SyntheticTransactions {
+			writer.WriteHash(6, &v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_ResponseBlock)
+	return buffer.Bytes(), err
+}
+
+func (v *ResponseBlock) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Height is missing")
+	} else if v.Height == 0 {
+		errs = append(errs, "field Height is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Time is missing")
+	} else if v.Time == (time.Time{}) {
+		errs = append(errs, "field Time is not set")
+	}
 	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
-		errs = append(errs, "field DirectoryBlock is missing")
-	} else if v.DirectoryBlock == 0 {
-		errs = append(errs, "field DirectoryBlock is not set")
+		errs = append(errs, "field RootAnchor is missing")
+	} else if v.RootAnchor == ([32]byte{}) {
+		errs = append(errs, "field RootAnchor is not set")
 	}
 	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
-		errs = append(errs, "field Receipt is missing")
-	} else if (v.Receipt).Equal(new(protocol.Receipt)) {
-		errs = append(errs, "field Receipt is not set")
+		errs = append(errs, "field Updates is missing")
+	} else if len(v.Updates) == 0 {
+		errs = append(errs, "field Updates is not set")
+	}
+	if len(v.fieldsSet) > 5 && !v.fieldsSet[5] {
+		errs = append(errs, "field Transactions is missing")
+	} else if len(v.Transactions) == 0 {
+		errs = append(errs, "field Transactions is not set")
+	}
+	if len(v.fieldsSet) > 6 && !v.fieldsSet[6] {
+		errs = append(errs, "field SyntheticTransactions is missing")
+	} else if len(v.SyntheticTransactions) == 0 {
+		errs = append(errs, "field SyntheticTransactions is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_ResponseBlockRange = []string{
+	1: "Blocks",
+	2: "Total",
+}
+
+func (v *ResponseBlockRange) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(len(v.Blocks) == 0) {
+		for _, v := range v.Blocks {
+			writer.WriteValue(1, v)
+		}
+	}
+	if !(v.Total == 0) {
+		writer.WriteUint(2, v.Total)
+	}
+
+	_, _, err := writer.Reset(fieldNames_ResponseBlockRange)
+	return buffer.Bytes(), err
+}
+
+func (v *ResponseBlockRange) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Blocks is missing")
+	} else if len(v.Blocks) == 0 {
+		errs = append(errs, "field Blocks is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Total is missing")
+	} else if v.Total == 0 {
+		errs = append(errs, "field Total is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_ResponseByTxId = []string{
+	1: "TxId",
+	2: "TxState",
+	3: "TxPendingState",
+	4: "TxSynthTxIds",
+	5: "Height",
+	6: "ChainState",
+	7: "Receipts",
+	8: "SignatureThreshold",
+	9: "Invalidated",
+}
+
+func (v *ResponseByTxId) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.TxId == ([32]byte{})) {
+		writer.WriteHash(1, &v.TxId)
+	}
+	if !(len(v.TxState) == 0) {
+		writer.WriteBytes(2, v.TxState)
+	}
+	if !(len(v.TxPendingState) == 0) {
+		writer.WriteBytes(3, v.TxPendingState)
+	}
+	if !(len(v.TxSynthTxIds) == 0) {
+		writer.WriteBytes(4, v.TxSynthTxIds)
+	}
+	writer.WriteInt(5, v.Height)
+	if !(len(v.ChainState) == 0) {
+		for _, v := range v.ChainState {
+			writer.WriteBytes(6, v)
+		}
+	}
+	if !(len(v.Receipts) == 0) {
+		for _, v := range v.Receipts {
+			writer.WriteValue(7, v)
+		}
+	}
+	if !(v.SignatureThreshold == 0) {
+		writer.WriteUint(8, v.SignatureThreshold)
+	}
+	if !(!v.Invalidated) {
+		writer.WriteBool(9, v.Invalidated)
+	}
+
+	_, _, err := writer.Reset(fieldNames_ResponseByTxId)
+	return buffer.Bytes(), err
+}
+
+func (v *ResponseByTxId) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field TxId is missing")
+	} else if v.TxId == ([32]byte{}) {
+		errs = append(errs, "field TxId is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field TxState is missing")
+	} else if len(v.TxState) == 0 {
+		errs = append(errs, "field TxState is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field TxPendingState is missing")
+	} else if len(v.TxPendingState) == 0 {
+		errs = append(errs, "field TxPendingState is not set")
+	}
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field TxSynthTxIds is missing")
+	} else if len(v.TxSynthTxIds) == 0 {
+		errs = append(errs, "field TxSynthTxIds is not set")
+	}
+	if len(v.fieldsSet) > 5 && !v.fieldsSet[5] {
+		errs = append(errs, "field Height is missing")
+	} else if v.Height == 0 {
+		errs = append(errs, "field Height is not set")
+	}
+	if len(v.fieldsSet) > 6 && !v.fieldsSet[6] {
+		errs = append(errs, "field ChainState is missing")
+	} else if len(v.ChainState) == 0 {
+		errs = append(errs, "field ChainState is not set")
+	}
+	if len(v.fieldsSet) > 7 && !v.fieldsSet[7] {
+		errs = append(errs, "field Receipts is missing")
+	} else if len(v.Receipts) == 0 {
+		errs = append(errs, "field Receipts is not set")
+	}
+	if len(v.fieldsSet) > 8 && !v.fieldsSet[8] {
+		errs = append(errs, "field SignatureThreshold is missing")
+	} else if v.SignatureThreshold == 0 {
+		errs = append(errs, "field SignatureThreshold is not set")
+	}
+	if len(v.fieldsSet) > 9 && !v.fieldsSet[9] {
+		errs = append(errs, "field Invalidated is missing")
+	} else if !v.Invalidated {
+		errs = append(errs, "field Invalidated is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_ResponseChainEntry = []string{
+	1: "Height",
+	2: "Entry",
+	3: "State",
+}
+
+func (v *ResponseChainEntry) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	writer.WriteInt(1, v.Height)
+	if !(len(v.Entry) == 0) {
+		writer.WriteBytes(2, v.Entry)
+	}
+	if !(len(v.State) == 0) {
+		for _, v := range v.State {
+			writer.WriteBytes(3, v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_ResponseChainEntry)
+	return buffer.Bytes(), err
+}
+
+func (v *ResponseChainEntry) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Height is missing")
+	} else if v.Height == 0 {
+		errs = append(errs, "field Height is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Entry is missing")
+	} else if len(v.Entry) == 0 {
+		errs = append(errs, "field Entry is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field State is missing")
+	} else if len(v.State) == 0 {
+		errs = append(errs, "field State is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_ResponseChainRange = []string{
+	1: "Start",
+	2: "End",
+	3: "Total",
+	4: "Entries",
+}
+
+func (v *ResponseChainRange) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	writer.WriteInt(1, v.Start)
+	writer.WriteInt(2, v.End)
+	writer.WriteInt(3, v.Total)
+	if !(len(v.Entries) == 0) {
+		for _, v := range v.Entries {
+			writer.WriteBytes(4, v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_ResponseChainRange)
+	return buffer.Bytes(), err
+}
+
+func (v *ResponseChainRange) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Start is missing")
+	} else if v.Start == 0 {
+		errs = append(errs, "field Start is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field End is missing")
+	} else if v.End == 0 {
+		errs = append(errs, "field End is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Total is missing")
+	} else if v.Total == 0 {
+		errs = append(errs, "field Total is not set")
+	}
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field Entries is missing")
+	} else if len(v.Entries) == 0 {
+		errs = append(errs, "field Entries is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_ResponseKey = []string{
+	1: "Accounts",
+}
+
+func (v *ResponseKey) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(len(v.Accounts) == 0) {
+		for _, v := range v.Accounts {
+			writer.WriteValue(1, v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_ResponseKey)
+	return buffer.Bytes(), err
+}
+
+func (v *ResponseKey) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Accounts is missing")
+	} else if len(v.Accounts) == 0 {
+		errs = append(errs, "field Accounts is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_ResponseKeyPageIndex = []string{
+	1: "KeyBook",
+	2: "KeyPage",
+	3: "Index",
+}
+
+func (v *ResponseKeyPageIndex) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.KeyBook == nil) {
+		writer.WriteUrl(1, v.KeyBook)
+	}
+	if !(v.KeyPage == nil) {
+		writer.WriteUrl(2, v.KeyPage)
+	}
+	writer.WriteUint(3, v.Index)
+
+	_, _, err := writer.Reset(fieldNames_ResponseKeyPageIndex)
+	return buffer.Bytes(), err
+}
+
+func (v *ResponseKeyPageIndex) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field KeyBook is missing")
+	} else if v.KeyBook == nil {
+		errs = append(errs, "field KeyBook is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field KeyPage is missing")
+	} else if v.KeyPage == nil {
+		errs = append(errs, "field KeyPage is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Index is missing")
+	} else if v.Index == 0 {
+		errs = append(errs, "field Index is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_ResponseOracle = []string{
+	1: "Active",
+	2: "Pending",
+	3: "Sources",
+	4: "Feeds",
+	5: "History",
+	6: "Total",
+}
+
+func (v *ResponseOracle) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Active == 0) {
+		writer.WriteUint(1, v.Active)
+	}
+	if !(v.Pending == 0) {
+		writer.WriteUint(2, v.Pending)
+	}
+	if !(len(v.Sources) == 0) {
+		for _, v := range v.Sources {
+			writer.WriteUrl(3, v)
+		}
+	}
+	if !(len(v.Feeds) == 0) {
+		for _, v := range v.Feeds {
+			writer.WriteValue(4, v)
+		}
+	}
+	if !(len(v.History) == 0) {
+		for _, v := range v.History {
+			writer.WriteValue(5, v)
+		}
+	}
+	if !(v.Total == 0) {
+		writer.WriteUint(6, v.Total)
+	}
+
+	_, _, err := writer.Reset(fieldNames_ResponseOracle)
+	return buffer.Bytes(), err
+}
+
+func (v *ResponseOracle) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Active is missing")
+	} else if v.Active == 0 {
+		errs = append(errs, "field Active is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Pending is missing")
+	} else if v.Pending == 0 {
+		errs = append(errs, "field Pending is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Sources is missing")
+	} else if len(v.Sources) == 0 {
+		errs = append(errs, "field Sources is not set")
+	}
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field Feeds is missing")
+	} else if len(v.Feeds) == 0 {
+		errs = append(errs, "field Feeds is not set")
+	}
+	if len(v.fieldsSet) > 5 && !v.fieldsSet[5] {
+		errs = append(errs, "field History is missing")
+	} else if len(v.History) == 0 {
+		errs = append(errs, "field History is not set")
+	}
+	if len(v.fieldsSet) > 6 && !v.fieldsSet[6] {
+		errs = append(errs, "field Total is missing")
+	} else if v.Total == 0 {
+		errs = append(errs, "field Total is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_ResponsePending = []string{
+	1: "Transactions",
+}
+
+func (v *ResponsePending) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(len(v.Transactions) == 0) {
+		for _, v := range v.Transactions {
+			writer.WriteHash(1, &v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_ResponsePending)
+	return buffer.Bytes(), err
+}
+
+func (v *ResponsePending) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Transactions is missing")
+	} else if len(v.Transactions) == 0 {
+		errs = append(errs, "field Transactions is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_ResponsePendingSignatures = []string{
+	1: "Url",
+	2: "Transactions",
+}
+
+func (v *ResponsePendingSignatures) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Url == nil) {
+		writer.WriteUrl(1, v.Url)
+	}
+	if !(len(v.Transactions) == 0) {
+		for _, v := range v.Transactions {
+			writer.WriteValue(2, v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_ResponsePendingSignatures)
+	return buffer.Bytes(), err
+}
+
+func (v *ResponsePendingSignatures) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Url is missing")
+	} else if v.Url == nil {
+		errs = append(errs, "field Url is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Transactions is missing")
+	} else if len(v.Transactions) == 0 {
+		errs = append(errs, "field Transactions is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_ResponseProof = []string{
+	1:  "Account",
+	2:  "Chain",
+	3:  "Start",
+	4:  "LocalBlock",
+	5:  "DirectoryBlock",
+	6:  "DirectoryAnchor",
+	7:  "Segments",
+	8:  "Receipt",
+	9:  "State",
+	10: "ChainAnchors",
+	11: "Transaction",
+}
+
+func (v *ResponseProof) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Account == nil) {
+		writer.WriteUrl(1, v.Account)
+	}
+	if !(len(v.Chain) == 0) {
+		writer.WriteString(2, v.Chain)
+	}
+	if !(len(v.Start) == 0) {
+		writer.WriteBytes(3, v.Start)
+	}
+	if !(v.LocalBlock == 0) {
+		writer.WriteUint(4, v.LocalBlock)
+	}
+	if !(v.DirectoryBlock == 0) {
+		writer.WriteUint(5, v.DirectoryBlock)
+	}
+	if !(len(v.DirectoryAnchor) == 0) {
+		writer.WriteBytes(6, v.DirectoryAnchor)
+	}
+	if !(len(v.Segments) == 0) {
+		for _, v := range v.Segments {
+			writer.WriteValue(7, v)
+		}
+	}
+	if !((v.Receipt).Equal(new(protocol.Receipt))) {
+		writer.WriteValue(8, &v.Receipt)
+	}
+	if !(len(v.State) == 0) {
+		writer.WriteBytes(9, v.State)
+	}
+	if !(len(v.ChainAnchors) == 0) {
+		for _, v := range v.ChainAnchors {
+			writer.WriteBytes(10, v)
+		}
+	}
+	if !(len(v.Transaction) == 0) {
+		writer.WriteBytes(11, v.Transaction)
+	}
+
+	_, _, err := writer.Reset(fieldNames_ResponseProof)
+	return buffer.Bytes(), err
+}
+
+func (v *ResponseProof) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Account is missing")
+	} else if v.Account == nil {
+		errs = append(errs, "field Account is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Chain is missing")
+	} else if len(v.Chain) == 0 {
+		errs = append(errs, "field Chain is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Start is missing")
+	} else if len(v.Start) == 0 {
+		errs = append(errs, "field Start is not set")
+	}
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field LocalBlock is missing")
+	} else if v.LocalBlock == 0 {
+		errs = append(errs, "field LocalBlock is not set")
+	}
+	if len(v.fieldsSet) > 5 && !v.fieldsSet[5] {
+		errs = append(errs, "field DirectoryBlock is missing")
+	} else if v.DirectoryBlock == 0 {
+		errs = append(errs, "field DirectoryBlock is not set")
+	}
+	if len(v.fieldsSet) > 6 && !v.fieldsSet[6] {
+		errs = append(errs, "field DirectoryAnchor is missing")
+	} else if len(v.DirectoryAnchor) == 0 {
+		errs = append(errs, "field DirectoryAnchor is not set")
+	}
+	if len(v.fieldsSet) > 7 && !v.fieldsSet[7] {
+		errs = append(errs, "field Segments is missing")
+	} else if len(v.Segments) == 0 {
+		errs = append(errs, "field Segments is not set")
+	}
+	if len(v.fieldsSet) > 8 && !v.fieldsSet[8] {
+		errs = append(errs, "field Receipt is missing")
+	} else if (v.Receipt).Equal(new(protocol.Receipt)) {
+		errs = append(errs, "field Receipt is not set")
+	}
+	if len(v.fieldsSet) > 10 && !v.fieldsSet[10] {
+		errs = append(errs, "field ChainAnchors is missing")
+	} else if len(v.ChainAnchors) == 0 {
+		errs = append(errs, "field ChainAnchors is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_ResponseSignatureStatus = []string{
+	1: "TxId",
+	2: "Origin",
+	3: "KeyPage",
+	4: "Threshold",
+	5: "Signed",
+	6: "Unsigned",
+	7: "Invalidated",
+}
+
+func (v *ResponseSignatureStatus) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.TxId == ([32]byte{})) {
+		writer.WriteHash(1, &v.TxId)
+	}
+	if !(v.Origin == nil) {
+		writer.WriteUrl(2, v.Origin)
+	}
+	if !(v.KeyPage == nil) {
+		writer.WriteUrl(3, v.KeyPage)
+	}
+	if !(v.Threshold == 0) {
+		writer.WriteUint(4, v.Threshold)
+	}
+	if !(len(v.Signed) == 0) {
+		for _, v := range v.Signed {
+			writer.WriteBytes(5, v)
+		}
+	}
+	if !(len(v.Unsigned) == 0) {
+		for _, v := range v.Unsigned {
+			writer.WriteBytes(6, v)
+		}
+	}
+	if !(!v.Invalidated) {
+		writer.WriteBool(7, v.Invalidated)
+	}
+
+	_, _, err := writer.Reset(fieldNames_ResponseSignatureStatus)
+	return buffer.Bytes(), err
+}
+
+func (v *ResponseSignatureStatus) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field TxId is missing")
+	} else if v.TxId == ([32]byte{}) {
+		errs = append(errs, "field TxId is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Origin is missing")
+	} else if v.Origin == nil {
+		errs = append(errs, "field Origin is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field KeyPage is missing")
+	} else if v.KeyPage == nil {
+		errs = append(errs, "field KeyPage is not set")
+	}
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field Threshold is missing")
+	} else if v.Threshold == 0 {
+		errs = append(errs, "field Threshold is not set")
+	}
+	if len(v.fieldsSet) > 5 && !v.fieldsSet[5] {
+		errs = append(errs, "field Signed is missing")
+	} else if len(v.Signed) == 0 {
+		errs = append(errs, "field Signed is not set")
+	}
+	if len(v.fieldsSet) > 6 && !v.fieldsSet[6] {
+		errs = append(errs, "field Unsigned is missing")
+	} else if len(v.Unsigned) == 0 {
+		errs = append(errs, "field Unsigned is not set")
+	}
+	if len(v.fieldsSet) > 7 && !v.fieldsSet[7] {
+		errs = append(errs, "field Invalidated is missing")
+	} else if !v.Invalidated {
+		errs = append(errs, "field Invalidated is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_ResponseSimulate = []string{
+	1: "Fee",
+	2: "Pending",
+	3: "Result",
+	4: "Changes",
+	5: "Synthetic",
+}
+
+func (v *ResponseSimulate) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Fee == 0) {
+		writer.WriteUint(1, v.Fee)
+	}
+	if !(!v.Pending) {
+		writer.WriteBool(2, v.Pending)
+	}
+	if !(len(v.Result) == 0) {
+		writer.WriteBytes(3, v.Result)
+	}
+	if !(len(v.Changes) == 0) {
+		for _, v := range v.Changes {
+			writer.WriteValue(4, v)
+		}
+	}
+	if !(len(v.Synthetic) == 0) {
+		for _, v := range v.Synthetic {
+			writer.WriteBytes(5, v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_ResponseSimulate)
+	return buffer.Bytes(), err
+}
+
+func (v *ResponseSimulate) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Fee is missing")
+	} else if v.Fee == 0 {
+		errs = append(errs, "field Fee is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Pending is missing")
+	} else if !v.Pending {
+		errs = append(errs, "field Pending is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Result is missing")
+	} else if len(v.Result) == 0 {
+		errs = append(errs, "field Result is not set")
+	}
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field Changes is missing")
+	} else if len(v.Changes) == 0 {
+		errs = append(errs, "field Changes is not set")
+	}
+	if len(v.fieldsSet) > 5 && !v.fieldsSet[5] {
+		errs = append(errs, "field Synthetic is missing")
+	} else if len(v.Synthetic) == 0 {
+		errs = append(errs, "field Synthetic is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_ResponseSyntheticOutbox = []string{
+	1: "Block",
+	2: "Time",
+	3: "Transactions",
+}
+
+func (v *ResponseSyntheticOutbox) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Block == 0) {
+		writer.WriteUint(1, v.Block)
+	}
+	if !(v.Time == (time.Time{})) {
+		writer.WriteTime(2, v.Time)
+	}
+	if !(len(v.Transactions) == 0) {
+		for _, v := range v.Transactions {
+			writer.WriteValue(3, v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_ResponseSyntheticOutbox)
+	return buffer.Bytes(), err
+}
+
+func (v *ResponseSyntheticOutbox) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Block is missing")
+	} else if v.Block == 0 {
+		errs = append(errs, "field Block is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Time is missing")
+	} else if v.Time == (time.Time{}) {
+		errs = append(errs, "field Time is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Transactions is missing")
+	} else if len(v.Transactions) == 0 {
+		errs = append(errs, "field Transactions is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_ResponseTxHistory = []string{
+	1: "Start",
+	2: "End",
+	3: "Total",
+	4: "Transactions",
+}
+
+func (v *ResponseTxHistory) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	writer.WriteInt(1, v.Start)
+	writer.WriteInt(2, v.End)
+	writer.WriteInt(3, v.Total)
+	if !(len(v.Transactions) == 0) {
+		for _, v := range v.Transactions {
+			writer.WriteValue(4, &v)
+		}
+	}
+
+	_, _, err := writer.Reset(fieldNames_ResponseTxHistory)
+	return buffer.Bytes(), err
+}
+
+func (v *ResponseTxHistory) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Start is missing")
+	} else if v.Start == 0 {
+		errs = append(errs, "field Start is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field End is missing")
+	} else if v.End == 0 {
+		errs = append(errs, "field End is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Total is missing")
+	} else if v.Total == 0 {
+		errs = append(errs, "field Total is not set")
+	}
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field Transactions is missing")
+	} else if len(v.Transactions) == 0 {
+		errs = append(errs, "field Transactions is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_StuckSyntheticTransaction = []string{
+	1: "Transaction",
+	2: "Source",
+	3: "Type",
+	4: "Destination",
+	5: "ProducedBlock",
+	6: "ProducedTime",
+	7: "Age",
+	8: "Attempts",
+	9: "NextAttempt",
+}
+
+func (v *StuckSyntheticTransaction) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Transaction == ([32]byte{})) {
+		writer.WriteHash(1, &v.Transaction)
+	}
+	if !(v.Source == nil) {
+		writer.WriteUrl(2, v.Source)
+	}
+	if !(v.Type == 0) {
+		writer.WriteEnum(3, v.Type)
+	}
+	if !(v.Destination == nil) {
+		writer.WriteUrl(4, v.Destination)
+	}
+	if !(v.ProducedBlock == 0) {
+		writer.WriteUint(5, v.ProducedBlock)
+	}
+	if !(v.ProducedTime == (time.Time{})) {
+		writer.WriteTime(6, v.ProducedTime)
+	}
+	if !(v.Age == 0) {
+		writer.WriteUint(7, v.Age)
+	}
+	if !(v.Attempts == 0) {
+		writer.WriteUint(8, v.Attempts)
+	}
+	if !(v.NextAttempt == 0) {
+		writer.WriteUint(9, v.NextAttempt)
+	}
+
+	_, _, err := writer.Reset(fieldNames_StuckSyntheticTransaction)
+	return buffer.Bytes(), err
+}
+
+func (v *StuckSyntheticTransaction) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Transaction is missing")
+	} else if v.Transaction == ([32]byte{}) {
+		errs = append(errs, "field Transaction is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Source is missing")
+	} else if v.Source == nil {
+		errs = append(errs, "field Source is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field Type is missing")
+	} else if v.Type == 0 {
+		errs = append(errs, "field Type is not set")
+	}
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field Destination is missing")
+	} else if v.Destination == nil {
+		errs = append(errs, "field Destination is not set")
+	}
+	if len(v.fieldsSet) > 5 && !v.fieldsSet[5] {
+		errs = append(errs, "field ProducedBlock is missing")
+	} else if v.ProducedBlock == 0 {
+		errs = append(errs, "field ProducedBlock is not set")
+	}
+	if len(v.fieldsSet) > 6 && !v.fieldsSet[6] {
+		errs = append(errs, "field ProducedTime is missing")
+	} else if v.ProducedTime == (time.Time{}) {
+		errs = append(errs, "field ProducedTime is not set")
+	}
+	if len(v.fieldsSet) > 7 && !v.fieldsSet[7] {
+		errs = append(errs, "field Age is missing")
+	} else if v.Age == 0 {
+		errs = append(errs, "field Age is not set")
+	}
+	if len(v.fieldsSet) > 8 && !v.fieldsSet[8] {
+		errs = append(errs, "field Attempts is missing")
+	} else if v.Attempts == 0 {
+		errs = append(errs, "field Attempts is not set")
+	}
+	if len(v.fieldsSet) > 9 && !v.fieldsSet[9] {
+		errs = append(errs, "field NextAttempt is missing")
+	} else if v.NextAttempt == 0 {
+		errs = append(errs, "field NextAttempt is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+var fieldNames_TxReceipt = []string{
+	1: "Account",
+	2: "Chain",
+	3: "DirectoryBlock",
+	4: "Receipt",
+}
+
+func (v *TxReceipt) MarshalBinary() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := encoding.NewWriter(buffer)
+
+	if !(v.Account == nil) {
+		writer.WriteUrl(1, v.Account)
+	}
+	if !(len(v.Chain) == 0) {
+		writer.WriteString(2, v.Chain)
+	}
+	if !(v.DirectoryBlock == 0) {
+		writer.WriteUint(3, v.DirectoryBlock)
+	}
+	if !((v.Receipt).Equal(new(protocol.Receipt))) {
+		writer.WriteValue(4, &v.Receipt)
+	}
+
+	_, _, err := writer.Reset(fieldNames_TxReceipt)
+	return buffer.Bytes(), err
+}
+
+func (v *TxReceipt) IsValid() error {
+	var errs []string
+
+	if len(v.fieldsSet) > 1 && !v.fieldsSet[1] {
+		errs = append(errs, "field Account is missing")
+	} else if v.Account == nil {
+		errs = append(errs, "field Account is not set")
+	}
+	if len(v.fieldsSet) > 2 && !v.fieldsSet[2] {
+		errs = append(errs, "field Chain is missing")
+	} else if len(v.Chain) == 0 {
+		errs = append(errs, "field Chain is not set")
+	}
+	if len(v.fieldsSet) > 3 && !v.fieldsSet[3] {
+		errs = append(errs, "field DirectoryBlock is missing")
+	} else if v.DirectoryBlock == 0 {
+		errs = append(errs, "field DirectoryBlock is not set")
+	}
+	if len(v.fieldsSet) > 4 && !v.fieldsSet[4] {
+		errs = append(errs, "field Receipt is missing")
+	} else if (v.Receipt).Equal(new(protocol.Receipt)) {
+		errs = append(errs, "field Receipt is not set")
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New(errs[0])
+	default:
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+func (v *AccountChange) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *AccountChange) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUrl(1); ok {
+		v.Url = x
+	}
+	if x, ok := reader.ReadBytes(2); ok {
+		v.Before = x
+	}
+	if x, ok := reader.ReadBytes(3); ok {
+		v.After = x
+	}
+
+	seen, err := reader.Reset(fieldNames_AccountChange)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *KeyAccount) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *KeyAccount) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUrl(1); ok {
+		v.Url = x
+	}
+	if x := new(protocol.AccountType); reader.ReadEnum(2, x) {
+		v.Type = *x
+	}
+	if x, ok := reader.ReadUrl(3); ok {
+		v.KeyBook = x
+	}
+
+	seen, err := reader.Reset(fieldNames_KeyAccount)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *MultiResponse) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *MultiResponse) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadString(1); ok {
+		v.Type = x
+	}
+	for {
+		if x, ok := reader.ReadString(2); ok {
+			v.Items = append(v.Items, x)
+		} else {
+			break
+		}
+	}
+	if x, ok := reader.ReadUint(3); ok {
+		v.Start = x
+	}
+	if x, ok := reader.ReadUint(4); ok {
+		v.Count = x
+	}
+	if x, ok := reader.ReadUint(5); ok {
+		v.Total = x
+	}
+
+	seen, err := reader.Reset(fieldNames_MultiResponse)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *OraclePrice) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *OraclePrice) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUint(1); ok {
+		v.Block = x
+	}
+	if x, ok := reader.ReadTime(2); ok {
+		v.Time = x
+	}
+	if x, ok := reader.ReadUint(3); ok {
+		v.Price = x
+	}
+
+	seen, err := reader.Reset(fieldNames_OraclePrice)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *RequestAnchorProof) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *RequestAnchorProof) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadBytes(1); ok {
+		v.Anchor = x
+	}
+
+	seen, err := reader.Reset(fieldNames_RequestAnchorProof)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *RequestBlock) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *RequestBlock) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUint(1); ok {
+		v.Height = x
+	}
+
+	seen, err := reader.Reset(fieldNames_RequestBlock)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *RequestBlockRange) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *RequestBlockRange) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUint(1); ok {
+		v.Start = x
+	}
+	if x, ok := reader.ReadUint(2); ok {
+		v.Count = x
+	}
+
+	seen, err := reader.Reset(fieldNames_RequestBlockRange)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *RequestKey) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *RequestKey) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadHash(1); ok {
+		v.KeyHash = *x
+	}
+
+	seen, err := reader.Reset(fieldNames_RequestKey)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *RequestKeyPageIndex) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *RequestKeyPageIndex) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUrl(1); ok {
+		v.Url = x
+	}
+	if x, ok := reader.ReadBytes(2); ok {
+		v.Key = x
+	}
+
+	seen, err := reader.Reset(fieldNames_RequestKeyPageIndex)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *RequestOracle) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *RequestOracle) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUint(1); ok {
+		v.Start = x
+	}
+	if x, ok := reader.ReadUint(2); ok {
+		v.Count = x
+	}
+
+	seen, err := reader.Reset(fieldNames_RequestOracle)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *RequestPendingSignatures) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *RequestPendingSignatures) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUrl(1); ok {
+		v.Url = x
+	}
+
+	seen, err := reader.Reset(fieldNames_RequestPendingSignatures)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *RequestProof) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *RequestProof) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUrl(1); ok {
+		v.Url = x
+	}
+	if x, ok := reader.ReadBytes(2); ok {
+		v.TxId = x
+	}
+
+	seen, err := reader.Reset(fieldNames_RequestProof)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *RequestSignatureStatus) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *RequestSignatureStatus) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadHash(1); ok {
+		v.TxId = *x
+	}
+
+	seen, err := reader.Reset(fieldNames_RequestSignatureStatus)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *RequestSimulate) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *RequestSimulate) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadBytes(1); ok {
+		v.Envelope = x
+	}
+
+	seen, err := reader.Reset(fieldNames_RequestSimulate)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *RequestSyntheticOutbox) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *RequestSyntheticOutbox) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUint(1); ok {
+		v.MinAge = x
+	}
+
+	seen, err := reader.Reset(fieldNames_RequestSyntheticOutbox)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *ResponseAnchorProof) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *ResponseAnchorProof) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadBytes(1); ok {
+		v.Anchor = x
+	}
+	if x, ok := reader.ReadUrl(2); ok {
+		v.Account = x
+	}
+	if x, ok := reader.ReadString(3); ok {
+		v.Chain = x
+	}
+	if x, ok := reader.ReadUint(4); ok {
+		v.Block = x
+	}
+	if x := new(protocol.Receipt); reader.ReadValue(5, x.UnmarshalBinary) {
+		v.ChainReceipt = *x
+	}
+	if x, ok := reader.ReadBytes(6); ok {
+		v.State = x
+	}
+	for {
+		if x, ok := reader.ReadBytes(7); ok {
+			v.ChainAnchors = append(v.ChainAnchors, x)
+		} else {
+			break
+		}
+	}
+	if x := new(protocol.Receipt); reader.ReadValue(8, x.UnmarshalBinary) {
+		v.StateReceipt = *x
 	}
 
-	switch len(errs) {
-	case 0:
-		return nil
-	case 1:
-		return errors.New(errs[0])
-	default:
-		return errors.New(strings.Join(errs, "; "))
-	}
+	seen, err := reader.Reset(fieldNames_ResponseAnchorProof)
+	v.fieldsSet = seen
+	return err
 }
 
-func (v *MultiResponse) UnmarshalBinary(data []byte) error {
+func (v *ResponseBlock) UnmarshalBinary(data []byte) error {
 	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
 }
 
-func (v *MultiResponse) UnmarshalBinaryFrom(rd io.Reader) error {
+func (v *ResponseBlock) UnmarshalBinaryFrom(rd io.Reader) error {
 	reader := encoding.NewReader(rd)
 
-	if x, ok := reader.ReadString(1); ok {
-		v.Type = x
+	if x, ok := reader.ReadUint(1); ok {
+		v.Height = x
+	}
+	if x, ok := reader.ReadTime(2); ok {
+		v.Time = x
+	}
+	if x, ok := reader.ReadHash(3); ok {
+		v.RootAnchor = *x
 	}
 	for {
-		if x, ok := reader.ReadString(2); ok {
-			v.Items = append(v.Items, x)
+		if x := new(protocol.AnchorMetadata); reader.ReadValue(4, x.UnmarshalBinary) {
+			v.Updates = append(v.Updates, *x)
 		} else {
 			break
 		}
 	}
-	if x, ok := reader.ReadUint(3); ok {
-		v.Start = x
-	}
-	if x, ok := reader.ReadUint(4); ok {
-		v.Count = x
+	for {
+		if x, ok := reader.ReadHash(5); ok {
+			v.Transactions = append(v.Transactions, *x)
+		} else {
+			break
+		}
 	}
-	if x, ok := reader.ReadUint(5); ok {
-		v.Total = x
+	for {
+		if x, ok := reader.ReadHash(6); ok {
+			v.SyntheticTransactions = append(v.SyntheticTransactions, *x)
+		} else {
+			break
+		}
 	}
 
-	seen, err := reader.Reset(fieldNames_MultiResponse)
+	seen, err := reader.Reset(fieldNames_ResponseBlock)
 	v.fieldsSet = seen
 	return err
 }
 
-func (v *RequestKeyPageIndex) UnmarshalBinary(data []byte) error {
+func (v *ResponseBlockRange) UnmarshalBinary(data []byte) error {
 	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
 }
 
-func (v *RequestKeyPageIndex) UnmarshalBinaryFrom(rd io.Reader) error {
+func (v *ResponseBlockRange) UnmarshalBinaryFrom(rd io.Reader) error {
 	reader := encoding.NewReader(rd)
 
-	if x, ok := reader.ReadUrl(1); ok {
-		v.Url = x
+	for {
+		if x := new(ResponseBlock); reader.ReadValue(1, x.UnmarshalBinary) {
+			v.Blocks = append(v.Blocks, x)
+		} else {
+			break
+		}
 	}
-	if x, ok := reader.ReadBytes(2); ok {
-		v.Key = x
+	if x, ok := reader.ReadUint(2); ok {
+		v.Total = x
 	}
 
-	seen, err := reader.Reset(fieldNames_RequestKeyPageIndex)
+	seen, err := reader.Reset(fieldNames_ResponseBlockRange)
 	v.fieldsSet = seen
 	return err
 }
@@ -939,6 +3270,26 @@ func (v *ResponseChainRange) UnmarshalBinaryFrom(rd io.Reader) error {
 	return err
 }
 
+func (v *ResponseKey) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *ResponseKey) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	for {
+		if x := new(KeyAccount); reader.ReadValue(1, x.UnmarshalBinary) {
+			v.Accounts = append(v.Accounts, x)
+		} else {
+			break
+		}
+	}
+
+	seen, err := reader.Reset(fieldNames_ResponseKey)
+	v.fieldsSet = seen
+	return err
+}
+
 func (v *ResponseKeyPageIndex) UnmarshalBinary(data []byte) error {
 	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
 }
@@ -961,6 +3312,49 @@ func (v *ResponseKeyPageIndex) UnmarshalBinaryFrom(rd io.Reader) error {
 	return err
 }
 
+func (v *ResponseOracle) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *ResponseOracle) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUint(1); ok {
+		v.Active = x
+	}
+	if x, ok := reader.ReadUint(2); ok {
+		v.Pending = x
+	}
+	for {
+		if x, ok := reader.ReadUrl(3); ok {
+			v.Sources = append(v.Sources, x)
+		} else {
+			break
+		}
+	}
+	for {
+		if x := new(protocol.OracleSubmission); reader.ReadValue(4, x.UnmarshalBinary) {
+			v.Feeds = append(v.Feeds, x)
+		} else {
+			break
+		}
+	}
+	for {
+		if x := new(OraclePrice); reader.ReadValue(5, x.UnmarshalBinary) {
+			v.History = append(v.History, x)
+		} else {
+			break
+		}
+	}
+	if x, ok := reader.ReadUint(6); ok {
+		v.Total = x
+	}
+
+	seen, err := reader.Reset(fieldNames_ResponseOracle)
+	v.fieldsSet = seen
+	return err
+}
+
 func (v *ResponsePending) UnmarshalBinary(data []byte) error {
 	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
 }
@@ -981,6 +3375,187 @@ func (v *ResponsePending) UnmarshalBinaryFrom(rd io.Reader) error {
 	return err
 }
 
+func (v *ResponsePendingSignatures) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *ResponsePendingSignatures) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUrl(1); ok {
+		v.Url = x
+	}
+	for {
+		if x := new(ResponseSignatureStatus); reader.ReadValue(2, x.UnmarshalBinary) {
+			v.Transactions = append(v.Transactions, x)
+		} else {
+			break
+		}
+	}
+
+	seen, err := reader.Reset(fieldNames_ResponsePendingSignatures)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *ResponseProof) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *ResponseProof) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUrl(1); ok {
+		v.Account = x
+	}
+	if x, ok := reader.ReadString(2); ok {
+		v.Chain = x
+	}
+	if x, ok := reader.ReadBytes(3); ok {
+		v.Start = x
+	}
+	if x, ok := reader.ReadUint(4); ok {
+		v.LocalBlock = x
+	}
+	if x, ok := reader.ReadUint(5); ok {
+		v.DirectoryBlock = x
+	}
+	if x, ok := reader.ReadBytes(6); ok {
+		v.DirectoryAnchor = x
+	}
+	for {
+		if x := new(protocol.Receipt); reader.ReadValue(7, x.UnmarshalBinary) {
+			v.Segments = append(v.Segments, x)
+		} else {
+			break
+		}
+	}
+	if x := new(protocol.Receipt); reader.ReadValue(8, x.UnmarshalBinary) {
+		v.Receipt = *x
+	}
+	if x, ok := reader.ReadBytes(9); ok {
+		v.State = x
+	}
+	for {
+		if x, ok := reader.ReadBytes(10); ok {
+			v.ChainAnchors = append(v.ChainAnchors, x)
+		} else {
+			break
+		}
+	}
+	if x, ok := reader.ReadBytes(11); ok {
+		v.Transaction = x
+	}
+
+	seen, err := reader.Reset(fieldNames_ResponseProof)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *ResponseSignatureStatus) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *ResponseSignatureStatus) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadHash(1); ok {
+		v.TxId = *x
+	}
+	if x, ok := reader.ReadUrl(2); ok {
+		v.Origin = x
+	}
+	if x, ok := reader.ReadUrl(3); ok {
+		v.KeyPage = x
+	}
+	if x, ok := reader.ReadUint(4); ok {
+		v.Threshold = x
+	}
+	for {
+		if x, ok := reader.ReadBytes(5); ok {
+			v.Signed = append(v.Signed, x)
+		} else {
+			break
+		}
+	}
+	for {
+		if x, ok := reader.ReadBytes(6); ok {
+			v.Unsigned = append(v.Unsigned, x)
+		} else {
+			break
+		}
+	}
+	if x, ok := reader.ReadBool(7); ok {
+		v.Invalidated = x
+	}
+
+	seen, err := reader.Reset(fieldNames_ResponseSignatureStatus)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *ResponseSimulate) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *ResponseSimulate) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUint(1); ok {
+		v.Fee = x
+	}
+	if x, ok := reader.ReadBool(2); ok {
+		v.Pending = x
+	}
+	if x, ok := reader.ReadBytes(3); ok {
+		v.Result = x
+	}
+	for {
+		if x := new(AccountChange); reader.ReadValue(4, x.UnmarshalBinary) {
+			v.Changes = append(v.Changes, x)
+		} else {
+			break
+		}
+	}
+	for {
+		if x, ok := reader.ReadBytes(5); ok {
+			v.Synthetic = append(v.Synthetic, x)
+		} else {
+			break
+		}
+	}
+
+	seen, err := reader.Reset(fieldNames_ResponseSimulate)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *ResponseSyntheticOutbox) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *ResponseSyntheticOutbox) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadUint(1); ok {
+		v.Block = x
+	}
+	if x, ok := reader.ReadTime(2); ok {
+		v.Time = x
+	}
+	for {
+		if x := new(StuckSyntheticTransaction); reader.ReadValue(3, x.UnmarshalBinary) {
+			v.Transactions = append(v.Transactions, x)
+		} else {
+			break
+		}
+	}
+
+	seen, err := reader.Reset(fieldNames_ResponseSyntheticOutbox)
+	v.fieldsSet = seen
+	return err
+}
+
 func (v *ResponseTxHistory) UnmarshalBinary(data []byte) error {
 	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
 }
@@ -1010,6 +3585,46 @@ func (v *ResponseTxHistory) UnmarshalBinaryFrom(rd io.Reader) error {
 	return err
 }
 
+func (v *StuckSyntheticTransaction) UnmarshalBinary(data []byte) error {
+	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
+}
+
+func (v *StuckSyntheticTransaction) UnmarshalBinaryFrom(rd io.Reader) error {
+	reader := encoding.NewReader(rd)
+
+	if x, ok := reader.ReadHash(1); ok {
+		v.Transaction = *x
+	}
+	if x, ok := reader.ReadUrl(2); ok {
+		v.Source = x
+	}
+	if x := new(protocol.TransactionType); reader.ReadEnum(3, x) {
+		v.Type = *x
+	}
+	if x, ok := reader.ReadUrl(4); ok {
+		v.Destination = x
+	}
+	if x, ok := reader.ReadUint(5); ok {
+		v.ProducedBlock = x
+	}
+	if x, ok := reader.ReadTime(6); ok {
+		v.ProducedTime = x
+	}
+	if x, ok := reader.ReadUint(7); ok {
+		v.Age = x
+	}
+	if x, ok := reader.ReadUint(8); ok {
+		v.Attempts = x
+	}
+	if x, ok := reader.ReadUint(9); ok {
+		v.NextAttempt = x
+	}
+
+	seen, err := reader.Reset(fieldNames_StuckSyntheticTransaction)
+	v.fieldsSet = seen
+	return err
+}
+
 func (v *TxReceipt) UnmarshalBinary(data []byte) error {
 	return v.UnmarshalBinaryFrom(bytes.NewReader(data))
 }
@@ -1030,9 +3645,37 @@ func (v *TxReceipt) UnmarshalBinaryFrom(rd io.Reader) error {
 		v.Receipt = *x
 	}
 
-	seen, err := reader.Reset(fieldNames_TxReceipt)
-	v.fieldsSet = seen
-	return err
+	seen, err := reader.Reset(fieldNames_TxReceipt)
+	v.fieldsSet = seen
+	return err
+}
+
+func (v *AccountChange) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Url    *url.URL `json:"url,omitempty"`
+		Before *string  `json:"before,omitempty"`
+		After  *string  `json:"after,omitempty"`
+	}{}
+	u.Url = v.Url
+	u.Before = encoding.BytesToJSON(v.Before)
+	u.After = encoding.BytesToJSON(v.After)
+	return json.Marshal(&u)
+}
+
+func (v *RequestAnchorProof) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Anchor *string `json:"anchor,omitempty"`
+	}{}
+	u.Anchor = encoding.BytesToJSON(v.Anchor)
+	return json.Marshal(&u)
+}
+
+func (v *RequestKey) MarshalJSON() ([]byte, error) {
+	u := struct {
+		KeyHash string `json:"keyHash,omitempty"`
+	}{}
+	u.KeyHash = encoding.ChainToJSON(v.KeyHash)
+	return json.Marshal(&u)
 }
 
 func (v *RequestKeyPageIndex) MarshalJSON() ([]byte, error) {
@@ -1045,6 +3688,81 @@ func (v *RequestKeyPageIndex) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&u)
 }
 
+func (v *RequestProof) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Url  *url.URL `json:"url,omitempty"`
+		TxId *string  `json:"txId,omitempty"`
+	}{}
+	u.Url = v.Url
+	u.TxId = encoding.BytesToJSON(v.TxId)
+	return json.Marshal(&u)
+}
+
+func (v *RequestSignatureStatus) MarshalJSON() ([]byte, error) {
+	u := struct {
+		TxId string `json:"txId,omitempty"`
+	}{}
+	u.TxId = encoding.ChainToJSON(v.TxId)
+	return json.Marshal(&u)
+}
+
+func (v *RequestSimulate) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Envelope *string `json:"envelope,omitempty"`
+	}{}
+	u.Envelope = encoding.BytesToJSON(v.Envelope)
+	return json.Marshal(&u)
+}
+
+func (v *ResponseAnchorProof) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Anchor       *string          `json:"anchor,omitempty"`
+		Account      *url.URL         `json:"account,omitempty"`
+		Chain        string           `json:"chain,omitempty"`
+		Block        uint64           `json:"block,omitempty"`
+		ChainReceipt protocol.Receipt `json:"chainReceipt,omitempty"`
+		State        *string          `json:"state,omitempty"`
+		ChainAnchors []*string        `json:"chainAnchors,omitempty"`
+		StateReceipt protocol.Receipt `json:"stateReceipt,omitempty"`
+	}{}
+	u.Anchor = encoding.BytesToJSON(v.Anchor)
+	u.Account = v.Account
+	u.Chain = v.Chain
+	u.Block = v.Block
+	u.ChainReceipt = v.ChainReceipt
+	u.State = encoding.BytesToJSON(v.State)
+	u.ChainAnchors = make([]*string, len(v.ChainAnchors))
+	for i, x := range v.ChainAnchors {
+		u.ChainAnchors[i] = encoding.BytesToJSON(x)
+	}
+	u.StateReceipt = v.StateReceipt
+	return json.Marshal(&u)
+}
+
+func (v *ResponseBlock) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Height                uint64                    `json:"height,omitempty"`
+		Time                  time.Time                 `json:"time,omitempty"`
+		RootAnchor            string                    `json:"rootAnchor,omitempty"`
+		Updates               []protocol.AnchorMetadata `json:"updates,omitempty"`
+		Transactions          []string                  `json:"transactions,omitempty"`
+		SyntheticTransactions []string                  `json:"syntheticTransactions,omitempty"`
+	}{}
+	u.Height = v.Height
+	u.Time = v.Time
+	u.RootAnchor = encoding.ChainToJSON(v.RootAnchor)
+	u.Updates = v.Updates
+	u.Transactions = make([]string, len(v.Transactions))
+	for i, x := range v.Transactions {
+		u.Transactions[i] = encoding.ChainToJSON(x)
+	}
+	u.SyntheticTransactions = make([]string, len(v.SyntheticTransactions))
+	for i, x := range v.SyntheticTransactions {
+		u.SyntheticTransactions[i] = encoding.ChainToJSON(x)
+	}
+	return json.Marshal(&u)
+}
+
 func (v *ResponseByTxId) MarshalJSON() ([]byte, error) {
 	u := struct {
 		TxId               string       `json:"txId,omitempty"`
@@ -1115,6 +3833,164 @@ func (v *ResponsePending) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&u)
 }
 
+func (v *ResponseProof) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Account         *url.URL            `json:"account,omitempty"`
+		Chain           string              `json:"chain,omitempty"`
+		Start           *string             `json:"start,omitempty"`
+		LocalBlock      uint64              `json:"localBlock,omitempty"`
+		DirectoryBlock  uint64              `json:"directoryBlock,omitempty"`
+		DirectoryAnchor *string             `json:"directoryAnchor,omitempty"`
+		Segments        []*protocol.Receipt `json:"segments,omitempty"`
+		Receipt         protocol.Receipt    `json:"receipt,omitempty"`
+		State           *string             `json:"state,omitempty"`
+		ChainAnchors    []*string           `json:"chainAnchors,omitempty"`
+		Transaction     *string             `json:"transaction,omitempty"`
+	}{}
+	u.Account = v.Account
+	u.Chain = v.Chain
+	u.Start = encoding.BytesToJSON(v.Start)
+	u.LocalBlock = v.LocalBlock
+	u.DirectoryBlock = v.DirectoryBlock
+	u.DirectoryAnchor = encoding.BytesToJSON(v.DirectoryAnchor)
+	u.Segments = v.Segments
+	u.Receipt = v.Receipt
+	u.State = encoding.BytesToJSON(v.State)
+	u.ChainAnchors = make([]*string, len(v.ChainAnchors))
+	for i, x := range v.ChainAnchors {
+		u.ChainAnchors[i] = encoding.BytesToJSON(x)
+	}
+	u.Transaction = encoding.BytesToJSON(v.Transaction)
+	return json.Marshal(&u)
+}
+
+func (v *ResponseSignatureStatus) MarshalJSON() ([]byte, error) {
+	u := struct {
+		TxId        string    `json:"txId,omitempty"`
+		Origin      *url.URL  `json:"origin,omitempty"`
+		KeyPage     *url.URL  `json:"keyPage,omitempty"`
+		Threshold   uint64    `json:"threshold,omitempty"`
+		Signed      []*string `json:"signed,omitempty"`
+		Unsigned    []*string `json:"unsigned,omitempty"`
+		Invalidated bool      `json:"invalidated,omitempty"`
+	}{}
+	u.TxId = encoding.ChainToJSON(v.TxId)
+	u.Origin = v.Origin
+	u.KeyPage = v.KeyPage
+	u.Threshold = v.Threshold
+	u.Signed = make([]*string, len(v.Signed))
+	for i, x := range v.Signed {
+		u.Signed[i] = encoding.BytesToJSON(x)
+	}
+	u.Unsigned = make([]*string, len(v.Unsigned))
+	for i, x := range v.Unsigned {
+		u.Unsigned[i] = encoding.BytesToJSON(x)
+	}
+	u.Invalidated = v.Invalidated
+	return json.Marshal(&u)
+}
+
+func (v *ResponseSimulate) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Fee       uint64           `json:"fee,omitempty"`
+		Pending   bool             `json:"pending,omitempty"`
+		Result    *string          `json:"result,omitempty"`
+		Changes   []*AccountChange `json:"changes,omitempty"`
+		Synthetic []*string        `json:"synthetic,omitempty"`
+	}{}
+	u.Fee = v.Fee
+	u.Pending = v.Pending
+	u.Result = encoding.BytesToJSON(v.Result)
+	u.Changes = v.Changes
+	u.Synthetic = make([]*string, len(v.Synthetic))
+	for i, x := range v.Synthetic {
+		u.Synthetic[i] = encoding.BytesToJSON(x)
+	}
+	return json.Marshal(&u)
+}
+
+func (v *StuckSyntheticTransaction) MarshalJSON() ([]byte, error) {
+	u := struct {
+		Transaction   string                   `json:"transaction,omitempty"`
+		Source        *url.URL                 `json:"source,omitempty"`
+		Type          protocol.TransactionType `json:"type,omitempty"`
+		Destination   *url.URL                 `json:"destination,omitempty"`
+		ProducedBlock uint64                   `json:"producedBlock,omitempty"`
+		ProducedTime  time.Time                `json:"producedTime,omitempty"`
+		Age           uint64                   `json:"age,omitempty"`
+		Attempts      uint64                   `json:"attempts,omitempty"`
+		NextAttempt   uint64                   `json:"nextAttempt,omitempty"`
+	}{}
+	u.Transaction = encoding.ChainToJSON(v.Transaction)
+	u.Source = v.Source
+	u.Type = v.Type
+	u.Destination = v.Destination
+	u.ProducedBlock = v.ProducedBlock
+	u.ProducedTime = v.ProducedTime
+	u.Age = v.Age
+	u.Attempts = v.Attempts
+	u.NextAttempt = v.NextAttempt
+	return json.Marshal(&u)
+}
+
+func (v *AccountChange) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Url    *url.URL `json:"url,omitempty"`
+		Before *string  `json:"before,omitempty"`
+		After  *string  `json:"after,omitempty"`
+	}{}
+	u.Url = v.Url
+	u.Before = encoding.BytesToJSON(v.Before)
+	u.After = encoding.BytesToJSON(v.After)
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Url = u.Url
+	if x, err := encoding.BytesFromJSON(u.Before); err != nil {
+		return fmt.Errorf("error decoding Before: %w", err)
+	} else {
+		v.Before = x
+	}
+	if x, err := encoding.BytesFromJSON(u.After); err != nil {
+		return fmt.Errorf("error decoding After: %w", err)
+	} else {
+		v.After = x
+	}
+	return nil
+}
+
+func (v *RequestAnchorProof) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Anchor *string `json:"anchor,omitempty"`
+	}{}
+	u.Anchor = encoding.BytesToJSON(v.Anchor)
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	if x, err := encoding.BytesFromJSON(u.Anchor); err != nil {
+		return fmt.Errorf("error decoding Anchor: %w", err)
+	} else {
+		v.Anchor = x
+	}
+	return nil
+}
+
+func (v *RequestKey) UnmarshalJSON(data []byte) error {
+	u := struct {
+		KeyHash string `json:"keyHash,omitempty"`
+	}{}
+	u.KeyHash = encoding.ChainToJSON(v.KeyHash)
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	if x, err := encoding.ChainFromJSON(u.KeyHash); err != nil {
+		return fmt.Errorf("error decoding KeyHash: %w", err)
+	} else {
+		v.KeyHash = x
+	}
+	return nil
+}
+
 func (v *RequestKeyPageIndex) UnmarshalJSON(data []byte) error {
 	u := struct {
 		Url *url.URL `json:"url,omitempty"`
@@ -1134,6 +4010,159 @@ func (v *RequestKeyPageIndex) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (v *RequestProof) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Url  *url.URL `json:"url,omitempty"`
+		TxId *string  `json:"txId,omitempty"`
+	}{}
+	u.Url = v.Url
+	u.TxId = encoding.BytesToJSON(v.TxId)
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Url = u.Url
+	if x, err := encoding.BytesFromJSON(u.TxId); err != nil {
+		return fmt.Errorf("error decoding TxId: %w", err)
+	} else {
+		v.TxId = x
+	}
+	return nil
+}
+
+func (v *RequestSignatureStatus) UnmarshalJSON(data []byte) error {
+	u := struct {
+		TxId string `json:"txId,omitempty"`
+	}{}
+	u.TxId = encoding.ChainToJSON(v.TxId)
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	if x, err := encoding.ChainFromJSON(u.TxId); err != nil {
+		return fmt.Errorf("error decoding TxId: %w", err)
+	} else {
+		v.TxId = x
+	}
+	return nil
+}
+
+func (v *RequestSimulate) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Envelope *string `json:"envelope,omitempty"`
+	}{}
+	u.Envelope = encoding.BytesToJSON(v.Envelope)
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	if x, err := encoding.BytesFromJSON(u.Envelope); err != nil {
+		return fmt.Errorf("error decoding Envelope: %w", err)
+	} else {
+		v.Envelope = x
+	}
+	return nil
+}
+
+func (v *ResponseAnchorProof) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Anchor       *string          `json:"anchor,omitempty"`
+		Account      *url.URL         `json:"account,omitempty"`
+		Chain        string           `json:"chain,omitempty"`
+		Block        uint64           `json:"block,omitempty"`
+		ChainReceipt protocol.Receipt `json:"chainReceipt,omitempty"`
+		State        *string          `json:"state,omitempty"`
+		ChainAnchors []*string        `json:"chainAnchors,omitempty"`
+		StateReceipt protocol.Receipt `json:"stateReceipt,omitempty"`
+	}{}
+	u.Anchor = encoding.BytesToJSON(v.Anchor)
+	u.Account = v.Account
+	u.Chain = v.Chain
+	u.Block = v.Block
+	u.ChainReceipt = v.ChainReceipt
+	u.State = encoding.BytesToJSON(v.State)
+	u.ChainAnchors = make([]*string, len(v.ChainAnchors))
+	for i, x := range v.ChainAnchors {
+		u.ChainAnchors[i] = encoding.BytesToJSON(x)
+	}
+	u.StateReceipt = v.StateReceipt
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	if x, err := encoding.BytesFromJSON(u.Anchor); err != nil {
+		return fmt.Errorf("error decoding Anchor: %w", err)
+	} else {
+		v.Anchor = x
+	}
+	v.Account = u.Account
+	v.Chain = u.Chain
+	v.Block = u.Block
+	v.ChainReceipt = u.ChainReceipt
+	if x, err := encoding.BytesFromJSON(u.State); err != nil {
+		return fmt.Errorf("error decoding State: %w", err)
+	} else {
+		v.State = x
+	}
+	v.ChainAnchors = make([][]byte, len(u.ChainAnchors))
+	for i, x := range u.ChainAnchors {
+		if x, err := encoding.BytesFromJSON(x); err != nil {
+			return fmt.Errorf("error decoding ChainAnchors: %w", err)
+		} else {
+			v.ChainAnchors[i] = x
+		}
+	}
+	v.StateReceipt = u.StateReceipt
+	return nil
+}
+
+func (v *ResponseBlock) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Height                uint64                    `json:"height,omitempty"`
+		Time                  time.Time                 `json:"time,omitempty"`
+		RootAnchor            string                    `json:"rootAnchor,omitempty"`
+		Updates               []protocol.AnchorMetadata `json:"updates,omitempty"`
+		Transactions          []string                  `json:"transactions,omitempty"`
+		SyntheticTransactions []string                  `json:"syntheticTransactions,omitempty"`
+	}{}
+	u.Height = v.Height
+	u.Time = v.Time
+	u.RootAnchor = encoding.ChainToJSON(v.RootAnchor)
+	u.Updates = v.Updates
+	u.Transactions = make([]string, len(v.Transactions))
+	for i, x := range v.Transactions {
+		u.Transactions[i] = encoding.ChainToJSON(x)
+	}
+	u.SyntheticTransactions = make([]string, len(v.SyntheticTransactions))
+	for i, x := range v.SyntheticTransactions {
+		u.SyntheticTransactions[i] = encoding.ChainToJSON(x)
+	}
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Height = u.Height
+	v.Time = u.Time
+	if x, err := encoding.ChainFromJSON(u.RootAnchor); err != nil {
+		return fmt.Errorf("error decoding RootAnchor: %w", err)
+	} else {
+		v.RootAnchor = x
+	}
+	v.Updates = u.Updates
+	v.Transactions = make([][32]byte, len(u.Transactions))
+	for i, x := range u.Transactions {
+		if x, err := encoding.ChainFromJSON(x); err != nil {
+			return fmt.Errorf("error decoding Transactions: %w", err)
+		} else {
+			v.Transactions[i] = x
+		}
+	}
+	v.SyntheticTransactions = make([][32]byte, len(u.SyntheticTransactions))
+	for i, x := range u.SyntheticTransactions {
+		if x, err := encoding.ChainFromJSON(x); err != nil {
+			return fmt.Errorf("error decoding SyntheticTransactions: %w", err)
+		} else {
+			v.SyntheticTransactions[i] = x
+		}
+	}
+	return nil
+}
+
 func (v *ResponseByTxId) UnmarshalJSON(data []byte) error {
 	u := struct {
 		TxId               string       `json:"txId,omitempty"`
@@ -1280,3 +4309,203 @@ func (v *ResponsePending) UnmarshalJSON(data []byte) error {
 	}
 	return nil
 }
+
+func (v *ResponseProof) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Account         *url.URL            `json:"account,omitempty"`
+		Chain           string              `json:"chain,omitempty"`
+		Start           *string             `json:"start,omitempty"`
+		LocalBlock      uint64              `json:"localBlock,omitempty"`
+		DirectoryBlock  uint64              `json:"directoryBlock,omitempty"`
+		DirectoryAnchor *string             `json:"directoryAnchor,omitempty"`
+		Segments        []*protocol.Receipt `json:"segments,omitempty"`
+		Receipt         protocol.Receipt    `json:"receipt,omitempty"`
+		State           *string             `json:"state,omitempty"`
+		ChainAnchors    []*string           `json:"chainAnchors,omitempty"`
+		Transaction     *string             `json:"transaction,omitempty"`
+	}{}
+	u.Account = v.Account
+	u.Chain = v.Chain
+	u.Start = encoding.BytesToJSON(v.Start)
+	u.LocalBlock = v.LocalBlock
+	u.DirectoryBlock = v.DirectoryBlock
+	u.DirectoryAnchor = encoding.BytesToJSON(v.DirectoryAnchor)
+	u.Segments = v.Segments
+	u.Receipt = v.Receipt
+	u.State = encoding.BytesToJSON(v.State)
+	u.ChainAnchors = make([]*string, len(v.ChainAnchors))
+	for i, x := range v.ChainAnchors {
+		u.ChainAnchors[i] = encoding.BytesToJSON(x)
+	}
+	u.Transaction = encoding.BytesToJSON(v.Transaction)
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Account = u.Account
+	v.Chain = u.Chain
+	if x, err := encoding.BytesFromJSON(u.Start); err != nil {
+		return fmt.Errorf("error decoding Start: %w", err)
+	} else {
+		v.Start = x
+	}
+	v.LocalBlock = u.LocalBlock
+	v.DirectoryBlock = u.DirectoryBlock
+	if x, err := encoding.BytesFromJSON(u.DirectoryAnchor); err != nil {
+		return fmt.Errorf("error decoding DirectoryAnchor: %w", err)
+	} else {
+		v.DirectoryAnchor = x
+	}
+	v.Segments = u.Segments
+	v.Receipt = u.Receipt
+	if x, err := encoding.BytesFromJSON(u.State); err != nil {
+		return fmt.Errorf("error decoding State: %w", err)
+	} else {
+		v.State = x
+	}
+	v.ChainAnchors = make([][]byte, len(u.ChainAnchors))
+	for i, x := range u.ChainAnchors {
+		if x, err := encoding.BytesFromJSON(x); err != nil {
+			return fmt.Errorf("error decoding ChainAnchors: %w", err)
+		} else {
+			v.ChainAnchors[i] = x
+		}
+	}
+	if x, err := encoding.BytesFromJSON(u.Transaction); err != nil {
+		return fmt.Errorf("error decoding Transaction: %w", err)
+	} else {
+		v.Transaction = x
+	}
+	return nil
+}
+
+func (v *ResponseSignatureStatus) UnmarshalJSON(data []byte) error {
+	u := struct {
+		TxId        string    `json:"txId,omitempty"`
+		Origin      *url.URL  `json:"origin,omitempty"`
+		KeyPage     *url.URL  `json:"keyPage,omitempty"`
+		Threshold   uint64    `json:"threshold,omitempty"`
+		Signed      []*string `json:"signed,omitempty"`
+		Unsigned    []*string `json:"unsigned,omitempty"`
+		Invalidated bool      `json:"invalidated,omitempty"`
+	}{}
+	u.TxId = encoding.ChainToJSON(v.TxId)
+	u.Origin = v.Origin
+	u.KeyPage = v.KeyPage
+	u.Threshold = v.Threshold
+	u.Signed = make([]*string, len(v.Signed))
+	for i, x := range v.Signed {
+		u.Signed[i] = encoding.BytesToJSON(x)
+	}
+	u.Unsigned = make([]*string, len(v.Unsigned))
+	for i, x := range v.Unsigned {
+		u.Unsigned[i] = encoding.BytesToJSON(x)
+	}
+	u.Invalidated = v.Invalidated
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	if x, err := encoding.ChainFromJSON(u.TxId); err != nil {
+		return fmt.Errorf("error decoding TxId: %w", err)
+	} else {
+		v.TxId = x
+	}
+	v.Origin = u.Origin
+	v.KeyPage = u.KeyPage
+	v.Threshold = u.Threshold
+	v.Signed = make([][]byte, len(u.Signed))
+	for i, x := range u.Signed {
+		if x, err := encoding.BytesFromJSON(x); err != nil {
+			return fmt.Errorf("error decoding Signed: %w", err)
+		} else {
+			v.Signed[i] = x
+		}
+	}
+	v.Unsigned = make([][]byte, len(u.Unsigned))
+	for i, x := range u.Unsigned {
+		if x, err := encoding.BytesFromJSON(x); err != nil {
+			return fmt.Errorf("error decoding Unsigned: %w", err)
+		} else {
+			v.Unsigned[i] = x
+		}
+	}
+	v.Invalidated = u.Invalidated
+	return nil
+}
+
+func (v *ResponseSimulate) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Fee       uint64           `json:"fee,omitempty"`
+		Pending   bool             `json:"pending,omitempty"`
+		Result    *string          `json:"result,omitempty"`
+		Changes   []*AccountChange `json:"changes,omitempty"`
+		Synthetic []*string        `json:"synthetic,omitempty"`
+	}{}
+	u.Fee = v.Fee
+	u.Pending = v.Pending
+	u.Result = encoding.BytesToJSON(v.Result)
+	u.Changes = v.Changes
+	u.Synthetic = make([]*string, len(v.Synthetic))
+	for i, x := range v.Synthetic {
+		u.Synthetic[i] = encoding.BytesToJSON(x)
+	}
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	v.Fee = u.Fee
+	v.Pending = u.Pending
+	if x, err := encoding.BytesFromJSON(u.Result); err != nil {
+		return fmt.Errorf("error decoding Result: %w", err)
+	} else {
+		v.Result = x
+	}
+	v.Changes = u.Changes
+	v.Synthetic = make([][]byte, len(u.Synthetic))
+	for i, x := range u.Synthetic {
+		if x, err := encoding.BytesFromJSON(x); err != nil {
+			return fmt.Errorf("error decoding Synthetic: %w", err)
+		} else {
+			v.Synthetic[i] = x
+		}
+	}
+	return nil
+}
+
+func (v *StuckSyntheticTransaction) UnmarshalJSON(data []byte) error {
+	u := struct {
+		Transaction   string                   `json:"transaction,omitempty"`
+		Source        *url.URL                 `json:"source,omitempty"`
+		Type          protocol.TransactionType `json:"type,omitempty"`
+		Destination   *url.URL                 `json:"destination,omitempty"`
+		ProducedBlock uint64                   `json:"producedBlock,omitempty"`
+		ProducedTime  time.Time                `json:"producedTime,omitempty"`
+		Age           uint64                   `json:"age,omitempty"`
+		Attempts      uint64                   `json:"attempts,omitempty"`
+		NextAttempt   uint64                   `json:"nextAttempt,omitempty"`
+	}{}
+	u.Transaction = encoding.ChainToJSON(v.Transaction)
+	u.Source = v.Source
+	u.Type = v.Type
+	u.Destination = v.Destination
+	u.ProducedBlock = v.ProducedBlock
+	u.ProducedTime = v.ProducedTime
+	u.Age = v.Age
+	u.Attempts = v.Attempts
+	u.NextAttempt = v.NextAttempt
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	if x, err := encoding.ChainFromJSON(u.Transaction); err != nil {
+		return fmt.Errorf("error decoding Transaction: %w", err)
+	} else {
+		v.Transaction = x
+	}
+	v.Source = u.Source
+	v.Type = u.Type
+	v.Destination = u.Destination
+	v.ProducedBlock = u.ProducedBlock
+	v.ProducedTime = u.ProducedTime
+	v.Age = u.Age
+	v.Attempts = u.Attempts
+	v.NextAttempt = u.NextAttempt
+	return nil
+}
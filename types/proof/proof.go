@@ -0,0 +1,184 @@
+// Package proof verifies proofs returned by the query-proof API method without
+// contacting a node.
+//
+// A proof consists of segments. Each segment proves that its start is included
+// in the start of the next segment. The segments of a transaction proof go
+// from the transaction hash to the anchor of the account chain, from there to
+// the anchor of the subnet's minor root chain, and from there to the directory
+// root anchor. The segments of an account state proof start with the account's
+// BPT entry and go through the BPT root instead of an account chain.
+package proof
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+	"gitlab.com/accumulatenetwork/accumulate/smt/managed"
+	"gitlab.com/accumulatenetwork/accumulate/types/api/query"
+)
+
+// ErrInvalidProof is returned if a proof is not valid.
+var ErrInvalidProof = errors.New("invalid proof")
+
+// Verify verifies that the proof proves that the given hash is included in
+// the directory root anchor of the proof. Verify returns the combined receipt.
+//
+// Verify does not establish that the directory root anchor is genuine. The
+// caller must compare the DirectoryAnchor of the proof with a directory root
+// anchor it trusts.
+func Verify(proof *query.ResponseProof, hash []byte) (*managed.Receipt, error) {
+	if len(proof.Segments) == 0 {
+		return nil, fmt.Errorf("%w: no segments", ErrInvalidProof)
+	}
+
+	var receipt *managed.Receipt
+	for i, segment := range proof.Segments {
+		r := segment.Convert()
+		if i == 0 {
+			receipt = r
+			continue
+		}
+
+		var err error
+		receipt, err = receipt.Combine(r)
+		if err != nil {
+			return nil, fmt.Errorf("%w: segment %d does not start where segment %d ends: %v", ErrInvalidProof, i, i-1, err)
+		}
+	}
+
+	if !receipt.Validate() {
+		return nil, fmt.Errorf("%w: the combined receipt does not validate", ErrInvalidProof)
+	}
+
+	if !bytes.Equal(receipt.Element, hash) {
+		return nil, fmt.Errorf("%w: want start %X, got %X", ErrInvalidProof, hash, receipt.Element)
+	}
+
+	if !bytes.Equal(receipt.MDRoot, proof.DirectoryAnchor) {
+		return nil, fmt.Errorf("%w: want directory anchor %X, got %X", ErrInvalidProof, proof.DirectoryAnchor, receipt.MDRoot)
+	}
+
+	// The combined receipt included with the proof must match the segments
+	combined := proof.Receipt.Convert()
+	if !bytes.Equal(combined.Element, receipt.Element) || !bytes.Equal(combined.MDRoot, receipt.MDRoot) {
+		return nil, fmt.Errorf("%w: the receipt does not match the segments", ErrInvalidProof)
+	}
+
+	return receipt, nil
+}
+
+// StateHash computes the BPT entry of an account from its marshalled state and
+// the anchors of its chains, in the order the chains are listed in the
+// account's metadata.
+func StateHash(state []byte, chainAnchors [][]byte) []byte {
+	h := sha256.Sum256(state)
+	hashes := append([]byte{}, h[:]...)
+	for _, anchor := range chainAnchors {
+		h := sha256.Sum256(anchor)
+		hashes = append(hashes, h[:]...)
+	}
+
+	h = sha256.Sum256(hashes)
+	return h[:]
+}
+
+// VerifyAccount verifies a proof of the state of an account and returns the
+// account.
+func VerifyAccount(proof *query.ResponseProof) (protocol.Account, error) {
+	if len(proof.State) == 0 {
+		return nil, fmt.Errorf("%w: missing account state", ErrInvalidProof)
+	}
+
+	_, err := Verify(proof, StateHash(proof.State, proof.ChainAnchors))
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := protocol.UnmarshalAccount(proof.State)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid account state: %v", ErrInvalidProof, err)
+	}
+
+	if proof.Account != nil && !proof.Account.Equal(account.Header().Url) {
+		return nil, fmt.Errorf("%w: want account %v, got %v", ErrInvalidProof, proof.Account, account.Header().Url)
+	}
+
+	return account, nil
+}
+
+// VerifyAnchor verifies a proof that the directory recorded the given root
+// anchor of a subnet and returns the BPT root of the directory the proof ends
+// at.
+//
+// VerifyAnchor does not establish that the BPT root is genuine. The caller must
+// compare it with the app hash of a directory header it trusts.
+func VerifyAnchor(proof *query.ResponseAnchorProof, anchor []byte) ([]byte, error) {
+	// The anchor is an entry of a chain of the anchor pool
+	receipt := proof.ChainReceipt.Convert()
+	if !receipt.Validate() {
+		return nil, fmt.Errorf("%w: the chain receipt does not validate", ErrInvalidProof)
+	}
+	if !bytes.Equal(receipt.Element, anchor) {
+		return nil, fmt.Errorf("%w: want start %X, got %X", ErrInvalidProof, anchor, receipt.Element)
+	}
+
+	var found bool
+	for _, chainAnchor := range proof.ChainAnchors {
+		if bytes.Equal(chainAnchor, receipt.MDRoot) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: the chain receipt does not end at an anchor of the account", ErrInvalidProof)
+	}
+
+	// The state of the anchor pool is an entry of the BPT
+	bpt := proof.StateReceipt.Convert()
+	if !bpt.Validate() {
+		return nil, fmt.Errorf("%w: the state receipt does not validate", ErrInvalidProof)
+	}
+	if !bytes.Equal(bpt.Element, StateHash(proof.State, proof.ChainAnchors)) {
+		return nil, fmt.Errorf("%w: the state receipt does not start at the state of the account", ErrInvalidProof)
+	}
+
+	account, err := protocol.UnmarshalAccount(proof.State)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid account state: %v", ErrInvalidProof, err)
+	}
+	if account.GetType() != protocol.AccountTypeAnchor || !protocol.IsDnUrl(account.Header().Url) {
+		return nil, fmt.Errorf("%w: %v is not the anchor pool of the directory", ErrInvalidProof, account.Header().Url)
+	}
+
+	return bpt.MDRoot, nil
+}
+
+// VerifyTransaction verifies a proof of the transaction with the given hash
+// and returns the transaction.
+func VerifyTransaction(proof *query.ResponseProof, txid []byte) (*protocol.Transaction, error) {
+	if len(proof.Transaction) == 0 {
+		return nil, fmt.Errorf("%w: missing transaction", ErrInvalidProof)
+	}
+
+	_, err := Verify(proof, txid)
+	if err != nil {
+		return nil, err
+	}
+
+	txn := new(protocol.Transaction)
+	err = txn.UnmarshalBinary(proof.Transaction)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid transaction: %v", ErrInvalidProof, err)
+	}
+
+	env := new(protocol.Envelope)
+	env.Transaction = txn
+	if !bytes.Equal(env.GetTxHash(), txid) {
+		return nil, fmt.Errorf("%w: the transaction does not match its hash", ErrInvalidProof)
+	}
+
+	return txn, nil
+}
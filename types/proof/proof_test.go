@@ -0,0 +1,78 @@
+package proof_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/accumulatenetwork/accumulate/protocol"
+	"gitlab.com/accumulatenetwork/accumulate/smt/managed"
+	"gitlab.com/accumulatenetwork/accumulate/types/api/query"
+	. "gitlab.com/accumulatenetwork/accumulate/types/proof"
+)
+
+func hash(s string) []byte {
+	return managed.Sha256([]byte(s))
+}
+
+func newProof() (*query.ResponseProof, []byte) {
+	start := hash("transaction")
+	a, b := hash("a"), hash("b")
+
+	// First segment: start is on the left, a is on the right
+	mid := managed.Hash(start).Combine(managed.Sha256, a)
+
+	// Second segment: b is on the left, mid is on the right
+	end := managed.Hash(b).Combine(managed.Sha256, mid)
+
+	proof := new(query.ResponseProof)
+	proof.DirectoryAnchor = end
+	proof.Segments = []*protocol.Receipt{
+		{Start: start, Entries: []protocol.ReceiptEntry{{Hash: a, Right: true}}},
+		{Start: mid, Entries: []protocol.ReceiptEntry{{Hash: b}}},
+	}
+	proof.Receipt = protocol.Receipt{Start: start, Entries: []protocol.ReceiptEntry{{Hash: a, Right: true}, {Hash: b}}}
+	return proof, start
+}
+
+func TestVerify(t *testing.T) {
+	proof, start := newProof()
+	receipt, err := Verify(proof, start)
+	require.NoError(t, err)
+	require.Equal(t, proof.DirectoryAnchor, []byte(receipt.MDRoot))
+}
+
+func TestVerifyInvalid(t *testing.T) {
+	t.Run("Wrong start", func(t *testing.T) {
+		proof, _ := newProof()
+		_, err := Verify(proof, hash("other"))
+		require.ErrorIs(t, err, ErrInvalidProof)
+	})
+
+	t.Run("Wrong anchor", func(t *testing.T) {
+		proof, start := newProof()
+		proof.DirectoryAnchor = hash("other")
+		_, err := Verify(proof, start)
+		require.ErrorIs(t, err, ErrInvalidProof)
+	})
+
+	t.Run("Broken chain", func(t *testing.T) {
+		proof, start := newProof()
+		proof.Segments[1].Start = hash("other")
+		_, err := Verify(proof, start)
+		require.ErrorIs(t, err, ErrInvalidProof)
+	})
+
+	t.Run("Tampered entry", func(t *testing.T) {
+		proof, start := newProof()
+		proof.Segments[0].Entries[0].Right = false
+		_, err := Verify(proof, start)
+		require.ErrorIs(t, err, ErrInvalidProof)
+	})
+
+	t.Run("No segments", func(t *testing.T) {
+		proof, start := newProof()
+		proof.Segments = nil
+		_, err := Verify(proof, start)
+		require.ErrorIs(t, err, ErrInvalidProof)
+	})
+}
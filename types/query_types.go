@@ -4,40 +4,70 @@ type QueryType uint64
 
 //QueryType enumeration order matters, do not change order when adding new enums.
 const (
-	QueryTypeUnknown      = QueryType(iota)
-	QueryTypeUrl          // Query by Url
-	QueryTypeChainId      // Query by chain id
-	QueryTypeTxId         // Query tx and pending chains By TxId
-	QueryTypeTxHistory    // Query transaction history
-	QueryTypeDirectoryUrl // Query directory by URL
-	QueryTypeData         // Query a specific data entry using the url and optional entry hash
-	QueryTypeDataSet      // Query a set of data given pagination parameters for a given URL
-	QueryTypeKeyPageIndex // Query key page index
+	QueryTypeUnknown           = QueryType(iota)
+	QueryTypeUrl               // Query by Url
+	QueryTypeChainId           // Query by chain id
+	QueryTypeTxId              // Query tx and pending chains By TxId
+	QueryTypeTxHistory         // Query transaction history
+	QueryTypeDirectoryUrl      // Query directory by URL
+	QueryTypeData              // Query a specific data entry using the url and optional entry hash
+	QueryTypeDataSet           // Query a set of data given pagination parameters for a given URL
+	QueryTypeKeyPageIndex      // Query key page index
+	QueryTypePendingSignatures // Query pending transactions waiting on a key page
+	QueryTypeSignatureStatus   // Query the signatures of a pending transaction
+	QueryTypeProof             // Query a proof of a transaction or account state
+	QueryTypeSyntheticOutbox   // Query synthetic transactions that have not been acknowledged
+	QueryTypeBlock             // Query what happened in a block
+	QueryTypeBlockRange        // Query what happened in a range of blocks
+	QueryTypeKey               // Query the key pages and lite accounts of a key
+	QueryTypeOracle            // Query the current, pending, and historical oracle price
+	QueryTypeSimulate          // Simulate the execution of a transaction
+	QueryTypeAnchorProof       // Query a proof that the directory recorded a root anchor
 )
 
 // Enum value maps for QueryType.
 var (
 	QueryTypeName = map[QueryType]string{
-		QueryTypeUnknown:      "QueryTypeUnknown",
-		QueryTypeUrl:          "QueryTypeUrl",
-		QueryTypeChainId:      "QueryTypeChainId",
-		QueryTypeTxId:         "QueryTypeTxId",
-		QueryTypeTxHistory:    "QueryTypeTxHistory",
-		QueryTypeDirectoryUrl: "QueryTypeDirectoryUrl",
-		QueryTypeData:         "QueryTypeData",
-		QueryTypeDataSet:      "QueryTypeDataSet",
-		QueryTypeKeyPageIndex: "QueryTypeKeyPageIndex",
+		QueryTypeUnknown:           "QueryTypeUnknown",
+		QueryTypeUrl:               "QueryTypeUrl",
+		QueryTypeChainId:           "QueryTypeChainId",
+		QueryTypeTxId:              "QueryTypeTxId",
+		QueryTypeTxHistory:         "QueryTypeTxHistory",
+		QueryTypeDirectoryUrl:      "QueryTypeDirectoryUrl",
+		QueryTypeData:              "QueryTypeData",
+		QueryTypeDataSet:           "QueryTypeDataSet",
+		QueryTypeKeyPageIndex:      "QueryTypeKeyPageIndex",
+		QueryTypePendingSignatures: "QueryTypePendingSignatures",
+		QueryTypeSignatureStatus:   "QueryTypeSignatureStatus",
+		QueryTypeProof:             "QueryTypeProof",
+		QueryTypeSyntheticOutbox:   "QueryTypeSyntheticOutbox",
+		QueryTypeBlock:             "QueryTypeBlock",
+		QueryTypeBlockRange:        "QueryTypeBlockRange",
+		QueryTypeKey:               "QueryTypeKey",
+		QueryTypeOracle:            "QueryTypeOracle",
+		QueryTypeSimulate:          "QueryTypeSimulate",
+		QueryTypeAnchorProof:       "QueryTypeAnchorProof",
 	}
 	QueryTypeValue = map[string]QueryType{
-		"QueryTypeUnknown":      QueryTypeUnknown,
-		"QueryTypeUrl":          QueryTypeUrl,
-		"QueryTypeChainId":      QueryTypeChainId,
-		"QueryTypeTxId":         QueryTypeTxId,
-		"QueryTypeTxHistory":    QueryTypeTxHistory,
-		"QueryTypeDirectoryUrl": QueryTypeDirectoryUrl,
-		"QueryTypeData":         QueryTypeData,
-		"QueryTypeDataSet":      QueryTypeDataSet,
-		"QueryTypeKeyPageIndex": QueryTypeKeyPageIndex,
+		"QueryTypeUnknown":           QueryTypeUnknown,
+		"QueryTypeUrl":               QueryTypeUrl,
+		"QueryTypeChainId":           QueryTypeChainId,
+		"QueryTypeTxId":              QueryTypeTxId,
+		"QueryTypeTxHistory":         QueryTypeTxHistory,
+		"QueryTypeDirectoryUrl":      QueryTypeDirectoryUrl,
+		"QueryTypeData":              QueryTypeData,
+		"QueryTypeDataSet":           QueryTypeDataSet,
+		"QueryTypeKeyPageIndex":      QueryTypeKeyPageIndex,
+		"QueryTypePendingSignatures": QueryTypePendingSignatures,
+		"QueryTypeSignatureStatus":   QueryTypeSignatureStatus,
+		"QueryTypeProof":             QueryTypeProof,
+		"QueryTypeSyntheticOutbox":   QueryTypeSyntheticOutbox,
+		"QueryTypeBlock":             QueryTypeBlock,
+		"QueryTypeBlockRange":        QueryTypeBlockRange,
+		"QueryTypeKey":               QueryTypeKey,
+		"QueryTypeOracle":            QueryTypeOracle,
+		"QueryTypeSimulate":          QueryTypeSimulate,
+		"QueryTypeAnchorProof":       QueryTypeAnchorProof,
 	}
 )
 